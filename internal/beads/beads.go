@@ -10,6 +10,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/runtime"
 )
@@ -18,33 +19,70 @@ import (
 // ZFC: Only define errors that don't require stderr parsing for decisions.
 // ErrNotARepo and ErrSyncConflict were removed - agents should handle these directly.
 var (
-	ErrNotInstalled = errors.New("bd not installed: run 'pip install beads-cli' or see https://github.com/anthropics/beads")
-	ErrNotFound     = errors.New("issue not found")
+	ErrNotInstalled  = errors.New("bd not installed: run 'pip install beads-cli' or see https://github.com/anthropics/beads")
+	ErrNotFound      = errors.New("issue not found")
+	ErrAlreadyClosed = errors.New("issue already closed")
+
+	// ErrConcurrentModification is returned by UpdateDescriptionFields when
+	// another writer keeps changing the issue out from under it.
+	ErrConcurrentModification = errors.New("issue was concurrently modified")
+
+	// ErrDuplicateID is returned when bd refuses to create an issue because
+	// its ID collides with an existing (possibly tombstoned) record.
+	ErrDuplicateID = errors.New("issue id already exists")
+
+	// ErrOutOfSync is returned when bd's local database has drifted out of
+	// sync with the routed/remote copy (e.g. after a rebase or a write from
+	// another clone) and needs a resync before the command can proceed.
+	ErrOutOfSync = errors.New("bd database out of sync")
+
+	// ErrDaemonStale is returned when the bd daemon's cached state is stale
+	// and needs to be restarted before the command can proceed.
+	ErrDaemonStale = errors.New("bd daemon is stale")
+
+	// ErrPermission is returned when bd rejects the operation because the
+	// caller lacks permission on the underlying database or repo.
+	ErrPermission = errors.New("permission denied")
+)
+
+// maxDescriptionFieldUpdateAttempts bounds the retry loop in
+// UpdateDescriptionFields before it gives up with ErrConcurrentModification.
+const maxDescriptionFieldUpdateAttempts = 5
+
+// CloseReason is a typed close reason for Close, matching bd's --reason values.
+// Callers can still pass an arbitrary string; these cover the common cases.
+type CloseReason string
+
+const (
+	CloseReasonMerged    CloseReason = "merged"
+	CloseReasonWontfix   CloseReason = "wontfix"
+	CloseReasonDuplicate CloseReason = "duplicate"
+	CloseReasonStale     CloseReason = "stale"
 )
 
 // Issue represents a beads issue.
 type Issue struct {
-	ID          string   `json:"id"`
-	Title       string   `json:"title"`
-	Description string   `json:"description"`
-	Status      string   `json:"status"`
-	Priority    int      `json:"priority"`
-	Type        string   `json:"issue_type"`
-	CreatedAt   string   `json:"created_at"`
-	CreatedBy   string   `json:"created_by,omitempty"`
-	UpdatedAt   string   `json:"updated_at"`
-	ClosedAt    string   `json:"closed_at,omitempty"`
-	Parent      string   `json:"parent,omitempty"`
-	Assignee    string   `json:"assignee,omitempty"`
-	Children    []string `json:"children,omitempty"`
-	DependsOn   []string `json:"depends_on,omitempty"`
-	Blocks      []string `json:"blocks,omitempty"`
-	BlockedBy   []string `json:"blocked_by,omitempty"`
-	Labels      []string `json:"labels,omitempty"`
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Status      string    `json:"status"`
+	Priority    int       `json:"priority"`
+	Type        string    `json:"issue_type"`
+	CreatedAt   Timestamp `json:"created_at"`
+	CreatedBy   string    `json:"created_by,omitempty"`
+	UpdatedAt   Timestamp `json:"updated_at"`
+	ClosedAt    Timestamp `json:"closed_at,omitempty"`
+	Parent      string    `json:"parent,omitempty"`
+	Assignee    string    `json:"assignee,omitempty"`
+	Children    []string  `json:"children,omitempty"`
+	DependsOn   []string  `json:"depends_on,omitempty"`
+	Blocks      []string  `json:"blocks,omitempty"`
+	BlockedBy   []string  `json:"blocked_by,omitempty"`
+	Labels      []string  `json:"labels,omitempty"`
 
 	// Agent bead slots (type=agent only)
 	HookBead   string `json:"hook_bead,omitempty"`   // Current work attached to agent's hook
-	AgentState string `json:"agent_state,omitempty"` // Agent lifecycle state (spawning, working, done, stuck)
+	AgentState string `json:"agent_state,omitempty"` // Agent lifecycle state (spawning, working, done, stuck, needs_attention)
 	// Note: role_bead field removed - role definitions are now config-based
 
 	// Counts from list output
@@ -57,6 +95,29 @@ type Issue struct {
 	Dependents   []IssueDep `json:"dependents,omitempty"`
 }
 
+// Age returns how long ago the issue was created, or zero if bd didn't
+// report a created_at.
+func (i *Issue) Age() time.Duration {
+	if i.CreatedAt.IsZero() {
+		return 0
+	}
+	return time.Since(i.CreatedAt.Time)
+}
+
+// TimeInStatus returns how long the issue has been in its current status:
+// since ClosedAt for a closed issue, since UpdatedAt otherwise. Returns zero
+// if the relevant timestamp wasn't reported.
+func (i *Issue) TimeInStatus() time.Duration {
+	ref := i.UpdatedAt.Time
+	if i.Status == "closed" && !i.ClosedAt.IsZero() {
+		ref = i.ClosedAt.Time
+	}
+	if ref.IsZero() {
+		return 0
+	}
+	return time.Since(ref)
+}
+
 // IssueDep represents a dependency or dependent issue with its relation.
 type IssueDep struct {
 	ID             string `json:"id"`
@@ -114,6 +175,7 @@ type Beads struct {
 	workDir  string
 	beadsDir string // Optional BEADS_DIR override for cross-database access
 	isolated bool   // If true, suppress inherited beads env vars (for test isolation)
+	actor    string // If set, overrides BD_ACTOR in the subprocess environment for every run
 
 	// Lazy-cached town root for routing resolution.
 	// Populated on first call to getTownRoot() to avoid filesystem walk on every operation.
@@ -139,13 +201,29 @@ func NewWithBeadsDir(workDir, beadsDir string) *Beads {
 	return &Beads{workDir: workDir, beadsDir: beadsDir}
 }
 
-// getActor returns the BD_ACTOR value for this context.
-// Returns empty string when in isolated mode (tests) to prevent
+// WithActor sets the identity attributed to every bd invocation made through
+// this wrapper: it's exported as BD_ACTOR in the subprocess environment
+// (overriding whatever the ambient shell has) so writes made by gt's own
+// code - the refinery manager, witness, spawn, rig reset, etc. - show up
+// under the identity performing the action rather than an inherited or
+// stale actor. Returns the receiver so it can be chained onto a constructor
+// call, e.g. beads.New(dir).WithActor("gastown/refinery").
+func (b *Beads) WithActor(actor string) *Beads {
+	b.actor = actor
+	return b
+}
+
+// getActor returns the BD_ACTOR value for this context: the explicit actor
+// set via WithActor if any, otherwise the ambient BD_ACTOR environment
+// variable. Returns empty string when in isolated mode (tests) to prevent
 // inherited actors from routing to production databases.
 func (b *Beads) getActor() string {
 	if b.isolated {
 		return ""
 	}
+	if b.actor != "" {
+		return b.actor
+	}
 	return os.Getenv("BD_ACTOR")
 }
 
@@ -176,6 +254,11 @@ func (b *Beads) Init(prefix string) error {
 	return err
 }
 
+// execCommandFunc constructs the exec.Cmd for a bd invocation. A package
+// variable so tests can substitute a fake and assert on the constructed
+// command, e.g. verifying BD_ACTOR is present in its environment.
+var execCommandFunc = exec.Command
+
 // run executes a bd command and returns stdout.
 func (b *Beads) run(args ...string) ([]byte, error) {
 	// Use --no-daemon for faster read operations (avoids daemon IPC overhead)
@@ -201,7 +284,7 @@ func (b *Beads) run(args ...string) ([]byte, error) {
 		fullArgs = append([]string{"--db", beadsDB}, fullArgs...)
 	}
 
-	cmd := exec.Command("bd", fullArgs...) //nolint:gosec // G204: bd is a trusted internal tool
+	cmd := execCommandFunc("bd", fullArgs...) //nolint:gosec // G204: bd is a trusted internal tool
 	cmd.Dir = b.workDir
 
 	// Build environment: filter beads env vars when in isolated mode (tests)
@@ -214,6 +297,14 @@ func (b *Beads) run(args ...string) ([]byte, error) {
 	}
 	cmd.Env = append(env, "BEADS_DIR="+beadsDir)
 
+	// An explicit actor (set via WithActor) overrides whatever BD_ACTOR the
+	// ambient environment carried, so writes are attributed to the identity
+	// actually performing them rather than an inherited or stale one.
+	// Appended last since a later duplicate key wins over an earlier one.
+	if b.actor != "" {
+		cmd.Env = append(cmd.Env, "BD_ACTOR="+b.actor)
+	}
+
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
@@ -242,8 +333,12 @@ func (b *Beads) Run(args ...string) ([]byte, error) {
 
 // wrapError wraps bd errors with context.
 // ZFC: Avoid parsing stderr to make decisions. Transport errors to agents instead.
-// Exception: ErrNotInstalled (exec.ErrNotFound) and ErrNotFound (issue lookup) are
-// acceptable as they enable basic error handling without decision-making.
+// Exception: ErrNotInstalled (exec.ErrNotFound), ErrNotFound (issue lookup),
+// ErrDuplicateID, ErrOutOfSync, ErrDaemonStale and ErrPermission are acceptable
+// as they enable callers to react to well-known bd failure modes with
+// errors.Is instead of scattering strings.Contains checks over stderr
+// themselves. The last four are wrapped with %w so the original stderr
+// survives in Error() even though the sentinel is what callers match on.
 func (b *Beads) wrapError(err error, stderr string, args []string) error {
 	stderr = strings.TrimSpace(stderr)
 
@@ -259,6 +354,36 @@ func (b *Beads) wrapError(err error, stderr string, args []string) error {
 		return ErrNotFound
 	}
 
+	// ErrAlreadyClosed lets callers treat double-close as idempotent instead
+	// of parsing stderr themselves.
+	if strings.Contains(stderr, "already closed") {
+		return ErrAlreadyClosed
+	}
+
+	// ErrDuplicateID covers bd's sqlite-level rejection of a colliding ID,
+	// including tombstoned beads that block recreation.
+	if strings.Contains(stderr, "UNIQUE constraint") {
+		return fmt.Errorf("bd %s: %w: %s", strings.Join(args, " "), ErrDuplicateID, stderr)
+	}
+
+	// ErrOutOfSync covers bd detecting that its local database has drifted
+	// from the routed copy and needs a resync before the command can proceed.
+	if strings.Contains(stderr, "out of sync") {
+		return fmt.Errorf("bd %s: %w: %s", strings.Join(args, " "), ErrOutOfSync, stderr)
+	}
+
+	// ErrDaemonStale covers bd reporting that its background daemon needs a
+	// restart before it will serve accurate results.
+	if strings.Contains(stderr, "daemon") && strings.Contains(stderr, "stale") {
+		return fmt.Errorf("bd %s: %w: %s", strings.Join(args, " "), ErrDaemonStale, stderr)
+	}
+
+	// ErrPermission covers bd or the underlying filesystem/repo rejecting the
+	// operation for lack of permission.
+	if strings.Contains(stderr, "permission denied") {
+		return fmt.Errorf("bd %s: %w: %s", strings.Join(args, " "), ErrPermission, stderr)
+	}
+
 	if stderr != "" {
 		return fmt.Errorf("bd %s: %s", strings.Join(args, " "), stderr)
 	}
@@ -595,10 +720,96 @@ func (b *Beads) Update(id string, opts UpdateOptions) error {
 	return err
 }
 
-// Close closes one or more issues.
+// BulkUpdate applies the same UpdateOptions to every issue in ids. bd has
+// no native multi-ID update, so this is a sequential loop under the hood;
+// it stops and returns on the first failure, identifying which ID failed,
+// leaving any remaining IDs unmodified.
+func (b *Beads) BulkUpdate(ids []string, opts UpdateOptions) error {
+	for _, id := range ids {
+		if err := b.Update(id, opts); err != nil {
+			return fmt.Errorf("bulk update %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// UpdateDescriptionFields merges set into the issue's "key: value" description
+// fields and drops any keys in remove, using the same line-parsing rules as
+// SetMRFields: only known field keys (the MR field vocabulary, the agent bead
+// field vocabulary, plus whatever keys this call names in set or remove) are
+// treated as structured fields, so free-text lines that happen to contain a
+// colon are left alone. Unlike a plain Show-edit-Update, it guards against two
+// writers racing on the same description: it re-checks the issue's updated_at
+// right before writing and, if another update landed in between, re-reads and
+// re-merges against the fresh description instead of clobbering it. Gives up
+// with ErrConcurrentModification after maxDescriptionFieldUpdateAttempts.
+func (b *Beads) UpdateDescriptionFields(id string, set map[string]string, remove []string) error {
+	for attempt := 0; attempt < maxDescriptionFieldUpdateAttempts; attempt++ {
+		before, err := b.Show(id)
+		if err != nil {
+			return fmt.Errorf("reading issue %s: %w", id, err)
+		}
+
+		newDesc := mergeDescriptionFields(before.Description, set, remove)
+		if newDesc == before.Description {
+			return nil
+		}
+
+		// Bail out and retry with fresh data if someone else wrote to the
+		// issue between our read above and the write below.
+		current, err := b.Show(id)
+		if err != nil {
+			return fmt.Errorf("re-reading issue %s: %w", id, err)
+		}
+		if !current.UpdatedAt.Time.Equal(before.UpdatedAt.Time) {
+			continue
+		}
+
+		if err := b.Update(id, UpdateOptions{Description: &newDesc}); err != nil {
+			return fmt.Errorf("updating issue %s: %w", id, err)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", ErrConcurrentModification, id)
+}
+
+// Close closes a single issue, optionally with a reason (pass "" for none).
+// Returns ErrAlreadyClosed if the issue was already closed, so callers can
+// treat double-close as idempotent instead of parsing stderr themselves.
+// If a runtime session ID is set in the environment, it is passed to bd close
+// for work attribution tracking (see decision 009-session-events-architecture.md).
+func (b *Beads) Close(id, reason string) error {
+	args := []string{"close", id}
+	if reason != "" {
+		args = append(args, "--reason="+reason)
+	}
+
+	// Pass session ID for work attribution if available
+	if sessionID := runtime.SessionIDFromEnv(); sessionID != "" {
+		args = append(args, "--session="+sessionID)
+	}
+
+	_, err := b.run(args...)
+	return err
+}
+
+// Reopen reopens a single closed issue, optionally with a reason (pass ""
+// for none).
+func (b *Beads) Reopen(id, reason string) error {
+	args := []string{"reopen", id}
+	if reason != "" {
+		args = append(args, "--reason="+reason)
+	}
+
+	_, err := b.run(args...)
+	return err
+}
+
+// CloseMany closes one or more issues in a single call, with no reason.
 // If a runtime session ID is set in the environment, it is passed to bd close
 // for work attribution tracking (see decision 009-session-events-architecture.md).
-func (b *Beads) Close(ids ...string) error {
+func (b *Beads) CloseMany(ids ...string) error {
 	if len(ids) == 0 {
 		return nil
 	}
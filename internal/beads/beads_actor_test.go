@@ -0,0 +1,104 @@
+package beads
+
+import (
+	"os/exec"
+	"testing"
+)
+
+func TestWithActor_ChainsAndSetsField(t *testing.T) {
+	b := New(t.TempDir()).WithActor("gastown/refinery")
+	if b.actor != "gastown/refinery" {
+		t.Errorf("actor = %q, want %q", b.actor, "gastown/refinery")
+	}
+}
+
+func TestGetActor_PrefersExplicitActorOverAmbientEnv(t *testing.T) {
+	t.Setenv("BD_ACTOR", "ambient-actor")
+
+	b := New(t.TempDir()).WithActor("explicit-actor")
+	if got := b.getActor(); got != "explicit-actor" {
+		t.Errorf("getActor() = %q, want %q", got, "explicit-actor")
+	}
+}
+
+func TestGetActor_FallsBackToAmbientEnvWhenUnset(t *testing.T) {
+	t.Setenv("BD_ACTOR", "ambient-actor")
+
+	b := New(t.TempDir())
+	if got := b.getActor(); got != "ambient-actor" {
+		t.Errorf("getActor() = %q, want %q", got, "ambient-actor")
+	}
+}
+
+func TestGetActor_IsolatedIgnoresBothExplicitAndAmbient(t *testing.T) {
+	t.Setenv("BD_ACTOR", "ambient-actor")
+
+	b := NewIsolated(t.TempDir())
+	b.actor = "explicit-actor"
+	if got := b.getActor(); got != "" {
+		t.Errorf("getActor() = %q, want empty in isolated mode", got)
+	}
+}
+
+// TestRun_SetsBDActorEnvWhenActorConfigured verifies, via an injectable
+// execCommandFunc, that WithActor's identity actually reaches the bd
+// subprocess's environment rather than just informing the --actor flag on
+// Create.
+func TestRun_SetsBDActorEnvWhenActorConfigured(t *testing.T) {
+	orig := execCommandFunc
+	defer func() { execCommandFunc = orig }()
+
+	var captured *exec.Cmd
+	execCommandFunc = func(name string, arg ...string) *exec.Cmd {
+		cmd := exec.Command("true")
+		captured = cmd
+		return cmd
+	}
+
+	b := New(t.TempDir()).WithActor("gastown/refinery")
+	if _, err := b.run("list"); err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+
+	if captured == nil {
+		t.Fatal("execCommandFunc was never invoked")
+	}
+	if !envContains(captured.Env, "BD_ACTOR=gastown/refinery") {
+		t.Errorf("Env = %v, want it to contain BD_ACTOR=gastown/refinery", captured.Env)
+	}
+}
+
+// TestRun_NoActorConfigured_DoesNotForceBDActor verifies that an unconfigured
+// actor leaves BD_ACTOR to the ambient environment instead of forcing an
+// empty override.
+func TestRun_NoActorConfigured_DoesNotForceBDActor(t *testing.T) {
+	orig := execCommandFunc
+	defer func() { execCommandFunc = orig }()
+
+	t.Setenv("BD_ACTOR", "ambient-actor")
+
+	var captured *exec.Cmd
+	execCommandFunc = func(name string, arg ...string) *exec.Cmd {
+		cmd := exec.Command("true")
+		captured = cmd
+		return cmd
+	}
+
+	b := New(t.TempDir())
+	if _, err := b.run("list"); err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+
+	if !envContains(captured.Env, "BD_ACTOR=ambient-actor") {
+		t.Errorf("Env = %v, want ambient BD_ACTOR=ambient-actor preserved", captured.Env)
+	}
+}
+
+func envContains(env []string, want string) bool {
+	for _, e := range env {
+		if e == want {
+			return true
+		}
+	}
+	return false
+}
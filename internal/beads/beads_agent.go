@@ -6,7 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"os/exec"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // runSlotSet runs `bd slot set` from a specific directory.
@@ -36,15 +38,27 @@ func runSlotClear(workDir, beadID, slotName string) error {
 type AgentFields struct {
 	RoleType          string // polecat, witness, refinery, deacon, mayor
 	Rig               string // Rig name (empty for global agents like mayor/deacon)
-	AgentState        string // spawning, working, done, stuck
+	AgentState        string // spawning, working, done, stuck, needs_attention
 	HookBead          string // Currently pinned work bead ID
 	CleanupStatus     string // ZFC: polecat self-reports git state (clean, has_uncommitted, has_stash, has_unpushed)
 	ActiveMR          string // Currently active merge request bead ID (for traceability)
 	NotificationLevel string // DND mode: verbose, normal, muted (default: normal)
+	FocusMode         string // Focus mode: "on" while active, "" (off) otherwise
+	FocusUntil        string // RFC3339 expiry for focus mode; empty means not focused
+	RecoveryAttempts  int    // Number of witness-initiated session restarts for the current hooked work
+	LastRecoveryAt    string // RFC3339 time of the most recent witness recovery attempt
+	AssignedAt        string // RFC3339 time the agent's current issue was assigned; empty when idle
+	Account           string // Claude account handle this agent's session was spawned under (empty if account-less)
+	LastHeartbeat     string // RFC3339 time of the most recent gt prime/gt heartbeat call; empty if never reported
 	// Note: RoleBead field removed - role definitions are now config-based.
 	// See internal/config/roles/*.toml and config-based-roles.md.
 }
 
+// AgentStateNeedsAttention marks an agent bead whose session died holding
+// hooked work that witness.Recover couldn't safely restart (dirty worktree
+// or recovery attempts exhausted). A human or the mayor must intervene.
+const AgentStateNeedsAttention = "needs_attention"
+
 // Notification level constants
 const (
 	NotifyVerbose = "verbose" // All notifications (mail, convoy events, etc.)
@@ -97,9 +111,100 @@ func FormatAgentDescription(title string, fields *AgentFields) string {
 		lines = append(lines, "notification_level: null")
 	}
 
+	if fields.FocusMode != "" {
+		lines = append(lines, fmt.Sprintf("focus_mode: %s", fields.FocusMode))
+	} else {
+		lines = append(lines, "focus_mode: null")
+	}
+
+	if fields.FocusUntil != "" {
+		lines = append(lines, fmt.Sprintf("focus_until: %s", fields.FocusUntil))
+	} else {
+		lines = append(lines, "focus_until: null")
+	}
+
+	lines = append(lines, fmt.Sprintf("recovery_attempts: %d", fields.RecoveryAttempts))
+
+	if fields.LastRecoveryAt != "" {
+		lines = append(lines, fmt.Sprintf("last_recovery_at: %s", fields.LastRecoveryAt))
+	} else {
+		lines = append(lines, "last_recovery_at: null")
+	}
+
+	if fields.AssignedAt != "" {
+		lines = append(lines, fmt.Sprintf("assigned_at: %s", fields.AssignedAt))
+	} else {
+		lines = append(lines, "assigned_at: null")
+	}
+
+	if fields.Account != "" {
+		lines = append(lines, fmt.Sprintf("account: %s", fields.Account))
+	} else {
+		lines = append(lines, "account: null")
+	}
+
+	if fields.LastHeartbeat != "" {
+		lines = append(lines, fmt.Sprintf("last_heartbeat: %s", fields.LastHeartbeat))
+	} else {
+		lines = append(lines, "last_heartbeat: null")
+	}
+
 	return strings.Join(lines, "\n")
 }
 
+// agentFieldKeys lists the agent bead description field keys (lowercase)
+// that mergeDescriptionFields treats as structured fields rather than free
+// text - the keys agentFieldsToMap can produce, plus role_bead which
+// ParseAgentFields still recognizes (and ignores) for backward compat.
+var agentFieldKeys = map[string]bool{
+	"role_type":          true,
+	"role_bead":          true,
+	"rig":                true,
+	"agent_state":        true,
+	"hook_bead":          true,
+	"cleanup_status":     true,
+	"active_mr":          true,
+	"notification_level": true,
+	"focus_mode":         true,
+	"focus_until":        true,
+	"recovery_attempts":  true,
+	"last_recovery_at":   true,
+	"assigned_at":        true,
+	"account":            true,
+	"last_heartbeat":     true,
+}
+
+// agentFieldsToMap converts fields into the same key/value set that
+// FormatAgentDescription writes, for use with UpdateDescriptionFields.
+// Empty string fields become the literal "null" placeholder (matching
+// FormatAgentDescription) so merging in these fields clears stale values
+// instead of leaving them in place.
+func agentFieldsToMap(fields *AgentFields) map[string]string {
+	orNull := func(s string) string {
+		if s == "" {
+			return "null"
+		}
+		return s
+	}
+
+	return map[string]string{
+		"role_type":          fields.RoleType,
+		"rig":                orNull(fields.Rig),
+		"agent_state":        fields.AgentState,
+		"hook_bead":          orNull(fields.HookBead),
+		"cleanup_status":     orNull(fields.CleanupStatus),
+		"active_mr":          orNull(fields.ActiveMR),
+		"notification_level": orNull(fields.NotificationLevel),
+		"focus_mode":         orNull(fields.FocusMode),
+		"focus_until":        orNull(fields.FocusUntil),
+		"recovery_attempts":  strconv.Itoa(fields.RecoveryAttempts),
+		"last_recovery_at":   orNull(fields.LastRecoveryAt),
+		"assigned_at":        orNull(fields.AssignedAt),
+		"account":            orNull(fields.Account),
+		"last_heartbeat":     orNull(fields.LastHeartbeat),
+	}
+}
+
 // ParseAgentFields extracts agent fields from an issue's description.
 func ParseAgentFields(description string) *AgentFields {
 	fields := &AgentFields{}
@@ -138,6 +243,22 @@ func ParseAgentFields(description string) *AgentFields {
 			fields.ActiveMR = value
 		case "notification_level":
 			fields.NotificationLevel = value
+		case "focus_mode":
+			fields.FocusMode = value
+		case "focus_until":
+			fields.FocusUntil = value
+		case "recovery_attempts":
+			if n, err := parseIntField(value); err == nil {
+				fields.RecoveryAttempts = n
+			}
+		case "last_recovery_at":
+			fields.LastRecoveryAt = value
+		case "assigned_at":
+			fields.AssignedAt = value
+		case "account":
+			fields.Account = value
+		case "last_heartbeat":
+			fields.LastHeartbeat = value
 		}
 	}
 
@@ -228,8 +349,8 @@ func (b *Beads) CreateOrReopenAgentBead(id, title string, fields *AgentFields) (
 		return issue, nil
 	}
 
-	// Check if it's a UNIQUE constraint error
-	if !strings.Contains(err.Error(), "UNIQUE constraint failed") {
+	// Check if it's a duplicate-ID error
+	if !errors.Is(err, ErrDuplicateID) {
 		return nil, err
 	}
 
@@ -238,21 +359,49 @@ func (b *Beads) CreateOrReopenAgentBead(id, title string, fields *AgentFields) (
 
 	// The bead already exists (should be closed from previous polecat lifecycle)
 	// Reopen it and update its fields
-	if _, reopenErr := b.run("reopen", id, "--reason=re-spawning agent"); reopenErr != nil {
+	if reopenErr := b.Reopen(id, "re-spawning agent"); reopenErr != nil {
 		// If reopen fails, the bead might already be open - continue with update
 		if !strings.Contains(reopenErr.Error(), "already open") {
 			return nil, fmt.Errorf("reopening existing agent bead: %w (original error: %v)", reopenErr, err)
 		}
 	}
 
-	// Update the bead with new fields
-	description := FormatAgentDescription(title, fields)
-	updateOpts := UpdateOptions{
-		Title:       &title,
-		Description: &description,
+	// Capture the pre-reopen state and history for the transition log below,
+	// and strip the history block before merging fields: UpdateDescriptionFields
+	// treats any "key: value" line as a field, so it would collapse a
+	// multi-entry history block down to a single line if left in place.
+	before, showErr := b.Show(id)
+	if showErr == nil {
+		stripped := stripAgentHistory(before.Description)
+		if stripped != before.Description {
+			_ = b.Update(id, UpdateOptions{Description: &stripped})
+		}
 	}
-	if err := b.Update(id, updateOpts); err != nil {
-		return nil, fmt.Errorf("updating reopened agent bead: %w", err)
+
+	// Update the bead's title directly, then merge the fields onto its
+	// description through UpdateDescriptionFields rather than overwriting it
+	// outright - a respawn landing at the same moment as, say, a cleanup
+	// status report from the outgoing agent shouldn't lose either write.
+	if err := b.Update(id, UpdateOptions{Title: &title}); err != nil {
+		return nil, fmt.Errorf("updating reopened agent bead title: %w", err)
+	}
+	if err := b.UpdateDescriptionFields(id, agentFieldsToMap(fields), nil); err != nil {
+		return nil, fmt.Errorf("updating reopened agent bead fields: %w", err)
+	}
+
+	// Restore the preserved history and log the reopen transition.
+	if showErr == nil {
+		reopened, err := b.Show(id)
+		if err == nil {
+			description := carryForwardAgentHistory(before.Description, reopened.Description)
+			description = appendAgentHistory(description, AgentHistoryEntry{
+				At:    time.Now(),
+				From:  before.AgentState,
+				To:    fields.AgentState,
+				Actor: b.getActor(),
+			})
+			_ = b.Update(id, UpdateOptions{Description: &description})
+		}
 	}
 
 	// Note: role slot no longer set - role definitions are config-based
@@ -284,7 +433,17 @@ func (b *Beads) CreateOrReopenAgentBead(id, title string, fields *AgentFields) (
 // This ensures consistency with `bd slot show` and other beads commands.
 // Previously, this function embedded these fields in the description text,
 // which caused inconsistencies with bd slot commands (see GH #gt-9v52).
+//
+// It also appends a bounded transition-history line to the description (see
+// appendAgentHistory) recording old state -> new state and the actor. This
+// is a separate, additive audit trail, not a synced state field, so it
+// doesn't reintroduce the gt-9v52 class of bug.
 func (b *Beads) UpdateAgentState(id string, state string, hookBead *string) error {
+	// Best-effort: fetch the pre-transition issue so we can log a history
+	// entry after the state change succeeds. Failure here shouldn't block
+	// the actual state update.
+	before, showErr := b.Show(id)
+
 	// Update agent state using bd agent state command
 	// This updates the agent_state column directly in SQLite
 	_, err := b.run("agent", "state", id, state)
@@ -292,6 +451,16 @@ func (b *Beads) UpdateAgentState(id string, state string, hookBead *string) erro
 		return fmt.Errorf("updating agent state: %w", err)
 	}
 
+	if showErr == nil && before.AgentState != state {
+		description := appendAgentHistory(before.Description, AgentHistoryEntry{
+			At:    time.Now(),
+			From:  before.AgentState,
+			To:    state,
+			Actor: b.getActor(),
+		})
+		_ = b.Update(id, UpdateOptions{Description: &description})
+	}
+
 	// Update hook_bead if provided
 	if hookBead != nil {
 		if *hookBead != "" {
@@ -394,6 +563,75 @@ func (b *Beads) UpdateAgentActiveMR(id string, activeMR string) error {
 	return b.Update(id, UpdateOptions{Description: &description})
 }
 
+// UpdateAgentAccount records which Claude account handle an agent's session
+// was spawned under, so callers can tally active sessions per account
+// (e.g. for round-robin/least-used spawn allocation) without needing a
+// separate tracking file.
+func (b *Beads) UpdateAgentAccount(id string, account string) error {
+	issue, err := b.Show(id)
+	if err != nil {
+		return err
+	}
+
+	fields := ParseAgentFields(issue.Description)
+	fields.Account = account
+
+	description := FormatAgentDescription(issue.Title, fields)
+	return b.Update(id, UpdateOptions{Description: &description})
+}
+
+// UpdateAgentHeartbeat records that an agent is alive and responsive, as
+// opposed to merely holding a tmux pane open. gt prime calls this on every
+// role detection pass, and gt heartbeat lets hooks call it between primes,
+// so StaleAgents can tell "Claude is stuck in a tool loop" apart from
+// "session exists." Uses UpdateDescriptionFields so a heartbeat write can't
+// clobber a concurrent field update from the same agent (e.g. cleanup
+// status reported right as the session exits).
+func (b *Beads) UpdateAgentHeartbeat(id string, at time.Time) error {
+	return b.UpdateDescriptionFields(id, map[string]string{
+		"last_heartbeat": at.UTC().Format(time.RFC3339),
+	}, nil)
+}
+
+// UpdateAgentRecovery records a witness-initiated session recovery attempt
+// against an agent bead: the running attempt count and when it happened.
+// Used by witness.Recover so repeated crashes of the same hooked work don't
+// restart forever - once attempts are exhausted the caller escalates to the
+// mayor instead of retrying.
+func (b *Beads) UpdateAgentRecovery(id string, attempts int, at time.Time) error {
+	issue, err := b.Show(id)
+	if err != nil {
+		return err
+	}
+
+	fields := ParseAgentFields(issue.Description)
+	fields.RecoveryAttempts = attempts
+	fields.LastRecoveryAt = at.UTC().Format(time.RFC3339)
+
+	description := FormatAgentDescription(issue.Title, fields)
+	return b.Update(id, UpdateOptions{Description: &description})
+}
+
+// UpdateAgentAssignedAt records when the agent's currently hooked issue was
+// assigned, so callers can compute elapsed time without also reading the
+// issue bead. Pass a zero time.Time to clear it (e.g. on done/close).
+func (b *Beads) UpdateAgentAssignedAt(id string, at time.Time) error {
+	issue, err := b.Show(id)
+	if err != nil {
+		return err
+	}
+
+	fields := ParseAgentFields(issue.Description)
+	if at.IsZero() {
+		fields.AssignedAt = ""
+	} else {
+		fields.AssignedAt = at.UTC().Format(time.RFC3339)
+	}
+
+	description := FormatAgentDescription(issue.Title, fields)
+	return b.Update(id, UpdateOptions{Description: &description})
+}
+
 // UpdateAgentNotificationLevel updates the notification_level field in an agent bead.
 // Valid levels: verbose, normal, muted (DND mode).
 // Pass empty string to reset to default (normal).
@@ -435,6 +673,64 @@ func (b *Beads) GetAgentNotificationLevel(id string) (string, error) {
 	return fields.NotificationLevel, nil
 }
 
+// DefaultFocusDuration bounds how long focus mode lasts when `gt mail focus
+// on` is run without --until. Mail check --inject runs constantly, so a
+// forgotten focus session would otherwise silently swallow mail forever if
+// the polecat crashed or was nuked before turning it back off.
+const DefaultFocusDuration = 30 * time.Minute
+
+// UpdateAgentFocus sets or clears focus mode on an agent bead. When on is
+// true, until is the absolute time focus expires; IsAgentFocused treats an
+// expired focus window as off without requiring anyone to clear it.
+func (b *Beads) UpdateAgentFocus(id string, on bool, until time.Time) error {
+	issue, err := b.Show(id)
+	if err != nil {
+		return err
+	}
+
+	fields := ParseAgentFields(issue.Description)
+	if on {
+		fields.FocusMode = "on"
+		fields.FocusUntil = until.UTC().Format(time.RFC3339)
+	} else {
+		fields.FocusMode = ""
+		fields.FocusUntil = ""
+	}
+
+	description := FormatAgentDescription(issue.Title, fields)
+	return b.Update(id, UpdateOptions{Description: &description})
+}
+
+// IsAgentFocused returns whether focus mode is currently active for an
+// agent bead, and the time it's set to expire. A missing bead or an expired
+// window both report focused=false, so a crashed session can't leave focus
+// stuck on forever.
+func (b *Beads) IsAgentFocused(id string) (focused bool, until time.Time, err error) {
+	_, fields, err := b.GetAgentBead(id)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	if fields == nil {
+		return false, time.Time{}, nil
+	}
+	return EvaluateFocus(fields, time.Now())
+}
+
+// EvaluateFocus is the pure decision logic behind IsAgentFocused, split out
+// so expiry can be tested without a real beads database.
+func EvaluateFocus(fields *AgentFields, now time.Time) (focused bool, until time.Time, err error) {
+	if fields == nil || fields.FocusMode != "on" || fields.FocusUntil == "" {
+		return false, time.Time{}, nil
+	}
+
+	until, err = time.Parse(time.RFC3339, fields.FocusUntil)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("parsing focus_until %q: %w", fields.FocusUntil, err)
+	}
+
+	return now.Before(until), until, nil
+}
+
 // DeleteAgentBead permanently deletes an agent bead.
 // Uses --hard --force for immediate permanent deletion (no tombstone).
 //
@@ -467,12 +763,7 @@ func (b *Beads) CloseAndClearAgentBead(id, reason string) error {
 	issue, err := b.Show(id)
 	if err != nil {
 		// If we can't read the issue, still attempt to close
-		args := []string{"close", id}
-		if reason != "" {
-			args = append(args, "--reason="+reason)
-		}
-		_, closeErr := b.run(args...)
-		return closeErr
+		return b.Close(id, reason)
 	}
 
 	// Parse existing fields and clear mutable ones
@@ -482,8 +773,17 @@ func (b *Beads) CloseAndClearAgentBead(id, reason string) error {
 	fields.CleanupStatus = "" // Clear cleanup_status
 	fields.AgentState = "closed"
 
-	// Update description with cleared fields
-	description := FormatAgentDescription(issue.Title, fields)
+	// Update description with cleared fields. FormatAgentDescription
+	// regenerates the description from scratch, so carry the existing
+	// transition-history block forward and log this transition before
+	// writing it back.
+	description := carryForwardAgentHistory(issue.Description, FormatAgentDescription(issue.Title, fields))
+	description = appendAgentHistory(description, AgentHistoryEntry{
+		At:    time.Now(),
+		From:  issue.AgentState,
+		To:    "closed",
+		Actor: b.getActor(),
+	})
 	if err := b.Update(id, UpdateOptions{Description: &description}); err != nil {
 		// Non-fatal: continue with close even if update fails
 	}
@@ -493,12 +793,7 @@ func (b *Beads) CloseAndClearAgentBead(id, reason string) error {
 		// Non-fatal
 	}
 
-	args := []string{"close", id}
-	if reason != "" {
-		args = append(args, "--reason="+reason)
-	}
-	_, err = b.run(args...)
-	return err
+	return b.Close(id, reason)
 }
 
 // GetAgentBead retrieves an agent bead by ID.
@@ -520,6 +815,80 @@ func (b *Beads) GetAgentBead(id string) (*Issue, *AgentFields, error) {
 	return issue, fields, nil
 }
 
+// CountSessionsByAccount tallies active agent sessions per Claude account
+// handle, by scanning agent beads' Account field. Beads with no account
+// recorded (spawned before an account was resolved, or account-less setups)
+// are omitted. Used to pick the least-loaded account for spawn allocation.
+func (b *Beads) CountSessionsByAccount() (map[string]int, error) {
+	issues, err := b.ListAgentBeads()
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int)
+	for _, issue := range issues {
+		fields := ParseAgentFields(issue.Description)
+		if fields.Account == "" {
+			continue
+		}
+		counts[fields.Account]++
+	}
+	return counts, nil
+}
+
+// StaleAgents returns agent beads that claim to be running (open, with an
+// agent_state other than "closed") but whose last_heartbeat is older than
+// maxAge, or missing entirely. now is passed in explicitly so callers (and
+// tests) can drive this off a fake clock instead of time.Now().
+//
+// An agent that never reported a heartbeat at all (e.g. one spawned before
+// heartbeats existed, or one whose first prime hasn't run yet) is treated
+// as stale rather than skipped - "unknown" liveness shouldn't be silently
+// trusted.
+func (b *Beads) StaleAgents(maxAge time.Duration, now time.Time) ([]*Issue, error) {
+	issues, err := b.ListAgentBeads()
+	if err != nil {
+		return nil, err
+	}
+
+	var stale []*Issue
+	for _, issue := range issues {
+		fields := ParseAgentFields(issue.Description)
+		if AgentClaimsStale(issue, fields, maxAge, now) {
+			stale = append(stale, issue)
+		}
+	}
+
+	return stale, nil
+}
+
+// AgentClaimsStale reports whether an agent bead claims to be running (open,
+// with an agent_state other than "closed") but its last_heartbeat is older
+// than maxAge, or missing entirely. Split out from StaleAgents so callers
+// that already have issues in hand (e.g. witness patrol, which lists agent
+// beads scoped to a single rig) can apply the same rule without a second
+// query. now is passed in explicitly so callers and tests can drive this
+// off a fake clock instead of time.Now().
+func AgentClaimsStale(issue *Issue, fields *AgentFields, maxAge time.Duration, now time.Time) bool {
+	if issue.Status != "open" {
+		return false
+	}
+	if fields.AgentState == "" || fields.AgentState == "closed" {
+		return false
+	}
+
+	if fields.LastHeartbeat == "" {
+		return true
+	}
+
+	last, err := time.Parse(time.RFC3339, fields.LastHeartbeat)
+	if err != nil {
+		return true
+	}
+
+	return now.Sub(last) > maxAge
+}
+
 // ListAgentBeads returns all agent beads in a single query.
 // Returns a map of agent bead ID to Issue.
 func (b *Beads) ListAgentBeads() (map[string]*Issue, error) {
@@ -0,0 +1,147 @@
+package beads
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// agentHistoryCap bounds how many transitions an agent bead's description
+// keeps. Without a cap, a long-lived polecat cycling through
+// spawning/working/done many times over its life would grow the
+// description without bound.
+const agentHistoryCap = 20
+
+// historyLinePrefix marks a transition-history line in an agent bead's
+// description, distinct from the "key: value" AgentFields lines that
+// ParseAgentFields reads. It deliberately doesn't round-trip through
+// mergeDescriptionFields (see splitAgentHistory) since that function
+// dedupes same-key lines and would collapse the whole block into one entry.
+const historyLinePrefix = "history: "
+
+// AgentHistoryEntry records one agent_state transition on an agent bead.
+type AgentHistoryEntry struct {
+	At    time.Time
+	From  string // empty for the bead's first recorded transition
+	To    string
+	Actor string // empty if BD_ACTOR wasn't set
+}
+
+// formatHistoryLine renders one history line for appendAgentHistory. Empty
+// From/Actor are written as "-" so ParseAgentHistory can round-trip them
+// without ambiguity against a genuinely empty field.
+func formatHistoryLine(e AgentHistoryEntry) string {
+	from := e.From
+	if from == "" {
+		from = "-"
+	}
+	actor := e.Actor
+	if actor == "" {
+		actor = "-"
+	}
+	return fmt.Sprintf("%s%s %s->%s %s", historyLinePrefix, e.At.UTC().Format(time.RFC3339), from, e.To, actor)
+}
+
+// ParseAgentHistory extracts the transition-history entries from an agent
+// bead's description, in the order they were recorded (oldest first).
+// Malformed lines are skipped rather than failing the whole parse.
+func ParseAgentHistory(description string) []AgentHistoryEntry {
+	var entries []AgentHistoryEntry
+
+	for _, line := range strings.Split(description, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, historyLinePrefix) {
+			continue
+		}
+
+		fields := strings.Fields(strings.TrimPrefix(trimmed, historyLinePrefix))
+		if len(fields) < 2 {
+			continue
+		}
+
+		at, err := time.Parse(time.RFC3339, fields[0])
+		if err != nil {
+			continue
+		}
+
+		from, to, ok := strings.Cut(fields[1], "->")
+		if !ok {
+			continue
+		}
+		if from == "-" {
+			from = ""
+		}
+
+		actor := strings.Join(fields[2:], " ")
+		if actor == "-" {
+			actor = ""
+		}
+
+		entries = append(entries, AgentHistoryEntry{At: at, From: from, To: to, Actor: actor})
+	}
+
+	return entries
+}
+
+// splitAgentHistory separates description into its non-history content
+// (trailing blank lines trimmed) and its raw history lines, in the order
+// they appear.
+func splitAgentHistory(description string) (kept string, historyLines []string) {
+	var keptLines []string
+	for _, line := range strings.Split(description, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), historyLinePrefix) {
+			historyLines = append(historyLines, line)
+		} else {
+			keptLines = append(keptLines, line)
+		}
+	}
+
+	for len(keptLines) > 0 && strings.TrimSpace(keptLines[len(keptLines)-1]) == "" {
+		keptLines = keptLines[:len(keptLines)-1]
+	}
+
+	return strings.Join(keptLines, "\n"), historyLines
+}
+
+// stripAgentHistory removes the history block from description entirely.
+// Used before a description update path that isn't history-aware (like
+// UpdateDescriptionFields's generic key:value merge) so it never sees the
+// history lines and can't corrupt them.
+func stripAgentHistory(description string) string {
+	kept, _ := splitAgentHistory(description)
+	return kept
+}
+
+// carryForwardAgentHistory copies the history block from oldDescription onto
+// the end of newBaseDescription. Used when a description is regenerated from
+// scratch (FormatAgentDescription) so the rewrite doesn't silently drop
+// history it doesn't know about.
+func carryForwardAgentHistory(oldDescription, newBaseDescription string) string {
+	_, historyLines := splitAgentHistory(oldDescription)
+	if len(historyLines) == 0 {
+		return newBaseDescription
+	}
+
+	base := strings.TrimRight(newBaseDescription, "\n")
+	if base == "" {
+		return strings.Join(historyLines, "\n")
+	}
+	return base + "\n" + strings.Join(historyLines, "\n")
+}
+
+// appendAgentHistory appends entry to description's transition-history
+// block, truncating to the most recent agentHistoryCap entries so the
+// description doesn't grow forever.
+func appendAgentHistory(description string, entry AgentHistoryEntry) string {
+	kept, historyLines := splitAgentHistory(description)
+
+	historyLines = append(historyLines, formatHistoryLine(entry))
+	if len(historyLines) > agentHistoryCap {
+		historyLines = historyLines[len(historyLines)-agentHistoryCap:]
+	}
+
+	if kept == "" {
+		return strings.Join(historyLines, "\n")
+	}
+	return kept + "\n" + strings.Join(historyLines, "\n")
+}
@@ -0,0 +1,99 @@
+package beads
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestAppendAgentHistory_AddsEntry(t *testing.T) {
+	description := "role_type: polecat\nagent_state: working\n"
+	at := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	got := appendAgentHistory(description, AgentHistoryEntry{At: at, From: "spawning", To: "working", Actor: "witness"})
+
+	entries := ParseAgentHistory(got)
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want 1:\n%s", len(entries), got)
+	}
+	if !entries[0].At.Equal(at) || entries[0].From != "spawning" || entries[0].To != "working" || entries[0].Actor != "witness" {
+		t.Errorf("entry = %+v, want {%v spawning working witness}", entries[0], at)
+	}
+}
+
+func TestAppendAgentHistory_TruncatesAtCap(t *testing.T) {
+	description := "role_type: polecat\n"
+	for i := 0; i < agentHistoryCap+5; i++ {
+		at := time.Date(2026, 1, 1, 0, 0, i, 0, time.UTC)
+		description = appendAgentHistory(description, AgentHistoryEntry{At: at, From: fmt.Sprintf("s%d", i), To: fmt.Sprintf("s%d", i+1)})
+	}
+
+	entries := ParseAgentHistory(description)
+	if len(entries) != agentHistoryCap {
+		t.Fatalf("got %d entries, want %d (bounded)", len(entries), agentHistoryCap)
+	}
+
+	// The oldest entries should have been dropped, keeping only the most
+	// recent agentHistoryCap.
+	wantFirstFrom := fmt.Sprintf("s%d", 5)
+	if entries[0].From != wantFirstFrom {
+		t.Errorf("oldest surviving entry From = %q, want %q", entries[0].From, wantFirstFrom)
+	}
+	wantLastTo := fmt.Sprintf("s%d", agentHistoryCap+5)
+	if entries[len(entries)-1].To != wantLastTo {
+		t.Errorf("newest entry To = %q, want %q", entries[len(entries)-1].To, wantLastTo)
+	}
+}
+
+func TestParseAgentHistory_RoundTrip(t *testing.T) {
+	want := []AgentHistoryEntry{
+		{At: time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC), From: "", To: "spawning", Actor: ""},
+		{At: time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC), From: "spawning", To: "working", Actor: "witness"},
+		{At: time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC), From: "working", To: "closed", Actor: "mayor"},
+	}
+
+	description := "role_type: polecat\nagent_state: closed\n"
+	for _, e := range want {
+		description = appendAgentHistory(description, e)
+	}
+
+	got := ParseAgentHistory(description)
+	if len(got) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(got), len(want))
+	}
+	for i, e := range want {
+		if !got[i].At.Equal(e.At) || got[i].From != e.From || got[i].To != e.To || got[i].Actor != e.Actor {
+			t.Errorf("entry %d = %+v, want %+v", i, got[i], e)
+		}
+	}
+}
+
+func TestCarryForwardAgentHistory_PreservesAcrossRewrite(t *testing.T) {
+	old := appendAgentHistory("role_type: polecat\nagent_state: working\n",
+		AgentHistoryEntry{At: time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC), From: "spawning", To: "working"})
+
+	newBase := "role_type: polecat\nagent_state: closed\n"
+	got := carryForwardAgentHistory(old, newBase)
+
+	entries := ParseAgentHistory(got)
+	if len(entries) != 1 || entries[0].To != "working" {
+		t.Fatalf("carryForwardAgentHistory dropped history: %v", entries)
+	}
+	if fields := ParseAgentFields(got); fields.AgentState != "closed" {
+		t.Errorf("AgentState = %q, want closed (should keep the new base fields)", fields.AgentState)
+	}
+}
+
+func TestStripAgentHistory_RemovesHistoryLines(t *testing.T) {
+	description := appendAgentHistory("role_type: polecat\n",
+		AgentHistoryEntry{At: time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC), To: "working"})
+
+	got := stripAgentHistory(description)
+
+	if len(ParseAgentHistory(got)) != 0 {
+		t.Errorf("stripAgentHistory left history lines: %q", got)
+	}
+	if fields := ParseAgentFields(got); fields.RoleType != "polecat" {
+		t.Errorf("stripAgentHistory dropped unrelated fields: %q", got)
+	}
+}
@@ -0,0 +1,186 @@
+package beads
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEvaluateFocus(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		fields      *AgentFields
+		wantFocused bool
+	}{
+		{
+			name:        "nil fields",
+			fields:      nil,
+			wantFocused: false,
+		},
+		{
+			name:        "focus mode off",
+			fields:      &AgentFields{FocusMode: "", FocusUntil: now.Add(time.Hour).Format(time.RFC3339)},
+			wantFocused: false,
+		},
+		{
+			name:        "focus mode on, not yet expired",
+			fields:      &AgentFields{FocusMode: "on", FocusUntil: now.Add(time.Hour).Format(time.RFC3339)},
+			wantFocused: true,
+		},
+		{
+			name:        "focus mode on, expired - self-heals to off",
+			fields:      &AgentFields{FocusMode: "on", FocusUntil: now.Add(-time.Minute).Format(time.RFC3339)},
+			wantFocused: false,
+		},
+		{
+			name:        "focus mode on, no expiry set",
+			fields:      &AgentFields{FocusMode: "on", FocusUntil: ""},
+			wantFocused: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			focused, _, err := EvaluateFocus(tt.fields, now)
+			if err != nil {
+				t.Fatalf("EvaluateFocus() error = %v", err)
+			}
+			if focused != tt.wantFocused {
+				t.Errorf("EvaluateFocus() focused = %v, want %v", focused, tt.wantFocused)
+			}
+		})
+	}
+}
+
+func TestEvaluateFocus_UnparseableExpiry(t *testing.T) {
+	fields := &AgentFields{FocusMode: "on", FocusUntil: "not-a-timestamp"}
+	focused, _, err := EvaluateFocus(fields, time.Now())
+	if err == nil {
+		t.Fatal("EvaluateFocus() expected error for unparseable focus_until, got nil")
+	}
+	if focused {
+		t.Error("EvaluateFocus() should report unfocused when focus_until can't be parsed")
+	}
+}
+
+func TestAgentClaimsStale(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	maxAge := 20 * time.Minute
+
+	tests := []struct {
+		name   string
+		issue  *Issue
+		fields *AgentFields
+		want   bool
+	}{
+		{
+			name:   "closed bead is not stale regardless of heartbeat",
+			issue:  &Issue{Status: "closed"},
+			fields: &AgentFields{AgentState: "idle", LastHeartbeat: now.Add(-2 * time.Hour).Format(time.RFC3339)},
+			want:   false,
+		},
+		{
+			name:   "agent_state closed is not stale",
+			issue:  &Issue{Status: "open"},
+			fields: &AgentFields{AgentState: "closed", LastHeartbeat: ""},
+			want:   false,
+		},
+		{
+			name:   "empty agent_state is not stale (not claiming to run)",
+			issue:  &Issue{Status: "open"},
+			fields: &AgentFields{AgentState: "", LastHeartbeat: ""},
+			want:   false,
+		},
+		{
+			name:   "running with no heartbeat ever reported is stale",
+			issue:  &Issue{Status: "open"},
+			fields: &AgentFields{AgentState: "idle", LastHeartbeat: ""},
+			want:   true,
+		},
+		{
+			name:   "running with a fresh heartbeat is not stale",
+			issue:  &Issue{Status: "open"},
+			fields: &AgentFields{AgentState: "idle", LastHeartbeat: now.Add(-5 * time.Minute).Format(time.RFC3339)},
+			want:   false,
+		},
+		{
+			name:   "running with an old heartbeat is stale",
+			issue:  &Issue{Status: "open"},
+			fields: &AgentFields{AgentState: "idle", LastHeartbeat: now.Add(-30 * time.Minute).Format(time.RFC3339)},
+			want:   true,
+		},
+		{
+			name:   "unparseable heartbeat is treated as stale",
+			issue:  &Issue{Status: "open"},
+			fields: &AgentFields{AgentState: "idle", LastHeartbeat: "not-a-timestamp"},
+			want:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AgentClaimsStale(tt.issue, tt.fields, maxAge, now)
+			if got != tt.want {
+				t.Errorf("AgentClaimsStale() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatAndParseAgentFields_Focus(t *testing.T) {
+	fields := &AgentFields{
+		RoleType:   "polecat",
+		AgentState: "working",
+		FocusMode:  "on",
+		FocusUntil: "2026-01-01T12:30:00Z",
+	}
+
+	description := FormatAgentDescription("Agent: Toast", fields)
+	parsed := ParseAgentFields(description)
+
+	if parsed.FocusMode != "on" {
+		t.Errorf("FocusMode = %q, want %q", parsed.FocusMode, "on")
+	}
+	if parsed.FocusUntil != "2026-01-01T12:30:00Z" {
+		t.Errorf("FocusUntil = %q, want %q", parsed.FocusUntil, "2026-01-01T12:30:00Z")
+	}
+}
+
+func TestFormatAgentDescription_FocusOff(t *testing.T) {
+	fields := &AgentFields{RoleType: "polecat", AgentState: "working"}
+	description := FormatAgentDescription("Agent: Toast", fields)
+	parsed := ParseAgentFields(description)
+
+	if parsed.FocusMode != "" {
+		t.Errorf("FocusMode = %q, want empty (off)", parsed.FocusMode)
+	}
+	if parsed.FocusUntil != "" {
+		t.Errorf("FocusUntil = %q, want empty", parsed.FocusUntil)
+	}
+}
+
+func TestFormatAndParseAgentFields_AssignedAt(t *testing.T) {
+	fields := &AgentFields{
+		RoleType:   "polecat",
+		AgentState: "working",
+		AssignedAt: "2026-01-01T12:00:00Z",
+	}
+
+	description := FormatAgentDescription("Agent: Toast", fields)
+	parsed := ParseAgentFields(description)
+
+	if parsed.AssignedAt != "2026-01-01T12:00:00Z" {
+		t.Errorf("AssignedAt = %q, want %q", parsed.AssignedAt, "2026-01-01T12:00:00Z")
+	}
+}
+
+func TestFormatAgentDescription_AssignedAtEmpty(t *testing.T) {
+	fields := &AgentFields{RoleType: "polecat", AgentState: "done"}
+	description := FormatAgentDescription("Agent: Toast", fields)
+	parsed := ParseAgentFields(description)
+
+	if parsed.AssignedAt != "" {
+		t.Errorf("AssignedAt = %q, want empty", parsed.AssignedAt)
+	}
+}
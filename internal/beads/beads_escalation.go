@@ -336,12 +336,11 @@ func (b *Beads) ListStaleEscalations(threshold time.Duration) ([]*Issue, error)
 		}
 
 		// Check if older than threshold
-		createdAt, err := time.Parse(time.RFC3339, issue.CreatedAt)
-		if err != nil {
-			continue // Skip if can't parse
+		if issue.CreatedAt.IsZero() {
+			continue // Skip if bd didn't report a created_at
 		}
 
-		if createdAt.Before(cutoff) {
+		if issue.CreatedAt.Before(cutoff) {
 			stale = append(stale, issue)
 		}
 	}
@@ -4,6 +4,7 @@ package beads
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 // FindMRForBranch searches for an existing merge-request bead for the given branch.
@@ -31,6 +32,133 @@ func (b *Beads) FindMRForBranch(branch string) (*Issue, error) {
 	return nil, nil
 }
 
+// FindActiveRejection searches closed merge-request beads for an unexpired
+// manual rejection of the given branch. Returns the rejected MR bead if one
+// is found within expiryDays of its rejection, nil if the branch was never
+// rejected or its rejection has aged out. Used by `gt done`/`gt mq submit`
+// to stop a rejected branch from being resubmitted as a fresh MR before the
+// worker addresses the rejection reason.
+func (b *Beads) FindActiveRejection(branch string, expiryDays int) (*Issue, error) {
+	issues, err := b.List(ListOptions{
+		Status: "closed",
+		Label:  "gt:merge-request",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, issue := range issues {
+		fields := ParseMRFields(issue)
+		if isRejectionActive(fields, branch, expiryDays, now) {
+			return issue, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// isRejectionActive reports whether fields describes an unexpired rejection
+// of branch as of now. Split out from FindActiveRejection so the expiry
+// decision can be unit tested without a real beads database.
+func isRejectionActive(fields *MRFields, branch string, expiryDays int, now time.Time) bool {
+	if fields == nil || fields.Branch != branch || fields.RejectedAt == "" {
+		return false
+	}
+	rejectedAt, err := time.Parse(time.RFC3339, fields.RejectedAt)
+	if err != nil {
+		return false // unparseable timestamp - treat as not tracked
+	}
+	cutoff := now.AddDate(0, 0, -expiryDays)
+	return rejectedAt.After(cutoff)
+}
+
+// UnrejectMR lifts an active rejection on the given branch, letting `gt done`
+// / `gt mq submit` create a fresh MR bead for it again. The rejected bead
+// stays closed (closed MRs are immutable) - only its RejectedAt marker is
+// cleared, so FindActiveRejection stops matching it.
+// Returns the bead that was unrejected, or nil if the branch had no active
+// rejection.
+func (b *Beads) UnrejectMR(branch string, expiryDays int) (*Issue, error) {
+	issue, err := b.FindActiveRejection(branch, expiryDays)
+	if err != nil {
+		return nil, err
+	}
+	if issue == nil {
+		return nil, nil
+	}
+
+	fields := ParseMRFields(issue)
+	fields.RejectedAt = ""
+	if err := b.UpdateMRFields(issue.ID, fields); err != nil {
+		return nil, fmt.Errorf("clearing rejection: %w", err)
+	}
+	return issue, nil
+}
+
+// ApproveMRReview records a witness sign-off on a merge-request bead, letting
+// ProcessMR proceed past a merge_queue.require_review gate. Clears any
+// pending review-rejection so the MR reads as approved, not still-in-review.
+func (b *Beads) ApproveMRReview(id, reviewer string) (*Issue, error) {
+	issue, err := b.Show(id)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := ParseMRFields(issue)
+	if fields == nil {
+		fields = &MRFields{}
+	}
+	fields.ReviewedBy = reviewer
+	fields.ReviewedAt = time.Now().UTC().Format(time.RFC3339)
+	fields.ReviewRejectReason = ""
+	fields.ReviewRejectedAt = ""
+
+	if err := b.UpdateMRFields(id, fields); err != nil {
+		return nil, fmt.Errorf("recording review approval: %w", err)
+	}
+	return issue, nil
+}
+
+// RequestMRChanges records a witness review rejection on a merge-request
+// bead, sending it back for rework without closing it (unlike RejectMR,
+// which permanently rejects the branch). Clears any prior approval so
+// ProcessMR's require_review gate blocks it again until re-reviewed.
+func (b *Beads) RequestMRChanges(id, reviewer, reason string) (*Issue, error) {
+	issue, err := b.Show(id)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := ParseMRFields(issue)
+	if fields == nil {
+		fields = &MRFields{}
+	}
+	fields.ReviewedBy = ""
+	fields.ReviewedAt = ""
+	fields.ReviewRejectReason = reason
+	fields.ReviewRejectedAt = time.Now().UTC().Format(time.RFC3339)
+
+	if err := b.UpdateMRFields(id, fields); err != nil {
+		return nil, fmt.Errorf("recording review rejection: %w", err)
+	}
+	return issue, nil
+}
+
+// UpdateMRFields writes/refreshes the structured MRFields on an existing
+// merge-request bead, preserving any other description content. Used by
+// `gt done` to keep an idempotent MR bead's branch/target/worker/rig fields
+// current when a polecat re-runs done against the same branch.
+func (b *Beads) UpdateMRFields(id string, fields *MRFields) error {
+	issue, err := b.Show(id)
+	if err != nil {
+		return err
+	}
+
+	description := SetMRFields(issue, fields)
+	return b.Update(id, UpdateOptions{Description: &description})
+}
+
 // AddGateWaiter registers an agent as a waiter on a gate bead.
 // When the gate closes, the waiter will receive a wake notification via gt gate wake.
 // The waiter is typically the polecat's address (e.g., "gastown/polecats/Toast").
@@ -0,0 +1,175 @@
+package beads
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsRejectionActive(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		fields     *MRFields
+		branch     string
+		expiryDays int
+		want       bool
+	}{
+		{
+			name:       "nil fields",
+			fields:     nil,
+			branch:     "polecat/Nux/gt-xyz",
+			expiryDays: 7,
+			want:       false,
+		},
+		{
+			name:       "rejected today - rediscover skip",
+			fields:     &MRFields{Branch: "polecat/Nux/gt-xyz", RejectedAt: now.Add(-time.Hour).Format(time.RFC3339)},
+			branch:     "polecat/Nux/gt-xyz",
+			expiryDays: 7,
+			want:       true,
+		},
+		{
+			name:       "rejected 10 days ago with 7 day expiry - rediscover include",
+			fields:     &MRFields{Branch: "polecat/Nux/gt-xyz", RejectedAt: now.AddDate(0, 0, -10).Format(time.RFC3339)},
+			branch:     "polecat/Nux/gt-xyz",
+			expiryDays: 7,
+			want:       false,
+		},
+		{
+			name:       "different branch not matched",
+			fields:     &MRFields{Branch: "polecat/Nux/gt-other", RejectedAt: now.Format(time.RFC3339)},
+			branch:     "polecat/Nux/gt-xyz",
+			expiryDays: 7,
+			want:       false,
+		},
+		{
+			name:       "no rejection recorded",
+			fields:     &MRFields{Branch: "polecat/Nux/gt-xyz"},
+			branch:     "polecat/Nux/gt-xyz",
+			expiryDays: 7,
+			want:       false,
+		},
+		{
+			name:       "unreject cleared the marker",
+			fields:     &MRFields{Branch: "polecat/Nux/gt-xyz", RejectedAt: ""},
+			branch:     "polecat/Nux/gt-xyz",
+			expiryDays: 7,
+			want:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isRejectionActive(tt.fields, tt.branch, tt.expiryDays, now)
+			if got != tt.want {
+				t.Errorf("isRejectionActive() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsRejectionActive_UnparseableTimestamp(t *testing.T) {
+	fields := &MRFields{Branch: "polecat/Nux/gt-xyz", RejectedAt: "not-a-timestamp"}
+	if isRejectionActive(fields, "polecat/Nux/gt-xyz", 7, time.Now()) {
+		t.Error("isRejectionActive() should report inactive when rejected_at can't be parsed")
+	}
+}
+
+func TestFormatAndParseMRFields_Rejection(t *testing.T) {
+	fields := &MRFields{
+		Branch:       "polecat/Nux/gt-xyz",
+		RejectReason: "does not meet requirements",
+		RejectedAt:   "2026-01-01T12:00:00Z",
+	}
+
+	description := FormatMRFields(fields)
+	issue := &Issue{Description: description}
+	parsed := ParseMRFields(issue)
+
+	if parsed.RejectReason != fields.RejectReason {
+		t.Errorf("RejectReason = %q, want %q", parsed.RejectReason, fields.RejectReason)
+	}
+	if parsed.RejectedAt != fields.RejectedAt {
+		t.Errorf("RejectedAt = %q, want %q", parsed.RejectedAt, fields.RejectedAt)
+	}
+}
+
+func TestFormatAndParseMRFields_Review(t *testing.T) {
+	fields := &MRFields{
+		Branch:             "polecat/Nux/gt-xyz",
+		ReviewRejectReason: "missing test evidence",
+		ReviewRejectedAt:   "2026-01-01T12:00:00Z",
+	}
+
+	description := FormatMRFields(fields)
+	issue := &Issue{Description: description}
+	parsed := ParseMRFields(issue)
+
+	if parsed.ReviewRejectReason != fields.ReviewRejectReason {
+		t.Errorf("ReviewRejectReason = %q, want %q", parsed.ReviewRejectReason, fields.ReviewRejectReason)
+	}
+	if parsed.ReviewRejectedAt != fields.ReviewRejectedAt {
+		t.Errorf("ReviewRejectedAt = %q, want %q", parsed.ReviewRejectedAt, fields.ReviewRejectedAt)
+	}
+	if parsed.ReviewedBy != "" {
+		t.Errorf("ReviewedBy = %q, want empty", parsed.ReviewedBy)
+	}
+}
+
+func TestSetMRFields_ApprovalClearsRejection(t *testing.T) {
+	issue := &Issue{
+		Description: FormatMRFields(&MRFields{
+			Branch:             "polecat/Nux/gt-xyz",
+			Worker:             "Nux",
+			ReviewRejectReason: "missing test evidence",
+			ReviewRejectedAt:   "2026-01-01T12:00:00Z",
+		}),
+	}
+
+	fields := ParseMRFields(issue)
+	fields.ReviewRejectReason = ""
+	fields.ReviewRejectedAt = ""
+	fields.ReviewedBy = "greenplace/witness"
+	fields.ReviewedAt = "2026-01-02T09:00:00Z"
+
+	newDescription := SetMRFields(issue, fields)
+	reparsed := ParseMRFields(&Issue{Description: newDescription})
+
+	if reparsed.ReviewRejectReason != "" {
+		t.Errorf("ReviewRejectReason = %q, want empty after approval", reparsed.ReviewRejectReason)
+	}
+	if reparsed.ReviewedBy != "greenplace/witness" {
+		t.Errorf("ReviewedBy = %q, want %q", reparsed.ReviewedBy, "greenplace/witness")
+	}
+	if reparsed.Branch != "polecat/Nux/gt-xyz" {
+		t.Errorf("Branch = %q, want preserved", reparsed.Branch)
+	}
+}
+
+func TestSetMRFields_ClearingRejectedAtPreservesOtherFields(t *testing.T) {
+	issue := &Issue{
+		Description: FormatMRFields(&MRFields{
+			Branch:       "polecat/Nux/gt-xyz",
+			Worker:       "Nux",
+			RejectReason: "does not meet requirements",
+			RejectedAt:   "2026-01-01T12:00:00Z",
+		}),
+	}
+
+	fields := ParseMRFields(issue)
+	fields.RejectedAt = "" // simulate UnrejectMR lifting the rejection
+
+	newDescription := SetMRFields(issue, fields)
+	reparsed := ParseMRFields(&Issue{Description: newDescription})
+
+	if reparsed.RejectedAt != "" {
+		t.Errorf("RejectedAt = %q, want empty after unreject", reparsed.RejectedAt)
+	}
+	if reparsed.Branch != "polecat/Nux/gt-xyz" {
+		t.Errorf("Branch = %q, want preserved", reparsed.Branch)
+	}
+	if reparsed.Worker != "Nux" {
+		t.Errorf("Worker = %q, want preserved", reparsed.Worker)
+	}
+}
@@ -2,10 +2,24 @@
 package beads
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
+
+	"github.com/gofrs/flock"
+)
+
+const (
+	// redirectLockFile guards the cleanup+redirect-write section of
+	// SetupRedirect so two concurrent calls for the same worktree can't
+	// interleave (e.g. delete each other's half-written redirect file).
+	redirectLockFile    = "redirect.lock"
+	redirectLockTimeout = 2 * time.Second
 )
 
 // ResolveBeadsDir returns the actual beads directory, following any redirect.
@@ -151,18 +165,34 @@ func cleanBeadsRuntimeFiles(beadsDir string) error {
 // SetupRedirect creates a .beads/redirect file for a worktree to point to the rig's shared beads.
 // This is used by crew, polecats, and refinery worktrees to share the rig's beads database.
 //
+// Equivalent to SetupRedirectForce(townRoot, worktreePath, false): if a bd daemon
+// has the worktree's beads database open, it refuses rather than risk deleting
+// files out from under it.
+func SetupRedirect(townRoot, worktreePath string) error {
+	return SetupRedirectForce(townRoot, worktreePath, false)
+}
+
+// SetupRedirectForce is SetupRedirect with control over what happens when a bd
+// daemon has the worktree's .beads database open: force=false refuses with an
+// actionable error, force=true stops the daemon first.
+//
 // Parameters:
 //   - townRoot: the town root directory (e.g., ~/gt)
 //   - worktreePath: the worktree directory (e.g., <rig>/crew/<name> or <rig>/refinery/rig)
 //
 // The function:
 //  1. Computes the relative path from worktree to rig-level .beads
-//  2. Cleans up runtime files (preserving tracked files like formulas/)
-//  3. Creates the redirect file
+//  2. Checks for an active bd daemon on the worktree's beads dir, stopping it
+//     (force) or refusing (no force)
+//  3. Cleans up runtime files (preserving tracked files like formulas/)
+//  4. Creates the redirect file
+//
+// A directory-level lock is held for steps 3-4 so two concurrent
+// SetupRedirect(Force) calls for the same worktree can't interleave.
 //
 // Safety: This function refuses to create redirects in the canonical beads location
 // (mayor/rig) to prevent circular redirect chains.
-func SetupRedirect(townRoot, worktreePath string) error {
+func SetupRedirectForce(townRoot, worktreePath string, force bool) error {
 	// Get rig root from worktree path
 	// worktreePath = <town>/<rig>/crew/<name> or <town>/<rig>/refinery/rig etc.
 	relPath, err := filepath.Rel(townRoot, worktreePath)
@@ -197,17 +227,39 @@ func SetupRedirect(townRoot, worktreePath string) error {
 		usesMayorFallback = true
 	}
 
-	// Clean up runtime files in .beads/ but preserve tracked files (formulas/, README.md, etc.)
 	worktreeBeadsDir := filepath.Join(worktreePath, ".beads")
-	if err := cleanBeadsRuntimeFiles(worktreeBeadsDir); err != nil {
-		return fmt.Errorf("cleaning runtime files: %w", err)
-	}
-
-	// Create .beads directory if it doesn't exist
 	if err := os.MkdirAll(worktreeBeadsDir, 0755); err != nil {
 		return fmt.Errorf("creating .beads dir: %w", err)
 	}
 
+	// Take a directory-level lock so two concurrent SetupRedirect calls for
+	// this worktree can't interleave their cleanup+write.
+	redirectLock := flock.New(filepath.Join(worktreeBeadsDir, redirectLockFile))
+	lockCtx, cancel := context.WithTimeout(context.Background(), redirectLockTimeout)
+	defer cancel()
+	locked, err := redirectLock.TryLockContext(lockCtx, 100*time.Millisecond)
+	if err != nil || !locked {
+		return fmt.Errorf("another redirect setup is already in progress for %s", worktreePath)
+	}
+	defer func() { _ = redirectLock.Unlock() }()
+
+	// A bd daemon (or another gt process) may still have this .beads database
+	// open. Deleting beads.db/issues.jsonl out from under it leaves a
+	// half-deleted directory and a confused daemon, so check first.
+	if bdDaemonActive(worktreePath) {
+		if !force {
+			return fmt.Errorf("bd daemon has %s open; stop it first (bd daemon stop) or retry with force", worktreeBeadsDir)
+		}
+		if err := stopBdDaemonFor(worktreePath); err != nil {
+			return fmt.Errorf("stopping bd daemon: %w", err)
+		}
+	}
+
+	// Clean up runtime files in .beads/ but preserve tracked files (formulas/, README.md, etc.)
+	if err := cleanBeadsRuntimeFiles(worktreeBeadsDir); err != nil {
+		return fmt.Errorf("cleaning runtime files: %w", err)
+	}
+
 	// Compute relative path from worktree to rig root
 	// e.g., crew/<name> (depth 2) -> ../../.beads
 	//       refinery/rig (depth 2) -> ../../.beads
@@ -243,3 +295,30 @@ func SetupRedirect(townRoot, worktreePath string) error {
 
 	return nil
 }
+
+// bdDaemonActive reports whether a bd daemon has worktreePath's .beads
+// database open, by asking bd itself rather than inferring from lock/pid
+// files (which bd's daemon implementation, not this package, owns).
+func bdDaemonActive(worktreePath string) bool {
+	cmd := exec.Command("bd", "daemon", "status")
+	cmd.Dir = worktreePath
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		// bd not installed, or no daemon for this dir - either way, nothing to stop
+		return false
+	}
+	return strings.Contains(stdout.String(), "Daemon is running")
+}
+
+// stopBdDaemonFor stops the bd daemon serving worktreePath's .beads database.
+func stopBdDaemonFor(worktreePath string) error {
+	cmd := exec.Command("bd", "daemon", "stop")
+	cmd.Dir = worktreePath
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
@@ -2,12 +2,15 @@ package beads
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/gofrs/flock"
 )
 
 // TestNew verifies the constructor.
@@ -85,8 +88,8 @@ func TestIsBeadsRepo(t *testing.T) {
 }
 
 // TestWrapError tests error wrapping.
-// ZFC: Only test ErrNotFound detection. ErrNotARepo and ErrSyncConflict
-// were removed as per ZFC - agents should handle those errors directly.
+// ZFC: Only test the sentinel-backed classifications below. Anything else
+// stays an opaque string - agents should handle those errors directly.
 func TestWrapError(t *testing.T) {
 	b := New("/test")
 
@@ -97,6 +100,7 @@ func TestWrapError(t *testing.T) {
 	}{
 		{"Issue not found: gt-xyz", ErrNotFound, false},
 		{"gt-xyz not found", ErrNotFound, false},
+		{"issue gt-xyz is already closed", ErrAlreadyClosed, false},
 	}
 
 	for _, tt := range tests {
@@ -113,6 +117,52 @@ func TestWrapError(t *testing.T) {
 	}
 }
 
+// TestWrapError_ClassifiesAndPreservesStderr covers the sentinels that wrap
+// with %w rather than returning bare, checking both errors.Is and that the
+// original stderr (captured from real bd failure modes) survives in Error().
+func TestWrapError_ClassifiesAndPreservesStderr(t *testing.T) {
+	b := New("/test")
+
+	tests := []struct {
+		name    string
+		stderr  string
+		wantErr error
+	}{
+		{
+			name:    "duplicate id",
+			stderr:  "Error: UNIQUE constraint failed: issues.id",
+			wantErr: ErrDuplicateID,
+		},
+		{
+			name:    "database out of sync",
+			stderr:  "Error: database is out of sync, run 'bd sync' first",
+			wantErr: ErrOutOfSync,
+		},
+		{
+			name:    "stale daemon",
+			stderr:  "Error: bd daemon is stale, restart with 'bd daemon restart'",
+			wantErr: ErrDaemonStale,
+		},
+		{
+			name:    "permission denied",
+			stderr:  "Error: open /var/lib/beads/beads.db: permission denied",
+			wantErr: ErrPermission,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := b.wrapError(nil, tt.stderr, []string{"test"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("wrapError(%q) = %v, want errors.Is match for %v", tt.stderr, err, tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.stderr) {
+				t.Errorf("wrapError(%q).Error() = %q, want it to contain the original stderr", tt.stderr, err.Error())
+			}
+		})
+	}
+}
+
 // Integration test that runs against real bd if available
 func TestIntegration(t *testing.T) {
 	if testing.Short() {
@@ -568,6 +618,7 @@ func TestMRFieldsRoundTrip(t *testing.T) {
 		Rig:         "gastown",
 		MergeCommit: "abc123def789",
 		CloseReason: "merged",
+		TestCommand: "go test ./...",
 	}
 
 	// Format to string
@@ -1799,6 +1850,36 @@ func TestSetupRedirect(t *testing.T) {
 			t.Errorf("resolved = %q, want %q", resolved, mayorRigBeads)
 		}
 	})
+
+	t.Run("refuses when another redirect setup holds the lock", func(t *testing.T) {
+		townRoot := t.TempDir()
+		rigRoot := filepath.Join(townRoot, "testrig")
+		rigBeads := filepath.Join(rigRoot, ".beads")
+		crewPath := filepath.Join(rigRoot, "crew", "max")
+		crewBeads := filepath.Join(crewPath, ".beads")
+
+		if err := os.MkdirAll(rigBeads, 0755); err != nil {
+			t.Fatalf("mkdir rig beads: %v", err)
+		}
+		if err := os.MkdirAll(crewBeads, 0755); err != nil {
+			t.Fatalf("mkdir crew beads: %v", err)
+		}
+
+		// Simulate a concurrent SetupRedirect call already holding the lock.
+		lock := flock.New(filepath.Join(crewBeads, redirectLockFile))
+		if err := lock.Lock(); err != nil {
+			t.Fatalf("acquiring test lock: %v", err)
+		}
+		defer func() { _ = lock.Unlock() }()
+
+		err := SetupRedirect(townRoot, crewPath)
+		if err == nil {
+			t.Error("SetupRedirect should refuse while another setup holds the lock")
+		}
+		if err != nil && !strings.Contains(err.Error(), "already in progress") {
+			t.Errorf("error should mention a setup in progress, got: %v", err)
+		}
+	})
 }
 
 // TestAgentBeadTombstoneBug demonstrates the bd bug where `bd delete --hard --force`
@@ -1876,10 +1957,10 @@ func TestAgentBeadTombstoneBug(t *testing.T) {
 		AgentState: "spawning",
 	})
 	if err == nil {
-		t.Fatal("expected UNIQUE constraint error, got nil")
+		t.Fatal("expected duplicate-ID error, got nil")
 	}
-	if !strings.Contains(err.Error(), "UNIQUE constraint") {
-		t.Errorf("expected UNIQUE constraint error, got: %v", err)
+	if !errors.Is(err, ErrDuplicateID) {
+		t.Errorf("expected ErrDuplicateID, got: %v", err)
 	}
 
 	// Step 5: BUG - bd reopen fails (tombstones are invisible)
@@ -1887,8 +1968,8 @@ func TestAgentBeadTombstoneBug(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected reopen to fail on tombstone, got nil")
 	}
-	if !strings.Contains(err.Error(), "no issue found") && !strings.Contains(err.Error(), "issue not found") {
-		t.Errorf("expected 'issue not found' error, got: %v", err)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got: %v", err)
 	}
 
 	t.Log("BUG CONFIRMED: bd delete --hard creates tombstones that block recreation")
@@ -1951,6 +2032,76 @@ func TestAgentBeadCloseReopenWorkaround(t *testing.T) {
 	t.Log("WORKAROUND CONFIRMED: Close + Reopen works for agent bead lifecycle")
 }
 
+// TestCloseReopen_Workaround mirrors TestAgentBeadCloseReopenWorkaround but
+// exercises the public Close/Reopen API directly rather than the agent-bead
+// helpers built on top of it.
+func TestCloseReopen_Workaround(t *testing.T) {
+	t.Skip("bd CLI 0.47.2 bug: database writes don't commit")
+
+	tmpDir := t.TempDir()
+	bd := NewIsolated(tmpDir)
+	if err := bd.Init("test"); err != nil {
+		t.Fatalf("bd init: %v", err)
+	}
+
+	issue, err := bd.Create(CreateOptions{Title: "Test issue"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	if err := bd.Close(issue.ID, "no longer needed"); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	shown, err := bd.Show(issue.ID)
+	if err != nil {
+		t.Fatalf("Show after close: %v", err)
+	}
+	if shown.Status != "closed" {
+		t.Errorf("status = %q, want 'closed'", shown.Status)
+	}
+
+	if err := bd.Reopen(issue.ID, "re-opening for follow-up"); err != nil {
+		t.Fatalf("Reopen: %v", err)
+	}
+
+	shown, err = bd.Show(issue.ID)
+	if err != nil {
+		t.Fatalf("Show after reopen: %v", err)
+	}
+	if shown.Status != "open" {
+		t.Errorf("status = %q, want 'open'", shown.Status)
+	}
+}
+
+// TestClose_AlreadyClosedReturnsSentinel mirrors
+// TestCloseAndClearAgentBead_AlreadyClosed but asserts that Close itself
+// surfaces ErrAlreadyClosed on a double-close, so callers can treat it as
+// idempotent without parsing stderr.
+func TestClose_AlreadyClosedReturnsSentinel(t *testing.T) {
+	t.Skip("bd CLI 0.47.2 bug: database writes don't commit")
+
+	tmpDir := t.TempDir()
+	bd := NewIsolated(tmpDir)
+	if err := bd.Init("test"); err != nil {
+		t.Fatalf("bd init: %v", err)
+	}
+
+	issue, err := bd.Create(CreateOptions{Title: "Test issue"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	if err := bd.Close(issue.ID, "first close"); err != nil {
+		t.Fatalf("first Close: %v", err)
+	}
+
+	err = bd.Close(issue.ID, "second close")
+	if !errors.Is(err, ErrAlreadyClosed) {
+		t.Errorf("second Close = %v, want ErrAlreadyClosed", err)
+	}
+}
+
 // TestCreateOrReopenAgentBead_ClosedBead tests that CreateOrReopenAgentBead
 // successfully reopens a closed agent bead and updates its fields.
 func TestCreateOrReopenAgentBead_ClosedBead(t *testing.T) {
@@ -2374,3 +2525,30 @@ func TestCloseAndClearAgentBead_ReasonVariations(t *testing.T) {
 		})
 	}
 }
+
+func TestParseAndSetWorkFields(t *testing.T) {
+	issue := &Issue{Description: "Fix the flaky test."}
+
+	fields := ParseWorkFields(issue)
+	if fields != nil {
+		t.Fatalf("ParseWorkFields() on issue with no fields = %+v, want nil", fields)
+	}
+
+	newDesc := SetWorkFields(issue, &WorkFields{AssignedAt: "2026-01-01T12:00:00Z"})
+	want := "assigned_at: 2026-01-01T12:00:00Z\n\nFix the flaky test."
+	if newDesc != want {
+		t.Errorf("SetWorkFields() = %q, want %q", newDesc, want)
+	}
+
+	issue.Description = newDesc
+	fields = ParseWorkFields(issue)
+	if fields == nil || fields.AssignedAt != "2026-01-01T12:00:00Z" {
+		t.Errorf("ParseWorkFields() = %+v, want AssignedAt = 2026-01-01T12:00:00Z", fields)
+	}
+
+	// Clearing (nil/empty fields) removes the field but keeps other content.
+	cleared := SetWorkFields(issue, &WorkFields{})
+	if cleared != "Fix the flaky test." {
+		t.Errorf("SetWorkFields() clear = %q, want %q", cleared, "Fix the flaky test.")
+	}
+}
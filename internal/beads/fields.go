@@ -178,6 +178,7 @@ type MRFields struct {
 	MergeCommit string // SHA of merge commit (set on close)
 	CloseReason string // Reason for closing: merged, rejected, conflict, superseded
 	AgentBead   string // Agent bead ID that created this MR (for traceability)
+	TestCommand string // Test command that actually ran (set on close); empty if tests were skipped
 
 	// Conflict resolution fields (for priority scoring)
 	RetryCount      int    // Number of conflict-resolution cycles
@@ -187,6 +188,18 @@ type MRFields struct {
 	// Convoy tracking (for priority scoring - convoy starvation prevention)
 	ConvoyID        string // Parent convoy ID if part of a convoy
 	ConvoyCreatedAt string // Convoy creation time (ISO 8601) for starvation prevention
+
+	// Rejection tracking (for FindActiveRejection - prevents a rejected
+	// branch from being resubmitted as a fresh MR before the worker fixes it)
+	RejectReason string // Human-readable reason the MR was rejected
+	RejectedAt   string // Rejection time (RFC 3339); cleared by UnrejectMR
+
+	// Review tracking (for merge_queue.require_review - gates ProcessMR on a
+	// witness sign-off before the refinery is allowed to merge)
+	ReviewedBy         string // Identity that approved the MR (e.g., "greenplace/witness")
+	ReviewedAt         string // Approval time (RFC 3339)
+	ReviewRejectReason string // Reason changes were requested, if the last review was a rejection
+	ReviewRejectedAt   string // Time changes were requested (RFC 3339); cleared when ReviewedBy is set
 }
 
 // ParseMRFields extracts structured merge-request fields from an issue's description.
@@ -244,6 +257,9 @@ func ParseMRFields(issue *Issue) *MRFields {
 		case "agent_bead", "agent-bead", "agentbead":
 			fields.AgentBead = value
 			hasFields = true
+		case "test_command", "test-command", "testcommand":
+			fields.TestCommand = value
+			hasFields = true
 		case "retry_count", "retry-count", "retrycount":
 			if n, err := parseIntField(value); err == nil {
 				fields.RetryCount = n
@@ -261,6 +277,24 @@ func ParseMRFields(issue *Issue) *MRFields {
 		case "convoy_created_at", "convoy-created-at", "convoycreatedat":
 			fields.ConvoyCreatedAt = value
 			hasFields = true
+		case "reject_reason", "reject-reason", "rejectreason":
+			fields.RejectReason = value
+			hasFields = true
+		case "rejected_at", "rejected-at", "rejectedat":
+			fields.RejectedAt = value
+			hasFields = true
+		case "reviewed_by", "reviewed-by", "reviewedby":
+			fields.ReviewedBy = value
+			hasFields = true
+		case "reviewed_at", "reviewed-at", "reviewedat":
+			fields.ReviewedAt = value
+			hasFields = true
+		case "review_reject_reason", "review-reject-reason", "reviewrejectreason":
+			fields.ReviewRejectReason = value
+			hasFields = true
+		case "review_rejected_at", "review-rejected-at", "reviewrejectedat":
+			fields.ReviewRejectedAt = value
+			hasFields = true
 		}
 	}
 
@@ -310,6 +344,9 @@ func FormatMRFields(fields *MRFields) string {
 	if fields.AgentBead != "" {
 		lines = append(lines, "agent_bead: "+fields.AgentBead)
 	}
+	if fields.TestCommand != "" {
+		lines = append(lines, "test_command: "+fields.TestCommand)
+	}
 	if fields.RetryCount > 0 {
 		lines = append(lines, fmt.Sprintf("retry_count: %d", fields.RetryCount))
 	}
@@ -325,10 +362,87 @@ func FormatMRFields(fields *MRFields) string {
 	if fields.ConvoyCreatedAt != "" {
 		lines = append(lines, "convoy_created_at: "+fields.ConvoyCreatedAt)
 	}
+	if fields.RejectReason != "" {
+		lines = append(lines, "reject_reason: "+fields.RejectReason)
+	}
+	if fields.RejectedAt != "" {
+		lines = append(lines, "rejected_at: "+fields.RejectedAt)
+	}
+	if fields.ReviewedBy != "" {
+		lines = append(lines, "reviewed_by: "+fields.ReviewedBy)
+	}
+	if fields.ReviewedAt != "" {
+		lines = append(lines, "reviewed_at: "+fields.ReviewedAt)
+	}
+	if fields.ReviewRejectReason != "" {
+		lines = append(lines, "review_reject_reason: "+fields.ReviewRejectReason)
+	}
+	if fields.ReviewRejectedAt != "" {
+		lines = append(lines, "review_rejected_at: "+fields.ReviewRejectedAt)
+	}
 
 	return strings.Join(lines, "\n")
 }
 
+// mrFieldKeys lists the MR description field keys (lowercase) that
+// SetMRFields and mergeDescriptionFields treat as structured fields rather
+// than free text.
+var mrFieldKeys = map[string]bool{
+	"branch":               true,
+	"target":               true,
+	"source_issue":         true,
+	"source-issue":         true,
+	"sourceissue":          true,
+	"worker":               true,
+	"rig":                  true,
+	"merge_commit":         true,
+	"merge-commit":         true,
+	"mergecommit":          true,
+	"close_reason":         true,
+	"close-reason":         true,
+	"closereason":          true,
+	"agent_bead":           true,
+	"agent-bead":           true,
+	"agentbead":            true,
+	"test_command":         true,
+	"test-command":         true,
+	"testcommand":          true,
+	"retry_count":          true,
+	"retry-count":          true,
+	"retrycount":           true,
+	"last_conflict_sha":    true,
+	"last-conflict-sha":    true,
+	"lastconflictsha":      true,
+	"conflict_task_id":     true,
+	"conflict-task-id":     true,
+	"conflicttaskid":       true,
+	"convoy_id":            true,
+	"convoy-id":            true,
+	"convoyid":             true,
+	"convoy":               true,
+	"convoy_created_at":    true,
+	"convoy-created-at":    true,
+	"convoycreatedat":      true,
+	"reject_reason":        true,
+	"reject-reason":        true,
+	"rejectreason":         true,
+	"rejected_at":          true,
+	"rejected-at":          true,
+	"rejectedat":           true,
+	"reviewed_by":          true,
+	"reviewed-by":          true,
+	"reviewedby":           true,
+	"reviewed_at":          true,
+	"reviewed-at":          true,
+	"reviewedat":           true,
+	"review_reject_reason": true,
+	"review-reject-reason": true,
+	"reviewrejectreason":   true,
+	"review_rejected_at":   true,
+	"review-rejected-at":   true,
+	"reviewrejectedat":     true,
+}
+
 // SetMRFields updates an issue's description with the given MR fields.
 // Existing MR field lines are replaced; other content is preserved.
 // Returns the new description string.
@@ -337,42 +451,6 @@ func SetMRFields(issue *Issue, fields *MRFields) string {
 		return FormatMRFields(fields)
 	}
 
-	// Known MR field keys (lowercase)
-	mrKeys := map[string]bool{
-		"branch":             true,
-		"target":             true,
-		"source_issue":       true,
-		"source-issue":       true,
-		"sourceissue":        true,
-		"worker":             true,
-		"rig":                true,
-		"merge_commit":       true,
-		"merge-commit":       true,
-		"mergecommit":        true,
-		"close_reason":       true,
-		"close-reason":       true,
-		"closereason":        true,
-		"agent_bead":         true,
-		"agent-bead":         true,
-		"agentbead":          true,
-		"retry_count":        true,
-		"retry-count":        true,
-		"retrycount":         true,
-		"last_conflict_sha":  true,
-		"last-conflict-sha":  true,
-		"lastconflictsha":    true,
-		"conflict_task_id":   true,
-		"conflict-task-id":   true,
-		"conflicttaskid":     true,
-		"convoy_id":          true,
-		"convoy-id":          true,
-		"convoyid":           true,
-		"convoy":             true,
-		"convoy_created_at":  true,
-		"convoy-created-at":  true,
-		"convoycreatedat":    true,
-	}
-
 	// Collect non-MR lines from existing description
 	var otherLines []string
 	if issue.Description != "" {
@@ -392,7 +470,7 @@ func SetMRFields(issue *Issue, fields *MRFields) string {
 			}
 
 			key := strings.ToLower(strings.TrimSpace(trimmed[:colonIdx]))
-			if !mrKeys[key] {
+			if !mrFieldKeys[key] {
 				otherLines = append(otherLines, line)
 			}
 			// Skip MR field lines - they'll be replaced
@@ -421,6 +499,100 @@ func SetMRFields(issue *Issue, fields *MRFields) string {
 	return formatted + "\n\n" + strings.Join(otherLines, "\n")
 }
 
+// mergeDescriptionFields merges set into desc's "key: value" lines and drops
+// any keys in remove, preserving free-text content and unrelated fields the
+// same way SetMRFields does. Like SetMRFields, it's scoped to a known key
+// vocabulary - mrFieldKeys plus agentFieldKeys, plus whatever keys the
+// caller names in set/remove - so a free-text line that happens to contain
+// a colon (a URL, a note like "Reason: see below") isn't mistaken for a
+// field and swept into the merged block. Existing fields keep their
+// original casing and position; new keys from set are appended in map
+// iteration order.
+func mergeDescriptionFields(desc string, set map[string]string, remove []string) string {
+	knownKeys := make(map[string]bool, len(mrFieldKeys)+len(agentFieldKeys)+len(set)+len(remove))
+	for k := range mrFieldKeys {
+		knownKeys[k] = true
+	}
+	for k := range agentFieldKeys {
+		knownKeys[k] = true
+	}
+	for k := range set {
+		knownKeys[strings.ToLower(k)] = true
+	}
+
+	removeKeys := make(map[string]bool, len(remove))
+	for _, k := range remove {
+		lower := strings.ToLower(k)
+		removeKeys[lower] = true
+		knownKeys[lower] = true
+	}
+
+	values := map[string]string{}
+	var order []string
+	var otherLines []string
+
+	for _, line := range strings.Split(desc, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			otherLines = append(otherLines, line)
+			continue
+		}
+
+		colonIdx := strings.Index(trimmed, ":")
+		if colonIdx == -1 {
+			otherLines = append(otherLines, line)
+			continue
+		}
+
+		key := strings.TrimSpace(trimmed[:colonIdx])
+		value := strings.TrimSpace(trimmed[colonIdx+1:])
+		lower := strings.ToLower(key)
+		if !knownKeys[lower] {
+			otherLines = append(otherLines, line)
+			continue
+		}
+		if _, seen := values[lower]; !seen {
+			order = append(order, key)
+		}
+		values[lower] = value
+	}
+
+	for key, value := range set {
+		lower := strings.ToLower(key)
+		if _, seen := values[lower]; !seen {
+			order = append(order, key)
+		}
+		values[lower] = value
+	}
+
+	var fieldLines []string
+	for _, key := range order {
+		lower := strings.ToLower(key)
+		if removeKeys[lower] {
+			continue
+		}
+		fieldLines = append(fieldLines, key+": "+values[lower])
+	}
+
+	// Trim leading/trailing blank lines from other content.
+	for len(otherLines) > 0 && strings.TrimSpace(otherLines[len(otherLines)-1]) == "" {
+		otherLines = otherLines[:len(otherLines)-1]
+	}
+	for len(otherLines) > 0 && strings.TrimSpace(otherLines[0]) == "" {
+		otherLines = otherLines[1:]
+	}
+
+	formatted := strings.Join(fieldLines, "\n")
+	if formatted == "" {
+		return strings.Join(otherLines, "\n")
+	}
+	if len(otherLines) == 0 {
+		return formatted
+	}
+
+	return formatted + "\n\n" + strings.Join(otherLines, "\n")
+}
+
 // SynthesisFields holds structured fields for synthesis beads.
 // These fields track the synthesis step in a convoy workflow.
 type SynthesisFields struct {
@@ -502,6 +674,117 @@ func FormatSynthesisFields(fields *SynthesisFields) string {
 	return strings.Join(lines, "\n")
 }
 
+// WorkFields holds work-assignment metadata stored on a task issue's
+// description. bd has no native assignment-timestamp field, so AssignedAt
+// mirrors the assigned_at recorded on the assignee's agent bead, letting
+// either side answer "how long has this been in progress" without a join.
+type WorkFields struct {
+	AssignedAt string // RFC3339 time the issue was last assigned; empty once cleared
+}
+
+// ParseWorkFields extracts work-assignment fields from an issue's description.
+// Fields are expected as "key: value" lines. Returns nil if none are found.
+func ParseWorkFields(issue *Issue) *WorkFields {
+	if issue == nil || issue.Description == "" {
+		return nil
+	}
+
+	fields := &WorkFields{}
+	hasFields := false
+
+	for _, line := range strings.Split(issue.Description, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		colonIdx := strings.Index(line, ":")
+		if colonIdx == -1 {
+			continue
+		}
+
+		key := strings.TrimSpace(line[:colonIdx])
+		value := strings.TrimSpace(line[colonIdx+1:])
+		if value == "" {
+			continue
+		}
+
+		switch strings.ToLower(key) {
+		case "assigned_at", "assigned-at", "assignedat":
+			fields.AssignedAt = value
+			hasFields = true
+		}
+	}
+
+	if !hasFields {
+		return nil
+	}
+	return fields
+}
+
+// FormatWorkFields formats WorkFields as a string suitable for an issue description.
+func FormatWorkFields(fields *WorkFields) string {
+	if fields == nil || fields.AssignedAt == "" {
+		return ""
+	}
+	return "assigned_at: " + fields.AssignedAt
+}
+
+// SetWorkFields updates an issue's description with the given work fields.
+// Existing work field lines are replaced; other content is preserved.
+// Returns the new description string.
+func SetWorkFields(issue *Issue, fields *WorkFields) string {
+	if issue == nil {
+		return FormatWorkFields(fields)
+	}
+
+	workKeys := map[string]bool{
+		"assigned_at": true,
+		"assigned-at": true,
+		"assignedat":  true,
+	}
+
+	var otherLines []string
+	if issue.Description != "" {
+		for _, line := range strings.Split(issue.Description, "\n") {
+			trimmed := strings.TrimSpace(line)
+			if trimmed == "" {
+				otherLines = append(otherLines, line)
+				continue
+			}
+
+			colonIdx := strings.Index(trimmed, ":")
+			if colonIdx == -1 {
+				otherLines = append(otherLines, line)
+				continue
+			}
+
+			key := strings.ToLower(strings.TrimSpace(trimmed[:colonIdx]))
+			if !workKeys[key] {
+				otherLines = append(otherLines, line)
+			}
+		}
+	}
+
+	formatted := FormatWorkFields(fields)
+
+	for len(otherLines) > 0 && strings.TrimSpace(otherLines[len(otherLines)-1]) == "" {
+		otherLines = otherLines[:len(otherLines)-1]
+	}
+	for len(otherLines) > 0 && strings.TrimSpace(otherLines[0]) == "" {
+		otherLines = otherLines[1:]
+	}
+
+	if formatted == "" {
+		return strings.Join(otherLines, "\n")
+	}
+	if len(otherLines) == 0 {
+		return formatted
+	}
+
+	return formatted + "\n\n" + strings.Join(otherLines, "\n")
+}
+
 // RoleConfig holds structured lifecycle configuration for role beads.
 // These fields are stored as "key: value" lines in the role bead description.
 // This enables agents to self-register their lifecycle configuration,
@@ -0,0 +1,67 @@
+package beads
+
+import "testing"
+
+func TestMergeDescriptionFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		desc   string
+		set    map[string]string
+		remove []string
+		want   string
+	}{
+		{
+			name: "adds new field to empty description",
+			desc: "",
+			set:  map[string]string{"status": "ready"},
+			want: "status: ready",
+		},
+		{
+			name: "updates existing field in place",
+			desc: "branch: polecat/Nux/gt-1\nworker: Nux",
+			set:  map[string]string{"worker": "Toast"},
+			want: "branch: polecat/Nux/gt-1\nworker: Toast",
+		},
+		{
+			name: "appends new field after existing ones",
+			desc: "branch: polecat/Nux/gt-1",
+			set:  map[string]string{"worker": "Nux"},
+			want: "branch: polecat/Nux/gt-1\nworker: Nux",
+		},
+		{
+			name: "removes a field",
+			desc: "branch: polecat/Nux/gt-1\nworker: Nux",
+			remove: []string{
+				"worker",
+			},
+			want: "branch: polecat/Nux/gt-1",
+		},
+		{
+			name: "preserves free-text content below the fields",
+			desc: "branch: polecat/Nux/gt-1\n\nSome handoff notes here.",
+			set:  map[string]string{"worker": "Toast"},
+			want: "branch: polecat/Nux/gt-1\nworker: Toast\n\nSome handoff notes here.",
+		},
+		{
+			name: "key match is case-insensitive",
+			desc: "Worker: Nux",
+			set:  map[string]string{"worker": "Toast"},
+			want: "Worker: Toast",
+		},
+		{
+			name: "leaves an unknown key with a colon as free text",
+			desc: "branch: polecat/Nux/gt-1\nSee: https://example.com/notes",
+			set:  map[string]string{"worker": "Toast"},
+			want: "branch: polecat/Nux/gt-1\nworker: Toast\n\nSee: https://example.com/notes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeDescriptionFields(tt.desc, tt.set, tt.remove)
+			if got != tt.want {
+				t.Errorf("mergeDescriptionFields(%q, %v, %v) = %q, want %q", tt.desc, tt.set, tt.remove, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Package beads garbage collection sweeps for town-level maintenance.
+package beads
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// GCConfig configures a town-level garbage collection sweep.
+// A zero-value retention deletes/clears eligible beads immediately.
+type GCConfig struct {
+	WispRetention    time.Duration   // closed wisp messages older than this are deleted
+	HandoffRetention time.Duration   // handoff bead content untouched for longer than this is cleared
+	ActivePolecats   map[string]bool // "rig/name" addresses of polecats that still exist
+	DryRun           bool
+}
+
+// GCResult reports how many beads were reclaimed per category.
+type GCResult struct {
+	WispsDeleted     int
+	AgentBeadsPruned int
+	HandoffsCleared  int
+}
+
+// GC runs all town-level garbage collection sweeps and returns the reclaimed
+// counts per category. Each sweep is also exposed as its own method so the
+// deacon can run them individually on a schedule.
+func (b *Beads) GC(cfg GCConfig) (*GCResult, error) {
+	result := &GCResult{}
+
+	wisps, err := b.GCWisps(cfg.WispRetention, cfg.DryRun)
+	if err != nil {
+		return result, fmt.Errorf("gc wisps: %w", err)
+	}
+	result.WispsDeleted = wisps
+
+	agents, err := b.GCAgentBeads(cfg.ActivePolecats, cfg.DryRun)
+	if err != nil {
+		return result, fmt.Errorf("gc agent beads: %w", err)
+	}
+	result.AgentBeadsPruned = agents
+
+	handoffs, err := b.GCHandoffContent(cfg.HandoffRetention, cfg.DryRun)
+	if err != nil {
+		return result, fmt.Errorf("gc handoff content: %w", err)
+	}
+	result.HandoffsCleared = handoffs
+
+	return result, nil
+}
+
+// gcMessageCandidate is the subset of bd list output needed to find stale
+// closed wisp mail. Wisp messages aren't exported to JSONL, so they only
+// ever accumulate in the live database.
+type gcMessageCandidate struct {
+	ID       string `json:"id"`
+	Wisp     bool   `json:"wisp"`
+	ClosedAt string `json:"closed_at"`
+}
+
+// GCWisps deletes closed wisp (ephemeral) messages older than retention.
+func (b *Beads) GCWisps(retention time.Duration, dryRun bool) (int, error) {
+	out, err := b.run("list", "--label=gt:message", "--status=closed", "--json", "--limit=0")
+	if err != nil {
+		return 0, fmt.Errorf("listing closed messages: %w", err)
+	}
+
+	var candidates []gcMessageCandidate
+	if err := json.Unmarshal(out, &candidates); err != nil {
+		return 0, fmt.Errorf("parsing closed messages: %w", err)
+	}
+
+	cutoff := time.Now().Add(-retention)
+	count := 0
+	for _, c := range candidates {
+		if !c.Wisp {
+			continue
+		}
+		closedAt, err := time.Parse(time.RFC3339, c.ClosedAt)
+		if err != nil || closedAt.After(cutoff) {
+			continue
+		}
+		if !dryRun {
+			if _, err := b.run("delete", c.ID, "--hard", "--force"); err != nil {
+				return count, fmt.Errorf("deleting wisp %s: %w", c.ID, err)
+			}
+		}
+		count++
+	}
+	return count, nil
+}
+
+// GCAgentBeads prunes closed polecat agent beads whose polecat no longer
+// exists. activePolecats keys are "rig/name" addresses (see agentBeadToAddress
+// in the mail package) for polecats that are still registered; anything else
+// with a closed gt:agent bead for a polecat role is hard-deleted.
+func (b *Beads) GCAgentBeads(activePolecats map[string]bool, dryRun bool) (int, error) {
+	issues, err := b.List(ListOptions{
+		Status:   "closed",
+		Label:    "gt:agent",
+		Priority: -1,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("listing closed agent beads: %w", err)
+	}
+
+	count := 0
+	for _, issue := range issues {
+		rig, role, name, ok := ParseAgentBeadID(issue.ID)
+		if !ok || role != "polecat" {
+			continue
+		}
+		if activePolecats[rig+"/"+name] {
+			continue
+		}
+		if !dryRun {
+			if err := b.DeleteAgentBead(issue.ID); err != nil {
+				return count, fmt.Errorf("deleting agent bead %s: %w", issue.ID, err)
+			}
+		}
+		count++
+	}
+	return count, nil
+}
+
+// GCHandoffContent clears handoff bead descriptions that haven't been
+// updated in longer than retention, so stale advice doesn't linger for a
+// role that hasn't run in a while.
+func (b *Beads) GCHandoffContent(retention time.Duration, dryRun bool) (int, error) {
+	issues, err := b.List(ListOptions{
+		Status:   StatusPinned,
+		Priority: -1,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("listing pinned issues: %w", err)
+	}
+
+	cutoff := time.Now().Add(-retention)
+	count := 0
+	for _, issue := range issues {
+		if !strings.HasSuffix(issue.Title, " Handoff") || issue.Description == "" {
+			continue
+		}
+		if issue.UpdatedAt.IsZero() || issue.UpdatedAt.After(cutoff) {
+			continue
+		}
+		if !dryRun {
+			empty := ""
+			if err := b.Update(issue.ID, UpdateOptions{Description: &empty}); err != nil {
+				return count, fmt.Errorf("clearing handoff %s: %w", issue.ID, err)
+			}
+		}
+		count++
+	}
+	return count, nil
+}
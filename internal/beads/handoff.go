@@ -100,15 +100,31 @@ type ClearMailResult struct {
 	Cleared int // Number of pinned messages cleared (content removed)
 }
 
-// ClearMail closes or clears all open messages.
-// Non-pinned messages are closed with the given reason.
-// Pinned messages have their description cleared but remain open.
-func (b *Beads) ClearMail(reason string) (*ClearMailResult, error) {
-	// List all open messages
+// ClearMailOptions configures the scope of a ClearMail operation.
+type ClearMailOptions struct {
+	Reason string
+
+	// Assignee restricts clearing to messages addressed to this identity
+	// (e.g. "gastown/witness", "mayor/"). Empty clears mail town-wide.
+	Assignee string
+
+	// IncludePinned also clears pinned messages' content. Pinned messages
+	// (like handoff beads) are left untouched by default, since they're
+	// permanent records rather than transient mail.
+	IncludePinned bool
+}
+
+// ClearMail closes or clears open messages, scoped by opts.Assignee.
+// Non-pinned messages are closed with opts.Reason. Pinned messages are
+// left untouched unless opts.IncludePinned is set, in which case their
+// description is cleared but they remain open.
+func (b *Beads) ClearMail(opts ClearMailOptions) (*ClearMailResult, error) {
+	// List all open messages in scope
 	issues, err := b.List(ListOptions{
 		Status:   "open",
 		Label:    "gt:message",
 		Priority: -1,
+		Assignee: opts.Assignee,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("listing messages: %w", err)
@@ -122,15 +138,17 @@ func (b *Beads) ClearMail(reason string) (*ClearMailResult, error) {
 
 	for _, issue := range issues {
 		if issue.Status == StatusPinned {
-			toClear = append(toClear, issue)
-		} else {
-			toClose = append(toClose, issue.ID)
+			if opts.IncludePinned {
+				toClear = append(toClear, issue)
+			}
+			continue
 		}
+		toClose = append(toClose, issue.ID)
 	}
 
 	// Close non-pinned messages in batch
 	if len(toClose) > 0 {
-		if err := b.CloseWithReason(reason, toClose...); err != nil {
+		if err := b.CloseWithReason(opts.Reason, toClose...); err != nil {
 			return nil, fmt.Errorf("closing messages: %w", err)
 		}
 		result.Closed = len(toClose)
@@ -0,0 +1,171 @@
+package beads
+
+import (
+	"os/exec"
+	"sync"
+	"testing"
+)
+
+// seedMessage creates an open "gt:message" issue assigned to addr.
+func seedMessage(t *testing.T, b *Beads, addr, title string) {
+	t.Helper()
+	issue, err := b.Create(CreateOptions{
+		Title:       title,
+		Type:        "message",
+		Priority:    2,
+		Description: "test message",
+	})
+	if err != nil {
+		t.Fatalf("seeding message %q: %v", title, err)
+	}
+	addr2 := addr
+	if err := b.Update(issue.ID, UpdateOptions{Assignee: &addr2}); err != nil {
+		t.Fatalf("assigning message %q to %s: %v", title, addr, err)
+	}
+}
+
+func TestClearMailScopesToAssignee(t *testing.T) {
+	if _, err := exec.LookPath("bd"); err != nil {
+		t.Skip("bd not installed")
+	}
+
+	tmpDir := t.TempDir()
+	b := NewIsolated(tmpDir)
+	if err := b.Init("test"); err != nil {
+		t.Fatalf("bd init: %v", err)
+	}
+
+	// Seed mailboxes for three distinct identities.
+	seedMessage(t, b, "gastown/witness", "witness msg 1")
+	seedMessage(t, b, "gastown/witness", "witness msg 2")
+	seedMessage(t, b, "gastown/refinery", "refinery msg")
+	seedMessage(t, b, "mayor/", "mayor msg")
+
+	result, err := b.ClearMail(ClearMailOptions{
+		Reason:   "test scoped clear",
+		Assignee: "gastown/witness",
+	})
+	if err != nil {
+		t.Fatalf("ClearMail: %v", err)
+	}
+	if result.Closed != 2 {
+		t.Errorf("Closed = %d, want 2", result.Closed)
+	}
+
+	remaining, err := b.List(ListOptions{Status: "open", Label: "gt:message", Priority: -1})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	for _, issue := range remaining {
+		if issue.Assignee == "gastown/witness" {
+			t.Errorf("witness message %s still open after scoped clear", issue.ID)
+		}
+	}
+	if len(remaining) != 2 {
+		t.Errorf("expected refinery and mayor messages to remain untouched, got %d open messages", len(remaining))
+	}
+}
+
+func TestClearMailLeavesPinnedUnlessIncluded(t *testing.T) {
+	if _, err := exec.LookPath("bd"); err != nil {
+		t.Skip("bd not installed")
+	}
+
+	tmpDir := t.TempDir()
+	b := NewIsolated(tmpDir)
+	if err := b.Init("test"); err != nil {
+		t.Fatalf("bd init: %v", err)
+	}
+
+	issue, err := b.Create(CreateOptions{
+		Title:       "pinned msg",
+		Type:        "message",
+		Priority:    2,
+		Description: "keep me",
+	})
+	if err != nil {
+		t.Fatalf("seeding pinned message: %v", err)
+	}
+	assignee := "gastown/witness"
+	pinned := StatusPinned
+	if err := b.Update(issue.ID, UpdateOptions{Assignee: &assignee, Status: &pinned}); err != nil {
+		t.Fatalf("pinning message: %v", err)
+	}
+
+	result, err := b.ClearMail(ClearMailOptions{Reason: "test", Assignee: assignee})
+	if err != nil {
+		t.Fatalf("ClearMail: %v", err)
+	}
+	if result.Cleared != 0 {
+		t.Errorf("Cleared = %d, want 0 (pinned messages untouched by default)", result.Cleared)
+	}
+
+	shown, err := b.Show(issue.ID)
+	if err != nil {
+		t.Fatalf("Show: %v", err)
+	}
+	if shown.Description != "keep me" {
+		t.Errorf("pinned message description changed without --include-pinned: %q", shown.Description)
+	}
+
+	result, err = b.ClearMail(ClearMailOptions{Reason: "test", Assignee: assignee, IncludePinned: true})
+	if err != nil {
+		t.Fatalf("ClearMail with IncludePinned: %v", err)
+	}
+	if result.Cleared != 1 {
+		t.Errorf("Cleared = %d, want 1", result.Cleared)
+	}
+}
+
+func TestUpdateDescriptionFieldsConcurrentWritersBothSurvive(t *testing.T) {
+	if _, err := exec.LookPath("bd"); err != nil {
+		t.Skip("bd not installed")
+	}
+
+	tmpDir := t.TempDir()
+	b := NewIsolated(tmpDir)
+	if err := b.Init("test"); err != nil {
+		t.Fatalf("bd init: %v", err)
+	}
+
+	issue, err := b.Create(CreateOptions{
+		Title:       "concurrent field update",
+		Description: "",
+	})
+	if err != nil {
+		t.Fatalf("seeding issue: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 2)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		if err := b.UpdateDescriptionFields(issue.ID, map[string]string{"branch": "polecat/Nux/gt-1"}, nil); err != nil {
+			errs <- err
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		if err := b.UpdateDescriptionFields(issue.ID, map[string]string{"worker": "Toast"}, nil); err != nil {
+			errs <- err
+		}
+	}()
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatalf("UpdateDescriptionFields: %v", err)
+	}
+
+	shown, err := b.Show(issue.ID)
+	if err != nil {
+		t.Fatalf("Show: %v", err)
+	}
+	fields := ParseMRFields(shown)
+	if fields == nil || fields.Branch != "polecat/Nux/gt-1" {
+		t.Errorf("branch field missing after concurrent update: description = %q", shown.Description)
+	}
+	if fields == nil || fields.Worker != "Toast" {
+		t.Errorf("worker field missing after concurrent update: description = %q", shown.Description)
+	}
+}
@@ -17,7 +17,9 @@ type MoleculeStep struct {
 	WaitsFor     []string       // Dynamic wait conditions (e.g., "all-children")
 	Tier         string         // Optional tier hint: haiku, sonnet, opus
 	Type         string         // Step type: "task" (default), "wait", etc.
+	Phase        string         // Optional phase gate: discovery, structural, tactical, synthesis
 	Backoff      *BackoffConfig // Backoff configuration for wait-type steps
+	Priority     *int           // Explicit priority pin (0-4), overriding inheritance from the parent issue. Nil if not pinned.
 }
 
 // BackoffConfig defines exponential backoff parameters for wait-type steps.
@@ -45,10 +47,18 @@ var waitsForLineRegex = regexp.MustCompile(`(?i)^WaitsFor:\s*(.+)$`)
 // Common types: "task" (default), "wait" (await-signal with backoff)
 var typeLineRegex = regexp.MustCompile(`(?i)^Type:\s*(\w+)\s*$`)
 
+// phaseLineRegex matches "Phase: discovery|structural|tactical|synthesis" lines.
+// See ReadySteps for how phases gate readiness beyond plain Needs: dependencies.
+var phaseLineRegex = regexp.MustCompile(`(?i)^Phase:\s*(discovery|structural|tactical|synthesis)\s*$`)
+
 // backoffLineRegex matches "Backoff: base=30s, multiplier=2, max=10m" lines.
 // Parses backoff configuration for wait-type steps.
 var backoffLineRegex = regexp.MustCompile(`(?i)^Backoff:\s*(.+)$`)
 
+// priorityLineRegex matches "Priority: <0-4>" lines, pinning a step's
+// priority instead of letting it inherit from the parent issue.
+var priorityLineRegex = regexp.MustCompile(`(?i)^Priority:\s*([0-4])\s*$`)
+
 // templateVarRegex matches {{variable}} placeholders.
 var templateVarRegex = regexp.MustCompile(`\{\{(\w+)\}\}`)
 
@@ -59,9 +69,11 @@ var templateVarRegex = regexp.MustCompile(`\{\{(\w+)\}\}`)
 //	## Step: <ref>
 //	<prose instructions>
 //	Needs: <step>, <step>  # optional
+//	Phase: discovery|structural|tactical|synthesis  # optional
 //	Tier: haiku|sonnet|opus  # optional
 //	Type: task|wait  # optional, default is "task"
 //	Backoff: base=30s, multiplier=2, max=10m  # optional, for wait-type steps
+//	Priority: <0-4>  # optional, pins the step's priority instead of inheriting the parent's
 //
 // Returns an empty slice if no steps are found.
 func ParseMoleculeSteps(description string) ([]MoleculeStep, error) {
@@ -103,6 +115,12 @@ func ParseMoleculeSteps(description string) ([]MoleculeStep, error) {
 				continue
 			}
 
+			// Check for Phase: line
+			if matches := phaseLineRegex.FindStringSubmatch(trimmed); matches != nil {
+				currentStep.Phase = strings.ToLower(matches[1])
+				continue
+			}
+
 			// Check for WaitsFor: line
 			if matches := waitsForLineRegex.FindStringSubmatch(trimmed); matches != nil {
 				conditions := strings.Split(matches[1], ",")
@@ -127,6 +145,14 @@ func ParseMoleculeSteps(description string) ([]MoleculeStep, error) {
 				continue
 			}
 
+			// Check for Priority: line
+			if matches := priorityLineRegex.FindStringSubmatch(trimmed); matches != nil {
+				if p, err := strconv.Atoi(matches[1]); err == nil {
+					currentStep.Priority = &p
+				}
+				continue
+			}
+
 			// Regular instruction line
 			instructionLines = append(instructionLines, line)
 		}
@@ -240,19 +266,55 @@ func ExpandTemplateVars(text string, ctx map[string]string) string {
 type InstantiateOptions struct {
 	// Context map for {{variable}} substitution
 	Context map[string]string
+
+	// Resume, when a previous instantiation of the same molecule onto the
+	// same parent is found, returns the existing step issues instead of
+	// creating a second set. Ignored if ForceNew is set.
+	Resume bool
+
+	// ForceNew skips the already-instantiated check entirely and always
+	// creates a fresh set of step issues, even if the molecule was already
+	// poured onto this parent. Use sparingly - this is what produces the
+	// duplicate-steps bug Resume exists to prevent.
+	ForceNew bool
+}
+
+// instantiatedFromMarker is the description line InstantiateMolecule writes
+// on every step issue it creates, recording which molecule proto it came
+// from. Used to detect a previous instantiation onto the same parent.
+func instantiatedFromMarker(molID string) string {
+	return "instantiated_from: " + molID
+}
+
+// instantiatedChildren filters children down to those carrying an
+// instantiated_from marker for molID, i.e. step issues a previous
+// InstantiateMolecule(mol, parent, ...) call already created.
+func instantiatedChildren(children []*Issue, molID string) []*Issue {
+	marker := instantiatedFromMarker(molID)
+
+	var found []*Issue
+	for _, child := range children {
+		for _, line := range strings.Split(child.Description, "\n") {
+			if strings.TrimSpace(line) == marker {
+				found = append(found, child)
+				break
+			}
+		}
+	}
+	return found
 }
 
 // InstantiateMolecule creates child issues from a molecule template.
 //
 // This function supports two molecule formats (format bridge pattern):
 //
-// 1. New format (child issues): If the molecule proto has child issues,
-//    those children are used as templates. Dependencies are copied from
-//    the template children's DependsOn relationships.
+//  1. New format (child issues): If the molecule proto has child issues,
+//     those children are used as templates. Dependencies are copied from
+//     the template children's DependsOn relationships.
 //
-// 2. Old format (embedded markdown): If the molecule has no children,
-//    steps are parsed from the Description field using ParseMoleculeSteps().
-//    Dependencies are extracted from "Needs:" declarations in the markdown.
+//  2. Old format (embedded markdown): If the molecule has no children,
+//     steps are parsed from the Description field using ParseMoleculeSteps().
+//     Dependencies are extracted from "Needs:" declarations in the markdown.
 //
 // For each step, this creates:
 //   - A child issue with ID "{parent.ID}.{step.Ref}"
@@ -263,6 +325,9 @@ type InstantiateOptions struct {
 //   - Dependencies wired according to template
 //
 // The function is atomic via bd CLI - either all issues are created or none.
+// Priority inherits from the parent issue, unless a step pins its own via
+// a "Priority:" line (old format) - see instantiateFromMarkdown.
+//
 // Returns the created step issues.
 func (b *Beads) InstantiateMolecule(mol *Issue, parent *Issue, opts InstantiateOptions) ([]*Issue, error) {
 	if mol == nil {
@@ -272,6 +337,19 @@ func (b *Beads) InstantiateMolecule(mol *Issue, parent *Issue, opts InstantiateO
 		return nil, fmt.Errorf("parent issue is nil")
 	}
 
+	if !opts.ForceNew {
+		existing, err := b.List(ListOptions{Parent: parent.ID, Status: "all", Priority: -1})
+		if err == nil {
+			if already := instantiatedChildren(existing, mol.ID); len(already) > 0 {
+				if opts.Resume {
+					return already, nil
+				}
+				return nil, fmt.Errorf("molecule %s was already instantiated onto %s (%d step(s) exist): check status with `gt mol progress %s`, or pass ForceNew to instantiate again",
+					mol.ID, parent.ID, len(already), parent.ID)
+			}
+		}
+	}
+
 	// FORMAT BRIDGE: Try new format first (child issues), fall back to old format (markdown)
 	templateChildren, err := b.List(ListOptions{
 		Parent:   mol.ID,
@@ -309,7 +387,7 @@ func (b *Beads) instantiateFromChildren(mol *Issue, parent *Issue, templates []*
 		if description != "" {
 			description += "\n\n"
 		}
-		description += fmt.Sprintf("instantiated_from: %s\ntemplate_step: %s", mol.ID, tmpl.ID)
+		description += fmt.Sprintf("%s\ntemplate_step: %s", instantiatedFromMarker(mol.ID), tmpl.ID)
 
 		// Create the child issue
 		childOpts := CreateOptions{
@@ -327,7 +405,7 @@ func (b *Beads) instantiateFromChildren(mol *Issue, parent *Issue, templates []*
 		if err != nil {
 			// Attempt to clean up created issues on failure (best-effort cleanup)
 			for _, created := range createdIssues {
-				_ = b.Close(created.ID)
+				_ = b.Close(created.ID, "rollback: creation failed")
 			}
 			return nil, fmt.Errorf("creating step from template %q: %w", tmpl.ID, err)
 		}
@@ -402,16 +480,25 @@ func (b *Beads) instantiateFromMarkdown(mol *Issue, parent *Issue, opts Instanti
 		if description != "" {
 			description += "\n\n"
 		}
-		description += fmt.Sprintf("instantiated_from: %s\nstep: %s", mol.ID, step.Ref)
+		description += fmt.Sprintf("%s\nstep: %s", instantiatedFromMarker(mol.ID), step.Ref)
 		if step.Tier != "" {
 			description += fmt.Sprintf("\ntier: %s", step.Tier)
 		}
+		if step.Phase != "" {
+			description += fmt.Sprintf("\nphase: %s", step.Phase)
+		}
+
+		// Priority inherits from the parent unless the step pins its own.
+		priority := parent.Priority
+		if step.Priority != nil {
+			priority = *step.Priority
+		}
 
 		// Create the child issue
 		childOpts := CreateOptions{
 			Title:       step.Title,
 			Type:        "task",
-			Priority:    parent.Priority,
+			Priority:    priority,
 			Description: description,
 			Parent:      parent.ID,
 		}
@@ -420,7 +507,7 @@ func (b *Beads) instantiateFromMarkdown(mol *Issue, parent *Issue, opts Instanti
 		if err != nil {
 			// Attempt to clean up created issues on failure (best-effort cleanup)
 			for _, created := range createdIssues {
-				_ = b.Close(created.ID)
+				_ = b.Close(created.ID, "rollback: creation failed")
 			}
 			return nil, fmt.Errorf("creating step %q: %w", step.Ref, err)
 		}
@@ -1,6 +1,7 @@
 package beads
 
 import (
+	"os/exec"
 	"reflect"
 	"strings"
 	"testing"
@@ -787,3 +788,270 @@ Needs: step1`
 		t.Errorf("step[1].Type = %q, want task", steps[1].Type)
 	}
 }
+
+func TestParseMoleculeSteps_WithPhase(t *testing.T) {
+	desc := `## Step: inventory
+Gather the facts.
+Phase: Discovery
+
+## Step: design
+Plan the approach.
+Phase: structural
+Needs: inventory
+
+## Step: implement
+Do the work.
+Phase: tactical
+Needs: design
+
+## Step: report
+Summarize results.
+Phase: synthesis
+Needs: implement`
+
+	steps, err := ParseMoleculeSteps(desc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(steps) != 4 {
+		t.Fatalf("expected 4 steps, got %d", len(steps))
+	}
+
+	// Phase is normalized to lowercase
+	want := []string{"discovery", "structural", "tactical", "synthesis"}
+	for i, w := range want {
+		if steps[i].Phase != w {
+			t.Errorf("step[%d].Phase = %q, want %q", i, steps[i].Phase, w)
+		}
+	}
+}
+
+func TestParseMoleculeSteps_NoPhase(t *testing.T) {
+	desc := `## Step: solo
+No phase declared.`
+
+	steps, err := ParseMoleculeSteps(desc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(steps) != 1 {
+		t.Fatalf("expected 1 step, got %d", len(steps))
+	}
+	if steps[0].Phase != "" {
+		t.Errorf("step[0].Phase = %q, want empty (ungated)", steps[0].Phase)
+	}
+}
+
+func TestInstantiatedChildren(t *testing.T) {
+	children := []*Issue{
+		{ID: "gt-1.step-a", Description: instantiatedFromMarker("mol-review") + "\nstep: step-a"},
+		{ID: "gt-1.step-b", Description: instantiatedFromMarker("mol-other") + "\nstep: step-b"},
+		{ID: "gt-1.unrelated", Description: "no marker here"},
+	}
+
+	found := instantiatedChildren(children, "mol-review")
+	if len(found) != 1 || found[0].ID != "gt-1.step-a" {
+		t.Fatalf("expected only gt-1.step-a, got %+v", found)
+	}
+
+	found = instantiatedChildren(children, "mol-nonexistent")
+	if len(found) != 0 {
+		t.Fatalf("expected no matches for an unrelated molecule ID, got %+v", found)
+	}
+}
+
+func TestInstantiateMolecule_DoubleInstantiateRequiresFlag(t *testing.T) {
+	if _, err := exec.LookPath("bd"); err != nil {
+		t.Skip("bd not installed")
+	}
+
+	tmpDir := t.TempDir()
+	b := NewIsolated(tmpDir)
+	if err := b.Init("test"); err != nil {
+		t.Fatalf("bd init: %v", err)
+	}
+
+	mol, err := b.Create(CreateOptions{
+		Title:       "review molecule",
+		Type:        "molecule",
+		Description: "## Step: review\nLook it over.",
+	})
+	if err != nil {
+		t.Fatalf("creating molecule: %v", err)
+	}
+
+	parent, err := b.Create(CreateOptions{Title: "feature work"})
+	if err != nil {
+		t.Fatalf("creating parent: %v", err)
+	}
+
+	first, err := b.InstantiateMolecule(mol, parent, InstantiateOptions{})
+	if err != nil {
+		t.Fatalf("first InstantiateMolecule: %v", err)
+	}
+	if len(first) != 1 {
+		t.Fatalf("expected 1 step, got %d", len(first))
+	}
+
+	// Default (neither flag): must fail rather than silently duplicate.
+	if _, err := b.InstantiateMolecule(mol, parent, InstantiateOptions{}); err == nil {
+		t.Fatal("expected error on double-instantiate without --resume/--force-new")
+	}
+
+	// --resume: returns the existing steps, creates nothing new.
+	resumed, err := b.InstantiateMolecule(mol, parent, InstantiateOptions{Resume: true})
+	if err != nil {
+		t.Fatalf("resume InstantiateMolecule: %v", err)
+	}
+	if len(resumed) != 1 || resumed[0].ID != first[0].ID {
+		t.Fatalf("resume should return the existing step, got %+v", resumed)
+	}
+
+	// --force-new: pours a second set of steps.
+	forced, err := b.InstantiateMolecule(mol, parent, InstantiateOptions{ForceNew: true})
+	if err != nil {
+		t.Fatalf("force-new InstantiateMolecule: %v", err)
+	}
+	if len(forced) != 1 || forced[0].ID == first[0].ID {
+		t.Fatalf("force-new should create a fresh step, got %+v", forced)
+	}
+
+	all, err := b.List(ListOptions{Parent: parent.ID, Status: "all", Priority: -1})
+	if err != nil {
+		t.Fatalf("listing children: %v", err)
+	}
+	if len(all) != 2 {
+		t.Fatalf("expected 2 total steps after force-new, got %d", len(all))
+	}
+}
+
+func TestParseMoleculeSteps_WithPriority(t *testing.T) {
+	desc := `## Step: normal
+Do something routine.
+
+## Step: urgent
+Do something urgent.
+Priority: 0`
+
+	steps, err := ParseMoleculeSteps(desc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(steps) != 2 {
+		t.Fatalf("expected 2 steps, got %d", len(steps))
+	}
+
+	if steps[0].Priority != nil {
+		t.Errorf("step[0].Priority = %v, want nil (no pin)", steps[0].Priority)
+	}
+	if steps[1].Priority == nil || *steps[1].Priority != 0 {
+		t.Errorf("step[1].Priority = %v, want pointer to 0", steps[1].Priority)
+	}
+}
+
+func TestInstantiateMolecule_PriorityInheritanceAndPin(t *testing.T) {
+	if _, err := exec.LookPath("bd"); err != nil {
+		t.Skip("bd not installed")
+	}
+
+	tmpDir := t.TempDir()
+	b := NewIsolated(tmpDir)
+	if err := b.Init("test"); err != nil {
+		t.Fatalf("bd init: %v", err)
+	}
+
+	mol, err := b.Create(CreateOptions{
+		Title: "incident response molecule",
+		Type:  "molecule",
+		Description: "## Step: triage\n" +
+			"Assess impact.\n\n" +
+			"## Step: postmortem\n" +
+			"Write it up once things are stable.\n" +
+			"Priority: 3",
+	})
+	if err != nil {
+		t.Fatalf("creating molecule: %v", err)
+	}
+
+	parent, err := b.Create(CreateOptions{Title: "prod outage", Priority: 0})
+	if err != nil {
+		t.Fatalf("creating parent: %v", err)
+	}
+
+	steps, err := b.InstantiateMolecule(mol, parent, InstantiateOptions{})
+	if err != nil {
+		t.Fatalf("InstantiateMolecule: %v", err)
+	}
+	if len(steps) != 2 {
+		t.Fatalf("expected 2 steps, got %d", len(steps))
+	}
+
+	byTitle := make(map[string]*Issue)
+	for _, s := range steps {
+		byTitle[s.Title] = s
+	}
+
+	triage := byTitle["Assess impact."]
+	if triage == nil || triage.Priority != 0 {
+		t.Errorf("triage step should inherit parent priority 0, got %+v", triage)
+	}
+
+	postmortem := byTitle["Write it up once things are stable."]
+	if postmortem == nil || postmortem.Priority != 3 {
+		t.Errorf("postmortem step should keep its pinned priority 3, got %+v", postmortem)
+	}
+}
+
+func TestBulkUpdate(t *testing.T) {
+	if _, err := exec.LookPath("bd"); err != nil {
+		t.Skip("bd not installed")
+	}
+
+	tmpDir := t.TempDir()
+	b := NewIsolated(tmpDir)
+	if err := b.Init("test"); err != nil {
+		t.Fatalf("bd init: %v", err)
+	}
+
+	a, err := b.Create(CreateOptions{Title: "a", Priority: 2})
+	if err != nil {
+		t.Fatalf("creating a: %v", err)
+	}
+	c, err := b.Create(CreateOptions{Title: "c", Priority: 2})
+	if err != nil {
+		t.Fatalf("creating c: %v", err)
+	}
+
+	priority := 0
+	if err := b.BulkUpdate([]string{a.ID, c.ID}, UpdateOptions{Priority: &priority}); err != nil {
+		t.Fatalf("BulkUpdate: %v", err)
+	}
+
+	for _, id := range []string{a.ID, c.ID} {
+		issue, err := b.Show(id)
+		if err != nil {
+			t.Fatalf("Show(%s): %v", id, err)
+		}
+		if issue.Priority != 0 {
+			t.Errorf("issue %s priority = %d, want 0 after BulkUpdate", id, issue.Priority)
+		}
+	}
+}
+
+func TestBulkUpdate_StopsOnFirstError(t *testing.T) {
+	if _, err := exec.LookPath("bd"); err != nil {
+		t.Skip("bd not installed")
+	}
+
+	tmpDir := t.TempDir()
+	b := NewIsolated(tmpDir)
+	if err := b.Init("test"); err != nil {
+		t.Fatalf("bd init: %v", err)
+	}
+
+	priority := 1
+	err := b.BulkUpdate([]string{"gt-nonexistent"}, UpdateOptions{Priority: &priority})
+	if err == nil {
+		t.Fatal("expected error for a nonexistent issue ID")
+	}
+}
@@ -0,0 +1,138 @@
+package beads
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Phase names for the discovery -> structural -> tactical -> synthesis flow
+// described in internal/style.PhaseTable. A step declares its phase with a
+// "Phase:" line alongside "Needs:" in its molecule definition (see
+// ParseMoleculeSteps); instantiateFromMarkdown carries it into the created
+// step issue as a "phase:" provenance line. Steps with no declared phase are
+// ungated - only their own Needs: dependencies determine readiness.
+const (
+	PhaseDiscovery  = "discovery"
+	PhaseStructural = "structural"
+	PhaseTactical   = "tactical"
+	PhaseSynthesis  = "synthesis"
+)
+
+// phaseMetadataRegex matches the "phase: <name>" provenance line written by
+// instantiateFromMarkdown into a step issue's description.
+var phaseMetadataRegex = regexp.MustCompile(`(?im)^phase:\s*(discovery|structural|tactical|synthesis)\s*$`)
+
+// ExtractStepPhase returns the phase declared in a step issue's description,
+// or "" if the step has no phase.
+func ExtractStepPhase(description string) string {
+	if matches := phaseMetadataRegex.FindStringSubmatch(description); matches != nil {
+		return strings.ToLower(matches[1])
+	}
+	return ""
+}
+
+// ReadySteps returns the children of rootID that are ready to be worked on
+// right now: their own Needs dependencies are satisfied AND the phase-gating
+// rules from internal/style.PhaseTable are satisfied. Swarm dispatch and the
+// molecule step-completion flow should use this instead of computing
+// dependency readiness themselves, so phase gating is applied consistently.
+func (b *Beads) ReadySteps(rootID string) ([]*Issue, error) {
+	children, err := b.List(ListOptions{
+		Parent:   rootID,
+		Status:   "all",
+		Priority: -1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing molecule steps: %w", err)
+	}
+
+	return readyStepsFromChildren(children), nil
+}
+
+// readyStepsFromChildren applies dependency and phase gating to an
+// already-fetched set of molecule step issues. Split out from ReadySteps so
+// the gating rules can be unit-tested without a bd process.
+//
+// Phase gating, on top of normal dependency readiness:
+//   - discovery:  no extra gate (full parallelism)
+//   - structural: blocked until every discovery step is closed; sequential,
+//     so only the first ready structural step is surfaced at a time
+//   - tactical:   blocked until every structural step is closed (parallel
+//     once unblocked)
+//   - synthesis:  blocked until every tactical step is closed; single
+//     runner, so only the first ready synthesis step is surfaced at a time
+//
+// Steps with no declared phase are gated only by their own dependencies.
+func readyStepsFromChildren(children []*Issue) []*Issue {
+	closedIDs := make(map[string]bool, len(children))
+	phaseOf := make(map[string]string, len(children))
+	openInPhase := make(map[string]int)
+	inProgressInPhase := make(map[string]bool)
+
+	for _, child := range children {
+		phase := ExtractStepPhase(child.Description)
+		phaseOf[child.ID] = phase
+
+		switch child.Status {
+		case "closed":
+			closedIDs[child.ID] = true
+		default:
+			if phase != "" {
+				openInPhase[phase]++
+			}
+			if child.Status == "in_progress" {
+				inProgressInPhase[phase] = true
+			}
+		}
+	}
+
+	phaseGateOpen := func(phase string) bool {
+		switch phase {
+		case PhaseStructural:
+			return openInPhase[PhaseDiscovery] == 0
+		case PhaseTactical:
+			return openInPhase[PhaseStructural] == 0
+		case PhaseSynthesis:
+			return openInPhase[PhaseTactical] == 0
+		default:
+			return true
+		}
+	}
+
+	var ready []*Issue
+	singleRunnerClaimed := make(map[string]bool)
+
+	for _, child := range children {
+		if child.Status != "open" {
+			continue
+		}
+
+		allDepsClosed := true
+		for _, depID := range child.DependsOn {
+			if !closedIDs[depID] {
+				allDepsClosed = false
+				break
+			}
+		}
+		if !allDepsClosed {
+			continue
+		}
+
+		phase := phaseOf[child.ID]
+		if !phaseGateOpen(phase) {
+			continue
+		}
+
+		if phase == PhaseStructural || phase == PhaseSynthesis {
+			if inProgressInPhase[phase] || singleRunnerClaimed[phase] {
+				continue
+			}
+			singleRunnerClaimed[phase] = true
+		}
+
+		ready = append(ready, child)
+	}
+
+	return ready
+}
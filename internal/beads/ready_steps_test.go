@@ -0,0 +1,164 @@
+package beads
+
+import "testing"
+
+// phasedStep builds a molecule step issue with the given phase, status, and
+// dependencies, using the same "phase: <name>" provenance line
+// instantiateFromMarkdown writes.
+func phasedStep(id, phase, status string, dependsOn ...string) *Issue {
+	description := ""
+	if phase != "" {
+		description = "phase: " + phase
+	}
+	return &Issue{ID: id, Status: status, Description: description, DependsOn: dependsOn}
+}
+
+func idsOf(issues []*Issue) []string {
+	ids := make([]string, len(issues))
+	for i, issue := range issues {
+		ids[i] = issue.ID
+	}
+	return ids
+}
+
+func assertReadyIDs(t *testing.T, children []*Issue, want []string) {
+	t.Helper()
+	got := idsOf(readyStepsFromChildren(children))
+	if len(got) != len(want) {
+		t.Fatalf("ready = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("ready = %v, want %v", got, want)
+		}
+	}
+}
+
+// TestReadyStepsFromChildren_AllFourPhases exercises a molecule spanning all
+// four phases, checking which steps are ready at each stage of execution.
+func TestReadyStepsFromChildren_AllFourPhases(t *testing.T) {
+	t.Run("start: only discovery is ready, full parallelism", func(t *testing.T) {
+		children := []*Issue{
+			phasedStep("m.d1", PhaseDiscovery, "open"),
+			phasedStep("m.d2", PhaseDiscovery, "open"),
+			phasedStep("m.s1", PhaseStructural, "open"),
+			phasedStep("m.s2", PhaseStructural, "open"),
+			phasedStep("m.t1", PhaseTactical, "open"),
+			phasedStep("m.y1", PhaseSynthesis, "open"),
+		}
+		assertReadyIDs(t, children, []string{"m.d1", "m.d2"})
+	})
+
+	t.Run("discovery in progress still blocks structural", func(t *testing.T) {
+		children := []*Issue{
+			phasedStep("m.d1", PhaseDiscovery, "closed"),
+			phasedStep("m.d2", PhaseDiscovery, "in_progress"),
+			phasedStep("m.s1", PhaseStructural, "open"),
+		}
+		assertReadyIDs(t, children, nil)
+	})
+
+	t.Run("discovery done: structural becomes ready, but only one at a time", func(t *testing.T) {
+		children := []*Issue{
+			phasedStep("m.d1", PhaseDiscovery, "closed"),
+			phasedStep("m.d2", PhaseDiscovery, "closed"),
+			phasedStep("m.s1", PhaseStructural, "open"),
+			phasedStep("m.s2", PhaseStructural, "open"),
+			phasedStep("m.t1", PhaseTactical, "open"),
+		}
+		// Structural is sequential - only the first candidate surfaces, and
+		// tactical is still blocked on structural completing.
+		assertReadyIDs(t, children, []string{"m.s1"})
+	})
+
+	t.Run("structural in progress blocks other structural and all tactical", func(t *testing.T) {
+		children := []*Issue{
+			phasedStep("m.d1", PhaseDiscovery, "closed"),
+			phasedStep("m.s1", PhaseStructural, "in_progress"),
+			phasedStep("m.s2", PhaseStructural, "open"),
+			phasedStep("m.t1", PhaseTactical, "open"),
+		}
+		assertReadyIDs(t, children, nil)
+	})
+
+	t.Run("structural done: tactical is ready in full parallel", func(t *testing.T) {
+		children := []*Issue{
+			phasedStep("m.d1", PhaseDiscovery, "closed"),
+			phasedStep("m.s1", PhaseStructural, "closed"),
+			phasedStep("m.t1", PhaseTactical, "open"),
+			phasedStep("m.t2", PhaseTactical, "open"),
+			phasedStep("m.y1", PhaseSynthesis, "open"),
+		}
+		assertReadyIDs(t, children, []string{"m.t1", "m.t2"})
+	})
+
+	t.Run("tactical still open blocks synthesis", func(t *testing.T) {
+		children := []*Issue{
+			phasedStep("m.s1", PhaseStructural, "closed"),
+			phasedStep("m.t1", PhaseTactical, "closed"),
+			phasedStep("m.t2", PhaseTactical, "in_progress"),
+			phasedStep("m.y1", PhaseSynthesis, "open"),
+		}
+		assertReadyIDs(t, children, nil)
+	})
+
+	t.Run("all tactical done: synthesis is ready, single runner", func(t *testing.T) {
+		children := []*Issue{
+			phasedStep("m.t1", PhaseTactical, "closed"),
+			phasedStep("m.t2", PhaseTactical, "closed"),
+			phasedStep("m.y1", PhaseSynthesis, "open"),
+			phasedStep("m.y2", PhaseSynthesis, "open"),
+		}
+		assertReadyIDs(t, children, []string{"m.y1"})
+	})
+
+	t.Run("synthesis in progress blocks any other synthesis step", func(t *testing.T) {
+		children := []*Issue{
+			phasedStep("m.t1", PhaseTactical, "closed"),
+			phasedStep("m.y1", PhaseSynthesis, "in_progress"),
+			phasedStep("m.y2", PhaseSynthesis, "open"),
+		}
+		assertReadyIDs(t, children, nil)
+	})
+}
+
+func TestReadyStepsFromChildren_UnphasedStepsOnlyGatedByNeeds(t *testing.T) {
+	children := []*Issue{
+		phasedStep("m.1", "", "closed"),
+		phasedStep("m.2", "", "open", "m.1"),
+		phasedStep("m.3", "", "open", "m.2"),
+	}
+	assertReadyIDs(t, children, []string{"m.2"})
+}
+
+func TestReadyStepsFromChildren_MixedPhasedAndUnphased(t *testing.T) {
+	// An ungated step is unaffected by phase gates, but a phased step next
+	// to it still respects the phase rules.
+	children := []*Issue{
+		phasedStep("m.plain", "", "open"),
+		phasedStep("m.d1", PhaseDiscovery, "open"),
+		phasedStep("m.s1", PhaseStructural, "open"),
+	}
+	assertReadyIDs(t, children, []string{"m.plain", "m.d1"})
+}
+
+func TestExtractStepPhase(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		want        string
+	}{
+		{"no phase", "instantiated_from: gt-mol\nstep: implement", ""},
+		{"lowercase", "phase: tactical", "tactical"},
+		{"case insensitive key and value", "Phase: SYNTHESIS", "synthesis"},
+		{"among other metadata", "instantiated_from: gt-mol\nstep: design\nphase: structural\ntier: opus", "structural"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractStepPhase(tt.description); got != tt.want {
+				t.Errorf("ExtractStepPhase(%q) = %q, want %q", tt.description, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package beads
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// bdTimestampLayouts lists the timestamp formats bd is known to emit across
+// versions. RFC3339 (with or without fractional seconds) is the common case;
+// the others show up in older exports and hand-edited fixtures.
+var bdTimestampLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04:05Z",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// Timestamp wraps time.Time so Issue's created_at/updated_at/closed_at fields
+// decode cleanly regardless of which bd version produced them. bd omits or
+// blanks these fields for issues that haven't reached that state yet (e.g.
+// closed_at on an open issue), which the stdlib time.Time unmarshaller
+// rejects outright.
+type Timestamp struct {
+	time.Time
+}
+
+// MarshalJSON encodes the zero value as an empty string, matching bd's own
+// encoding of unset timestamps.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.Time.IsZero() {
+		return json.Marshal("")
+	}
+	return json.Marshal(t.Time.Format(time.RFC3339))
+}
+
+// UnmarshalJSON accepts bd's various timestamp encodings, treating a missing
+// or empty value as the zero time rather than an error.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("beads: timestamp is not a JSON string: %w", err)
+	}
+	if s == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+	for _, layout := range bdTimestampLayouts {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+	return fmt.Errorf("beads: unrecognized timestamp format %q", s)
+}
+
+// HumanizeAge formats a duration as a short "Ns ago" / "Nm ago" / "Nh ago" /
+// "Nd ago" string for compact display in queue and status output.
+func HumanizeAge(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds ago", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd ago", int(d.Hours()/24))
+	}
+}
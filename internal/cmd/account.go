@@ -7,8 +7,11 @@ import (
 	"sort"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -89,6 +92,35 @@ type AccountListItem struct {
 	Description string `json:"description,omitempty"`
 	ConfigDir   string `json:"config_dir"`
 	IsDefault   bool   `json:"is_default"`
+	Sessions    int    `json:"sessions"`
+}
+
+// townSessionCountsByAccount tallies active agent sessions per account
+// handle across every rig in the town, by scanning each rig's agent beads.
+// Rigs whose beads can't be queried are skipped rather than failing the
+// whole count.
+func townSessionCountsByAccount(townRoot string) map[string]int {
+	rigsConfig, err := config.LoadRigsConfig(constants.MayorRigsPath(townRoot))
+	if err != nil {
+		return nil
+	}
+
+	rigMgr := rig.NewManager(townRoot, rigsConfig, git.NewGit(townRoot))
+	counts := make(map[string]int)
+	for _, name := range rigMgr.ListRigNames() {
+		r, err := rigMgr.GetRig(name)
+		if err != nil {
+			continue
+		}
+		rigCounts, err := beads.New(r.BeadsPath()).CountSessionsByAccount()
+		if err != nil {
+			continue
+		}
+		for handle, n := range rigCounts {
+			counts[handle] += n
+		}
+	}
+	return counts
 }
 
 func runAccountList(cmd *cobra.Command, args []string) error {
@@ -115,6 +147,7 @@ func runAccountList(cmd *cobra.Command, args []string) error {
 	}
 
 	// Build list items
+	sessionCounts := townSessionCountsByAccount(townRoot)
 	var items []AccountListItem
 	for handle, acct := range cfg.Accounts {
 		items = append(items, AccountListItem{
@@ -123,6 +156,7 @@ func runAccountList(cmd *cobra.Command, args []string) error {
 			Description: acct.Description,
 			ConfigDir:   acct.ConfigDir,
 			IsDefault:   handle == cfg.Default,
+			Sessions:    sessionCounts[handle],
 		})
 	}
 
@@ -149,6 +183,7 @@ func runAccountList(cmd *cobra.Command, args []string) error {
 		if item.Email != "" {
 			fmt.Printf("  %s", item.Email)
 		}
+		fmt.Printf("  %s", style.Dim.Render(fmt.Sprintf("%d session(s)", item.Sessions)))
 		if item.IsDefault {
 			fmt.Printf("  %s", style.Dim.Render("(default)"))
 		}
@@ -328,6 +363,22 @@ func runAccountStatus(cmd *cobra.Command, args []string) error {
 	}
 	fmt.Printf("Config Dir: %s\n", configDir)
 
+	if len(cfg.Accounts) > 1 {
+		fmt.Printf("\n%s\n", style.Bold.Render("Sessions per account"))
+		sessionCounts := townSessionCountsByAccount(townRoot)
+		handles := make([]string, 0, len(cfg.Accounts))
+		for h := range cfg.Accounts {
+			handles = append(handles, h)
+		}
+		sort.Strings(handles)
+		for _, h := range handles {
+			fmt.Printf("  %-20s %d session(s)\n", h, sessionCounts[h])
+		}
+		if cfg.Strategy != "" {
+			fmt.Printf("\n%s\n", style.Dim.Render(fmt.Sprintf("(allocation strategy: %s)", cfg.Strategy)))
+		}
+	}
+
 	if envAccount != "" {
 		fmt.Printf("\n%s\n", style.Dim.Render("(set via GT_ACCOUNT environment variable)"))
 	} else if handle == cfg.Default {
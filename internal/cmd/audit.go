@@ -275,7 +275,7 @@ func collectBeadsActivity(townRoot, actor string, since time.Time) ([]AuditEntry
 		// Check created_by
 		if issue.CreatedBy != "" {
 			if actor == "" || matchesActor(issue.CreatedBy, actor) {
-				ts := parseBeadsTimestamp(issue.CreatedAt)
+				ts := issue.CreatedAt.Time
 				if !since.IsZero() && ts.Before(since) {
 					continue
 				}
@@ -294,9 +294,9 @@ func collectBeadsActivity(townRoot, actor string, since time.Time) ([]AuditEntry
 		// Check if issue was closed and has an assignee
 		if issue.Status == "closed" && issue.Assignee != "" {
 			if actor == "" || matchesActor(issue.Assignee, actor) {
-				ts := parseBeadsTimestamp(issue.ClosedAt)
+				ts := issue.ClosedAt.Time
 				if ts.IsZero() {
-					ts = parseBeadsTimestamp(issue.UpdatedAt)
+					ts = issue.UpdatedAt.Time
 				}
 				if !since.IsZero() && ts.Before(since) {
 					continue
@@ -316,23 +316,6 @@ func collectBeadsActivity(townRoot, actor string, since time.Time) ([]AuditEntry
 	return entries, nil
 }
 
-// parseBeadsTimestamp parses a beads timestamp string.
-func parseBeadsTimestamp(s string) time.Time {
-	// Try various formats
-	formats := []string{
-		time.RFC3339,
-		"2006-01-02 15:04",
-		"2006-01-02T15:04:05",
-		"2006-01-02",
-	}
-	for _, format := range formats {
-		if t, err := time.Parse(format, s); err == nil {
-			return t
-		}
-	}
-	return time.Time{}
-}
-
 // collectTownlogEvents queries the town log for agent lifecycle events.
 func collectTownlogEvents(townRoot, actor string, since time.Time) ([]AuditEntry, error) {
 	var entries []AuditEntry
@@ -0,0 +1,232 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/mail"
+)
+
+// briefGatherTimeout bounds the total wall-clock time spent gathering
+// gt brief's sections. Each section is independent and best-effort - if
+// one hangs or errors, the rest still print.
+const briefGatherTimeout = 3 * time.Second
+
+var briefCmd = &cobra.Command{
+	Use:     "brief",
+	GroupID: GroupDiag,
+	Short:   "Single-screen summary of what to do next",
+	Long: `Compose one compact section per concern so polecats don't have to run
+bd ready, gt mail inbox, gt mol status, and git status separately:
+
+  - Hooked work (from wisp/handoff)
+  - Unread mail subjects (top 5)
+  - Molecule step position
+  - In-progress issues assigned to you
+  - Git branch/dirty summary
+
+Sections are gathered concurrently under a shared timeout; a source that
+times out or errors is simply omitted rather than blocking the others.`,
+	RunE: runBrief,
+}
+
+func init() {
+	rootCmd.AddCommand(briefCmd)
+}
+
+func runBrief(cmd *cobra.Command, args []string) error {
+	rctx, err := GetRole()
+	if err != nil {
+		return err
+	}
+
+	info := gatherBriefData(rctx)
+	renderBrief(os.Stdout, info)
+	return nil
+}
+
+// briefGathered holds the results of gt brief's concurrent data-gathering
+// phase. Each field is written by exactly one primeTask, so no locking is
+// needed beyond runPrimeTasks' own WaitGroup. A nil/zero field means that
+// section's source produced nothing (empty or failed) - the section is
+// rendered as "(none)" rather than treated as an error.
+type briefGathered struct {
+	hookedIssue *beads.Issue
+	unreadMail  []*mail.Message
+	molecule    *MoleculeCurrentInfo
+	inProgress  []*beads.Issue
+	branch      string
+	gitStatus   *git.GitStatus
+}
+
+// gatherBriefData runs brief's independent bd/mail/git queries concurrently
+// under a shared timeout, mirroring gatherPrimeData's approach.
+func gatherBriefData(rctx RoleContext) *briefGathered {
+	ctx, cancel := context.WithTimeout(context.Background(), briefGatherTimeout)
+	defer cancel()
+
+	result := &briefGathered{}
+	assignee := getAgentIdentity(rctx)
+
+	var tasks []primeTask
+
+	if assignee != "" {
+		tasks = append(tasks, primeTask{name: "hooked bead", fn: func() {
+			result.hookedIssue = findHookedBead(rctx.WorkDir, assignee)
+		}})
+
+		tasks = append(tasks, primeTask{name: "unread mail", fn: func() {
+			mailbox := mail.NewMailboxBeads(assignee, rctx.TownRoot)
+			messages, err := mailbox.ListUnread()
+			if err == nil {
+				result.unreadMail = messages
+			}
+		}})
+
+		tasks = append(tasks, primeTask{name: "in-progress issues", fn: func() {
+			b := beads.New(rctx.WorkDir)
+			issues, err := b.List(beads.ListOptions{Status: "in_progress", Assignee: assignee, Priority: -1})
+			if err == nil {
+				result.inProgress = issues
+			}
+		}})
+	}
+
+	tasks = append(tasks, primeTask{name: "molecule current", fn: func() {
+		result.molecule = fetchMoleculeCurrent(ctx, rctx.WorkDir)
+	}})
+
+	tasks = append(tasks, primeTask{name: "git summary", fn: func() {
+		g := git.NewGit(rctx.WorkDir)
+		if !g.IsRepo() {
+			return
+		}
+		if branch, err := g.CurrentBranch(); err == nil {
+			result.branch = branch
+		}
+		if status, err := g.Status(); err == nil {
+			result.gitStatus = status
+		}
+	}})
+
+	runPrimeTasks(ctx, nil, tasks)
+	return result
+}
+
+// fetchMoleculeCurrent runs `gt mol current --json` and decodes the result,
+// or returns nil if it fails or produces nothing.
+func fetchMoleculeCurrent(ctx context.Context, workDir string) *MoleculeCurrentInfo {
+	cmd := exec.CommandContext(ctx, "gt", "mol", "current", "--json")
+	cmd.Dir = workDir
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil
+	}
+
+	var info MoleculeCurrentInfo
+	if err := json.Unmarshal(stdout.Bytes(), &info); err != nil {
+		return nil
+	}
+	return &info
+}
+
+// renderBrief prints one compact section per concern. It never fails: a
+// section with nothing to show just prints "(none)" instead of being
+// omitted, so the overall shape of the output is stable.
+func renderBrief(w *os.File, info *briefGathered) {
+	fmt.Fprintln(w, "## gt brief")
+
+	fmt.Fprint(w, "Hooked:   ")
+	if info.hookedIssue != nil {
+		fmt.Fprintf(w, "%s - %s\n", info.hookedIssue.ID, sanitizeBriefText(info.hookedIssue.Title, 80))
+	} else {
+		fmt.Fprintln(w, "(none)")
+	}
+
+	fmt.Fprint(w, "Mail:     ")
+	if len(info.unreadMail) == 0 {
+		fmt.Fprintln(w, "(none unread)")
+	} else {
+		fmt.Fprintf(w, "%d unread\n", len(info.unreadMail))
+		for i, msg := range info.unreadMail {
+			if i >= 5 {
+				break
+			}
+			fmt.Fprintf(w, "  - %s: %s\n", msg.From, sanitizeBriefText(msg.Subject, 80))
+		}
+	}
+
+	fmt.Fprint(w, "Molecule: ")
+	if info.molecule != nil && info.molecule.MoleculeID != "" {
+		if info.molecule.CurrentStepID != "" {
+			fmt.Fprintf(w, "%s - step %d/%d: %s (%s)\n",
+				sanitizeBriefText(info.molecule.MoleculeTitle, 60),
+				info.molecule.StepsComplete+1, info.molecule.StepsTotal,
+				info.molecule.CurrentStepID, sanitizeBriefText(info.molecule.CurrentStep, 60))
+		} else {
+			fmt.Fprintf(w, "%s - %s\n", sanitizeBriefText(info.molecule.MoleculeTitle, 60), info.molecule.Status)
+		}
+	} else {
+		fmt.Fprintln(w, "(none attached)")
+	}
+
+	fmt.Fprint(w, "In progress: ")
+	if len(info.inProgress) == 0 {
+		fmt.Fprintln(w, "(none)")
+	} else {
+		fmt.Fprintf(w, "%d issue(s)\n", len(info.inProgress))
+		for _, issue := range info.inProgress {
+			fmt.Fprintf(w, "  - %s: %s\n", issue.ID, sanitizeBriefText(issue.Title, 80))
+		}
+	}
+
+	fmt.Fprint(w, "Git:      ")
+	if info.branch == "" && info.gitStatus == nil {
+		fmt.Fprintln(w, "(unavailable)")
+	} else {
+		branch := info.branch
+		if branch == "" {
+			branch = "(unknown)"
+		}
+		if info.gitStatus == nil {
+			fmt.Fprintf(w, "%s\n", branch)
+		} else if info.gitStatus.Clean {
+			fmt.Fprintf(w, "%s (clean)\n", branch)
+		} else {
+			dirty := len(info.gitStatus.Modified) + len(info.gitStatus.Added) + len(info.gitStatus.Deleted) + len(info.gitStatus.Untracked)
+			fmt.Fprintf(w, "%s (%d file(s) dirty)\n", branch, dirty)
+		}
+	}
+}
+
+// sanitizeBriefText makes untrusted text (mail subjects, issue titles) safe
+// to print into a plain-text terminal brief: control characters (including
+// newlines, which could otherwise forge extra "sections" or fake prompts)
+// are stripped and the result is truncated to maxLen.
+func sanitizeBriefText(s string, maxLen int) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r == '\n' || r == '\r' || r == '\t' {
+			b.WriteByte(' ')
+			continue
+		}
+		if r < 0x20 || r == 0x7f {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return truncateString(strings.TrimSpace(b.String()), maxLen)
+}
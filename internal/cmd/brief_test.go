@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/mail"
+)
+
+// captureBrief renders a briefGathered to a temp file and returns the
+// output as a string.
+func captureBrief(t *testing.T, info *briefGathered) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe: %v", err)
+	}
+	renderBrief(w, info)
+	w.Close()
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("reading captured output: %v", err)
+	}
+	return string(out)
+}
+
+// TestRenderBrief_AllSectionsEmpty verifies every section stubbed to its
+// zero value still renders a stable "(none)"/"(unavailable)" shape rather
+// than being omitted.
+func TestRenderBrief_AllSectionsEmpty(t *testing.T) {
+	out := captureBrief(t, &briefGathered{})
+
+	for _, want := range []string{
+		"Hooked:   (none)",
+		"Mail:     (none unread)",
+		"Molecule: (none attached)",
+		"In progress: (none)",
+		"Git:      (unavailable)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q, got:\n%s", want, out)
+		}
+	}
+}
+
+// TestRenderBrief_PartialFailureStillRendersTheRest stubs only some
+// sources (as if the others timed out or errored) and checks that the
+// populated sections render normally while the missing ones degrade
+// gracefully.
+func TestRenderBrief_PartialFailureStillRendersTheRest(t *testing.T) {
+	info := &briefGathered{
+		hookedIssue: &beads.Issue{ID: "gt-abc123", Title: "Fix the thing"},
+		inProgress: []*beads.Issue{
+			{ID: "gt-def456", Title: "Other work"},
+		},
+		// unreadMail, molecule, branch, gitStatus deliberately left zero -
+		// simulating those sources timing out.
+	}
+
+	out := captureBrief(t, info)
+
+	if !strings.Contains(out, "Hooked:   gt-abc123 - Fix the thing") {
+		t.Errorf("expected hooked section to render, got:\n%s", out)
+	}
+	if !strings.Contains(out, "In progress: 1 issue(s)") || !strings.Contains(out, "gt-def456") {
+		t.Errorf("expected in-progress section to render, got:\n%s", out)
+	}
+	if !strings.Contains(out, "Mail:     (none unread)") {
+		t.Errorf("expected mail section to degrade gracefully, got:\n%s", out)
+	}
+	if !strings.Contains(out, "Molecule: (none attached)") {
+		t.Errorf("expected molecule section to degrade gracefully, got:\n%s", out)
+	}
+	if !strings.Contains(out, "Git:      (unavailable)") {
+		t.Errorf("expected git section to degrade gracefully, got:\n%s", out)
+	}
+}
+
+// TestRenderBrief_MailAndMolecule verifies the populated-mail and
+// populated-molecule rendering paths.
+func TestRenderBrief_MailAndMolecule(t *testing.T) {
+	info := &briefGathered{
+		unreadMail: []*mail.Message{
+			{From: "mayor/", Subject: "Please review MR"},
+			{From: "gastown/witness", Subject: "Escalation"},
+		},
+		molecule: &MoleculeCurrentInfo{
+			MoleculeID:    "gt-mol-1",
+			MoleculeTitle: "Ship the feature",
+			StepsComplete: 2,
+			StepsTotal:    5,
+			CurrentStepID: "gt-mol-1.3",
+			CurrentStep:   "Write tests",
+		},
+		branch:    "polecat/nux",
+		gitStatus: &git.GitStatus{Clean: false, Modified: []string{"a.go"}},
+	}
+
+	out := captureBrief(t, info)
+
+	if !strings.Contains(out, "2 unread") || !strings.Contains(out, "Please review MR") {
+		t.Errorf("expected mail section with subjects, got:\n%s", out)
+	}
+	if !strings.Contains(out, "Ship the feature") || !strings.Contains(out, "step 3/5") || !strings.Contains(out, "gt-mol-1.3") {
+		t.Errorf("expected molecule section with step position, got:\n%s", out)
+	}
+	if !strings.Contains(out, "polecat/nux (1 file(s) dirty)") {
+		t.Errorf("expected dirty git summary, got:\n%s", out)
+	}
+}
+
+// TestRenderBrief_MailTruncatedToFive verifies only the first 5 unread
+// subjects are shown even if more are unread.
+func TestRenderBrief_MailTruncatedToFive(t *testing.T) {
+	var messages []*mail.Message
+	for i := 0; i < 8; i++ {
+		messages = append(messages, &mail.Message{From: "mayor/", Subject: "msg"})
+	}
+	info := &briefGathered{unreadMail: messages}
+
+	out := captureBrief(t, info)
+	if got := strings.Count(out, "  - mayor/: msg"); got != 5 {
+		t.Errorf("expected 5 mail lines, got %d in:\n%s", got, out)
+	}
+	if !strings.Contains(out, "8 unread") {
+		t.Errorf("expected total unread count of 8, got:\n%s", out)
+	}
+}
+
+// TestSanitizeBriefText verifies control characters (which could forge
+// fake sections or prompts in plain-text output) are stripped and long
+// text is truncated.
+func TestSanitizeBriefText(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "newline becomes space",
+			input: "line one\nline two",
+			want:  "line one line two",
+		},
+		{
+			name:  "control chars stripped",
+			input: "hello\x1b[31mworld\x00",
+			want:  "hello[31mworld",
+		},
+		{
+			name:  "plain text passes through",
+			input: "Fix the parser bug",
+			want:  "Fix the parser bug",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sanitizeBriefText(tt.input, 80); got != tt.want {
+				t.Errorf("sanitizeBriefText(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	long := strings.Repeat("a", 100)
+	got := sanitizeBriefText(long, 10)
+	if len(got) != 10 {
+		t.Errorf("sanitizeBriefText should truncate to maxLen, got len %d", len(got))
+	}
+}
+
+// TestGatherBriefData_RespectsTimeoutAndDegrades runs the real concurrent
+// gather against a workspace with no bd/gt/git binaries available for it
+// to talk to (a bare temp dir with no role), verifying it returns well
+// within the gather budget instead of hanging, with every field left at
+// its zero value.
+func TestGatherBriefData_RespectsTimeoutAndDegrades(t *testing.T) {
+	workDir := t.TempDir()
+	rctx := RoleContext{Role: RoleUnknown, TownRoot: workDir, WorkDir: workDir}
+
+	start := time.Now()
+	info := gatherBriefData(rctx)
+	elapsed := time.Since(start)
+
+	if elapsed > briefGatherTimeout+time.Second {
+		t.Fatalf("gatherBriefData took %v, expected to finish near the %v budget", elapsed, briefGatherTimeout)
+	}
+	if info.branch != "" || info.gitStatus != nil {
+		t.Errorf("expected git fields to stay zero outside a repo, got branch=%q status=%+v", info.branch, info.gitStatus)
+	}
+
+	// Rendering the degraded result should still produce the full,
+	// stable section layout.
+	out := captureBrief(t, info)
+	if !strings.Contains(out, "## gt brief") {
+		t.Errorf("expected brief header, got:\n%s", out)
+	}
+}
@@ -113,10 +113,10 @@ func runBroadcast(cmd *cobra.Command, args []string) error {
 		if err := t.NudgeSession(agent.Name, message); err != nil {
 			failed++
 			failures = append(failures, fmt.Sprintf("%s: %v", agentName, err))
-			fmt.Printf("  %s %s %s\n", style.ErrorPrefix, AgentTypeIcons[agent.Type], agentName)
+			fmt.Printf("  %s %s %s\n", style.ErrorPrefix(), AgentTypeIcons[agent.Type], agentName)
 		} else {
 			succeeded++
-			fmt.Printf("  %s %s %s\n", style.SuccessPrefix, AgentTypeIcons[agent.Type], agentName)
+			fmt.Printf("  %s %s %s\n", style.SuccessPrefix(), AgentTypeIcons[agent.Type], agentName)
 		}
 
 		// Small delay between nudges to avoid overwhelming tmux
@@ -128,14 +128,14 @@ func runBroadcast(cmd *cobra.Command, args []string) error {
 	fmt.Println()
 	if failed > 0 {
 		fmt.Printf("%s Broadcast complete: %d succeeded, %d failed\n",
-			style.WarningPrefix, succeeded, failed)
+			style.WarningPrefix(), succeeded, failed)
 		for _, f := range failures {
 			fmt.Printf("  %s\n", style.Dim.Render(f))
 		}
 		return fmt.Errorf("%d nudge(s) failed", failed)
 	}
 
-	fmt.Printf("%s Broadcast complete: %d agent(s) nudged\n", style.SuccessPrefix, succeeded)
+	fmt.Printf("%s Broadcast complete: %d agent(s) nudged\n", style.SuccessPrefix(), succeeded)
 	return nil
 }
 
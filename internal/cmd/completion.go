@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// maxCompletionBeadIDs caps how many ready bead IDs are offered as
+// completions, so a large backlog doesn't make completion slow or unwieldy.
+const maxCompletionBeadIDs = 50
+
+// completionRigNames returns the names of all registered rigs, or nil (with
+// ShellCompDirectiveNoFileComp) if not inside a Gas Town workspace. All
+// completion functions in this file degrade silently this way - shell
+// completion should never print an error, it should just offer nothing.
+func completionRigNames(toComplete string) ([]string, cobra.ShellCompDirective) {
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	rigsConfig, err := config.LoadRigsConfig(filepath.Join(townRoot, "mayor", "rigs.json"))
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	mgr := rig.NewManager(townRoot, rigsConfig, git.NewGit(townRoot))
+	var names []string
+	for _, name := range mgr.ListRigNames() {
+		if strings.HasPrefix(name, toComplete) {
+			names = append(names, name)
+		}
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeRigArg completes a bare "<rig>" positional argument.
+func completeRigArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completionRigNames(toComplete)
+}
+
+// completeRigSlashAgent completes a "<rig>/<polecat-or-crew>" positional
+// argument: rig names up to the slash, then that rig's polecat and crew
+// names after it.
+func completeRigSlashAgent(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	rigName, rest, hasSlash := strings.Cut(toComplete, "/")
+	if !hasSlash {
+		names, directive := completionRigNames(rigName)
+		completions := make([]string, len(names))
+		for i, name := range names {
+			completions[i] = name + "/"
+		}
+		return completions, directive
+	}
+
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	rigsConfig, err := config.LoadRigsConfig(filepath.Join(townRoot, "mayor", "rigs.json"))
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	mgr := rig.NewManager(townRoot, rigsConfig, git.NewGit(townRoot))
+	r, err := mgr.GetRig(rigName)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var completions []string
+	for _, name := range append(append([]string{}, r.Polecats...), r.Crew...) {
+		if strings.HasPrefix(name, rest) {
+			completions = append(completions, rigName+"/"+name)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeBeadIDs completes an issue ID from the current rig's ready queue
+// (as reported by `bd ready`), capped at maxCompletionBeadIDs.
+func completeBeadIDs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	b := beads.New(beads.ResolveBeadsDir(cwd))
+	issues, err := b.Ready()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var completions []string
+	for _, issue := range issues {
+		if len(completions) >= maxCompletionBeadIDs {
+			break
+		}
+		if strings.HasPrefix(issue.ID, toComplete) {
+			completions = append(completions, issue.ID+"\t"+issue.Title)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeHandoffTarget completes gt handoff's [bead-or-role] argument:
+// well-known role names plus ready bead IDs.
+func completeHandoffTarget(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	roles := []string{"mayor", "crew", "witness", "refinery", "deacon"}
+	var completions []string
+	for _, role := range roles {
+		if strings.HasPrefix(role, toComplete) {
+			completions = append(completions, role)
+		}
+	}
+
+	beadCompletions, _ := completeBeadIDs(cmd, args, toComplete)
+	completions = append(completions, beadCompletions...)
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeMailAddress completes gt mail send's <address> argument: rig
+// broadcast/role addresses, rig/polecat and rig/crew addresses, "mayor/",
+// and configured mailing lists (list:<name>).
+func completeMailAddress(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var completions []string
+	if strings.HasPrefix("mayor/", toComplete) {
+		completions = append(completions, "mayor/")
+	}
+
+	if messaging, err := config.LoadMessagingConfig(config.MessagingConfigPath(townRoot)); err == nil {
+		for name := range messaging.Lists {
+			addr := "list:" + name
+			if strings.HasPrefix(addr, toComplete) {
+				completions = append(completions, addr)
+			}
+		}
+	}
+
+	rigsConfig, err := config.LoadRigsConfig(filepath.Join(townRoot, "mayor", "rigs.json"))
+	if err != nil {
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+	mgr := rig.NewManager(townRoot, rigsConfig, git.NewGit(townRoot))
+
+	rigName, rest, hasSlash := strings.Cut(toComplete, "/")
+	if !hasSlash {
+		for _, name := range mgr.ListRigNames() {
+			if strings.HasPrefix(name, rigName) {
+				completions = append(completions, name+"/")
+			}
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	r, err := mgr.GetRig(rigName)
+	if err != nil {
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+	for _, addr := range append(append([]string{"refinery", "witness"}, r.Polecats...), r.Crew...) {
+		if strings.HasPrefix(addr, rest) {
+			completions = append(completions, rigName+"/"+addr)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+// setupCompletionWorkspace builds a minimal Gas Town workspace with one rig
+// ("greenplace", polecats Toast and Rex, crew furiosa) under a temp dir and
+// points GT_TOWN at it, so completion helpers that call workspace.FindFromCwd
+// resolve without depending on the real cwd.
+func setupCompletionWorkspace(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+
+	mustMkdirAll(t, filepath.Join(root, "mayor"))
+	mustWriteFile(t, filepath.Join(root, "mayor", "town.json"), `{"name":"testtown"}`)
+	mustWriteFile(t, filepath.Join(root, "mayor", "rigs.json"), `{
+		"version": 1,
+		"rigs": {
+			"greenplace": {"git_url": "https://example.com/greenplace.git", "added_at": "2026-01-01T00:00:00Z"}
+		}
+	}`)
+
+	rigPath := filepath.Join(root, "greenplace")
+	mustMkdirAll(t, filepath.Join(rigPath, "polecats", "Toast"))
+	mustMkdirAll(t, filepath.Join(rigPath, "polecats", "Rex"))
+	mustMkdirAll(t, filepath.Join(rigPath, "crew", "furiosa"))
+	mustMkdirAll(t, filepath.Join(rigPath, "witness"))
+	mustMkdirAll(t, filepath.Join(rigPath, "refinery", "rig"))
+
+	t.Setenv("GT_TOWN", root)
+	return root
+}
+
+func mustMkdirAll(t *testing.T, path string) {
+	t.Helper()
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		t.Fatalf("mkdir %s: %v", path, err)
+	}
+}
+
+func mustWriteFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+}
+
+func TestCompleteRigArg(t *testing.T) {
+	setupCompletionWorkspace(t)
+
+	names, directive := completeRigArg(nil, nil, "")
+	if directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Errorf("directive = %v, want NoFileComp", directive)
+	}
+	if len(names) != 1 || names[0] != "greenplace" {
+		t.Errorf("names = %v, want [greenplace]", names)
+	}
+
+	// Already-consumed positional arg means no further completion.
+	names, _ = completeRigArg(nil, []string{"greenplace"}, "")
+	if names != nil {
+		t.Errorf("names = %v, want nil once the rig arg is filled", names)
+	}
+}
+
+func TestCompleteRigArg_OutsideWorkspace(t *testing.T) {
+	t.Setenv("GT_TOWN", t.TempDir()) // valid dir, but not a workspace
+
+	names, directive := completeRigArg(nil, nil, "")
+	if directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Errorf("directive = %v, want NoFileComp", directive)
+	}
+	if names != nil {
+		t.Errorf("names = %v, want nil outside a workspace", names)
+	}
+}
+
+func TestCompleteRigSlashAgent(t *testing.T) {
+	setupCompletionWorkspace(t)
+
+	// Before the slash: rig names, with a trailing slash appended.
+	completions, _ := completeRigSlashAgent(nil, nil, "green")
+	if len(completions) != 1 || completions[0] != "greenplace/" {
+		t.Errorf("completions = %v, want [greenplace/]", completions)
+	}
+
+	// After the slash: polecats and crew for that rig.
+	completions, _ = completeRigSlashAgent(nil, nil, "greenplace/")
+	sort.Strings(completions)
+	want := []string{"greenplace/Rex", "greenplace/Toast", "greenplace/furiosa"}
+	if len(completions) != len(want) {
+		t.Fatalf("completions = %v, want %v", completions, want)
+	}
+	for i := range want {
+		if completions[i] != want[i] {
+			t.Errorf("completions = %v, want %v", completions, want)
+		}
+	}
+
+	// Filtering by the partial polecat name.
+	completions, _ = completeRigSlashAgent(nil, nil, "greenplace/To")
+	if len(completions) != 1 || completions[0] != "greenplace/Toast" {
+		t.Errorf("completions = %v, want [greenplace/Toast]", completions)
+	}
+}
+
+func TestCompleteMailAddress(t *testing.T) {
+	setupCompletionWorkspace(t)
+
+	completions, _ := completeMailAddress(nil, nil, "")
+	found := map[string]bool{}
+	for _, c := range completions {
+		found[c] = true
+	}
+	if !found["mayor/"] {
+		t.Errorf("completions = %v, want to include mayor/", completions)
+	}
+	if !found["greenplace/"] {
+		t.Errorf("completions = %v, want to include greenplace/", completions)
+	}
+
+	completions, _ = completeMailAddress(nil, nil, "greenplace/")
+	found = map[string]bool{}
+	for _, c := range completions {
+		found[c] = true
+	}
+	for _, want := range []string{"greenplace/refinery", "greenplace/witness", "greenplace/Toast", "greenplace/furiosa"} {
+		if !found[want] {
+			t.Errorf("completions = %v, want to include %s", completions, want)
+		}
+	}
+}
+
+func TestCompleteHandoffTarget_Roles(t *testing.T) {
+	completions, directive := completeHandoffTarget(nil, nil, "wit")
+	if directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Errorf("directive = %v, want NoFileComp", directive)
+	}
+	if len(completions) != 1 || completions[0] != "witness" {
+		t.Errorf("completions = %v, want [witness]", completions)
+	}
+}
+
+// TestValidArgsFunctionsRegistered exercises cobra's own completion
+// machinery (the "completion test harness") rather than calling the
+// helpers directly, to catch wiring mistakes like registering a
+// ValidArgsFunction against the wrong command or flag.
+func TestValidArgsFunctionsRegistered(t *testing.T) {
+	setupCompletionWorkspace(t)
+
+	tests := []struct {
+		name string
+		cmd  *cobra.Command
+	}{
+		{"polecat add", polecatAddCmd},
+		{"polecat status", polecatStatusCmd},
+		{"session start", sessionStartCmd},
+		{"mail send", mailSendCmd},
+		{"handoff", handoffCmd},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.cmd.ValidArgsFunction == nil {
+				t.Fatalf("%s has no ValidArgsFunction registered", tt.cmd.Use)
+			}
+			// Calling it directly must not panic outside of a running
+			// completion session.
+			tt.cmd.ValidArgsFunction(tt.cmd, nil, "")
+		})
+	}
+
+	if sessionStartCmd.Flags().Lookup("issue") == nil {
+		t.Fatal("session start lost its --issue flag")
+	}
+}
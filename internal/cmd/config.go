@@ -29,7 +29,9 @@ Commands:
   gt config agent get <name>         Show agent configuration
   gt config agent set <name> <cmd>   Set custom agent command
   gt config agent remove <name>      Remove custom agent
-  gt config default-agent [name]     Get or set default agent`,
+  gt config default-agent [name]     Get or set default agent
+  gt config get <key>                Get a town tunable
+  gt config set <key> <value>        Set a town tunable`,
 }
 
 // Agent subcommands
@@ -140,6 +142,94 @@ Examples:
 	RunE: runConfigAgentEmailDomain,
 }
 
+// Generic tunable get/set subcommands
+
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Get a town tunable",
+	Long: `Get the effective value of a town-wide tunable.
+
+Town tunables (mail dedupe windows, idle timeouts, wisp retention, stall
+thresholds) live in settings/config.json under "defaults" and can be
+overridden per-invocation with a GT_SETTING_<KEY> environment variable
+(e.g. GT_SETTING_STALL_THRESHOLD=1h).
+
+Valid keys: ` + strings.Join(config.TunableKeys(), ", ") + `
+
+Examples:
+  gt config get stall_threshold
+  gt config get idle_polecat_timeout`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfigGet,
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a town tunable",
+	Long: `Set a town-wide tunable in settings/config.json.
+
+Values are validated as durations (e.g. "10s", "30m", "2h") before being
+written. A GT_SETTING_<KEY> environment variable, if set, takes precedence
+over the stored value at read time.
+
+Valid keys: ` + strings.Join(config.TunableKeys(), ", ") + `
+
+Examples:
+  gt config set stall_threshold 1h
+  gt config set idle_polecat_timeout 45m`,
+	Args: cobra.ExactArgs(2),
+	RunE: runConfigSet,
+}
+
+func runConfigGet(cmd *cobra.Command, args []string) error {
+	key := args[0]
+
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil {
+		return fmt.Errorf("finding town root: %w", err)
+	}
+
+	settingsPath := config.TownSettingsPath(townRoot)
+	townSettings, err := config.LoadOrCreateTownSettings(settingsPath)
+	if err != nil {
+		return fmt.Errorf("loading town settings: %w", err)
+	}
+
+	value, err := townSettings.GetTunable(key)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(value)
+	return nil
+}
+
+func runConfigSet(cmd *cobra.Command, args []string) error {
+	key, value := args[0], args[1]
+
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil {
+		return fmt.Errorf("finding town root: %w", err)
+	}
+
+	settingsPath := config.TownSettingsPath(townRoot)
+	townSettings, err := config.LoadOrCreateTownSettings(settingsPath)
+	if err != nil {
+		return fmt.Errorf("loading town settings: %w", err)
+	}
+
+	if err := townSettings.SetTunable(key, value); err != nil {
+		return err
+	}
+
+	if err := config.SaveTownSettings(settingsPath, townSettings); err != nil {
+		return fmt.Errorf("saving town settings: %w", err)
+	}
+
+	fmt.Printf("%s set to '%s'\n", style.Bold.Render(key), value)
+	return nil
+}
+
 // Flags
 var (
 	configAgentListJSON bool
@@ -532,6 +622,8 @@ func init() {
 	configCmd.AddCommand(configAgentCmd)
 	configCmd.AddCommand(configDefaultAgentCmd)
 	configCmd.AddCommand(configAgentEmailDomainCmd)
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
 
 	// Register with root
 	rootCmd.AddCommand(configCmd)
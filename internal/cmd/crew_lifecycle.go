@@ -354,13 +354,13 @@ func runCrewStart(cmd *cobra.Command, args []string) error {
 	skippedCount := 0
 	for res := range results {
 		if res.err != nil {
-			fmt.Printf("  %s %s/%s: %v\n", style.ErrorPrefix, rigName, res.name, res.err)
+			fmt.Printf("  %s %s/%s: %v\n", style.ErrorPrefix(), rigName, res.name, res.err)
 			lastErr = res.err
 		} else if res.skipped {
 			fmt.Printf("  %s %s/%s: already running\n", style.Dim.Render("○"), rigName, res.name)
 			skippedCount++
 		} else {
-			fmt.Printf("  %s %s/%s: started\n", style.SuccessPrefix, rigName, res.name)
+			fmt.Printf("  %s %s/%s: started\n", style.SuccessPrefix(), rigName, res.name)
 			startedCount++
 		}
 	}
@@ -478,7 +478,7 @@ func runCrewRestartAll() error {
 		if err != nil {
 			failed++
 			failures = append(failures, fmt.Sprintf("%s: %v", agentName, err))
-			fmt.Printf("  %s %s\n", style.ErrorPrefix, agentName)
+			fmt.Printf("  %s %s\n", style.ErrorPrefix(), agentName)
 			crewRig = savedRig
 			continue
 		}
@@ -492,10 +492,10 @@ func runCrewRestartAll() error {
 		if err != nil {
 			failed++
 			failures = append(failures, fmt.Sprintf("%s: %v", agentName, err))
-			fmt.Printf("  %s %s\n", style.ErrorPrefix, agentName)
+			fmt.Printf("  %s %s\n", style.ErrorPrefix(), agentName)
 		} else {
 			succeeded++
-			fmt.Printf("  %s %s\n", style.SuccessPrefix, agentName)
+			fmt.Printf("  %s %s\n", style.SuccessPrefix(), agentName)
 		}
 
 		crewRig = savedRig
@@ -507,14 +507,14 @@ func runCrewRestartAll() error {
 	fmt.Println()
 	if failed > 0 {
 		fmt.Printf("%s Restart complete: %d succeeded, %d failed\n",
-			style.WarningPrefix, succeeded, failed)
+			style.WarningPrefix(), succeeded, failed)
 		for _, f := range failures {
 			fmt.Printf("  %s\n", style.Dim.Render(f))
 		}
 		return fmt.Errorf("%d restart(s) failed", failed)
 	}
 
-	fmt.Printf("%s Restart complete: %d crew session(s) restarted\n", style.SuccessPrefix, succeeded)
+	fmt.Printf("%s Restart complete: %d crew session(s) restarted\n", style.SuccessPrefix(), succeeded)
 	return nil
 }
 
@@ -594,7 +594,7 @@ func runCrewStop(cmd *cobra.Command, args []string) error {
 		// Kill the session (with proper process cleanup to avoid orphans)
 		if err := t.KillSessionWithProcesses(sessionID); err != nil {
 			fmt.Printf("  %s [%s] %s: %s\n",
-				style.ErrorPrefix,
+				style.ErrorPrefix(),
 				r.Name, name,
 				style.Dim.Render(err.Error()))
 			lastErr = err
@@ -602,7 +602,7 @@ func runCrewStop(cmd *cobra.Command, args []string) error {
 		}
 
 		fmt.Printf("  %s [%s] %s: stopped\n",
-			style.SuccessPrefix,
+			style.SuccessPrefix(),
 			r.Name, name)
 
 		// Log kill event to town log
@@ -685,12 +685,12 @@ func runCrewStopAll() error {
 		if err := t.KillSessionWithProcesses(sessionID); err != nil {
 			failed++
 			failures = append(failures, fmt.Sprintf("%s: %v", agentName, err))
-			fmt.Printf("  %s %s\n", style.ErrorPrefix, agentName)
+			fmt.Printf("  %s %s\n", style.ErrorPrefix(), agentName)
 			continue
 		}
 
 		succeeded++
-		fmt.Printf("  %s %s\n", style.SuccessPrefix, agentName)
+		fmt.Printf("  %s %s\n", style.SuccessPrefix(), agentName)
 
 		// Log kill event to town log
 		townRoot, _ := workspace.FindFromCwd()
@@ -711,13 +711,13 @@ func runCrewStopAll() error {
 	fmt.Println()
 	if failed > 0 {
 		fmt.Printf("%s Stop complete: %d succeeded, %d failed\n",
-			style.WarningPrefix, succeeded, failed)
+			style.WarningPrefix(), succeeded, failed)
 		for _, f := range failures {
 			fmt.Printf("  %s\n", style.Dim.Render(f))
 		}
 		return fmt.Errorf("%d stop(s) failed", failed)
 	}
 
-	fmt.Printf("%s Stop complete: %d crew session(s) stopped\n", style.SuccessPrefix, succeeded)
+	fmt.Printf("%s Stop complete: %d crew session(s) stopped\n", style.SuccessPrefix(), succeeded)
 	return nil
 }
@@ -85,8 +85,14 @@ Detach with Ctrl-B D.`,
 var deaconStatusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Check Deacon session status",
-	Long:  `Check if the Deacon tmux session is currently running.`,
-	RunE:  runDeaconStatus,
+	Long: `Show a combined health report for the Deacon: tmux session state, pause
+state, how long since its last patrol heartbeat, and any sessions the
+restart ledger has quarantined.
+
+Exits non-zero if the report finds a problem (session not running, no
+recent heartbeat, or a quarantined session), so this is safe to wire into
+a cron health check.`,
+	RunE: runDeaconStatus,
 }
 
 var deaconRestartCmd = &cobra.Command{
@@ -214,6 +220,25 @@ Examples:
 	RunE: runDeaconStaleHooks,
 }
 
+var deaconStaleAgentsCmd = &cobra.Command{
+	Use:   "stale-agents",
+	Short: "Find agent beads whose heartbeat has gone stale",
+	Long: `Find agent beads that claim to be running but haven't heartbeated recently.
+
+A tmux session existing doesn't mean Claude is responsive - it could be stuck
+in a tool loop. This command lists agent beads whose agent_state isn't closed
+but whose last_heartbeat (updated by gt prime and gt heartbeat) is older than
+the threshold (default: 20 minutes), or was never reported at all.
+
+Unlike stale-hooks, this doesn't take action on its own - it's a report for
+the same escalation path witness patrol feeds (see FindingStaleHeartbeat).
+
+Examples:
+  gt deacon stale-agents                 # List stale agent beads
+  gt deacon stale-agents --max-age=10m   # Use a 10 minute threshold`,
+	RunE: runDeaconStaleAgents,
+}
+
 var deaconPauseCmd = &cobra.Command{
 	Use:   "pause",
 	Short: "Pause the Deacon to prevent patrol actions",
@@ -288,6 +313,29 @@ Examples:
 	RunE: runDeaconZombieScan,
 }
 
+var deaconRestartsCmd = &cobra.Command{
+	Use:   "restarts",
+	Short: "Show the restart backoff ledger",
+	Long: `Show per-session restart history tracked by the crash-loop backoff policy.
+
+The Deacon records every session it restarts. If a session is restarted more
+than the hourly limit, it's quarantined and won't be auto-restarted again
+until a human runs 'gt deacon unquarantine'.`,
+	RunE: runDeaconRestarts,
+}
+
+var deaconUnquarantineCmd = &cobra.Command{
+	Use:   "unquarantine <session>",
+	Short: "Clear quarantine and restart history for a session",
+	Long: `Clear the quarantine flag and restart history for a session, allowing
+the Deacon to restart it again on the next crash.
+
+Examples:
+  gt deacon unquarantine gt-greenplace-witness`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDeaconUnquarantine,
+}
+
 var (
 	triggerTimeout time.Duration
 
@@ -304,11 +352,19 @@ var (
 	staleHooksMaxAge time.Duration
 	staleHooksDryRun bool
 
+	staleAgentsMaxAge time.Duration
+
 	// Pause flags
 	pauseReason string
 
 	// Zombie scan flags
 	zombieScanDryRun bool
+
+	// Restarts flags
+	deaconRestartsJSON bool
+
+	// Status flags
+	deaconStatusJSON bool
 )
 
 func init() {
@@ -323,10 +379,16 @@ func init() {
 	deaconCmd.AddCommand(deaconForceKillCmd)
 	deaconCmd.AddCommand(deaconHealthStateCmd)
 	deaconCmd.AddCommand(deaconStaleHooksCmd)
+	deaconCmd.AddCommand(deaconStaleAgentsCmd)
 	deaconCmd.AddCommand(deaconPauseCmd)
 	deaconCmd.AddCommand(deaconResumeCmd)
 	deaconCmd.AddCommand(deaconCleanupOrphansCmd)
 	deaconCmd.AddCommand(deaconZombieScanCmd)
+	deaconCmd.AddCommand(deaconRestartsCmd)
+	deaconCmd.AddCommand(deaconUnquarantineCmd)
+
+	deaconRestartsCmd.Flags().BoolVar(&deaconRestartsJSON, "json", false, "Output as JSON")
+	deaconStatusCmd.Flags().BoolVar(&deaconStatusJSON, "json", false, "Output as JSON")
 
 	// Flags for trigger-pending
 	deaconTriggerPendingCmd.Flags().DurationVar(&triggerTimeout, "timeout", 2*time.Second,
@@ -352,6 +414,10 @@ func init() {
 	deaconStaleHooksCmd.Flags().BoolVar(&staleHooksDryRun, "dry-run", false,
 		"Preview what would be unhooked without making changes")
 
+	// Flags for stale-agents
+	deaconStaleAgentsCmd.Flags().DurationVar(&staleAgentsMaxAge, "max-age", 20*time.Minute,
+		"Maximum age before an agent bead's heartbeat is considered stale")
+
 	// Flags for pause
 	deaconPauseCmd.Flags().StringVar(&pauseReason, "reason", "",
 		"Reason for pausing the Deacon")
@@ -525,58 +591,73 @@ func runDeaconAttach(cmd *cobra.Command, args []string) error {
 }
 
 func runDeaconStatus(cmd *cobra.Command, args []string) error {
-	t := tmux.NewTmux()
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
 
-	sessionName := getDeaconSessionName()
+	health, err := deacon.CheckHealth(townRoot)
+	if err != nil {
+		return fmt.Errorf("checking deacon health: %w", err)
+	}
 
-	// Check pause state first (most important)
-	townRoot, _ := workspace.FindFromCwdOrError()
-	if townRoot != "" {
-		paused, state, err := deacon.IsPaused(townRoot)
-		if err == nil && paused {
-			fmt.Printf("%s DEACON PAUSED\n", style.Bold.Render("⏸️"))
-			if state.Reason != "" {
-				fmt.Printf("  Reason: %s\n", state.Reason)
-			}
-			fmt.Printf("  Paused at: %s\n", state.PausedAt.Format(time.RFC3339))
-			fmt.Printf("  Paused by: %s\n", state.PausedBy)
-			fmt.Println()
-			fmt.Printf("Resume with: %s\n", style.Dim.Render("gt deacon resume"))
-			fmt.Println()
+	if deaconStatusJSON {
+		if err := outputJSON(health); err != nil {
+			return err
+		}
+		if !health.Healthy() {
+			os.Exit(1)
 		}
+		return nil
 	}
 
-	running, err := t.HasSession(sessionName)
-	if err != nil {
-		return fmt.Errorf("checking session: %w", err)
+	if health.Paused {
+		fmt.Printf("%s DEACON PAUSED\n", style.Bold.Render("⏸️"))
+		if health.PauseReason != "" {
+			fmt.Printf("  Reason: %s\n", health.PauseReason)
+		}
+		if health.PausedAt != nil {
+			fmt.Printf("  Paused at: %s\n", health.PausedAt.Format(time.RFC3339))
+		}
+		if health.PausedBy != "" {
+			fmt.Printf("  Paused by: %s\n", health.PausedBy)
+		}
+		fmt.Println()
 	}
 
-	if running {
-		// Get session info for more details
-		info, err := t.GetSessionInfo(sessionName)
-		if err == nil {
+	t := tmux.NewTmux()
+	if health.SessionRunning {
+		fmt.Printf("%s Deacon session is %s\n", style.Bold.Render("●"), style.Bold.Render("running"))
+		if info, err := t.GetSessionInfo(health.SessionName); err == nil {
 			status := "detached"
 			if info.Attached {
 				status = "attached"
 			}
-			fmt.Printf("%s Deacon session is %s\n",
-				style.Bold.Render("●"),
-				style.Bold.Render("running"))
 			fmt.Printf("  Status: %s\n", status)
 			fmt.Printf("  Created: %s\n", info.Created)
-			fmt.Printf("\nAttach with: %s\n", style.Dim.Render("gt deacon attach"))
-		} else {
-			fmt.Printf("%s Deacon session is %s\n",
-				style.Bold.Render("●"),
-				style.Bold.Render("running"))
 		}
 	} else {
-		fmt.Printf("%s Deacon session is %s\n",
-			style.Dim.Render("○"),
-			"not running")
+		fmt.Printf("%s Deacon session is %s\n", style.Dim.Render("○"), "not running")
+	}
+
+	fmt.Println()
+	for _, line := range health.Lines {
+		marker := style.Bold.Render("✓ OK")
+		if !line.OK {
+			marker = style.Bold.Render("⚠ WARN")
+		}
+		fmt.Printf("  %s %s\n", marker, line.Message)
+	}
+
+	if health.SessionRunning {
+		fmt.Printf("\nAttach with: %s\n", style.Dim.Render("gt deacon attach"))
+	} else {
 		fmt.Printf("\nStart with: %s\n", style.Dim.Render("gt deacon start"))
 	}
 
+	if !health.Healthy() {
+		os.Exit(1)
+	}
 	return nil
 }
 
@@ -1105,6 +1186,32 @@ func runDeaconStaleHooks(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// runDeaconStaleAgents reports agent beads whose heartbeat has gone stale.
+func runDeaconStaleAgents(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	result, err := deacon.ScanStaleAgents(townRoot, &deacon.StaleAgentConfig{MaxAge: staleAgentsMaxAge})
+	if err != nil {
+		return fmt.Errorf("scanning stale agents: %w", err)
+	}
+
+	if len(result.Stale) == 0 {
+		fmt.Printf("%s No stale agent beads found\n", style.Dim.Render("○"))
+		return nil
+	}
+
+	fmt.Printf("%s Found %d stale agent bead(s) (no heartbeat in over %s)\n",
+		style.Bold.Render("●"), len(result.Stale), staleAgentsMaxAge)
+	for _, issue := range result.Stale {
+		fmt.Printf("  %s %s: %s\n", style.Dim.Render("○"), issue.ID, issue.Title)
+	}
+
+	return nil
+}
+
 // runDeaconPause pauses the Deacon to prevent patrol actions.
 func runDeaconPause(cmd *cobra.Command, args []string) error {
 	townRoot, err := workspace.FindFromCwdOrError()
@@ -1285,3 +1392,53 @@ func runDeaconZombieScan(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func runDeaconRestarts(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	ledger, err := deacon.LoadRestartLedger(townRoot)
+	if err != nil {
+		return fmt.Errorf("loading restart ledger: %w", err)
+	}
+
+	if deaconRestartsJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(ledger)
+	}
+
+	if len(ledger.Sessions) == 0 {
+		fmt.Printf("%s No restarts recorded yet\n", style.Dim.Render("○"))
+		return nil
+	}
+
+	for session, e := range ledger.Sessions {
+		fmt.Printf("Session: %s\n", style.Bold.Render(session))
+		fmt.Printf("  Restarts in window: %d\n", len(e.Timestamps))
+		if e.Quarantined {
+			fmt.Printf("  %s Quarantined since %s: %s\n",
+				style.Bold.Render("⚠"), e.QuarantinedAt.Format(time.RFC3339), e.QuarantineReason)
+		}
+		fmt.Println()
+	}
+
+	return nil
+}
+
+func runDeaconUnquarantine(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	sessionName := args[0]
+	if err := deacon.Unquarantine(townRoot, sessionName); err != nil {
+		return fmt.Errorf("unquarantining %s: %w", sessionName, err)
+	}
+
+	fmt.Printf("%s Cleared quarantine for %s\n", style.Bold.Render("✓"), sessionName)
+	return nil
+}
@@ -92,14 +92,14 @@ func runDnd(cmd *cobra.Command, args []string) error {
 		if err := bd.UpdateAgentNotificationLevel(agentBeadID, beads.NotifyMuted); err != nil {
 			return fmt.Errorf("enabling DND: %w", err)
 		}
-		fmt.Printf("%s DND enabled - notifications muted\n", style.SuccessPrefix)
+		fmt.Printf("%s DND enabled - notifications muted\n", style.SuccessPrefix())
 		fmt.Printf("  Run %s to resume notifications\n", style.Bold.Render("gt dnd off"))
 
 	case "off":
 		if err := bd.UpdateAgentNotificationLevel(agentBeadID, beads.NotifyNormal); err != nil {
 			return fmt.Errorf("disabling DND: %w", err)
 		}
-		fmt.Printf("%s DND disabled - notifications resumed\n", style.SuccessPrefix)
+		fmt.Printf("%s DND disabled - notifications resumed\n", style.SuccessPrefix())
 
 	case "status":
 		levelDisplay := currentLevel
@@ -1,11 +1,15 @@
 package cmd
 
 import (
+	"bufio"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -14,6 +18,7 @@ var (
 	doctorVerbose         bool
 	doctorRig             string
 	doctorRestartSessions bool
+	doctorYes             bool
 )
 
 var doctorCmd = &cobra.Command{
@@ -30,6 +35,7 @@ Workspace checks:
   - town-config-valid        Check mayor/town.json is valid
   - rigs-registry-exists     Check mayor/rigs.json exists (fixable)
   - rigs-registry-valid      Check registered rigs exist (fixable)
+  - rigs-registry-schema     Check mayor/rigs.json against the rigs schema
   - mayor-exists             Check mayor/ directory structure
 
 Town root protection:
@@ -64,6 +70,7 @@ Rig checks (with --rig flag):
   - mayor-clone-exists       Verify mayor/rig/ clone exists (fixable)
   - polecat-clones-valid     Verify polecat directories are valid clones
   - beads-config-valid       Verify beads configuration (fixable)
+  - git-remotes              Verify origin remote is reachable and consistent
 
 Routing checks (fixable):
   - routes-config            Check beads routing configuration
@@ -80,7 +87,11 @@ Patrol checks:
   - patrol-plugins-accessible Verify plugin directories
   - patrol-roles-have-prompts Verify role prompts exist
 
-Use --fix to attempt automatic fixes for issues that support it.
+Use --fix to attempt automatic fixes for issues that support it. When
+stdout is a terminal, --fix asks for confirmation before each fix
+([y]es/[N]o/[a]ll/[q]uit); pass --yes to apply every fix without asking
+(the old --fix behavior, and what non-interactive/piped runs do by
+default).
 Use --rig to check a specific rig instead of the entire workspace.`,
 	RunE: runDoctor,
 }
@@ -90,9 +101,60 @@ func init() {
 	doctorCmd.Flags().BoolVarP(&doctorVerbose, "verbose", "v", false, "Show detailed output")
 	doctorCmd.Flags().StringVar(&doctorRig, "rig", "", "Check specific rig only")
 	doctorCmd.Flags().BoolVar(&doctorRestartSessions, "restart-sessions", false, "Restart patrol sessions when fixing stale settings (use with --fix)")
+	doctorCmd.Flags().BoolVarP(&doctorYes, "yes", "y", false, "Apply every fix without prompting (use with --fix)")
 	rootCmd.AddCommand(doctorCmd)
 }
 
+// fixPrompter drives the interactive y/N/a/q confirmation loop for
+// `gt doctor --fix`. The reader is injected so tests can drive it without a
+// real terminal.
+type fixPrompter struct {
+	in       *bufio.Reader
+	out      io.Writer
+	applyAll bool
+}
+
+func newFixPrompter(in io.Reader, out io.Writer) *fixPrompter {
+	return &fixPrompter{in: bufio.NewReader(in), out: out}
+}
+
+// confirm implements doctor.FixConfirmFunc.
+func (p *fixPrompter) confirm(check doctor.Check, result *doctor.CheckResult) (apply, keepGoing bool) {
+	if p.applyAll {
+		return true, true
+	}
+
+	fmt.Fprintf(p.out, "\n%s: %s\n", result.Name, result.Message)
+	if result.FixHint != "" {
+		fmt.Fprintf(p.out, "  fix: %s\n", result.FixHint)
+	} else if desc := check.Description(); desc != "" {
+		fmt.Fprintf(p.out, "  fix: %s\n", desc)
+	}
+
+	for {
+		fmt.Fprint(p.out, "Apply fix? [y/N/a/q] ")
+		line, err := p.in.ReadString('\n')
+		answer := strings.ToLower(strings.TrimSpace(line))
+		if err != nil && answer == "" {
+			// No more input (e.g. EOF); treat like the default answer.
+			return false, true
+		}
+		switch answer {
+		case "y", "yes":
+			return true, true
+		case "a", "all":
+			p.applyAll = true
+			return true, true
+		case "q", "quit":
+			return false, false
+		case "", "n", "no":
+			return false, true
+		default:
+			fmt.Fprintln(p.out, "Please answer y, n, a, or q.")
+		}
+	}
+}
+
 func runDoctor(cmd *cobra.Command, args []string) error {
 	// Find town root
 	townRoot, err := workspace.FindFromCwdOrError()
@@ -119,6 +181,8 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	// Register built-in checks
 	d.Register(doctor.NewStaleBinaryCheck())
 	d.Register(doctor.NewSqlite3Check())
+	d.Register(doctor.NewTmuxVersionCheck())
+	d.Register(doctor.NewBDCompatibilityCheck())
 	d.Register(doctor.NewTownGitCheck())
 	d.Register(doctor.NewTownRootBranchCheck())
 	d.Register(doctor.NewPreCheckoutHookCheck())
@@ -137,6 +201,7 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	d.Register(doctor.NewRoutingModeCheck())
 	d.Register(doctor.NewOrphanSessionCheck())
 	d.Register(doctor.NewZombieSessionCheck())
+	d.Register(doctor.NewRuntimeStateCheck())
 	d.Register(doctor.NewOrphanProcessCheck())
 	d.Register(doctor.NewWispGCCheck())
 	d.Register(doctor.NewCheckMisclassifiedWisps())
@@ -163,10 +228,12 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 
 	// Config architecture checks
 	d.Register(doctor.NewSettingsCheck())
+	d.Register(doctor.NewRigsSchemaCheck())
 	d.Register(doctor.NewSessionHookCheck())
 	d.Register(doctor.NewRuntimeGitignoreCheck())
 	d.Register(doctor.NewLegacyGastownCheck())
 	d.Register(doctor.NewClaudeSettingsCheck())
+	d.Register(doctor.NewWorkflowMoleculeCheck())
 
 	// Priming subsystem check
 	d.Register(doctor.NewPrimingCheck())
@@ -191,14 +258,22 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 
 	// Run checks
 	var report *doctor.Report
-	if doctorFix {
+	switch {
+	case doctorFix && doctorYes:
+		report = d.Fix(ctx)
+	case doctorFix && ui.IsTerminal():
+		report = d.FixInteractive(ctx, newFixPrompter(os.Stdin, os.Stdout).confirm)
+	case doctorFix:
+		// Not a terminal (piped/scripted): there's no one to prompt, so
+		// --fix behaves like --fix --yes.
 		report = d.Fix(ctx)
-	} else {
+	default:
 		report = d.Run(ctx)
 	}
 
 	// Print report
 	report.Print(os.Stdout, doctorVerbose)
+	report.PrintFixSummary(os.Stdout)
 
 	// Exit with error code if there are errors
 	if report.HasErrors() {
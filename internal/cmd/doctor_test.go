@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/doctor"
+)
+
+// stubCheck is a minimal doctor.Check for exercising fixPrompter without a
+// real check implementation.
+type stubCheck struct {
+	doctor.FixableCheck
+}
+
+func (s *stubCheck) Run(ctx *doctor.CheckContext) *doctor.CheckResult {
+	return &doctor.CheckResult{Name: s.Name()}
+}
+
+func TestFixPrompter_YesNoAllQuit(t *testing.T) {
+	check := &stubCheck{doctor.FixableCheck{BaseCheck: doctor.BaseCheck{CheckName: "some-check", CheckDescription: "Some check"}}}
+	result := &doctor.CheckResult{Name: "some-check", Message: "broken", FixHint: "run the thing"}
+
+	tests := []struct {
+		name          string
+		input         string
+		wantApply     bool
+		wantKeepGoing bool
+	}{
+		{"yes", "y\n", true, true},
+		{"no", "n\n", false, true},
+		{"empty defaults to no", "\n", false, true},
+		{"all applies and keeps going", "a\n", true, true},
+		{"quit skips and stops", "q\n", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			p := newFixPrompter(strings.NewReader(tt.input), &out)
+
+			apply, keepGoing := p.confirm(check, result)
+			if apply != tt.wantApply || keepGoing != tt.wantKeepGoing {
+				t.Errorf("confirm() = (%v, %v), want (%v, %v)", apply, keepGoing, tt.wantApply, tt.wantKeepGoing)
+			}
+			if !strings.Contains(out.String(), "run the thing") {
+				t.Error("prompt should surface the check's FixHint as the fix description")
+			}
+		})
+	}
+}
+
+func TestFixPrompter_AllStopsPrompting(t *testing.T) {
+	check := &stubCheck{doctor.FixableCheck{BaseCheck: doctor.BaseCheck{CheckName: "some-check"}}}
+	result := &doctor.CheckResult{Name: "some-check", Message: "broken"}
+
+	var out bytes.Buffer
+	// Only "a" is available to read; a second confirm() call must not need
+	// to read again once applyAll is set.
+	p := newFixPrompter(strings.NewReader("a\n"), &out)
+
+	if apply, keepGoing := p.confirm(check, result); !apply || !keepGoing {
+		t.Fatalf("first confirm() = (%v, %v), want (true, true)", apply, keepGoing)
+	}
+	if apply, keepGoing := p.confirm(check, result); !apply || !keepGoing {
+		t.Fatalf("second confirm() = (%v, %v), want (true, true) via applyAll", apply, keepGoing)
+	}
+}
+
+func TestFixPrompter_InvalidAnswerReprompts(t *testing.T) {
+	check := &stubCheck{doctor.FixableCheck{BaseCheck: doctor.BaseCheck{CheckName: "some-check"}}}
+	result := &doctor.CheckResult{Name: "some-check", Message: "broken"}
+
+	var out bytes.Buffer
+	p := newFixPrompter(strings.NewReader("bogus\ny\n"), &out)
+
+	apply, keepGoing := p.confirm(check, result)
+	if !apply || !keepGoing {
+		t.Fatalf("confirm() = (%v, %v), want (true, true) after reprompt", apply, keepGoing)
+	}
+	if !strings.Contains(out.String(), "Please answer") {
+		t.Error("invalid input should trigger a reprompt message")
+	}
+}
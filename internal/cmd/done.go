@@ -27,10 +27,17 @@ var doneCmd = &cobra.Command{
 	Long: `Signal that your work is complete and ready for the merge queue.
 
 This is a convenience command for polecats that:
-1. Submits the current branch to the merge queue
-2. Auto-detects issue ID from branch name
-3. Notifies the Witness with the exit outcome
-4. Exits the Claude session (polecats don't stay alive after completion)
+1. Verifies the branch is pushed to origin (pushes it if not)
+2. Submits the current branch to the merge queue
+3. Auto-detects issue ID from branch name
+4. Verifies the hooked issue is closed before notifying the Witness
+5. Notifies the Witness with the exit outcome
+6. Exits the Claude session (polecats don't stay alive after completion)
+
+COMPLETED refuses to notify the Witness until the hooked issue shows closed
+in beads - the Refinery must never merge an MR for an issue nobody marked
+done. DEFERRED and ESCALATED skip that requirement (the work isn't finished,
+so the issue stays open) but still record the polecat's git state.
 
 Exit statuses:
   COMPLETED      - Work done, MR submitted (default)
@@ -38,17 +45,25 @@ Exit statuses:
   DEFERRED       - Work paused, issue still open
   PHASE_COMPLETE - Phase done, awaiting gate (use --phase-complete)
 
+--exit is an alias for --status, used by gt handoff and gt mol step done.
+
 Phase handoff workflow:
   When a molecule has gate steps (async waits), use --phase-complete to signal
   that the current phase is complete but work continues after the gate closes.
   The Witness will recycle this polecat and dispatch a new one when the gate
   resolves.
 
+--defer-to <identity> (with --status DEFERRED) hands the open issue to
+another worker instead of just parking it: the issue is reassigned, the
+hook moves onto the target's agent bead, and the target gets a task mail
+with your notes (-m), the branch, and your git state.
+
 Examples:
   gt done                              # Submit branch, notify COMPLETED, exit session
   gt done --issue gt-abc               # Explicit issue ID
   gt done --status ESCALATED           # Signal blocker, skip MR
   gt done --status DEFERRED            # Pause work, skip MR
+  gt done --status DEFERRED --defer-to gastown/flint -m "stuck on the retry logic, see notes in PR"
   gt done --phase-complete --gate g-x  # Phase done, waiting on gate g-x`,
 	RunE: runDone,
 }
@@ -60,6 +75,8 @@ var (
 	donePhaseComplete bool
 	doneGate          string
 	doneCleanupStatus string
+	doneDeferTo       string
+	doneMessage       string
 )
 
 // Valid exit types for gt done
@@ -74,9 +91,12 @@ func init() {
 	doneCmd.Flags().StringVar(&doneIssue, "issue", "", "Source issue ID (default: parse from branch name)")
 	doneCmd.Flags().IntVarP(&donePriority, "priority", "p", -1, "Override priority (0-4, default: inherit from issue)")
 	doneCmd.Flags().StringVar(&doneStatus, "status", ExitCompleted, "Exit status: COMPLETED, ESCALATED, or DEFERRED")
+	doneCmd.Flags().StringVar(&doneStatus, "exit", ExitCompleted, "Alias for --status (used by gt handoff, gt mol step done)")
 	doneCmd.Flags().BoolVar(&donePhaseComplete, "phase-complete", false, "Signal phase complete - await gate before continuing")
 	doneCmd.Flags().StringVar(&doneGate, "gate", "", "Gate bead ID to wait on (with --phase-complete)")
 	doneCmd.Flags().StringVar(&doneCleanupStatus, "cleanup-status", "", "Git cleanup status: clean, uncommitted, unpushed, stash, unknown (ZFC: agent-observed)")
+	doneCmd.Flags().StringVar(&doneDeferTo, "defer-to", "", "Hand the open issue to another worker (requires --status DEFERRED)")
+	doneCmd.Flags().StringVarP(&doneMessage, "message", "m", "", "Notes for the next worker (used by --defer-to)")
 
 	rootCmd.AddCommand(doneCmd)
 }
@@ -105,6 +125,10 @@ func runDone(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if doneDeferTo != "" && exitType != ExitDeferred {
+		return fmt.Errorf("--defer-to requires --status DEFERRED")
+	}
+
 	// Find workspace with fallback for deleted worktrees (hq-3xaxy)
 	// If the polecat's worktree was deleted by Witness before gt done finishes,
 	// getcwd will fail. We fall back to GT_TOWN_ROOT env var in that case.
@@ -301,6 +325,17 @@ func runDone(cmd *cobra.Command, args []string) error {
 		if err := g.Push("origin", branch, false); err != nil {
 			return fmt.Errorf("pushing branch '%s' to origin: %w\nCommits exist locally but failed to push. Fix the issue and retry.", branch, err)
 		}
+
+		// Verify the push actually landed (hq-6dk53, hq-a4ksk): the worktree
+		// gets nuked at the end of gt done, so if the push silently failed to
+		// land (e.g. rejected by a pre-receive hook without a non-zero exit),
+		// the commits would be lost with no MR to show for them.
+		pushed, err := g.RemoteBranchExists("origin", branch)
+		if err != nil {
+			style.PrintWarning("could not verify branch landed on origin: %v", err)
+		} else if !pushed {
+			return fmt.Errorf("branch '%s' not found on origin after push; refusing to continue", branch)
+		}
 		fmt.Printf("%s Branch pushed to origin\n", style.Bold.Render("✓"))
 
 		if issueID == "" {
@@ -338,22 +373,38 @@ func runDone(cmd *cobra.Command, args []string) error {
 			// Continue with creation attempt - Create will fail if duplicate
 		}
 
+		// Refuse to resubmit a branch under an active manual rejection - the
+		// worker needs to address the rejection reason (or someone needs to
+		// run `gt mq unreject`) before it comes back around the merge queue.
+		if existingMR == nil {
+			if rejection, err := bd.FindActiveRejection(branch, rejectionExpiryDays(townRoot, rigName)); err == nil && rejection != nil {
+				rejectFields := beads.ParseMRFields(rejection)
+				return fmt.Errorf("branch %q was rejected (%s): %s\nRun 'gt mq unreject %s' once this is addressed", branch, rejection.ID, rejectFields.RejectReason, branch)
+			}
+		}
+
+		mrFields := &beads.MRFields{
+			Branch:      branch,
+			Target:      target,
+			SourceIssue: issueID,
+			Worker:      worker,
+			Rig:         rigName,
+			AgentBead:   agentBeadID,
+		}
+
 		if existingMR != nil {
-			// MR already exists - use it instead of creating a new one
+			// MR already exists - refresh its fields (idempotent gt done reruns
+			// can pick up a changed target/worker) instead of creating a new one.
 			mrID = existingMR.ID
+			if err := bd.UpdateMRFields(mrID, mrFields); err != nil {
+				style.PrintWarning("could not refresh MR fields: %v", err)
+			}
 			fmt.Printf("%s MR already exists (idempotent)\n", style.Bold.Render("✓"))
 			fmt.Printf("  MR ID: %s\n", style.Bold.Render(mrID))
 		} else {
 			// Build MR bead title and description
 			title := fmt.Sprintf("Merge: %s", issueID)
-			description := fmt.Sprintf("branch: %s\ntarget: %s\nsource_issue: %s\nrig: %s",
-				branch, target, issueID, rigName)
-			if worker != "" {
-				description += fmt.Sprintf("\nworker: %s", worker)
-			}
-			if agentBeadID != "" {
-				description += fmt.Sprintf("\nagent_bead: %s", agentBeadID)
-			}
+			description := beads.FormatMRFields(mrFields)
 
 			// Add conflict resolution tracking fields (initialized, updated by Refinery)
 			description += "\nretry_count: 0"
@@ -418,9 +469,40 @@ func runDone(cmd *cobra.Command, args []string) error {
 			fmt.Printf("  Issue: %s\n", issueID)
 		}
 		fmt.Printf("  Branch: %s\n", branch)
+
+		if doneDeferTo != "" {
+			if err := deferWorkTo(cwd, townRoot, doneDeferTo, issueID, agentBeadID, sender, branch, doneCleanupStatus, doneMessage); err != nil {
+				style.PrintWarning("could not defer to %s: %v", doneDeferTo, err)
+			} else {
+				fmt.Printf("%s Deferred to %s\n", style.Bold.Render("✓"), doneDeferTo)
+			}
+		}
 	}
 
 notifyWitness:
+	// CRITICAL: Verify the hooked issue is closed before the witness is told
+	// this polecat is done. COMPLETED without a closed issue means the
+	// refinery merges an MR that nothing ever marks finished. DEFERRED and
+	// ESCALATED are exempt - the work isn't finished, so the issue must stay
+	// open (hooked or not) for the next polecat to pick up; the git-state
+	// snapshot above (doneCleanupStatus) still runs for them regardless.
+	if exitType == ExitCompleted && agentBeadID != "" {
+		bd := beads.New(beads.ResolveBeadsDir(cwd))
+		if hookedID := getIssueFromAgentHook(bd, agentBeadID); hookedID != "" {
+			if err := closeHookedWork(bd, hookedID); err != nil {
+				return fmt.Errorf("closing hooked issue before notifying witness: %w", err)
+			}
+			closed, err := bd.Show(hookedID)
+			if err != nil {
+				style.PrintWarning("could not verify hooked issue %s closed: %v", hookedID, err)
+			} else if closed.Status != "closed" {
+				return fmt.Errorf("hooked issue %s not closed (status=%s); refusing to notify witness", hookedID, closed.Status)
+			} else {
+				fmt.Printf("%s Hooked issue %s closed\n", style.Bold.Render("✓"), hookedID)
+			}
+		}
+	}
+
 	// Notify Witness about completion
 	// Use town-level beads for cross-agent mail
 	townRouter := mail.NewRouter(townRoot)
@@ -439,6 +521,9 @@ notifyWitness:
 		bodyLines = append(bodyLines, fmt.Sprintf("Gate: %s", doneGate))
 	}
 	bodyLines = append(bodyLines, fmt.Sprintf("Branch: %s", branch))
+	if doneDeferTo != "" {
+		bodyLines = append(bodyLines, fmt.Sprintf("Deferred to: %s", doneDeferTo))
+	}
 
 	doneNotification := &mail.Message{
 		To:      witnessAddr,
@@ -474,6 +559,7 @@ notifyWitness:
 	// Log done event (townlog and activity feed)
 	_ = LogDone(townRoot, sender, issueID)
 	_ = events.LogFeed(events.TypeDone, sender, events.DonePayload(issueID, branch))
+	_ = events.LogFeed(events.TypeWorkDone, sender, events.WorkDonePayload(rigName, polecatName, issueID, branch))
 
 	// Update agent bead state (ZFC: self-report completion)
 	updateAgentStateOnDone(cwd, townRoot, exitType, issueID)
@@ -600,26 +686,14 @@ func updateAgentStateOnDone(cwd, townRoot, exitType, _ string) { // issueID unus
 	}
 
 	if agentBead.HookBead != "" {
-		hookedBeadID := agentBead.HookBead
-		// Only close if the hooked bead exists and is still in "hooked" status
-		if hookedBead, err := bd.Show(hookedBeadID); err == nil && hookedBead.Status == beads.StatusHooked {
-			// BUG FIX: Close attached molecule (wisp) BEFORE closing hooked bead.
-			// When using formula-on-bead (gt sling formula --on bead), the base bead
-			// has attached_molecule pointing to the wisp. Without this fix, gt done
-			// only closed the hooked bead, leaving the wisp orphaned.
-			// Order matters: wisp closes -> unblocks base bead -> base bead closes.
-			attachment := beads.ParseAttachmentFields(hookedBead)
-			if attachment != nil && attachment.AttachedMolecule != "" {
-				if err := bd.Close(attachment.AttachedMolecule); err != nil {
-					// Non-fatal: warn but continue
-					fmt.Fprintf(os.Stderr, "Warning: couldn't close attached molecule %s: %v\n", attachment.AttachedMolecule, err)
-				}
-			}
-
-			if err := bd.Close(hookedBeadID); err != nil {
-				// Non-fatal: warn but continue
-				fmt.Fprintf(os.Stderr, "Warning: couldn't close hooked bead %s: %v\n", hookedBeadID, err)
-			}
+		// Usually a no-op here: the ExitCompleted path in runDone already
+		// closed the hooked bead (and verified it) before notifying the
+		// witness. This is a safety net for exit types that don't require
+		// closure (ESCALATED/DEFERRED) or older callers that skipped it -
+		// closeHookedWork is idempotent, so calling it twice is harmless.
+		if err := closeHookedWork(bd, agentBead.HookBead); err != nil {
+			// Non-fatal: warn but continue
+			fmt.Fprintf(os.Stderr, "Warning: couldn't close hooked bead %s: %v\n", agentBead.HookBead, err)
 		}
 	}
 
@@ -661,6 +735,92 @@ func updateAgentStateOnDone(cwd, townRoot, exitType, _ string) { // issueID unus
 	}
 }
 
+// deferWorkTo hands an in-flight DEFERRED issue to another worker instead of
+// just parking it: it reassigns the issue, moves the hook wisp onto the
+// target's agent bead (clearing it from this one), and sends the target a
+// task mail with the polecat's final notes, branch, and git state so they
+// can pick up exactly where this polecat left off.
+func deferWorkTo(cwd, townRoot, target, issueID, fromAgentBeadID, sender, branch, cleanupStatus, notes string) error {
+	if issueID == "" {
+		return fmt.Errorf("no issue to defer (issue ID unknown)")
+	}
+
+	bd := beads.New(beads.ResolveBeadsDir(cwd))
+	if err := bd.Update(issueID, beads.UpdateOptions{Assignee: &target}); err != nil {
+		return fmt.Errorf("reassigning %s to %s: %w", issueID, target, err)
+	}
+
+	if targetAgentBeadID := addressToAgentBeadID(target); targetAgentBeadID != "" {
+		if err := bd.SetHookBead(targetAgentBeadID, issueID); err != nil {
+			style.PrintWarning("could not move hook to %s: %v", target, err)
+		}
+	} else {
+		style.PrintWarning("could not resolve agent bead for %s; hook not moved", target)
+	}
+
+	if fromAgentBeadID != "" {
+		if err := bd.ClearHookBead(fromAgentBeadID); err != nil {
+			style.PrintWarning("could not clear own hook: %v", err)
+		}
+	}
+
+	bodyLines := []string{
+		fmt.Sprintf("Issue: %s", issueID),
+		fmt.Sprintf("Branch: %s", branch),
+	}
+	if cleanupStatus != "" {
+		bodyLines = append(bodyLines, fmt.Sprintf("Git state: %s", cleanupStatus))
+	}
+	if notes != "" {
+		bodyLines = append(bodyLines, "", notes)
+	}
+
+	router := mail.NewRouter(townRoot)
+	return router.Send(&mail.Message{
+		From:    sender,
+		To:      target,
+		Subject: fmt.Sprintf("DEFERRED: %s", issueID),
+		Body:    strings.Join(bodyLines, "\n"),
+		Type:    mail.TypeTask,
+	})
+}
+
+// closeHookedWork closes the bead an agent has hooked, along with any
+// molecule attached to it. It's a no-op if the bead doesn't exist or is no
+// longer in "hooked" status, so it's safe to call more than once - e.g. once
+// in runDone before notifying the witness, and again (as a safety net) in
+// updateAgentStateOnDone.
+func closeHookedWork(bd *beads.Beads, hookedBeadID string) error {
+	if hookedBeadID == "" {
+		return nil
+	}
+
+	hookedBead, err := bd.Show(hookedBeadID)
+	if err != nil {
+		// Nothing to close - bead doesn't exist (already cleaned up, or never did)
+		return nil
+	}
+	if hookedBead.Status != beads.StatusHooked {
+		return nil
+	}
+
+	// BUG FIX: Close attached molecule (wisp) BEFORE closing hooked bead.
+	// When using formula-on-bead (gt sling formula --on bead), the base bead
+	// has attached_molecule pointing to the wisp. Without this fix, gt done
+	// only closed the hooked bead, leaving the wisp orphaned.
+	// Order matters: wisp closes -> unblocks base bead -> base bead closes.
+	if attachment := beads.ParseAttachmentFields(hookedBead); attachment != nil && attachment.AttachedMolecule != "" {
+		if err := bd.Close(attachment.AttachedMolecule, ""); err != nil {
+			return fmt.Errorf("closing attached molecule %s: %w", attachment.AttachedMolecule, err)
+		}
+	}
+
+	if err := bd.Close(hookedBeadID, ""); err != nil {
+		return fmt.Errorf("closing hooked bead %s: %w", hookedBeadID, err)
+	}
+	return nil
+}
+
 // getIssueFromAgentHook retrieves the issue ID from an agent's hook_bead field.
 // This is the authoritative source for what work a polecat is doing, since branch
 // names may not contain the issue ID (e.g., "polecat/furiosa-mkb0vq9f").
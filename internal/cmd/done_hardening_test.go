@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// TestDoneExitFlagAliasesStatus verifies that --exit is accepted as an alias
+// for --status. gt handoff and gt mol step done both call `gt done --exit
+// DEFERRED`, so --exit must set the same doneStatus variable --status does.
+func TestDoneExitFlagAliasesStatus(t *testing.T) {
+	if doneCmd.Flags().Lookup("exit") == nil {
+		t.Fatal("doneCmd has no --exit flag")
+	}
+
+	t.Cleanup(func() { doneStatus = ExitCompleted })
+
+	if err := doneCmd.Flags().Set("exit", "DEFERRED"); err != nil {
+		t.Fatalf("setting --exit: %v", err)
+	}
+	if doneStatus != "DEFERRED" {
+		t.Errorf("doneStatus = %q after --exit DEFERRED, want DEFERRED", doneStatus)
+	}
+}
+
+// closeHookedWorkBDScript stubs bd for closeHookedWork tests. gt-hooked is a
+// hooked bead with an attached molecule gt-mol; gt-hooked-plain is hooked
+// with no attachment; gt-hooked-closed is already closed. Every close call
+// appends the closed ID to closeLogPath so tests can assert both that a
+// close happened and the order it happened in.
+func closeHookedWorkBDScript(closeLogPath string) (unix, windows string) {
+	unix = `#!/bin/sh
+while [ "$1" = "--no-daemon" ] || [ "$1" = "--allow-stale" ]; do
+  shift
+done
+cmd="$1"
+shift || true
+case "$cmd" in
+  show)
+    id="$1"
+    case "$id" in
+      gt-hooked)
+        echo '[{"id":"gt-hooked","title":"Hooked work","status":"hooked","description":"attached_molecule: gt-mol"}]'
+        ;;
+      gt-hooked-plain)
+        echo '[{"id":"gt-hooked-plain","title":"Plain hooked work","status":"hooked","description":""}]'
+        ;;
+      gt-hooked-closed)
+        echo '[{"id":"gt-hooked-closed","title":"Already closed","status":"closed","description":""}]'
+        ;;
+      *)
+        echo '[]'
+        ;;
+    esac
+    ;;
+  close)
+    echo "$1" >> "` + closeLogPath + `"
+    ;;
+  *)
+    echo '[]'
+    ;;
+esac
+`
+	windows = "@echo off\r\n" // closeHookedWork tests don't run on Windows in this suite
+	return unix, windows
+}
+
+func setupCloseHookedWorkBD(t *testing.T) (bd *beads.Beads, closeLog string) {
+	t.Helper()
+	binDir := t.TempDir()
+	closeLog = filepath.Join(t.TempDir(), "closed.log")
+	unix, windows := closeHookedWorkBDScript(closeLog)
+	writeBDStub(t, binDir, unix, windows)
+
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	return beads.New(t.TempDir()), closeLog
+}
+
+func readCloseLog(t *testing.T, path string) []string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		t.Fatalf("reading close log: %v", err)
+	}
+	var ids []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if line != "" {
+			ids = append(ids, line)
+		}
+	}
+	return ids
+}
+
+func TestCloseHookedWork_ClosesAttachedMoleculeBeforeHookedBead(t *testing.T) {
+	bd, closeLog := setupCloseHookedWorkBD(t)
+
+	if err := closeHookedWork(bd, "gt-hooked"); err != nil {
+		t.Fatalf("closeHookedWork: %v", err)
+	}
+
+	got := readCloseLog(t, closeLog)
+	want := []string{"gt-mol", "gt-hooked"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("close order = %v, want %v (molecule must close before the hooked bead)", got, want)
+	}
+}
+
+func TestCloseHookedWork_NoAttachmentClosesOnlyHookedBead(t *testing.T) {
+	bd, closeLog := setupCloseHookedWorkBD(t)
+
+	if err := closeHookedWork(bd, "gt-hooked-plain"); err != nil {
+		t.Fatalf("closeHookedWork: %v", err)
+	}
+
+	got := readCloseLog(t, closeLog)
+	want := []string{"gt-hooked-plain"}
+	if len(got) != len(want) || got[0] != want[0] {
+		t.Errorf("close log = %v, want %v", got, want)
+	}
+}
+
+func TestCloseHookedWork_AlreadyClosedIsNoOp(t *testing.T) {
+	bd, closeLog := setupCloseHookedWorkBD(t)
+
+	if err := closeHookedWork(bd, "gt-hooked-closed"); err != nil {
+		t.Fatalf("closeHookedWork: %v", err)
+	}
+
+	if got := readCloseLog(t, closeLog); got != nil {
+		t.Errorf("close log = %v, want no closes for an already-closed bead", got)
+	}
+}
+
+func TestCloseHookedWork_MissingBeadIsNoOp(t *testing.T) {
+	bd, closeLog := setupCloseHookedWorkBD(t)
+
+	if err := closeHookedWork(bd, "gt-does-not-exist"); err != nil {
+		t.Fatalf("closeHookedWork: %v", err)
+	}
+
+	if got := readCloseLog(t, closeLog); got != nil {
+		t.Errorf("close log = %v, want no closes for a missing bead", got)
+	}
+}
+
+func TestCloseHookedWork_EmptyIDIsNoOp(t *testing.T) {
+	bd, closeLog := setupCloseHookedWorkBD(t)
+
+	if err := closeHookedWork(bd, ""); err != nil {
+		t.Fatalf("closeHookedWork: %v", err)
+	}
+
+	if got := readCloseLog(t, closeLog); got != nil {
+		t.Errorf("close log = %v, want no closes for an empty hooked bead ID", got)
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/mail"
 )
 
 // TestDoneUsesResolveBeadsDir verifies that the done command correctly uses
@@ -342,6 +344,90 @@ func TestGetIssueFromAgentHook(t *testing.T) {
 	}
 }
 
+// TestDeferWorkTo verifies that deferring to a crew identity reassigns the
+// issue, moves the hook from the deferring polecat's agent bead onto the
+// target's, and delivers a task mail describing the handoff.
+func TestDeferWorkTo(t *testing.T) {
+	if _, err := exec.LookPath("bd"); err != nil {
+		t.Skip("bd CLI not installed")
+	}
+
+	tmpDir := t.TempDir()
+	cmd := exec.Command("bd", "--no-daemon", "init", "--prefix", "test", "--quiet")
+	cmd.Dir = tmpDir
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("bd init: %v\n%s", err, output)
+	}
+
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	bd := beads.New(beadsDir)
+
+	issue, err := bd.CreateWithID("test-defer", beads.CreateOptions{
+		Title: "Work to defer",
+		Type:  "task",
+	})
+	if err != nil {
+		t.Fatalf("create issue: %v", err)
+	}
+
+	fromAgentBeadID := "test-gastown-polecat-furiosa"
+	if _, err := bd.CreateAgentBead(fromAgentBeadID, "Deferring polecat", nil); err != nil {
+		t.Fatalf("create from agent bead: %v", err)
+	}
+	if err := bd.SetHookBead(fromAgentBeadID, issue.ID); err != nil {
+		t.Fatalf("hook issue to from-agent: %v", err)
+	}
+
+	targetAgentBeadID := "test-gastown-crew-flint"
+	if _, err := bd.CreateAgentBead(targetAgentBeadID, "Target crew member", nil); err != nil {
+		t.Fatalf("create target agent bead: %v", err)
+	}
+
+	err = deferWorkTo(tmpDir, tmpDir, "gastown/crew/flint", issue.ID, fromAgentBeadID, "gastown/furiosa", "polecat/furiosa-test", "unpushed", "stuck on the retry logic")
+	if err != nil {
+		t.Fatalf("deferWorkTo: %v", err)
+	}
+
+	reassigned, err := bd.Show(issue.ID)
+	if err != nil {
+		t.Fatalf("show issue: %v", err)
+	}
+	if reassigned.Assignee != "gastown/crew/flint" {
+		t.Errorf("issue assignee = %q, want %q", reassigned.Assignee, "gastown/crew/flint")
+	}
+
+	fromAgent, err := bd.Show(fromAgentBeadID)
+	if err != nil {
+		t.Fatalf("show from-agent: %v", err)
+	}
+	if fromAgent.HookBead != "" {
+		t.Errorf("from-agent hook = %q, want cleared", fromAgent.HookBead)
+	}
+
+	targetAgent, err := bd.Show(targetAgentBeadID)
+	if err != nil {
+		t.Fatalf("show target agent: %v", err)
+	}
+	if targetAgent.HookBead != issue.ID {
+		t.Errorf("target agent hook = %q, want %q", targetAgent.HookBead, issue.ID)
+	}
+
+	mailbox := mail.NewMailboxFromAddress("gastown/crew/flint", tmpDir)
+	messages, err := mailbox.List()
+	if err != nil {
+		t.Fatalf("list target mailbox: %v", err)
+	}
+	found := false
+	for _, msg := range messages {
+		if msg.Type == mail.TypeTask && strings.Contains(msg.Body, "stuck on the retry logic") {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("target did not receive task mail with deferral notes")
+	}
+}
+
 // TestIsPolecatActor verifies that isPolecatActor correctly identifies
 // polecat actors vs other roles based on the BD_ACTOR format.
 func TestIsPolecatActor(t *testing.T) {
@@ -355,9 +355,9 @@ func stopAllPolecats(t *tmux.Tmux, townRoot string, rigNames []string, force boo
 			err := polecatMgr.Stop(info.Polecat, force)
 			if err == nil {
 				stopped++
-				fmt.Printf("  %s [%s] %s stopped\n", style.SuccessPrefix, rigName, info.Polecat)
+				fmt.Printf("  %s [%s] %s stopped\n", style.SuccessPrefix(), rigName, info.Polecat)
 			} else {
-				fmt.Printf("  %s [%s] %s: %s\n", style.ErrorPrefix, rigName, info.Polecat, err.Error())
+				fmt.Printf("  %s [%s] %s: %s\n", style.ErrorPrefix(), rigName, info.Polecat, err.Error())
 			}
 		}
 	}
@@ -370,9 +370,9 @@ func printDownStatus(name string, ok bool, detail string) {
 		return
 	}
 	if ok {
-		fmt.Printf("%s %s: %s\n", style.SuccessPrefix, name, style.Dim.Render(detail))
+		fmt.Printf("%s %s: %s\n", style.SuccessPrefix(), name, style.Dim.Render(detail))
 	} else {
-		fmt.Printf("%s %s: %s\n", style.ErrorPrefix, name, detail)
+		fmt.Printf("%s %s: %s\n", style.ErrorPrefix(), name, detail)
 	}
 }
 
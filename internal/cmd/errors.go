@@ -34,3 +34,47 @@ func IsSilentExit(err error) (int, bool) {
 	}
 	return 0, false
 }
+
+// Category sentinel errors for scripting: wrap a failure with
+// fmt.Errorf("...: %w", ErrX) and Execute() maps it to a distinct exit code,
+// so callers can react to "not in a workspace" (retry elsewhere) differently
+// from "polecat has uncommitted work" (needs a human) without parsing error
+// text. See `gt help exit-codes` for the full table and gt exit-codes.go for
+// the user-facing doc.
+var (
+	// ErrNoWorkspace means the command wasn't run inside a Gas Town workspace.
+	ErrNoWorkspace = errors.New("not in a Gas Town workspace")
+	// ErrDirtyWorktree means uncommitted work is blocking the operation.
+	ErrDirtyWorktree = errors.New("uncommitted work")
+	// ErrNotFound means the target (rig, polecat, crew member, bead...) doesn't exist.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict means the target is already in the requested state or owned elsewhere.
+	ErrConflict = errors.New("conflict")
+	// ErrPrecondition means a required precondition wasn't met.
+	ErrPrecondition = errors.New("precondition not met")
+)
+
+// categoryExitCodes maps each sentinel category to its process exit code,
+// checked in this order. Codes 2-6 are reserved for these categories;
+// uncategorized errors fall through to the default exit code of 1.
+var categoryExitCodes = []struct {
+	sentinel error
+	code     int
+}{
+	{ErrNoWorkspace, 2},
+	{ErrDirtyWorktree, 3},
+	{ErrNotFound, 4},
+	{ErrConflict, 5},
+	{ErrPrecondition, 6},
+}
+
+// ExitCodeForError returns the scripting exit code for err based on which
+// category sentinel (if any) it wraps. Uncategorized errors return 1.
+func ExitCodeForError(err error) int {
+	for _, c := range categoryExitCodes {
+		if errors.Is(err, c.sentinel) {
+			return c.code
+		}
+	}
+	return 1
+}
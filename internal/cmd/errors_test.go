@@ -90,3 +90,30 @@ func TestSilentExitError_Is(t *testing.T) {
 		t.Errorf("errors.As extracted code = %d, want 1", target.Code)
 	}
 }
+
+func TestExitCodeForError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil error", nil, 1},
+		{"uncategorized error", errors.New("boom"), 1},
+		{"no workspace", fmt.Errorf("not in a workspace: %w", ErrNoWorkspace), 2},
+		{"dirty worktree", fmt.Errorf("uncommitted changes: %w", ErrDirtyWorktree), 3},
+		{"not found", fmt.Errorf("rig 'foo' not found: %w", ErrNotFound), 4},
+		{"conflict", fmt.Errorf("already hooked: %w", ErrConflict), 5},
+		{"precondition", fmt.Errorf("tmux required: %w", ErrPrecondition), 6},
+		{"double wrapped", fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", ErrNotFound)), 4},
+		{"wrapped with original error preserved", fmt.Errorf("not in a workspace: %w: %w", ErrNoWorkspace, errors.New("stat .gastown: no such file")), 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExitCodeForError(tt.err)
+			if got != tt.want {
+				t.Errorf("ExitCodeForError(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
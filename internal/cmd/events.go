@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	eventsType   string
+	eventsActor  string
+	eventsRig    string
+	eventsSince  string
+	eventsLimit  int
+	eventsJSON   bool
+	eventsFollow bool
+)
+
+var eventsCmd = &cobra.Command{
+	Use:     "events",
+	GroupID: GroupDiag,
+	Short:   "Query the raw gt events log",
+	Long: `Query and filter events from the raw activity log (.events.jsonl).
+
+Unlike 'gt feed' (which curates events for human reading), 'gt events' is
+built for scripting: exact filters, --json output, and a bounded --limit
+that stays fast even against a large log by reading from the end.
+
+Examples:
+  gt events                                   # Last 50 events
+  gt events --type merged --limit 20          # Last 20 merge events
+  gt events --actor gastown/refinery          # Events from the refinery
+  gt events --rig gastown --since 2h          # gastown events in the last 2 hours
+  gt events --json                            # Machine-readable output
+  gt events --follow                          # Tail new events as they happen`,
+	RunE: runEvents,
+}
+
+func init() {
+	eventsCmd.Flags().StringVar(&eventsType, "type", "", "Filter by event type (e.g. merged, sling, handoff)")
+	eventsCmd.Flags().StringVar(&eventsActor, "actor", "", "Filter by actor (exact match or a leading path segment)")
+	eventsCmd.Flags().StringVar(&eventsRig, "rig", "", "Filter by rig")
+	eventsCmd.Flags().StringVar(&eventsSince, "since", "", "Show events since duration (e.g. 5m, 2h, 7d)")
+	eventsCmd.Flags().IntVarP(&eventsLimit, "limit", "n", 50, "Maximum number of events to show (0 = unlimited)")
+	eventsCmd.Flags().BoolVar(&eventsJSON, "json", false, "Output as JSON")
+	eventsCmd.Flags().BoolVarP(&eventsFollow, "follow", "f", false, "Tail new events as they're logged")
+
+	rootCmd.AddCommand(eventsCmd)
+}
+
+func runEvents(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	opts := events.QueryOptions{
+		Type:  eventsType,
+		Actor: eventsActor,
+		Rig:   eventsRig,
+		Limit: eventsLimit,
+	}
+	if eventsSince != "" {
+		d, err := parseDuration(eventsSince)
+		if err != nil {
+			return fmt.Errorf("invalid --since duration: %w", err)
+		}
+		opts.Since = time.Now().Add(-d)
+	}
+
+	matched, err := events.Query(townRoot, opts)
+	if err != nil {
+		return fmt.Errorf("querying events: %w", err)
+	}
+
+	if eventsJSON {
+		if err := printEventsJSON(matched); err != nil {
+			return err
+		}
+	} else {
+		printEventsText(matched)
+	}
+
+	if eventsFollow {
+		return followEvents(townRoot, opts)
+	}
+
+	return nil
+}
+
+func printEventsText(matched []events.Event) {
+	if len(matched) == 0 {
+		fmt.Printf("%s No matching events\n", style.Dim.Render("○"))
+		return
+	}
+	// Query returns most-recent-first; print chronologically like a log.
+	for i := len(matched) - 1; i >= 0; i-- {
+		e := matched[i]
+		fmt.Printf("%s %-16s %-28s", style.Dim.Render(e.Timestamp), e.Type, e.Actor)
+		if len(e.Payload) > 0 {
+			if data, err := json.Marshal(e.Payload); err == nil {
+				fmt.Printf(" %s", string(data))
+			}
+		}
+		fmt.Println()
+	}
+}
+
+func printEventsJSON(matched []events.Event) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(matched)
+}
+
+// followEvents tails newly-appended lines in the events log and prints
+// the ones matching opts, until the process is interrupted. It seeks to
+// the end of the file first, so it only ever shows events logged after
+// gt events started - the initial query above already covered history.
+func followEvents(townRoot string, opts events.QueryOptions) error {
+	path := filepath.Join(townRoot, events.EventsFile)
+	f, err := os.Open(path) //nolint:gosec // G304: path is constructed internally, not from user input
+	if err != nil {
+		return fmt.Errorf("opening events file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Seek(0, io.SeekEnd); err != nil {
+		return fmt.Errorf("seeking to end of events file: %w", err)
+	}
+
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			time.Sleep(250 * time.Millisecond)
+			continue
+		}
+
+		var e events.Event
+		if jsonErr := json.Unmarshal([]byte(strings.TrimSpace(line)), &e); jsonErr != nil {
+			continue // skip malformed lines
+		}
+		if !opts.Matches(&e) {
+			continue
+		}
+
+		if eventsJSON {
+			if err := printEventsJSON([]events.Event{e}); err != nil {
+				return err
+			}
+			continue
+		}
+		fmt.Printf("%s %-16s %-28s", style.Dim.Render(e.Timestamp), e.Type, e.Actor)
+		if len(e.Payload) > 0 {
+			if data, err := json.Marshal(e.Payload); err == nil {
+				fmt.Printf(" %s", string(data))
+			}
+		}
+		fmt.Println()
+	}
+}
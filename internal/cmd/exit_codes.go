@@ -0,0 +1,31 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+var exitCodesCmd = &cobra.Command{
+	Use:     "exit-codes",
+	GroupID: GroupDiag,
+	Short:   "Exit codes gt uses, for scripts that need more than pass/fail",
+	Long: `gt maps command failures to distinct exit codes so scripts wrapping gt
+can react without parsing error text.
+
+  0  Success
+  1  Uncategorized error (see the printed message)
+  2  No workspace - not inside a Gas Town workspace (retry from the right directory)
+  3  Dirty worktree - uncommitted work is blocking the operation (needs a human, or --force)
+  4  Not found - the target (rig, polecat, crew member, bead...) doesn't exist
+  5  Conflict - the target is already in the requested state or owned elsewhere
+  6  Precondition failed - a required precondition wasn't met (missing binary, bad flag combo...)
+
+Not every command distinguishes all of these yet; commands that haven't been
+migrated still exit 1 on any failure. sling, shutdown, handoff, and refinery
+are migrated, though not every command hits every category (e.g. shutdown
+never returns "conflict").`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(exitCodesCmd)
+}
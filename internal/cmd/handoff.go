@@ -6,11 +6,14 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/handoff"
+	"github.com/steveyegge/gastown/internal/identity"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
@@ -41,34 +44,73 @@ Examples:
   gt handoff -c                       # Collect state into handoff message
   gt handoff crew                     # Hand off crew session
   gt handoff mayor                    # Hand off mayor session
+  gt handoff --at 30m                 # Hand off in 30 minutes, once idle
+  gt handoff --at 15:04                # Hand off at 3:04pm, once idle
+  gt handoff --cancel-scheduled       # Cancel a pending scheduled handoff
 
 The --collect (-c) flag gathers current state (hooked work, inbox, ready beads,
 in-progress items) and includes it in the handoff mail. This provides context
 for the next session without manual summarization.
 
+The --at flag defers the handoff instead of running it immediately: it writes
+a scheduled-handoff wisp into the agent's runtime dir and arms a check ('gt
+handoff check') that fires once the time has passed and the pane has shown no
+new tool output for a while (capture-pane diffing). This lets an agent finish
+its current tool sequence rather than being cut off mid-step.
+
 Any molecule on the hook will be auto-continued by the new session.
 The SessionStart hook runs 'gt prime' to restore context.`,
 	RunE: runHandoff,
 }
 
+var handoffCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Fire a scheduled handoff if it's due and the pane is idle",
+	Long: `Checks this session's pending scheduled handoff (armed via 'gt handoff --at').
+
+If nothing is scheduled, or the scheduled time hasn't passed, this is a no-op.
+Once due, it captures the pane and compares it against the last check: if the
+content hasn't changed for the idle threshold, the handoff fires (same as
+running 'gt handoff' with the scheduled subject/message). Otherwise it just
+records the pane state and waits for the next check.
+
+Intended to be invoked repeatedly by deacon patrol or a SessionStart-adjacent
+hook, not typically run by hand.`,
+	RunE: runHandoffCheck,
+}
+
 var (
-	handoffWatch   bool
-	handoffDryRun  bool
-	handoffSubject string
-	handoffMessage string
-	handoffCollect bool
+	handoffWatch           bool
+	handoffDryRun          bool
+	handoffSubject         string
+	handoffMessage         string
+	handoffCollect         bool
+	handoffAt              string
+	handoffCancelScheduled bool
 )
 
 func init() {
+	handoffCmd.ValidArgsFunction = completeHandoffTarget
+
 	handoffCmd.Flags().BoolVarP(&handoffWatch, "watch", "w", true, "Switch to new session (for remote handoff)")
 	handoffCmd.Flags().BoolVarP(&handoffDryRun, "dry-run", "n", false, "Show what would be done without executing")
 	handoffCmd.Flags().StringVarP(&handoffSubject, "subject", "s", "", "Subject for handoff mail (optional)")
 	handoffCmd.Flags().StringVarP(&handoffMessage, "message", "m", "", "Message body for handoff mail (optional)")
 	handoffCmd.Flags().BoolVarP(&handoffCollect, "collect", "c", false, "Auto-collect state (status, inbox, beads) into handoff message")
+	handoffCmd.Flags().StringVar(&handoffAt, "at", "", "Defer handoff until a duration (30m) or clock time (15:04) has passed and the pane is idle")
+	handoffCmd.Flags().BoolVar(&handoffCancelScheduled, "cancel-scheduled", false, "Cancel a pending scheduled handoff")
+	handoffCmd.AddCommand(handoffCheckCmd)
 	rootCmd.AddCommand(handoffCmd)
 }
 
 func runHandoff(cmd *cobra.Command, args []string) error {
+	if handoffCancelScheduled {
+		return runHandoffCancelScheduled()
+	}
+	if handoffAt != "" {
+		return runHandoffSchedule(handoffAt)
+	}
+
 	// Check if we're a polecat - polecats use gt done instead
 	// GT_POLECAT is set by the session manager when starting polecat sessions
 	if polecatName := os.Getenv("GT_POLECAT"); polecatName != "" {
@@ -99,12 +141,12 @@ func runHandoff(cmd *cobra.Command, args []string) error {
 
 	// Verify we're in tmux
 	if !tmux.IsInsideTmux() {
-		return fmt.Errorf("not running in tmux - cannot hand off")
+		return fmt.Errorf("not running in tmux - cannot hand off: %w", ErrPrecondition)
 	}
 
 	pane := os.Getenv("TMUX_PANE")
 	if pane == "" {
-		return fmt.Errorf("TMUX_PANE not set - cannot hand off")
+		return fmt.Errorf("TMUX_PANE not set - cannot hand off: %w", ErrPrecondition)
 	}
 
 	// Get current session name
@@ -160,6 +202,7 @@ func runHandoff(cmd *cobra.Command, args []string) error {
 		_ = LogHandoff(townRoot, agent, handoffSubject)
 		// Also log to activity feed
 		_ = events.LogFeed(events.TypeHandoff, agent, events.HandoffPayload(handoffSubject, true))
+		_ = events.LogFeed(events.TypeAgentHandoff, agent, events.AgentHandoffPayload(agent, handoffSubject, true))
 	}
 
 	// Dry run mode - show what would happen (BEFORE any side effects)
@@ -215,6 +258,118 @@ func runHandoff(cmd *cobra.Command, args []string) error {
 	return t.RespawnPane(pane, restartCmd)
 }
 
+// runHandoffSchedule arms a deferred handoff: it writes a scheduled-handoff
+// wisp into the current directory's runtime dir instead of respawning now.
+func runHandoffSchedule(at string) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting working directory: %w", err)
+	}
+
+	now := time.Now()
+	target, err := handoff.ParseAt(at, now)
+	if err != nil {
+		return err
+	}
+
+	s := &handoff.Schedule{
+		At:        target,
+		Subject:   handoffSubject,
+		Message:   handoffMessage,
+		CreatedAt: now,
+	}
+	runtimeDir := handoff.RuntimeDir(cwd)
+	if err := handoff.Write(runtimeDir, s); err != nil {
+		return fmt.Errorf("writing scheduled handoff: %w", err)
+	}
+
+	fmt.Printf("%s Handoff scheduled for %s (fires once idle)\n",
+		style.Bold.Render("⏰"), target.Format(time.RFC3339))
+	return nil
+}
+
+// runHandoffCancelScheduled removes a pending scheduled handoff, if any.
+func runHandoffCancelScheduled() error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting working directory: %w", err)
+	}
+
+	runtimeDir := handoff.RuntimeDir(cwd)
+	existing, err := handoff.Load(runtimeDir)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		fmt.Println("No scheduled handoff to cancel")
+		return nil
+	}
+
+	if err := handoff.Clear(runtimeDir); err != nil {
+		return err
+	}
+	fmt.Printf("%s Cancelled scheduled handoff\n", style.Bold.Render("✓"))
+	return nil
+}
+
+// runHandoffCheck fires a scheduled handoff once it's due and the pane has
+// been idle for the idle threshold. See handoffCheckCmd for the intended
+// caller (deacon patrol / a SessionStart-adjacent hook).
+func runHandoffCheck(cmd *cobra.Command, args []string) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting working directory: %w", err)
+	}
+
+	runtimeDir := handoff.RuntimeDir(cwd)
+	s, err := handoff.Load(runtimeDir)
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		fmt.Println("No scheduled handoff")
+		return nil
+	}
+
+	now := time.Now()
+	if !s.Due(now) {
+		fmt.Printf("Scheduled handoff not due until %s\n", s.At.Format(time.RFC3339))
+		return nil
+	}
+
+	if !tmux.IsInsideTmux() {
+		return fmt.Errorf("not running in tmux - cannot check idle state: %w", ErrPrecondition)
+	}
+	sessionName, err := getCurrentTmuxSession()
+	if err != nil {
+		return fmt.Errorf("getting session name: %w", err)
+	}
+
+	t := tmux.NewTmux()
+	idle, err := handoff.CheckIdle(t, sessionName, s, handoff.DefaultIdleThreshold, now)
+	if err != nil {
+		return fmt.Errorf("checking pane idle state: %w", err)
+	}
+	if !idle {
+		// Persist the updated pane-hash tracking even though we're not
+		// firing yet, so the next check measures from here.
+		if err := handoff.Write(runtimeDir, s); err != nil {
+			style.PrintWarning("could not update idle tracking: %v", err)
+		}
+		fmt.Println("Scheduled handoff due but pane is still active - waiting for idle")
+		return nil
+	}
+
+	fmt.Printf("%s Scheduled handoff due and pane idle - handing off\n", style.Bold.Render("⏰"))
+	if err := handoff.Clear(runtimeDir); err != nil {
+		style.PrintWarning("could not clear scheduled handoff: %v", err)
+	}
+
+	handoffSubject = s.Subject
+	handoffMessage = s.Message
+	return runHandoff(cmd, nil)
+}
+
 // getCurrentTmuxSession returns the current tmux session name.
 func getCurrentTmuxSession() (string, error) {
 	out, err := exec.Command("tmux", "display-message", "-p", "#{session_name}").Output()
@@ -499,13 +654,13 @@ func sessionWorkDir(sessionName, townRoot string) (string, error) {
 }
 
 // sessionToGTRole converts a session name to a GT_ROLE value.
-// Uses session.ParseSessionName for consistent parsing across the codebase.
+// Uses identity.FromSessionName for consistent parsing across the codebase.
 func sessionToGTRole(sessionName string) string {
-	identity, err := session.ParseSessionName(sessionName)
+	id, err := identity.FromSessionName(sessionName)
 	if err != nil {
 		return ""
 	}
-	return identity.GTRole()
+	return id.GTRole()
 }
 
 // detectTownRootFromCwd walks up from the current directory to find the town root.
@@ -546,7 +701,7 @@ func handoffRemoteSession(t *tmux.Tmux, targetSession, restartCmd string) error
 		return fmt.Errorf("checking session: %w", err)
 	}
 	if !exists {
-		return fmt.Errorf("session '%s' not found - is the agent running?", targetSession)
+		return fmt.Errorf("session '%s' not found - is the agent running?: %w", targetSession, ErrNotFound)
 	}
 
 	// Get the pane ID for the target session
@@ -734,7 +889,7 @@ func hookBeadForHandoff(beadID string) error {
 	// Verify the bead exists first
 	verifyCmd := exec.Command("bd", "show", beadID, "--json")
 	if err := verifyCmd.Run(); err != nil {
-		return fmt.Errorf("bead '%s' not found", beadID)
+		return fmt.Errorf("bead '%s' not found: %w", beadID, ErrNotFound)
 	}
 
 	// Determine agent identity
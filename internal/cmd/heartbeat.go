@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var heartbeatCmd = &cobra.Command{
+	Use:     "heartbeat",
+	GroupID: GroupAgents,
+	Short:   "Record that this agent is alive and responsive",
+	Long: `Update the current agent's last_heartbeat field to now.
+
+gt prime already does this on every role detection pass. gt heartbeat
+exists so hooks (e.g. a PostToolUse hook) can report liveness between
+primes, without paying for a full prime run. This is what lets
+beads.StaleAgents and gt patrol tell "Claude is stuck in a tool loop"
+apart from "the tmux session merely exists."`,
+	Args: cobra.NoArgs,
+	RunE: runHeartbeat,
+}
+
+func init() {
+	rootCmd.AddCommand(heartbeatCmd)
+}
+
+func runHeartbeat(cmd *cobra.Command, args []string) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting current directory: %w", err)
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	roleInfo, err := GetRoleWithContext(cwd, townRoot)
+	if err != nil {
+		return fmt.Errorf("determining role: %w", err)
+	}
+
+	ctx := RoleContext{
+		Role:     roleInfo.Role,
+		Rig:      roleInfo.Rig,
+		Polecat:  roleInfo.Polecat,
+		TownRoot: townRoot,
+		WorkDir:  cwd,
+	}
+
+	agentBeadID := getAgentBeadID(ctx)
+	if agentBeadID == "" {
+		return fmt.Errorf("could not determine agent bead ID for role %s", roleInfo.Role)
+	}
+
+	bd := beads.New(townRoot)
+	if err := bd.UpdateAgentHeartbeat(agentBeadID, time.Now()); err != nil {
+		return fmt.Errorf("recording heartbeat: %w", err)
+	}
+
+	return nil
+}
@@ -473,7 +473,7 @@ func TestHookSlot_StatusTransitions(t *testing.T) {
 	}
 
 	// Finally close the bead
-	if err := b.Close(issue.ID); err != nil {
+	if err := b.Close(issue.ID, ""); err != nil {
 		t.Errorf("close hooked bead: %v", err)
 	}
 
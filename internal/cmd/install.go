@@ -35,6 +35,7 @@ var (
 	installPublic     bool
 	installShell      bool
 	installWrappers   bool
+	installPrefix     string
 )
 
 var installCmd = &cobra.Command{
@@ -47,9 +48,11 @@ The HQ (headquarters) is the top-level directory where Gas Town is installed -
 the root of your workspace where all rigs and agents live. It contains:
   - CLAUDE.md            Mayor role context (Mayor runs from HQ root)
   - mayor/               Mayor config, state, and rig registry
-  - .beads/              Town-level beads DB (hq-* prefix for mayor mail)
+  - .beads/              Town-level beads DB (hq-* prefix for mayor mail by default)
 
-If path is omitted, uses the current directory.
+If path is omitted, uses the current directory. Safe to rerun on a town
+that was only partially created (e.g. interrupted mid-run): each file is
+only written if it doesn't already exist.
 
 See docs/hq.md for advanced HQ configurations including beads
 redirects, multi-system setups, and HQ templates.
@@ -58,6 +61,7 @@ Examples:
   gt install ~/gt                              # Create HQ at ~/gt
   gt install . --name my-workspace             # Initialize current dir
   gt install ~/gt --no-beads                   # Skip .beads/ initialization
+  gt install ~/gt --prefix acme                # Use "acme-" instead of "hq-" for town beads
   gt install ~/gt --git                        # Also init git with .gitignore
   gt install ~/gt --github=user/repo           # Create private GitHub repo (default)
   gt install ~/gt --github=user/repo --public  # Create public GitHub repo
@@ -77,6 +81,7 @@ func init() {
 	installCmd.Flags().BoolVar(&installPublic, "public", false, "Make GitHub repo public (use with --github)")
 	installCmd.Flags().BoolVar(&installShell, "shell", false, "Install shell integration (sets GT_TOWN_ROOT/GT_RIG env vars)")
 	installCmd.Flags().BoolVar(&installWrappers, "wrappers", false, "Install gt-codex/gt-opencode wrapper scripts to ~/bin/")
+	installCmd.Flags().StringVar(&installPrefix, "prefix", "hq", "Beads issue prefix for the town-level database")
 	rootCmd.AddCommand(installCmd)
 }
 
@@ -107,8 +112,14 @@ func runInstall(cmd *cobra.Command, args []string) error {
 		townName = filepath.Base(absPath)
 	}
 
-	// Check if already a workspace
-	if isWS, _ := workspace.IsWorkspace(absPath); isWS && !installForce {
+	// Check if already a fully-installed workspace. This checks for
+	// mayor/town.json specifically rather than workspace.IsWorkspace (which
+	// also treats a bare mayor/ directory as a town) so that a rerun on a
+	// town that was only partially created - e.g. interrupted after creating
+	// mayor/ but before town.json was written - completes instead of being
+	// refused.
+	townJSONPath := filepath.Join(absPath, "mayor", "town.json")
+	if _, err := os.Stat(townJSONPath); err == nil && !installForce {
 		// If only --wrappers is requested in existing town, just install wrappers and exit
 		if installWrappers {
 			if err := wrappers.Install(); err != nil {
@@ -172,21 +183,40 @@ func runInstall(cmd *cobra.Command, args []string) error {
 		CreatedAt:  time.Now(),
 	}
 	townPath := filepath.Join(mayorDir, "town.json")
-	if err := config.SaveTownConfig(townPath, townConfig); err != nil {
+	if _, err := os.Stat(townPath); err == nil {
+		fmt.Printf("   • mayor/town.json already exists, leaving it as-is\n")
+	} else if err := config.SaveTownConfig(townPath, townConfig); err != nil {
 		return fmt.Errorf("writing town.json: %w", err)
+	} else {
+		fmt.Printf("   ✓ Created mayor/town.json\n")
 	}
-	fmt.Printf("   ✓ Created mayor/town.json\n")
 
-	// Create rigs.json in mayor/
-	rigsConfig := &config.RigsConfig{
-		Version: config.CurrentRigsVersion,
-		Rigs:    make(map[string]config.RigEntry),
-	}
+	// Create rigs.json in mayor/. Guarded on existence so rerunning install
+	// on a partially-created town doesn't wipe out rigs already registered
+	// by an earlier, interrupted run.
 	rigsPath := filepath.Join(mayorDir, "rigs.json")
-	if err := config.SaveRigsConfig(rigsPath, rigsConfig); err != nil {
-		return fmt.Errorf("writing rigs.json: %w", err)
+	if _, err := os.Stat(rigsPath); err == nil {
+		fmt.Printf("   • mayor/rigs.json already exists, leaving it as-is\n")
+	} else {
+		rigsConfig := &config.RigsConfig{
+			Version: config.CurrentRigsVersion,
+			Rigs:    make(map[string]config.RigEntry),
+		}
+		if err := config.SaveRigsConfig(rigsPath, rigsConfig); err != nil {
+			return fmt.Errorf("writing rigs.json: %w", err)
+		}
+		fmt.Printf("   ✓ Created mayor/rigs.json\n")
+	}
+
+	// Create accounts.json in mayor/ (template for multi-account Claude config).
+	accountsPath := filepath.Join(mayorDir, "accounts.json")
+	if _, err := os.Stat(accountsPath); err == nil {
+		fmt.Printf("   • mayor/accounts.json already exists, leaving it as-is\n")
+	} else if err := config.SaveAccountsConfig(accountsPath, config.NewAccountsConfig()); err != nil {
+		return fmt.Errorf("writing accounts.json: %w", err)
+	} else {
+		fmt.Printf("   ✓ Created mayor/accounts.json\n")
 	}
-	fmt.Printf("   ✓ Created mayor/rigs.json\n")
 
 	// Create Mayor CLAUDE.md at mayor/ (Mayor's canonical home)
 	// IMPORTANT: CLAUDE.md must be in ~/gt/mayor/, NOT ~/gt/
@@ -264,10 +294,10 @@ func runInstall(cmd *cobra.Command, args []string) error {
 			fmt.Printf("   ✓ Stopped %d orphaned bd daemon(s)\n", killed)
 		}
 
-		if err := initTownBeads(absPath); err != nil {
+		if err := initTownBeads(absPath, installPrefix); err != nil {
 			fmt.Printf("   %s Could not initialize town beads: %v\n", style.Dim.Render("⚠"), err)
 		} else {
-			fmt.Printf("   ✓ Initialized .beads/ (town-level beads with hq- prefix)\n")
+			fmt.Printf("   ✓ Initialized .beads/ (town-level beads with %s- prefix)\n", installPrefix)
 
 			// Provision embedded formulas to .beads/formulas/
 			if count, err := formula.ProvisionFormulas(absPath); err != nil {
@@ -383,10 +413,10 @@ func writeJSON(path string, data interface{}) error {
 }
 
 // initTownBeads initializes town-level beads database using bd init.
-// Town beads use the "hq-" prefix for mayor mail and cross-rig coordination.
-func initTownBeads(townPath string) error {
-	// Run: bd init --prefix hq
-	cmd := exec.Command("bd", "init", "--prefix", "hq")
+// Town beads use prefix (default "hq-") for mayor mail and cross-rig coordination.
+func initTownBeads(townPath, prefix string) error {
+	// Run: bd init --prefix <prefix>
+	cmd := exec.Command("bd", "init", "--prefix", prefix)
 	cmd.Dir = townPath
 
 	output, err := cmd.CombinedOutput()
@@ -406,7 +436,7 @@ func initTownBeads(townPath string) error {
 	}
 
 	// Explicitly set issue_prefix config (bd init --prefix may not persist it in newer versions).
-	prefixSetCmd := exec.Command("bd", "config", "set", "issue_prefix", "hq")
+	prefixSetCmd := exec.Command("bd", "config", "set", "issue_prefix", prefix)
 	prefixSetCmd.Dir = townPath
 	if prefixOutput, prefixErr := prefixSetCmd.CombinedOutput(); prefixErr != nil {
 		return fmt.Errorf("bd config set issue_prefix failed: %s", strings.TrimSpace(string(prefixOutput)))
@@ -421,9 +451,9 @@ func initTownBeads(townPath string) error {
 		fmt.Printf("   %s Could not set custom types: %s\n", style.Dim.Render("⚠"), strings.TrimSpace(string(configOutput)))
 	}
 
-	// Configure allowed_prefixes for convoy beads (hq-cv-* IDs).
-	// This allows bd create --id=hq-cv-xxx to pass prefix validation.
-	prefixCmd := exec.Command("bd", "config", "set", "allowed_prefixes", "hq,hq-cv")
+	// Configure allowed_prefixes for convoy beads (<prefix>-cv-* IDs).
+	// This allows bd create --id=<prefix>-cv-xxx to pass prefix validation.
+	prefixCmd := exec.Command("bd", "config", "set", "allowed_prefixes", prefix+","+prefix+"-cv")
 	prefixCmd.Dir = townPath
 	if prefixOutput, prefixErr := prefixCmd.CombinedOutput(); prefixErr != nil {
 		fmt.Printf("   %s Could not set allowed_prefixes: %s\n", style.Dim.Render("⚠"), strings.TrimSpace(string(prefixOutput)))
@@ -448,16 +478,16 @@ func initTownBeads(townPath string) error {
 		}
 	}
 
-	// Ensure routes.jsonl has an explicit town-level mapping for hq-* beads.
-	// This keeps hq-* operations stable even when invoked from rig worktrees.
-	if err := beads.AppendRoute(townPath, beads.Route{Prefix: "hq-", Path: "."}); err != nil {
+	// Ensure routes.jsonl has an explicit town-level mapping for <prefix>-* beads.
+	// This keeps <prefix>-* operations stable even when invoked from rig worktrees.
+	if err := beads.AppendRoute(townPath, beads.Route{Prefix: prefix + "-", Path: "."}); err != nil {
 		// Non-fatal: routing still works in many contexts, but explicit mapping is preferred.
 		fmt.Printf("   %s Could not update routes.jsonl: %v\n", style.Dim.Render("⚠"), err)
 	}
 
-	// Register hq-cv- prefix for convoy beads (auto-created by gt sling).
-	// Convoys use hq-cv-* IDs for visual distinction from other town beads.
-	if err := beads.AppendRoute(townPath, beads.Route{Prefix: "hq-cv-", Path: "."}); err != nil {
+	// Register <prefix>-cv- prefix for convoy beads (auto-created by gt sling).
+	// Convoys use <prefix>-cv-* IDs for visual distinction from other town beads.
+	if err := beads.AppendRoute(townPath, beads.Route{Prefix: prefix + "-cv-", Path: "."}); err != nil {
 		fmt.Printf("   %s Could not register convoy prefix: %v\n", style.Dim.Render("⚠"), err)
 	}
 
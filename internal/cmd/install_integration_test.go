@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 // TestInstallCreatesCorrectStructure validates that a fresh gt install
@@ -287,6 +288,164 @@ func TestInstallNoBeadsFlag(t *testing.T) {
 	}
 }
 
+// TestInstallCreatesAccountsConfig validates that mayor/accounts.json is
+// created as an empty template.
+func TestInstallCreatesAccountsConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+	hqPath := filepath.Join(tmpDir, "test-hq")
+
+	gtBinary := buildGT(t)
+
+	cmd := exec.Command(gtBinary, "install", hqPath, "--no-beads")
+	cmd.Env = append(os.Environ(), "HOME="+tmpDir)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("gt install failed: %v\nOutput: %s", err, output)
+	}
+
+	accountsPath := filepath.Join(hqPath, "mayor", "accounts.json")
+	assertFileExists(t, accountsPath, "mayor/accounts.json")
+
+	accountsConfig, err := config.LoadAccountsConfig(accountsPath)
+	if err != nil {
+		t.Fatalf("failed to load accounts.json: %v", err)
+	}
+	if len(accountsConfig.Accounts) != 0 {
+		t.Errorf("accounts.json should be empty, got %d accounts", len(accountsConfig.Accounts))
+	}
+}
+
+// TestInstallResumesPartialTown validates that rerunning gt install on a
+// town that was only partially created (mayor/ exists, but town.json
+// doesn't yet) completes it instead of refusing, and doesn't clobber
+// artifacts that already exist.
+func TestInstallResumesPartialTown(t *testing.T) {
+	tmpDir := t.TempDir()
+	hqPath := filepath.Join(tmpDir, "test-hq")
+
+	gtBinary := buildGT(t)
+
+	// Simulate a run that was interrupted right after creating mayor/rigs.json
+	// but before town.json was written.
+	mayorDir := filepath.Join(hqPath, "mayor")
+	if err := os.MkdirAll(mayorDir, 0755); err != nil {
+		t.Fatalf("failed to create mayor dir: %v", err)
+	}
+	rigsConfig := &config.RigsConfig{
+		Version: config.CurrentRigsVersion,
+		Rigs:    map[string]config.RigEntry{"sentinel": {}},
+	}
+	rigsPath := filepath.Join(mayorDir, "rigs.json")
+	if err := config.SaveRigsConfig(rigsPath, rigsConfig); err != nil {
+		t.Fatalf("failed to seed rigs.json: %v", err)
+	}
+
+	cmd := exec.Command(gtBinary, "install", hqPath, "--no-beads")
+	cmd.Env = append(os.Environ(), "HOME="+tmpDir)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("gt install on partial town failed: %v\nOutput: %s", err, output)
+	}
+
+	assertFileExists(t, filepath.Join(mayorDir, "town.json"), "mayor/town.json")
+
+	// The pre-existing rig registration must survive the completion run.
+	resumedRigsConfig, err := config.LoadRigsConfig(rigsPath)
+	if err != nil {
+		t.Fatalf("failed to load rigs.json: %v", err)
+	}
+	if _, ok := resumedRigsConfig.Rigs["sentinel"]; !ok {
+		t.Errorf("rigs.json lost the pre-existing 'sentinel' rig on resume")
+	}
+}
+
+// TestInstallCustomPrefix validates that --prefix changes the town beads
+// issue prefix instead of the hardcoded "hq" default.
+func TestInstallCustomPrefix(t *testing.T) {
+	if _, err := exec.LookPath("bd"); err != nil {
+		t.Skip("bd not installed, skipping prefix test")
+	}
+
+	tmpDir := t.TempDir()
+	hqPath := filepath.Join(tmpDir, "test-hq")
+
+	gtBinary := buildGT(t)
+
+	cmd := exec.Command(gtBinary, "install", hqPath, "--prefix", "acme")
+	cmd.Env = append(os.Environ(), "HOME="+tmpDir)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("gt install --prefix acme failed: %v\nOutput: %s", err, output)
+	}
+
+	bdCmd := exec.Command("bd", "--no-daemon", "config", "get", "issue_prefix")
+	bdCmd.Dir = hqPath
+	prefixOutput, err := bdCmd.Output()
+	if err != nil {
+		t.Fatalf("bd config get issue_prefix failed: %v", err)
+	}
+
+	prefix := strings.TrimSpace(string(prefixOutput))
+	if prefix != "acme" {
+		t.Errorf("beads issue_prefix = %q, want %q", prefix, "acme")
+	}
+}
+
+// TestInstallThenDoctorPasses validates the end-to-end flow requested for
+// town bootstrapping: gt install into a fresh directory, then
+// workspace.FindFromCwd recognizes it as a town, and gt doctor's core
+// checks pass against the freshly-scaffolded structure.
+func TestInstallThenDoctorPasses(t *testing.T) {
+	if _, err := exec.LookPath("bd"); err != nil {
+		t.Skip("bd not installed, skipping doctor test")
+	}
+
+	tmpDir := t.TempDir()
+	hqPath := filepath.Join(tmpDir, "test-hq")
+
+	gtBinary := buildGT(t)
+
+	cmd := exec.Command(gtBinary, "install", hqPath, "--name", "test-town")
+	cmd.Env = append(os.Environ(), "HOME="+tmpDir)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("gt install failed: %v\nOutput: %s", err, output)
+	}
+
+	// workspace.FindFromCwd walks up from the current directory, so chdir
+	// into the freshly-installed town before calling it.
+	origWD, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("getting current directory: %v", err)
+	}
+	defer func() { _ = os.Chdir(origWD) }()
+	if err := os.Chdir(hqPath); err != nil {
+		t.Fatalf("chdir into %s: %v", hqPath, err)
+	}
+	foundRoot, err := workspace.FindFromCwd()
+	if err != nil {
+		t.Fatalf("workspace.FindFromCwd failed: %v", err)
+	}
+	resolvedHQPath, err := filepath.EvalSymlinks(hqPath)
+	if err != nil {
+		t.Fatalf("resolving hq path: %v", err)
+	}
+	resolvedFoundRoot, err := filepath.EvalSymlinks(foundRoot)
+	if err != nil {
+		t.Fatalf("resolving found root: %v", err)
+	}
+	if resolvedFoundRoot != resolvedHQPath {
+		t.Errorf("workspace.FindFromCwd() = %q, want %q", foundRoot, resolvedHQPath)
+	}
+	if err := os.Chdir(origWD); err != nil {
+		t.Fatalf("restoring working directory: %v", err)
+	}
+
+	doctorCmd := exec.Command(gtBinary, "doctor")
+	doctorCmd.Dir = hqPath
+	doctorOutput, err := doctorCmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("gt doctor failed on freshly-installed town: %v\nOutput: %s", err, doctorOutput)
+	}
+}
+
 // assertDirExists checks that the given path exists and is a directory.
 func assertDirExists(t *testing.T, path, name string) {
 	t.Helper()
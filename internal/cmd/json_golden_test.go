@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/refinery"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+// TestJSONSchemas_Golden pins the on-disk shape of the --json output structs
+// for rig list, refinery status, refinery queue, and session list. A diff
+// here means a field was added, renamed, or removed - update the golden
+// file deliberately if the change is intentional, since scripts parse this
+// output.
+func TestJSONSchemas_Golden(t *testing.T) {
+	mrCreatedAt, err := time.Parse(time.RFC3339, "2026-01-15T09:00:00Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		data interface{}
+	}{
+		{
+			name: "rig_list",
+			data: []rig.RigSummary{
+				{
+					Name:         "greenplace",
+					PolecatCount: 2,
+					CrewCount:    1,
+					HasWitness:   true,
+					HasRefinery:  true,
+					HasMayor:     true,
+					Agents:       []string{"refinery", "witness", "mayor"},
+				},
+			},
+		},
+		{
+			name: "refinery_status",
+			data: RefineryStatusOutput{
+				Running:     true,
+				RigName:     "greenplace",
+				Session:     "gt-refinery-greenplace",
+				QueueLength: 1,
+				CurrentMR: &refinery.MergeRequest{
+					ID:           "gt-mr-1",
+					Branch:       "polecat/Toast/gt-abc",
+					Worker:       "Toast",
+					IssueID:      "gt-abc",
+					TargetBranch: "main",
+					Priority:     1,
+					CreatedAt:    mrCreatedAt,
+					Status:       refinery.MRInProgress,
+				},
+				LastMerge: &refinery.MergeRequest{
+					ID:           "gt-mr-0",
+					Branch:       "polecat/Rex/gt-xyz",
+					Worker:       "Rex",
+					IssueID:      "gt-xyz",
+					TargetBranch: "main",
+					Priority:     2,
+					CreatedAt:    mrCreatedAt,
+					Status:       refinery.MRClosed,
+					CloseReason:  refinery.CloseReasonMerged,
+				},
+			},
+		},
+		{
+			name: "refinery_queue",
+			data: []refinery.QueueItem{
+				{
+					Position: 1,
+					Age:      "5m",
+					MR: &refinery.MergeRequest{
+						ID:           "gt-mr-2",
+						Branch:       "polecat/Fang/gt-def",
+						Worker:       "Fang",
+						IssueID:      "gt-def",
+						TargetBranch: "main",
+						Priority:     0,
+						CreatedAt:    mrCreatedAt,
+						Status:       refinery.MROpen,
+					},
+				},
+			},
+		},
+		{
+			name: "session_list",
+			data: []SessionListItem{
+				{Rig: "greenplace", Polecat: "Toast", SessionID: "gt-greenplace-Toast", Running: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			enc := json.NewEncoder(&buf)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(tt.data); err != nil {
+				t.Fatalf("encoding: %v", err)
+			}
+
+			goldenPath := filepath.Join("testdata", "golden", tt.name+".json")
+			if os.Getenv("UPDATE_GOLDEN") != "" {
+				if err := os.WriteFile(goldenPath, buf.Bytes(), 0o644); err != nil {
+					t.Fatalf("writing golden file %s: %v", goldenPath, err)
+				}
+			}
+			want, err := os.ReadFile(goldenPath)
+			if err != nil {
+				t.Fatalf("reading golden file %s: %v", goldenPath, err)
+			}
+
+			if buf.String() != string(want) {
+				t.Errorf("JSON schema for %s changed.\ngot:\n%s\nwant:\n%s", tt.name, buf.String(), want)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// outputJSON writes data to stdout as indented JSON. Commands that support a
+// --json flag should route through this instead of hand-rolling an encoder,
+// so every command's JSON mode is formatted the same way.
+func outputJSON(data interface{}) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(data)
+}
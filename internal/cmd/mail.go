@@ -6,29 +6,41 @@ import (
 
 // Mail command flags
 var (
-	mailSubject       string
-	mailBody          string
-	mailPriority      int
-	mailUrgent        bool
-	mailPinned        bool
-	mailWisp          bool
-	mailPermanent     bool
-	mailType          string
-	mailReplyTo       string
-	mailNotify        bool
-	mailSendSelf      bool
-	mailCC            []string // CC recipients
-	mailInboxJSON     bool
-	mailReadJSON      bool
-	mailInboxUnread   bool
-	mailInboxAll      bool
-	mailInboxIdentity string
-	mailCheckInject   bool
-	mailCheckJSON     bool
-	mailCheckIdentity string
-	mailThreadJSON    bool
-	mailReplySubject  string
-	mailReplyMessage  string
+	mailSubject           string
+	mailBody              string
+	mailFile              string
+	mailPriority          int
+	mailUrgent            bool
+	mailPinned            bool
+	mailWisp              bool
+	mailPermanent         bool
+	mailType              string
+	mailReplyTo           string
+	mailNotify            bool
+	mailSendSelf          bool
+	mailCC                []string // CC recipients
+	mailInboxJSON         bool
+	mailReadJSON          bool
+	mailInboxUnread       bool
+	mailInboxAll          bool
+	mailInboxIdentity     string
+	mailInboxTypes        []string // filter by message type, repeatable
+	mailInboxPriority     string   // filter by priority, e.g. "<=1"
+	mailCheckInject       bool
+	mailCheckJSON         bool
+	mailCheckIdentity     string
+	mailThreadJSON        bool
+	mailReplySubject      string
+	mailReplyMessage      string
+	mailReplyFile         string
+	mailPeekIndex         int
+	mailPeekPriorityFirst bool
+	mailSnoozeFor         string
+	mailDeliverAt         string
+
+	// Outbox flags
+	mailOutboxPending bool
+	mailOutboxJSON    bool
 
 	// Search flags
 	mailSearchFrom    string
@@ -42,6 +54,14 @@ var (
 
 	// Clear flags
 	mailClearAll bool
+
+	// Delete flags
+	mailDeleteFrom          string
+	mailDeleteOlderThan     string
+	mailDeleteRead          bool
+	mailDeleteAllRead       bool
+	mailDeleteYes           bool
+	mailDeleteIncludePinned bool
 )
 
 var mailCmd = &cobra.Command{
@@ -117,6 +137,22 @@ Priority levels:
 
 Use --urgent as shortcut for --priority 0.
 
+Use --notify to also pop a tmux display-message in the recipient's session
+(silently skipped if tmux or the session isn't there). Urgent mail notifies
+automatically for rigs with notify_on_urgent set, no flag required.
+
+Use --file <path> to read the body from a file instead of -m, preserving
+exact whitespace (use --file - for stdin). Mutually exclusive with -m. If
+-s is omitted, the subject is taken from a "Subject: ..." header on the
+file's first line, followed by an optional blank line before the body.
+
+Use --deliver-at <time|duration> to schedule delivery for later instead of
+now, e.g. "02:00" or "6h". The message is created immediately but stays
+hidden from the recipient's inbox until 'gt mail release-due' delivers it
+(the deacon patrol runs this periodically). List scheduled messages you've
+sent with 'gt mail outbox --pending', and cancel one before it delivers
+with 'gt mail outbox cancel <message-id>'.
+
 Examples:
   gt mail send greenplace/Toast -s "Status check" -m "How's that bug fix going?"
   gt mail send mayor/ -s "Work complete" -m "Finished gt-abc"
@@ -126,7 +162,10 @@ Examples:
   gt mail send mayor/ -s "Re: Status" -m "Done" --reply-to msg-abc123
   gt mail send --self -s "Handoff" -m "Context for next session"
   gt mail send greenplace/Toast -s "Update" -m "Progress report" --cc overseer
-  gt mail send list:oncall -s "Alert" -m "System down"`,
+  gt mail send list:oncall -s "Alert" -m "System down"
+  gt mail send --self --file handoff.md
+  cat notes.md | gt mail send greenplace/Toast --file -
+  gt mail send mayor/ -s "Nightly audit" -m "Kick off the audit" --deliver-at 02:00`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runMailSend,
 }
@@ -173,25 +212,62 @@ Use 'gt mail mark-read' to mark messages as read.`,
 
 var mailPeekCmd = &cobra.Command{
 	Use:   "peek",
-	Short: "Show preview of first unread message",
-	Long: `Display a compact preview of the first unread message.
+	Short: "Show preview of an unread message",
+	Long: `Display a compact preview of an unread message.
 
 Useful for status bar popups - shows subject, sender, and body preview.
-Exits silently with code 1 if no unread messages.`,
+Exits silently with code 1 if no unread (visible) messages.
+
+By default the highest-priority unread message is previewed, so a popup
+doesn't get stuck showing a low-value notification while an urgent one
+sits further back in the queue. Use --priority-first=false to preview in
+inbox order instead, and --index to pick a specific position (1-based)
+from the resulting order.
+
+Messages hidden with 'gt mail snooze' are excluded; if any are, the
+output notes "(N snoozed)".
+
+Examples:
+  gt mail peek                    # highest-priority unread
+  gt mail peek --priority-first=false --index 2
+  gt mail peek --index 3          # 3rd message, priority-first order`,
 	RunE: runMailPeek,
 }
 
+var mailSnoozeCmd = &cobra.Command{
+	Use:   "snooze <message-id>",
+	Short: "Hide a message from peek/check until it expires",
+	Long: `Snooze a message so 'gt mail peek' and 'gt mail check' stop surfacing it.
+
+The message stays in the inbox and still counts as unread - snoozing
+only affects the low-value-notification surfaces, not 'gt mail inbox'.
+
+Examples:
+  gt mail snooze hq-abc123 --for 1h
+  gt mail snooze hq-abc123 --for 30m`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMailSnooze,
+}
+
 var mailDeleteCmd = &cobra.Command{
-	Use:   "delete <message-id> [message-id...]",
+	Use:   "delete [message-id...]",
 	Short: "Delete messages",
 	Long: `Delete (acknowledge) one or more messages.
 
 This closes the messages in beads.
 
+Messages can be given explicitly by ID, or matched with filter flags
+instead. Pinned messages are skipped unless --include-pinned is given.
+Filtered deletes print how many messages matched and ask for
+confirmation unless --yes is passed.
+
 Examples:
   gt mail delete hq-abc123
-  gt mail delete hq-abc123 hq-def456 hq-ghi789`,
-	Args: cobra.MinimumNArgs(1),
+  gt mail delete hq-abc123 hq-def456 hq-ghi789
+  gt mail delete --all-read
+  gt mail delete --from mayor/ --older-than 7d
+  gt mail delete --read --older-than 7d --yes`,
+	Args: cobra.ArbitraryArgs,
 	RunE: runMailDelete,
 }
 
@@ -287,12 +363,16 @@ This is a convenience command that automatically:
 - Prefixes the subject with "Re: " (if not already present)
 - Sends to the original sender
 
-The message body can be provided as a positional argument or via -m flag.
+The message body can be provided as a positional argument, via -m flag, or
+via --file <path> (--file - for stdin), which reads the body verbatim and,
+absent -s, can supply the subject via a "Subject: ..." header on its first
+line. --file is mutually exclusive with the positional argument and -m.
 
 Examples:
   gt mail reply msg-abc123 "Thanks, working on it now"
   gt mail reply msg-abc123 -m "Thanks, working on it now"
-  gt mail reply msg-abc123 -s "Custom subject" -m "Reply body"`,
+  gt mail reply msg-abc123 -s "Custom subject" -m "Reply body"
+  gt mail reply msg-abc123 --file reply.md`,
 	Args: cobra.RangeArgs(1, 2),
 	RunE: runMailReply,
 }
@@ -433,7 +513,63 @@ Examples:
 	RunE: runMailAnnounces,
 }
 
+var mailReleaseDueCmd = &cobra.Command{
+	Use:   "release-due",
+	Short: "Deliver scheduled messages whose time has come",
+	Long: `Flip every scheduled message ('gt mail send --deliver-at') that is now
+due into a normal, visible message.
+
+Intended to be run periodically by the deacon patrol, but safe to run
+by hand or from a hook - it's a no-op when nothing is due.
+
+Examples:
+  gt mail release-due`,
+	RunE: runMailReleaseDue,
+}
+
+var mailRetryHumanDeliveryCmd = &cobra.Command{
+	Use:   "retry-human-delivery",
+	Short: "Retry failed webhook/sendmail deliveries to the overseer",
+	Long: `Re-attempt bridging messages sent to --human (the overseer) whose
+webhook/sendmail delivery failed, per the overseer config's "delivery"
+settings. The beads mailbox copy is always durable; this only affects
+whether the external bridge (e.g. a phone notification) also went out.
+
+Intended to be run periodically by the deacon patrol, but safe to run
+by hand - it's a no-op when nothing is pending.
+
+Examples:
+  gt mail retry-human-delivery`,
+	RunE: runMailRetryHumanDelivery,
+}
+
+var mailOutboxCmd = &cobra.Command{
+	Use:   "outbox",
+	Short: "List messages you've sent that are still scheduled",
+	Long: `List scheduled ('gt mail send --deliver-at') messages sent by you that
+haven't been delivered yet.
+
+Examples:
+  gt mail outbox --pending
+  gt mail outbox --pending --json`,
+	RunE: runMailOutbox,
+}
+
+var mailOutboxCancelCmd = &cobra.Command{
+	Use:   "cancel <message-id>",
+	Short: "Cancel a scheduled message before it delivers",
+	Long: `Cancel a message scheduled with 'gt mail send --deliver-at' before it
+becomes due. Once delivered, use 'gt mail delete' instead.
+
+Examples:
+  gt mail outbox cancel hq-abc123`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMailOutboxCancel,
+}
+
 func init() {
+	mailSendCmd.ValidArgsFunction = completeMailAddress
+
 	// Send flags
 	mailSendCmd.Flags().StringVarP(&mailSubject, "subject", "s", "", "Message subject (required)")
 	mailSendCmd.Flags().StringVarP(&mailBody, "message", "m", "", "Message body")
@@ -448,7 +584,12 @@ func init() {
 	mailSendCmd.Flags().BoolVar(&mailPermanent, "permanent", false, "Send as permanent (not ephemeral, synced to remote)")
 	mailSendCmd.Flags().BoolVar(&mailSendSelf, "self", false, "Send to self (auto-detect from cwd)")
 	mailSendCmd.Flags().StringArrayVar(&mailCC, "cc", nil, "CC recipients (can be used multiple times)")
-	_ = mailSendCmd.MarkFlagRequired("subject") // cobra flags: error only at runtime if missing
+	mailSendCmd.Flags().StringVar(&mailFile, "file", "", "Read body from a file (use - for stdin), mutually exclusive with -m")
+	mailSendCmd.Flags().StringVar(&mailDeliverAt, "deliver-at", "", "Schedule delivery for later: a duration (6h) or clock time (02:00)")
+
+	// Outbox flags
+	mailOutboxCmd.Flags().BoolVar(&mailOutboxPending, "pending", false, "Show scheduled messages not yet delivered")
+	mailOutboxCmd.Flags().BoolVar(&mailOutboxJSON, "json", false, "Output as JSON")
 
 	// Inbox flags
 	mailInboxCmd.Flags().BoolVar(&mailInboxJSON, "json", false, "Output as JSON")
@@ -456,10 +597,20 @@ func init() {
 	mailInboxCmd.Flags().BoolVarP(&mailInboxAll, "all", "a", false, "Show all messages (read and unread)")
 	mailInboxCmd.Flags().StringVar(&mailInboxIdentity, "identity", "", "Explicit identity for inbox (e.g., greenplace/Toast)")
 	mailInboxCmd.Flags().StringVar(&mailInboxIdentity, "address", "", "Alias for --identity")
+	mailInboxCmd.Flags().StringArrayVar(&mailInboxTypes, "type", nil, "Filter by message type: task|scavenge|notification|reply (can be used multiple times)")
+	mailInboxCmd.Flags().StringVar(&mailInboxPriority, "priority", "", "Filter by priority, e.g. \"1\", \"<=1\", \">2\" (0=urgent .. 4=backlog)")
 
 	// Read flags
 	mailReadCmd.Flags().BoolVar(&mailReadJSON, "json", false, "Output as JSON")
 
+	// Peek flags
+	mailPeekCmd.Flags().IntVar(&mailPeekIndex, "index", 1, "1-based position of the message to preview")
+	mailPeekCmd.Flags().BoolVar(&mailPeekPriorityFirst, "priority-first", true, "Preview in priority order instead of inbox order")
+
+	// Snooze flags
+	mailSnoozeCmd.Flags().StringVar(&mailSnoozeFor, "for", "", "How long to snooze, e.g. 30m or 1h (required)")
+	_ = mailSnoozeCmd.MarkFlagRequired("for")
+
 	// Check flags
 	mailCheckCmd.Flags().BoolVar(&mailCheckInject, "inject", false, "Output format for Claude Code hooks")
 	mailCheckCmd.Flags().BoolVar(&mailCheckJSON, "json", false, "Output as JSON")
@@ -473,6 +624,7 @@ func init() {
 	mailReplyCmd.Flags().StringVarP(&mailReplySubject, "subject", "s", "", "Override reply subject (default: Re: <original>)")
 	mailReplyCmd.Flags().StringVarP(&mailReplyMessage, "message", "m", "", "Reply message body")
 	mailReplyCmd.Flags().StringVar(&mailReplyMessage, "body", "", "Reply message body (alias for --message)")
+	mailReplyCmd.Flags().StringVar(&mailReplyFile, "file", "", "Read reply body from a file (use - for stdin), mutually exclusive with -m and the positional message")
 
 	// Search flags
 	mailSearchCmd.Flags().StringVar(&mailSearchFrom, "from", "", "Filter by sender address")
@@ -487,11 +639,20 @@ func init() {
 	// Clear flags
 	mailClearCmd.Flags().BoolVar(&mailClearAll, "all", false, "Clear all messages (default behavior)")
 
+	// Delete flags
+	mailDeleteCmd.Flags().StringVar(&mailDeleteFrom, "from", "", "Only delete messages from this sender")
+	mailDeleteCmd.Flags().StringVar(&mailDeleteOlderThan, "older-than", "", "Only delete messages older than this, e.g. 24h or 7d")
+	mailDeleteCmd.Flags().BoolVar(&mailDeleteRead, "read", false, "Only delete read messages")
+	mailDeleteCmd.Flags().BoolVar(&mailDeleteAllRead, "all-read", false, "Delete all read messages (shorthand for --read with no other filter)")
+	mailDeleteCmd.Flags().BoolVarP(&mailDeleteYes, "yes", "y", false, "Skip the confirmation prompt")
+	mailDeleteCmd.Flags().BoolVar(&mailDeleteIncludePinned, "include-pinned", false, "Also delete pinned messages")
+
 	// Add subcommands
 	mailCmd.AddCommand(mailSendCmd)
 	mailCmd.AddCommand(mailInboxCmd)
 	mailCmd.AddCommand(mailReadCmd)
 	mailCmd.AddCommand(mailPeekCmd)
+	mailCmd.AddCommand(mailSnoozeCmd)
 	mailCmd.AddCommand(mailDeleteCmd)
 	mailCmd.AddCommand(mailArchiveCmd)
 	mailCmd.AddCommand(mailMarkReadCmd)
@@ -504,6 +665,10 @@ func init() {
 	mailCmd.AddCommand(mailClearCmd)
 	mailCmd.AddCommand(mailSearchCmd)
 	mailCmd.AddCommand(mailAnnouncesCmd)
+	mailCmd.AddCommand(mailReleaseDueCmd)
+	mailCmd.AddCommand(mailRetryHumanDeliveryCmd)
+	mailOutboxCmd.AddCommand(mailOutboxCancelCmd)
+	mailCmd.AddCommand(mailOutboxCmd)
 
 	rootCmd.AddCommand(mailCmd)
 }
@@ -4,12 +4,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/style"
 )
 
+// defaultHookReminderStaleAfter is used when a role's hook_reminder config
+// doesn't set an explicit stale_after.
+const defaultHookReminderStaleAfter = 15 * time.Minute
+
 func runMailCheck(cmd *cobra.Command, args []string) error {
 	// Determine which inbox (priority: --identity flag, auto-detect)
 	address := ""
@@ -40,7 +49,7 @@ func runMailCheck(cmd *cobra.Command, args []string) error {
 	}
 
 	// Count unread
-	_, unread, err := mailbox.Count()
+	unread, err := mailbox.CountUnread()
 	if err != nil {
 		if mailCheckInject {
 			return nil
@@ -48,12 +57,25 @@ func runMailCheck(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("counting messages: %w", err)
 	}
 
+	// Snoozed messages count as unread (they're still in the inbox) but
+	// don't count toward "new mail" on these low-value-notification
+	// surfaces. Fail open (treat nothing as snoozed) on error.
+	visible := unread
+	snoozedCount := 0
+	if unread > 0 {
+		if visibleMessages, s, err := mailbox.ListUnreadVisible(time.Now()); err == nil {
+			visible = len(visibleMessages)
+			snoozedCount = s
+		}
+	}
+
 	// JSON output
 	if mailCheckJSON {
 		result := map[string]interface{}{
 			"address": address,
 			"unread":  unread,
-			"has_new": unread > 0,
+			"snoozed": snoozedCount,
+			"has_new": visible > 0,
 		}
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
@@ -63,30 +85,119 @@ func runMailCheck(cmd *cobra.Command, args []string) error {
 	// Inject mode: output system-reminder if mail exists
 	if mailCheckInject {
 		if unread > 0 {
-			// Get subjects for context
-			messages, _ := mailbox.ListUnread()
-			var subjects []string
-			for _, msg := range messages {
-				subjects = append(subjects, fmt.Sprintf("- %s from %s: %s", msg.ID, msg.From, msg.Subject))
+			messages, snoozedCount, _ := mailbox.ListUnreadVisible(time.Now())
+
+			// While focused, only urgent mail is worth derailing the agent
+			// for - everything else is counted but stays silent. Fail open
+			// (no filtering) if the agent bead can't be found or read.
+			reportable := messages
+			suppressed := 0
+			if agentBeadID := addressToAgentBeadID(address); agentBeadID != "" {
+				bd := beads.New(workDir)
+				if focused, _, err := bd.IsAgentFocused(agentBeadID); err == nil && focused {
+					reportable, suppressed = splitUrgentMessages(messages)
+				}
 			}
 
-			fmt.Println("<system-reminder>")
-			fmt.Printf("You have %d unread message(s) in your inbox.\n\n", unread)
-			for _, s := range subjects {
-				fmt.Println(s)
+			if len(reportable) > 0 {
+				var subjects []string
+				for _, msg := range reportable {
+					subjects = append(subjects, fmt.Sprintf("- %s from %s: %s", msg.ID, msg.From, msg.Subject))
+				}
+
+				fmt.Println("<system-reminder>")
+				fmt.Printf("You have %d unread message(s) in your inbox.\n\n", len(reportable))
+				for _, s := range subjects {
+					fmt.Println(s)
+				}
+				if suppressed > 0 {
+					fmt.Printf("\n(%d more non-urgent message(s) suppressed by focus mode - see 'gt mail focus status')\n", suppressed)
+				}
+				if snoozedCount > 0 {
+					fmt.Printf("\n(%d message(s) snoozed - see 'gt mail snooze')\n", snoozedCount)
+				}
+				fmt.Println()
+				fmt.Println("Run 'gt mail inbox' to see your messages, or 'gt mail read <id>' for a specific message.")
+				fmt.Println("</system-reminder>")
 			}
-			fmt.Println()
-			fmt.Println("Run 'gt mail inbox' to see your messages, or 'gt mail read <id>' for a specific message.")
+		}
+
+		if reminder := staleHookReminderText(workDir); reminder != "" {
+			fmt.Println("<system-reminder>")
+			fmt.Println(reminder)
 			fmt.Println("</system-reminder>")
 		}
 		return nil
 	}
 
 	// Normal mode
-	if unread > 0 {
-		fmt.Printf("%s %d unread message(s)\n", style.Bold.Render("📬"), unread)
+	if visible > 0 {
+		fmt.Printf("%s %d unread message(s)", style.Bold.Render(style.Icon("📬", "[MAIL]")), visible)
+		if snoozedCount > 0 {
+			fmt.Printf(" %s", style.Dim.Render(fmt.Sprintf("(%d snoozed)", snoozedCount)))
+		}
+		fmt.Println()
 		return NewSilentExit(0)
 	}
-	fmt.Println("No new mail")
+	if snoozedCount > 0 {
+		fmt.Printf("No new mail %s\n", style.Dim.Render(fmt.Sprintf("(%d snoozed)", snoozedCount)))
+	} else {
+		fmt.Println("No new mail")
+	}
 	return NewSilentExit(1)
 }
+
+// staleHookReminderText returns the reminder to inject when the current
+// agent has a hooked bead but no sign of active work - no status change or
+// commit - within its role's configured staleness window. Fails open
+// (returns "") on any missing signal: an unknown role, an override that
+// disables the reminder, or an error reading git/role state.
+func staleHookReminderText(workDir string) string {
+	roleInfo, err := GetRole()
+	if err != nil {
+		return ""
+	}
+	if roleInfo.Role != RolePolecat && roleInfo.Role != RoleCrew {
+		return ""
+	}
+
+	rigPath := filepath.Join(roleInfo.TownRoot, roleInfo.Rig)
+	def, err := config.LoadRoleDefinition(roleInfo.TownRoot, rigPath, string(roleInfo.Role))
+	if err != nil || def.HookReminder.Disabled {
+		return ""
+	}
+	staleAfter := def.HookReminder.StaleAfter.Duration
+	if staleAfter <= 0 {
+		staleAfter = defaultHookReminderStaleAfter
+	}
+
+	hooked := findHookedBead(workDir, getAgentIdentity(roleInfo))
+	if hooked == nil {
+		return ""
+	}
+
+	lastCommit, _ := git.NewGit(roleInfo.WorkDir).LastCommitTime() // best effort
+	return staleHookReminder(hooked, lastCommit, time.Now(), staleAfter)
+}
+
+// staleHookReminder builds the reminder message for a hooked bead that
+// hasn't seen a status update or commit in staleAfter. Returns "" if the
+// bead is missing or there's a recent-enough activity signal.
+func staleHookReminder(hooked *beads.Issue, lastCommit, now time.Time, staleAfter time.Duration) string {
+	if hooked == nil {
+		return ""
+	}
+
+	lastActivity := hooked.UpdatedAt.Time
+	if lastCommit.After(lastActivity) {
+		lastActivity = lastCommit
+	}
+	if lastActivity.IsZero() || now.Sub(lastActivity) < staleAfter {
+		return ""
+	}
+
+	return fmt.Sprintf(
+		"You have a hooked bead (%s: %s) with no status change or commit in over %s. "+
+			"Run 'gt mol status' to check progress, or update the bead if you're still working it.",
+		hooked.ID, hooked.Title, staleAfter.Round(time.Minute))
+}
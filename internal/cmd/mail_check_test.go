@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestStaleHookReminder_NoHook(t *testing.T) {
+	if got := staleHookReminder(nil, time.Time{}, time.Now(), 15*time.Minute); got != "" {
+		t.Errorf("expected no reminder without a hooked bead, got %q", got)
+	}
+}
+
+func TestStaleHookReminder_FreshUpdateSuppressesReminder(t *testing.T) {
+	now := time.Now()
+	hooked := &beads.Issue{
+		ID:        "gt-1",
+		Title:     "do the thing",
+		UpdatedAt: beads.Timestamp{Time: now.Add(-5 * time.Minute)},
+	}
+
+	if got := staleHookReminder(hooked, time.Time{}, now, 15*time.Minute); got != "" {
+		t.Errorf("expected no reminder for a recently updated hook, got %q", got)
+	}
+}
+
+func TestStaleHookReminder_StaleUpdateFires(t *testing.T) {
+	now := time.Now()
+	hooked := &beads.Issue{
+		ID:        "gt-1",
+		Title:     "do the thing",
+		UpdatedAt: beads.Timestamp{Time: now.Add(-30 * time.Minute)},
+	}
+
+	got := staleHookReminder(hooked, time.Time{}, now, 15*time.Minute)
+	if got == "" {
+		t.Fatal("expected a reminder for a stale hook")
+	}
+	if !strings.Contains(got, "gt-1") || !strings.Contains(got, "gt mol status") {
+		t.Errorf("reminder missing expected content: %q", got)
+	}
+}
+
+func TestStaleHookReminder_RecentCommitSuppressesReminder(t *testing.T) {
+	now := time.Now()
+	hooked := &beads.Issue{
+		ID:        "gt-1",
+		Title:     "do the thing",
+		UpdatedAt: beads.Timestamp{Time: now.Add(-30 * time.Minute)},
+	}
+	lastCommit := now.Add(-2 * time.Minute)
+
+	if got := staleHookReminder(hooked, lastCommit, now, 15*time.Minute); got != "" {
+		t.Errorf("expected a recent commit to suppress the reminder, got %q", got)
+	}
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// maxMailFileBytes caps how much --file <path> (or --file - for stdin) will
+// read into memory. This is independent of mail.DefaultMaxBodySize, which
+// governs when a stored body spills to a blob file - this guards against
+// accidentally pointing --file at a huge or unbounded stream before it ever
+// reaches the router.
+var maxMailFileBytes = 4 * 1024 * 1024 // 4MiB
+
+// readMailBodyFile reads the raw contents of path for --file, treating "-"
+// as stdin. The body is returned verbatim (no trimming) so callers preserve
+// exact whitespace.
+func readMailBodyFile(path string) (string, error) {
+	var r io.Reader
+	if path == "-" {
+		r = os.Stdin
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return "", fmt.Errorf("opening %s: %w", path, err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, int64(maxMailFileBytes)+1))
+	if err != nil {
+		return "", fmt.Errorf("reading %s: %w", path, err)
+	}
+	if len(data) > maxMailFileBytes {
+		return "", fmt.Errorf("%s exceeds the %d byte limit for --file", path, maxMailFileBytes)
+	}
+	return string(data), nil
+}
+
+// splitMailFileSubject extracts a "Subject: <text>" header from the front of
+// raw --file content: a first line of the form "Subject: ..." followed by an
+// optional blank line, with everything after treated as the body verbatim.
+// If the first line isn't a Subject header, subject is empty and body is raw
+// unchanged.
+func splitMailFileSubject(raw string) (subject, body string) {
+	firstLine, rest, found := strings.Cut(raw, "\n")
+	if !found {
+		firstLine, rest = raw, ""
+	}
+
+	const prefix = "Subject: "
+	trimmed := strings.TrimSuffix(firstLine, "\r")
+	if !strings.HasPrefix(trimmed, prefix) {
+		return "", raw
+	}
+	subject = strings.TrimPrefix(trimmed, prefix)
+
+	switch {
+	case strings.HasPrefix(rest, "\r\n"):
+		rest = rest[2:]
+	case strings.HasPrefix(rest, "\n"):
+		rest = rest[1:]
+	}
+	return subject, rest
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadMailBodyFile_FromPath(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "body.txt")
+	want := "line one\n\nline two   \n  trailing space\n"
+	if err := os.WriteFile(path, []byte(want), 0644); err != nil {
+		t.Fatalf("write fixture: %v", err)
+	}
+
+	got, err := readMailBodyFile(path)
+	if err != nil {
+		t.Fatalf("readMailBodyFile: %v", err)
+	}
+	if got != want {
+		t.Errorf("body = %q, want %q", got, want)
+	}
+}
+
+func TestReadMailBodyFile_FromStdin(t *testing.T) {
+	want := "Subject: Handoff\n\nContext for the next session.\nSecond line.\n"
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe: %v", err)
+	}
+	if _, err := w.WriteString(want); err != nil {
+		t.Fatalf("write to pipe: %v", err)
+	}
+	w.Close()
+
+	origStdin := os.Stdin
+	os.Stdin = r
+	defer func() { os.Stdin = origStdin }()
+
+	got, err := readMailBodyFile("-")
+	if err != nil {
+		t.Fatalf("readMailBodyFile(-): %v", err)
+	}
+	if got != want {
+		t.Errorf("body = %q, want %q", got, want)
+	}
+}
+
+func TestReadMailBodyFile_ExceedsLimit(t *testing.T) {
+	origLimit := maxMailFileBytes
+	maxMailFileBytes = 8
+	defer func() { maxMailFileBytes = origLimit }()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "big.txt")
+	if err := os.WriteFile(path, []byte("this is way more than 8 bytes"), 0644); err != nil {
+		t.Fatalf("write fixture: %v", err)
+	}
+
+	if _, err := readMailBodyFile(path); err == nil {
+		t.Fatal("readMailBodyFile should fail when the file exceeds the byte limit")
+	}
+}
+
+func TestSplitMailFileSubject(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		wantSubj string
+		wantBody string
+	}{
+		{
+			name:     "no subject header",
+			raw:      "just a plain body\nwith two lines\n",
+			wantSubj: "",
+			wantBody: "just a plain body\nwith two lines\n",
+		},
+		{
+			name:     "subject header with blank line",
+			raw:      "Subject: Handoff notes\n\nBody starts here.\n",
+			wantSubj: "Handoff notes",
+			wantBody: "Body starts here.\n",
+		},
+		{
+			name:     "subject header without blank line",
+			raw:      "Subject: Quick update\nBody starts right away.\n",
+			wantSubj: "Quick update",
+			wantBody: "Body starts right away.\n",
+		},
+		{
+			name:     "subject header with CRLF blank line",
+			raw:      "Subject: Windows style\r\n\r\nBody.\r\n",
+			wantSubj: "Windows style",
+			wantBody: "Body.\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subj, body := splitMailFileSubject(tt.raw)
+			if subj != tt.wantSubj {
+				t.Errorf("subject = %q, want %q", subj, tt.wantSubj)
+			}
+			if body != tt.wantBody {
+				t.Errorf("body = %q, want %q", body, tt.wantBody)
+			}
+		})
+	}
+}
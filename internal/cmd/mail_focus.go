@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var mailFocusUntil string
+
+var mailFocusCmd = &cobra.Command{
+	Use:   "focus [on|off|status]",
+	Short: "Toggle focus mode to suppress non-urgent mail reminders",
+	Long: `Control whether "gt mail check --inject" nags the current agent about
+low-priority mail.
+
+While focused, mail check --inject only produces a system-reminder for
+PriorityUrgent messages - everything else is counted but stays silent, so a
+polecat deep in a tool-use chain isn't derailed by routine mail.
+
+Subcommands:
+  on      Enable focus mode (default duration: 30m, see --until)
+  off     Disable focus mode
+  status  Show current mode, expiry, and the count of currently suppressed mail
+
+Without arguments, shows the current status.
+
+Focus always has an expiry, even if --until isn't given: a crashed or nuked
+session can't leave itself muted forever.
+
+Examples:
+  gt mail focus on              # focus for the default 30m
+  gt mail focus on --until 2h   # focus for 2 hours
+  gt mail focus status
+  gt mail focus off`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runMailFocus,
+}
+
+func init() {
+	mailFocusCmd.Flags().StringVar(&mailFocusUntil, "until", "", "How long focus mode lasts, e.g. 30m or 2h (default 30m)")
+	mailCmd.AddCommand(mailFocusCmd)
+}
+
+func runMailFocus(cmd *cobra.Command, args []string) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting current directory: %w", err)
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	roleInfo, err := GetRoleWithContext(cwd, townRoot)
+	if err != nil {
+		return fmt.Errorf("determining role: %w", err)
+	}
+
+	ctx := RoleContext{
+		Role:     roleInfo.Role,
+		Rig:      roleInfo.Rig,
+		Polecat:  roleInfo.Polecat,
+		TownRoot: townRoot,
+		WorkDir:  cwd,
+	}
+
+	agentBeadID := getAgentBeadID(ctx)
+	if agentBeadID == "" {
+		return fmt.Errorf("could not determine agent bead ID for role %s", roleInfo.Role)
+	}
+
+	bd := beads.New(townRoot)
+
+	action := "status"
+	if len(args) > 0 {
+		action = args[0]
+	}
+
+	switch action {
+	case "on":
+		duration := beads.DefaultFocusDuration
+		if mailFocusUntil != "" {
+			duration, err = time.ParseDuration(mailFocusUntil)
+			if err != nil {
+				return fmt.Errorf("invalid --until %q: %w", mailFocusUntil, err)
+			}
+		}
+
+		until := time.Now().Add(duration)
+		if err := bd.UpdateAgentFocus(agentBeadID, true, until); err != nil {
+			return fmt.Errorf("enabling focus mode: %w", err)
+		}
+		fmt.Printf("%s Focus mode enabled until %s\n", style.SuccessPrefix(), until.Format(time.Kitchen))
+		fmt.Printf("  Non-urgent mail will be counted silently. Run %s to stop early.\n", style.Bold.Render("gt mail focus off"))
+
+	case "off":
+		if err := bd.UpdateAgentFocus(agentBeadID, false, time.Time{}); err != nil {
+			return fmt.Errorf("disabling focus mode: %w", err)
+		}
+		fmt.Printf("%s Focus mode disabled\n", style.SuccessPrefix())
+
+	case "status":
+		return printMailFocusStatus(bd, agentBeadID, townRoot, string(roleInfo.Role))
+
+	default:
+		return fmt.Errorf("unknown action %q: use on, off, or status", action)
+	}
+
+	return nil
+}
+
+// printMailFocusStatus reports whether focus mode is active and, if so, how
+// much non-urgent mail is currently being suppressed by it.
+func printMailFocusStatus(bd *beads.Beads, agentBeadID, townRoot, role string) error {
+	focused, until, err := bd.IsAgentFocused(agentBeadID)
+	if err != nil {
+		return fmt.Errorf("checking focus mode: %w", err)
+	}
+
+	if !focused {
+		fmt.Printf("Focus mode: %s\n", style.Dim.Render("off"))
+		return nil
+	}
+
+	fmt.Printf("Focus mode: %s (until %s)\n", style.Bold.Render("on"), until.Format(time.Kitchen))
+
+	address := detectSenderFromRole(role)
+	if address == "" {
+		return nil
+	}
+	mailbox := mail.NewMailboxFromAddress(address, townRoot)
+	messages, err := mailbox.ListUnread()
+	if err != nil {
+		// Best-effort: focus state is already reported above.
+		return nil
+	}
+	_, suppressed := splitUrgentMessages(messages)
+	fmt.Printf("  %s\n", style.Dim.Render(fmt.Sprintf("%d non-urgent message(s) currently suppressed", suppressed)))
+
+	return nil
+}
+
+// splitUrgentMessages separates urgent messages (which focus mode still
+// surfaces) from everything else (which focus mode counts but suppresses).
+func splitUrgentMessages(messages []*mail.Message) (urgent []*mail.Message, suppressed int) {
+	for _, msg := range messages {
+		if msg.Priority == mail.PriorityUrgent {
+			urgent = append(urgent, msg)
+		} else {
+			suppressed++
+		}
+	}
+	return urgent, suppressed
+}
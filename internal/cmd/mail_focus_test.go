@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/mail"
+)
+
+func TestSplitUrgentMessages(t *testing.T) {
+	messages := []*mail.Message{
+		{ID: "1", Priority: mail.PriorityUrgent},
+		{ID: "2", Priority: mail.PriorityNormal},
+		{ID: "3", Priority: mail.PriorityHigh},
+		{ID: "4", Priority: mail.PriorityUrgent},
+		{ID: "5", Priority: mail.PriorityLow},
+	}
+
+	urgent, suppressed := splitUrgentMessages(messages)
+
+	if len(urgent) != 2 {
+		t.Errorf("len(urgent) = %d, want 2", len(urgent))
+	}
+	for _, msg := range urgent {
+		if msg.Priority != mail.PriorityUrgent {
+			t.Errorf("splitUrgentMessages() included non-urgent message %s in urgent set", msg.ID)
+		}
+	}
+
+	if suppressed != 3 {
+		t.Errorf("suppressed = %d, want 3", suppressed)
+	}
+}
+
+func TestSplitUrgentMessages_AllUrgent(t *testing.T) {
+	messages := []*mail.Message{
+		{ID: "1", Priority: mail.PriorityUrgent},
+		{ID: "2", Priority: mail.PriorityUrgent},
+	}
+
+	urgent, suppressed := splitUrgentMessages(messages)
+
+	if len(urgent) != 2 {
+		t.Errorf("len(urgent) = %d, want 2", len(urgent))
+	}
+	if suppressed != 0 {
+		t.Errorf("suppressed = %d, want 0", suppressed)
+	}
+}
+
+func TestSplitUrgentMessages_Empty(t *testing.T) {
+	urgent, suppressed := splitUrgentMessages(nil)
+	if len(urgent) != 0 || suppressed != 0 {
+		t.Errorf("splitUrgentMessages(nil) = (%v, %d), want (nil, 0)", urgent, suppressed)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/steveyegge/gastown/internal/identity"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -72,67 +73,54 @@ func findLocalBeadsDir() (string, error) {
 // However, cwd-based detection is also tried to support running commands
 // from agent directories without GT_ROLE set (e.g., debugging sessions).
 func detectSender() string {
-	// Check GT_ROLE first (authoritative for agent sessions)
-	role := os.Getenv("GT_ROLE")
-	if role != "" {
-		// Agent session - build address from role and context
-		return detectSenderFromRole(role)
+	if id, ok := identity.FromEnv(); ok {
+		return id.Address()
 	}
-
-	// No GT_ROLE - try cwd-based detection, defaults to overseer if not in agent directory
 	return detectSenderFromCwd()
 }
 
-// detectSenderFromRole builds an address from the GT_ROLE and related env vars.
-// GT_ROLE can be either a simple role name ("crew", "polecat") or a full address
-// ("greenplace/crew/joe") depending on how the session was started.
-//
-// If GT_ROLE is a simple name but required env vars (GT_RIG, GT_POLECAT, etc.)
-// are missing, falls back to cwd-based detection. This could return "overseer"
-// if cwd doesn't match any known agent path - a misconfigured agent session.
+// detectSenderFromRole builds an address from an explicit role (which may
+// be a simple role name like "crew" or a full address like
+// "greenplace/crew/joe") plus the GT_RIG/GT_POLECAT/GT_CREW env vars for
+// context. Falls back to cwd-based detection if the role needs a rig/name
+// that isn't available from those env vars. This could return "overseer"
+// if cwd doesn't match any known agent path either - a misconfigured
+// agent session.
 func detectSenderFromRole(role string) string {
-	rig := os.Getenv("GT_RIG")
-
-	// Check if role is already a full address (contains /)
 	if strings.Contains(role, "/") {
-		// GT_ROLE is already a full address, use it directly
+		if id, err := identity.FromAddress(role); err == nil {
+			return id.Address()
+		}
 		return role
 	}
 
-	// GT_ROLE is a simple role name, build the full address
+	rig := os.Getenv("GT_RIG")
 	switch role {
 	case "mayor":
-		return "mayor/"
+		return identity.Identity{Role: identity.RoleMayor}.Address()
 	case "deacon":
-		return "deacon/"
+		return identity.Identity{Role: identity.RoleDeacon}.Address()
 	case "polecat":
-		polecat := os.Getenv("GT_POLECAT")
-		if rig != "" && polecat != "" {
-			return fmt.Sprintf("%s/%s", rig, polecat)
+		if polecat := os.Getenv("GT_POLECAT"); rig != "" && polecat != "" {
+			return identity.Identity{Role: identity.RolePolecat, Rig: rig, Name: polecat}.Address()
 		}
-		// Fallback to cwd detection for polecats
-		return detectSenderFromCwd()
 	case "crew":
-		crew := os.Getenv("GT_CREW")
-		if rig != "" && crew != "" {
-			return fmt.Sprintf("%s/crew/%s", rig, crew)
+		if crew := os.Getenv("GT_CREW"); rig != "" && crew != "" {
+			return identity.Identity{Role: identity.RoleCrew, Rig: rig, Name: crew}.Address()
 		}
-		// Fallback to cwd detection for crew
-		return detectSenderFromCwd()
 	case "witness":
 		if rig != "" {
-			return fmt.Sprintf("%s/witness", rig)
+			return identity.Identity{Role: identity.RoleWitness, Rig: rig}.Address()
 		}
-		return detectSenderFromCwd()
 	case "refinery":
 		if rig != "" {
-			return fmt.Sprintf("%s/refinery", rig)
+			return identity.Identity{Role: identity.RoleRefinery, Rig: rig}.Address()
 		}
-		return detectSenderFromCwd()
-	default:
-		// Unknown role, try cwd detection
-		return detectSenderFromCwd()
 	}
+
+	// Role given but env vars incomplete (or role unrecognized) - fall
+	// back to cwd-based detection.
+	return detectSenderFromCwd()
 }
 
 // detectSenderFromCwd is the legacy cwd-based detection for edge cases.
@@ -142,44 +130,8 @@ func detectSenderFromCwd() string {
 		return "overseer"
 	}
 
-	// If in a rig's polecats directory, extract address (format: rig/polecats/name)
-	if strings.Contains(cwd, "/polecats/") {
-		parts := strings.Split(cwd, "/polecats/")
-		if len(parts) >= 2 {
-			rigPath := parts[0]
-			polecatPath := strings.Split(parts[1], "/")[0]
-			rigName := filepath.Base(rigPath)
-			return fmt.Sprintf("%s/polecats/%s", rigName, polecatPath)
-		}
-	}
-
-	// If in a rig's crew directory, extract address (format: rig/crew/name)
-	if strings.Contains(cwd, "/crew/") {
-		parts := strings.Split(cwd, "/crew/")
-		if len(parts) >= 2 {
-			rigPath := parts[0]
-			crewName := strings.Split(parts[1], "/")[0]
-			rigName := filepath.Base(rigPath)
-			return fmt.Sprintf("%s/crew/%s", rigName, crewName)
-		}
-	}
-
-	// If in a rig's refinery directory, extract address (format: rig/refinery)
-	if strings.Contains(cwd, "/refinery") {
-		parts := strings.Split(cwd, "/refinery")
-		if len(parts) >= 1 {
-			rigName := filepath.Base(parts[0])
-			return fmt.Sprintf("%s/refinery", rigName)
-		}
-	}
-
-	// If in a rig's witness directory, extract address (format: rig/witness)
-	if strings.Contains(cwd, "/witness") {
-		parts := strings.Split(cwd, "/witness")
-		if len(parts) >= 1 {
-			rigName := filepath.Base(parts[0])
-			return fmt.Sprintf("%s/witness", rigName)
-		}
+	if id, ok := identity.FromPath(cwd); ok {
+		return id.Address()
 	}
 
 	// Default to overseer (human)
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/mail"
@@ -51,15 +53,14 @@ func runMailInbox(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// Get messages
-	// --all is the default behavior (shows all messages)
-	// --unread filters to only unread messages
-	var messages []*mail.Message
-	if mailInboxUnread {
-		messages, err = mailbox.ListUnread()
-	} else {
-		messages, err = mailbox.List()
+	filter, err := buildMailInboxFilter()
+	if err != nil {
+		return err
 	}
+
+	// Get messages. --all is the default behavior (shows all messages);
+	// --unread and --type/--priority narrow it via ListFiltered.
+	messages, err := mailbox.ListFiltered(filter)
 	if err != nil {
 		return fmt.Errorf("listing messages: %w", err)
 	}
@@ -112,6 +113,26 @@ func runMailInbox(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// buildMailInboxFilter turns the mail inbox flags into a mail.ListFilter.
+func buildMailInboxFilter() (mail.ListFilter, error) {
+	filter := mail.ListFilter{Unread: mailInboxUnread}
+
+	for _, t := range mailInboxTypes {
+		filter.Types = append(filter.Types, mail.ParseMessageType(t))
+	}
+
+	if mailInboxPriority != "" {
+		cmp, value, err := mail.ParsePriorityFilter(mailInboxPriority)
+		if err != nil {
+			return mail.ListFilter{}, err
+		}
+		filter.PriorityCmp = cmp
+		filter.PriorityValue = value
+	}
+
+	return filter, nil
+}
+
 func runMailRead(cmd *cobra.Command, args []string) error {
 	if len(args) == 0 {
 		return errors.New("message ID or index required")
@@ -188,9 +209,43 @@ func runMailRead(cmd *cobra.Command, args []string) error {
 		fmt.Printf("\n%s\n", msg.Body)
 	}
 
+	if msg.BodyRef != "" {
+		if _, err := os.Stat(msg.BodyRef); err != nil {
+			fmt.Printf("\n%s\n", style.Dim.Render(
+				fmt.Sprintf("(body was truncated and the full copy at %s is no longer available)", msg.BodyRef)))
+		}
+	}
+
 	return nil
 }
 
+// orderPeekMessages returns messages in the order 'gt mail peek' should
+// consider them: highest priority first (stable, so same-priority messages
+// keep their inbox order) when priorityFirst is set, otherwise unchanged.
+func orderPeekMessages(messages []*mail.Message, priorityFirst bool) []*mail.Message {
+	if !priorityFirst {
+		return messages
+	}
+	ordered := append([]*mail.Message(nil), messages...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return mail.PriorityToBeads(ordered[i].Priority) < mail.PriorityToBeads(ordered[j].Priority)
+	})
+	return ordered
+}
+
+// selectPeekMessage picks the message at the given 1-based index, clamping
+// indexes below 1 up to 1. ok is false if index falls past the end of
+// messages (including when messages is empty).
+func selectPeekMessage(messages []*mail.Message, index int) (msg *mail.Message, resolvedIndex int, ok bool) {
+	if index < 1 {
+		index = 1
+	}
+	if index > len(messages) {
+		return nil, index, false
+	}
+	return messages[index-1], index, true
+}
+
 func runMailPeek(cmd *cobra.Command, args []string) error {
 	// Determine which inbox
 	address := detectSender()
@@ -200,14 +255,23 @@ func runMailPeek(cmd *cobra.Command, args []string) error {
 		return NewSilentExit(1) // Silent exit - can't access mailbox
 	}
 
-	// Get unread messages
-	messages, err := mailbox.ListUnread()
-	if err != nil || len(messages) == 0 {
+	// Cheap check first - only load full messages if there's actually unread mail.
+	unread, err := mailbox.CountUnread()
+	if err != nil || unread == 0 {
 		return NewSilentExit(1) // Silent exit - no unread
 	}
 
-	// Show first unread message
-	msg := messages[0]
+	messages, snoozed, err := mailbox.ListUnreadVisible(time.Now())
+	if err != nil || len(messages) == 0 {
+		return NewSilentExit(1) // Silent exit - no unread (visible) mail
+	}
+
+	messages = orderPeekMessages(messages, mailPeekPriorityFirst)
+
+	msg, index, ok := selectPeekMessage(messages, mailPeekIndex)
+	if !ok {
+		return NewSilentExit(1) // Silent exit - no message at that position
+	}
 
 	// Header with priority indicator
 	priorityStr := ""
@@ -217,7 +281,7 @@ func runMailPeek(cmd *cobra.Command, args []string) error {
 		priorityStr = " [!]"
 	}
 
-	fmt.Printf("📬 %s%s\n", msg.Subject, priorityStr)
+	fmt.Printf("%s %s%s\n", style.Icon("📬", "[MAIL]"), msg.Subject, priorityStr)
 	fmt.Printf("From: %s\n", msg.From)
 	fmt.Printf("ID: %s\n\n", msg.ID)
 
@@ -234,9 +298,16 @@ func runMailPeek(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// Show count if more messages
-	if len(messages) > 1 {
-		fmt.Printf("\n%s\n", style.Dim.Render(fmt.Sprintf("(+%d more unread)", len(messages)-1)))
+	// Show counts for anything not currently on screen
+	var footer []string
+	if remaining := len(messages) - index; remaining > 0 {
+		footer = append(footer, fmt.Sprintf("+%d more unread", remaining))
+	}
+	if snoozed > 0 {
+		footer = append(footer, fmt.Sprintf("%d snoozed", snoozed))
+	}
+	if len(footer) > 0 {
+		fmt.Printf("\n%s\n", style.Dim.Render("("+strings.Join(footer, ", ")+")"))
 	}
 
 	return nil
@@ -251,25 +322,32 @@ func runMailDelete(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// Delete all specified messages
+	if len(args) > 0 {
+		return deleteMailByID(mailbox, args)
+	}
+	return deleteMailWhere(mailbox)
+}
+
+// deleteMailByID deletes each explicitly named message ID, preserving the
+// per-ID error reporting the original command had.
+func deleteMailByID(mailbox *mail.Mailbox, args []string) error {
 	deleted := 0
-	var errors []string
+	var errs []string
 	for _, msgID := range args {
 		if err := mailbox.Delete(msgID); err != nil {
-			errors = append(errors, fmt.Sprintf("%s: %v", msgID, err))
+			errs = append(errs, fmt.Sprintf("%s: %v", msgID, err))
 		} else {
 			deleted++
 		}
 	}
 
-	// Report results
-	if len(errors) > 0 {
+	if len(errs) > 0 {
 		fmt.Printf("%s Deleted %d/%d messages\n",
 			style.Bold.Render("⚠"), deleted, len(args))
-		for _, e := range errors {
+		for _, e := range errs {
 			fmt.Printf("  Error: %s\n", e)
 		}
-		return fmt.Errorf("failed to delete %d messages", len(errors))
+		return fmt.Errorf("failed to delete %d messages", len(errs))
 	}
 
 	if len(args) == 1 {
@@ -280,6 +358,68 @@ func runMailDelete(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// deleteMailWhere handles the filter-flag form of gt mail delete: --from,
+// --older-than, --read, --all-read, optionally combined, with a
+// confirmation prompt unless --yes is passed.
+func deleteMailWhere(mailbox *mail.Mailbox) error {
+	if mailDeleteFrom == "" && mailDeleteOlderThan == "" && !mailDeleteRead && !mailDeleteAllRead {
+		return errors.New("gt mail delete requires message IDs or at least one filter flag (--from, --older-than, --read, --all-read)")
+	}
+
+	filter := mail.ListFilter{
+		From:          mailDeleteFrom,
+		Read:          mailDeleteRead || mailDeleteAllRead,
+		IncludePinned: mailDeleteIncludePinned,
+	}
+	if mailDeleteOlderThan != "" {
+		d, err := parseDurationWithDays(mailDeleteOlderThan)
+		if err != nil {
+			return fmt.Errorf("invalid --older-than %q: %w", mailDeleteOlderThan, err)
+		}
+		filter.OlderThan = d
+	}
+
+	matched, err := mailbox.ListFiltered(filter)
+	if err != nil {
+		return fmt.Errorf("listing messages: %w", err)
+	}
+	if len(matched) == 0 {
+		fmt.Println("No messages matched")
+		return nil
+	}
+
+	if !mailDeleteYes {
+		fmt.Printf("Delete %d message(s)? [y/N] ", len(matched))
+		var response string
+		_, _ = fmt.Scanln(&response)
+		if response != "y" && response != "Y" && response != "yes" && response != "Yes" {
+			fmt.Println("Aborted")
+			return nil
+		}
+	}
+
+	deleted, err := mailbox.DeleteWhere(filter)
+	if err != nil {
+		return fmt.Errorf("deleting messages: %w", err)
+	}
+
+	fmt.Printf("%s Deleted %d message(s)\n", style.Bold.Render("✓"), deleted)
+	return nil
+}
+
+// parseDurationWithDays parses a duration string, additionally accepting a
+// "d" suffix for days (e.g. "7d"), which time.ParseDuration doesn't support.
+func parseDurationWithDays(s string) (time.Duration, error) {
+	if rest, ok := strings.CutSuffix(s, "d"); ok {
+		days, err := strconv.Atoi(rest)
+		if err != nil {
+			return 0, fmt.Errorf("expected e.g. \"7d\": %w", err)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
+}
+
 func runMailArchive(cmd *cobra.Command, args []string) error {
 	// Determine which inbox
 	address := detectSender()
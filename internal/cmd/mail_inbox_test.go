@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/mail"
+)
+
+func TestBuildMailInboxFilter(t *testing.T) {
+	resetMailInboxFlags := func() {
+		mailInboxUnread = false
+		mailInboxTypes = nil
+		mailInboxPriority = ""
+	}
+	t.Cleanup(resetMailInboxFlags)
+
+	tests := []struct {
+		name       string
+		unread     bool
+		types      []string
+		priority   string
+		wantFilter mail.ListFilter
+		wantErr    bool
+	}{
+		{
+			name:       "no flags set",
+			wantFilter: mail.ListFilter{},
+		},
+		{
+			name:       "unread only",
+			unread:     true,
+			wantFilter: mail.ListFilter{Unread: true},
+		},
+		{
+			name:       "single type",
+			types:      []string{"task"},
+			wantFilter: mail.ListFilter{Types: []mail.MessageType{mail.TypeTask}},
+		},
+		{
+			name:       "multiple types",
+			types:      []string{"task", "scavenge"},
+			wantFilter: mail.ListFilter{Types: []mail.MessageType{mail.TypeTask, mail.TypeScavenge}},
+		},
+		{
+			name:       "priority comparator",
+			priority:   "<=1",
+			wantFilter: mail.ListFilter{PriorityCmp: "<=", PriorityValue: 1},
+		},
+		{
+			name:       "unread combined with type and priority",
+			unread:     true,
+			types:      []string{"reply"},
+			priority:   ">0",
+			wantFilter: mail.ListFilter{Unread: true, Types: []mail.MessageType{mail.TypeReply}, PriorityCmp: ">", PriorityValue: 0},
+		},
+		{
+			name:     "invalid priority errors",
+			priority: "not-a-number",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetMailInboxFlags()
+			mailInboxUnread = tt.unread
+			mailInboxTypes = tt.types
+			mailInboxPriority = tt.priority
+
+			got, err := buildMailInboxFilter()
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("buildMailInboxFilter() expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildMailInboxFilter() unexpected error: %v", err)
+			}
+
+			if got.Unread != tt.wantFilter.Unread ||
+				got.PriorityCmp != tt.wantFilter.PriorityCmp ||
+				got.PriorityValue != tt.wantFilter.PriorityValue ||
+				len(got.Types) != len(tt.wantFilter.Types) {
+				t.Fatalf("buildMailInboxFilter() = %+v, want %+v", got, tt.wantFilter)
+			}
+			for i, ty := range got.Types {
+				if ty != tt.wantFilter.Types[i] {
+					t.Fatalf("buildMailInboxFilter() Types = %v, want %v", got.Types, tt.wantFilter.Types)
+				}
+			}
+		})
+	}
+}
+
+func TestOrderPeekMessages(t *testing.T) {
+	low := &mail.Message{ID: "low", Priority: mail.PriorityLow}
+	normal := &mail.Message{ID: "normal", Priority: mail.PriorityNormal}
+	urgent := &mail.Message{ID: "urgent", Priority: mail.PriorityUrgent}
+	messages := []*mail.Message{low, normal, urgent}
+
+	ordered := orderPeekMessages(messages, true)
+	if len(ordered) != 3 || ordered[0] != urgent || ordered[1] != normal || ordered[2] != low {
+		t.Fatalf("orderPeekMessages(priorityFirst=true) = %v, want [urgent normal low]", ordered)
+	}
+
+	unordered := orderPeekMessages(messages, false)
+	if len(unordered) != 3 || unordered[0] != low || unordered[1] != normal || unordered[2] != urgent {
+		t.Fatalf("orderPeekMessages(priorityFirst=false) = %v, want inbox order unchanged", unordered)
+	}
+
+	// Original slice order must be untouched by the priority-first sort.
+	if messages[0] != low || messages[2] != urgent {
+		t.Fatal("orderPeekMessages must not mutate its input slice")
+	}
+}
+
+func TestSelectPeekMessage(t *testing.T) {
+	a := &mail.Message{ID: "a"}
+	b := &mail.Message{ID: "b"}
+	messages := []*mail.Message{a, b}
+
+	if msg, idx, ok := selectPeekMessage(messages, 1); !ok || msg != a || idx != 1 {
+		t.Errorf("selectPeekMessage(1) = (%v, %d, %v), want (a, 1, true)", msg, idx, ok)
+	}
+	if msg, idx, ok := selectPeekMessage(messages, 2); !ok || msg != b || idx != 2 {
+		t.Errorf("selectPeekMessage(2) = (%v, %d, %v), want (b, 2, true)", msg, idx, ok)
+	}
+	if msg, _, ok := selectPeekMessage(messages, 0); !ok || msg != a {
+		t.Errorf("selectPeekMessage(0) should clamp to index 1, got (%v, %v)", msg, ok)
+	}
+	if _, _, ok := selectPeekMessage(messages, 3); ok {
+		t.Error("selectPeekMessage(3) should fail past the end of messages")
+	}
+	if _, _, ok := selectPeekMessage(nil, 1); ok {
+		t.Error("selectPeekMessage on empty messages (e.g. all unread snoozed) should fail")
+	}
+}
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+// mailNotifySession abstracts the tmux calls notifyRecipient needs, so tests
+// can stub it without a real tmux server.
+type mailNotifySession interface {
+	HasSession(name string) (bool, error)
+	DisplayMessageDefault(session, message string) error
+}
+
+// sessionNameForAddress resolves a mail address to its expected tmux session
+// name, reusing assigneeToSessionName for rig-scoped addresses and adding the
+// two singleton addresses it doesn't cover.
+func sessionNameForAddress(address string) (string, bool) {
+	switch address {
+	case "mayor", "mayor/":
+		return session.MayorSessionName(), true
+	case "deacon", "deacon/":
+		return session.DeaconSessionName(), true
+	}
+
+	name, _ := assigneeToSessionName(address)
+	return name, name != ""
+}
+
+// notifyRecipient shows a short tmux display-message popup in the
+// recipient's session announcing new mail. It falls back silently if the
+// address doesn't map to a session, tmux isn't running, or the session
+// doesn't exist - a missed popup is not worth failing the send over.
+func notifyRecipient(t mailNotifySession, address, from, subject string) {
+	sessionName, ok := sessionNameForAddress(address)
+	if !ok {
+		return
+	}
+
+	exists, err := t.HasSession(sessionName)
+	if err != nil || !exists {
+		return
+	}
+
+	popup := fmt.Sprintf("\U0001F4EC mail from %s: %s", from, subject)
+	_ = t.DisplayMessageDefault(sessionName, popup)
+}
+
+// rigWantsUrgentNotify reports whether the rig owning address has
+// notify_on_urgent set, so urgent mail gets a popup even without --notify.
+// Addresses that aren't rig-scoped (mayor/, deacon/) never auto-notify here;
+// --notify is the only way to reach those.
+func rigWantsUrgentNotify(townRoot, address string) bool {
+	rigName := strings.SplitN(address, "/", 2)[0]
+	if rigName == "" || rigName == "mayor" || rigName == "deacon" {
+		return false
+	}
+
+	rigsConfig, err := config.LoadRigsConfig(filepath.Join(townRoot, "mayor", "rigs.json"))
+	if err != nil {
+		return false
+	}
+	r, err := rig.NewManager(townRoot, rigsConfig, git.NewGit(townRoot)).GetRig(rigName)
+	if err != nil {
+		return false
+	}
+	return r.GetBoolConfig("notify_on_urgent")
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+)
+
+// stubMailNotifySession is a fake mailNotifySession for testing notifyRecipient
+// without a real tmux server.
+type stubMailNotifySession struct {
+	sessions      map[string]bool
+	displayedTo   string
+	displayedMsg  string
+	displayCalls  int
+	displayErr    error
+	hasSessionErr error
+}
+
+func (s *stubMailNotifySession) HasSession(name string) (bool, error) {
+	if s.hasSessionErr != nil {
+		return false, s.hasSessionErr
+	}
+	return s.sessions[name], nil
+}
+
+func (s *stubMailNotifySession) DisplayMessageDefault(session, message string) error {
+	s.displayCalls++
+	s.displayedTo = session
+	s.displayedMsg = message
+	return s.displayErr
+}
+
+func TestSessionNameForAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		wantOK  bool
+	}{
+		{"mayor/", true},
+		{"mayor", true},
+		{"deacon/", true},
+		{"gastown/witness", true},
+		{"gastown/Toast", true},
+		{"gastown/crew/max", true},
+		{"list:oncall", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			got, ok := sessionNameForAddress(tt.address)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && got == "" {
+				t.Error("resolved session name should not be empty")
+			}
+		})
+	}
+}
+
+func TestNotifyRecipient_DisplaysPopupWhenSessionExists(t *testing.T) {
+	stub := &stubMailNotifySession{sessions: map[string]bool{"gt-gastown-Toast": true}}
+
+	notifyRecipient(stub, "gastown/Toast", "mayor/", "Status check")
+
+	if stub.displayCalls != 1 {
+		t.Fatalf("displayCalls = %d, want 1", stub.displayCalls)
+	}
+	if stub.displayedTo != "gt-gastown-Toast" {
+		t.Errorf("displayedTo = %q, want gt-gastown-Toast", stub.displayedTo)
+	}
+	if want := "\U0001F4EC mail from mayor/: Status check"; stub.displayedMsg != want {
+		t.Errorf("displayedMsg = %q, want %q", stub.displayedMsg, want)
+	}
+}
+
+func TestNotifyRecipient_SilentFallbackWhenSessionMissing(t *testing.T) {
+	stub := &stubMailNotifySession{sessions: map[string]bool{}}
+
+	notifyRecipient(stub, "gastown/Toast", "mayor/", "Status check")
+
+	if stub.displayCalls != 0 {
+		t.Errorf("displayCalls = %d, want 0 (no session)", stub.displayCalls)
+	}
+}
+
+func TestNotifyRecipient_SilentFallbackWhenAddressUnresolvable(t *testing.T) {
+	stub := &stubMailNotifySession{sessions: map[string]bool{}}
+
+	notifyRecipient(stub, "list:oncall", "mayor/", "Status check")
+
+	if stub.displayCalls != 0 {
+		t.Errorf("displayCalls = %d, want 0 (address doesn't map to a session)", stub.displayCalls)
+	}
+}
+
+func TestNotifyRecipient_SilentFallbackWhenTmuxErrors(t *testing.T) {
+	stub := &stubMailNotifySession{hasSessionErr: errors.New("tmux: no server running")}
+
+	notifyRecipient(stub, "gastown/Toast", "mayor/", "Status check")
+
+	if stub.displayCalls != 0 {
+		t.Errorf("displayCalls = %d, want 0 (tmux unavailable)", stub.displayCalls)
+	}
+}
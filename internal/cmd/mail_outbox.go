@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+func runMailOutbox(cmd *cobra.Command, args []string) error {
+	workDir, err := findMailWorkDir()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	router := mail.NewRouter(workDir)
+	pending, err := router.Pending()
+	if err != nil {
+		return fmt.Errorf("listing pending messages: %w", err)
+	}
+
+	from := detectSender()
+	var mine []*mail.Message
+	for _, msg := range pending {
+		if msg.From == from {
+			mine = append(mine, msg)
+		}
+	}
+
+	if mailOutboxJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(mine)
+	}
+
+	if len(mine) == 0 {
+		fmt.Printf("  %s\n", style.Dim.Render("(no scheduled messages)"))
+		return nil
+	}
+
+	fmt.Printf("%s Scheduled message(s): %d\n\n", style.Bold.Render("📤"), len(mine))
+	for _, msg := range mine {
+		deliverAt := "unknown"
+		if msg.DeliverAt != nil {
+			deliverAt = msg.DeliverAt.Local().Format(time.RFC3339)
+		}
+		fmt.Printf("  %s → %s: %s (delivers %s)\n", msg.ID, msg.To, msg.Subject, deliverAt)
+	}
+
+	return nil
+}
+
+func runMailOutboxCancel(cmd *cobra.Command, args []string) error {
+	workDir, err := findMailWorkDir()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	messageID := args[0]
+	router := mail.NewRouter(workDir)
+	if err := router.CancelPending(messageID); err != nil {
+		return fmt.Errorf("cancelling %s: %w", messageID, err)
+	}
+
+	fmt.Printf("%s Cancelled %s\n", style.SuccessPrefix(), messageID)
+	return nil
+}
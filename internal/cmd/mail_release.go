@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+func runMailReleaseDue(cmd *cobra.Command, args []string) error {
+	workDir, err := findMailWorkDir()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	router := mail.NewRouter(workDir)
+	released, err := router.ReleaseDue(time.Now())
+	if err != nil {
+		return fmt.Errorf("releasing due messages: %w", err)
+	}
+
+	if released == 0 {
+		fmt.Printf("%s No due messages\n", style.Dim.Render("○"))
+		return nil
+	}
+
+	fmt.Printf("%s Released %d message(s)\n", style.SuccessPrefix(), released)
+	return nil
+}
+
+func runMailRetryHumanDelivery(cmd *cobra.Command, args []string) error {
+	workDir, err := findMailWorkDir()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	router := mail.NewRouter(workDir)
+	delivered, err := router.RetryHumanDeliveries()
+	if err != nil {
+		return fmt.Errorf("retrying human deliveries: %w", err)
+	}
+
+	if delivered == 0 {
+		fmt.Printf("%s No pending human deliveries\n", style.Dim.Render("○"))
+		return nil
+	}
+
+	fmt.Printf("%s Delivered %d message(s) to the overseer bridge\n", style.SuccessPrefix(), delivered)
+	return nil
+}
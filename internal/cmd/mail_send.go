@@ -6,16 +6,37 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/handoff"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 func runMailSend(cmd *cobra.Command, args []string) error {
+	if mailFile != "" && mailBody != "" {
+		return fmt.Errorf("--file and -m/--body are mutually exclusive")
+	}
+	if mailFile != "" {
+		raw, err := readMailBodyFile(mailFile)
+		if err != nil {
+			return err
+		}
+		fileSubject, body := splitMailFileSubject(raw)
+		mailBody = body
+		if mailSubject == "" {
+			mailSubject = fileSubject
+		}
+	}
+	if mailSubject == "" {
+		return fmt.Errorf("subject required: use -s, or --file with a \"Subject: ...\" header")
+	}
+
 	var to string
 
 	if mailSendSelf {
@@ -88,6 +109,15 @@ func runMailSend(cmd *cobra.Command, args []string) error {
 	// Set CC recipients
 	msg.CC = mailCC
 
+	// Set deliver-at: message stays pending until 'gt mail release-due' delivers it
+	if mailDeliverAt != "" {
+		deliverAt, err := handoff.ParseAt(mailDeliverAt, time.Now())
+		if err != nil {
+			return fmt.Errorf("invalid --deliver-at: %w", err)
+		}
+		msg.DeliverAt = &deliverAt
+	}
+
 	// Handle reply-to: auto-set type to reply and look up thread
 	if mailReplyTo != "" {
 		msg.ReplyTo = mailReplyTo
@@ -123,8 +153,14 @@ func runMailSend(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("sending message: %w", err)
 		}
 		_ = events.LogFeed(events.TypeMail, from, events.MailPayload(to, mailSubject))
+		if msg.DeliverAt == nil && (mailNotify || (msg.Priority == mail.PriorityUrgent && rigWantsUrgentNotify(townRoot, to))) {
+			notifyRecipient(tmux.NewTmux(), to, from, mailSubject)
+		}
 		fmt.Printf("%s Message sent to %s\n", style.Bold.Render("✓"), to)
 		fmt.Printf("  Subject: %s\n", mailSubject)
+		if msg.DeliverAt != nil {
+			fmt.Printf("  Scheduled for delivery at %s\n", msg.DeliverAt.Format(time.RFC3339))
+		}
 		return nil
 	}
 
@@ -158,6 +194,10 @@ func runMailSend(cmd *cobra.Command, args []string) error {
 				return fmt.Errorf("sending to %s: %w", rec.Address, err)
 			}
 			recipientAddrs = append(recipientAddrs, rec.Address)
+
+			if msg.DeliverAt == nil && (mailNotify || (msg.Priority == mail.PriorityUrgent && rigWantsUrgentNotify(townRoot, rec.Address))) {
+				notifyRecipient(tmux.NewTmux(), rec.Address, from, mailSubject)
+			}
 		}
 	}
 
@@ -178,6 +218,9 @@ func runMailSend(cmd *cobra.Command, args []string) error {
 	if msg.Type != mail.TypeNotification {
 		fmt.Printf("  Type: %s\n", msg.Type)
 	}
+	if msg.DeliverAt != nil {
+		fmt.Printf("  Scheduled for delivery at %s\n", msg.DeliverAt.Format(time.RFC3339))
+	}
 
 	return nil
 }
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+func runMailSnooze(cmd *cobra.Command, args []string) error {
+	messageID := args[0]
+
+	duration, err := time.ParseDuration(mailSnoozeFor)
+	if err != nil {
+		return fmt.Errorf("invalid --for %q: %w", mailSnoozeFor, err)
+	}
+
+	address := detectSender()
+	mailbox, err := getMailbox(address)
+	if err != nil {
+		return err
+	}
+
+	until := time.Now().Add(duration)
+	if err := mailbox.Snooze(messageID, until); err != nil {
+		return fmt.Errorf("snoozing %s: %w", messageID, err)
+	}
+
+	fmt.Printf("%s Snoozed %s until %s\n", style.SuccessPrefix(), messageID, until.Format(time.Kitchen))
+	return nil
+}
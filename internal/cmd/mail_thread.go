@@ -20,26 +20,32 @@ func runMailThread(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("not in a Gas Town workspace: %w", err)
 	}
 
-	// Determine which inbox
+	// Determine caller identity, used to label each message's direction
 	address := detectSender()
 
-	// Get mailbox and thread messages
+	// Query town beads directly for the whole thread, not just the
+	// caller's own mailbox - a mayor<->polecat exchange has messages
+	// addressed to both sides.
 	router := mail.NewRouter(workDir)
-	mailbox, err := router.GetMailbox(address)
+	messages, err := router.Thread(threadID)
 	if err != nil {
-		return fmt.Errorf("getting mailbox: %w", err)
+		return fmt.Errorf("getting thread: %w", err)
 	}
 
-	messages, err := mailbox.ListByThread(threadID)
-	if err != nil {
-		return fmt.Errorf("getting thread: %w", err)
+	views := make([]ThreadMessageView, len(messages))
+	callerIdentity := mail.AddressToIdentity(address)
+	for i, msg := range messages {
+		views[i] = ThreadMessageView{
+			Message:   msg,
+			Direction: threadDirection(callerIdentity, msg),
+		}
 	}
 
 	// JSON output
 	if mailThreadJSON {
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
-		return enc.Encode(messages)
+		return enc.Encode(views)
 	}
 
 	// Human-readable output
@@ -51,7 +57,8 @@ func runMailThread(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	for i, msg := range messages {
+	for i, v := range views {
+		msg := v.Message
 		typeMarker := ""
 		if msg.Type != "" && msg.Type != mail.TypeNotification {
 			typeMarker = fmt.Sprintf(" [%s]", msg.Type)
@@ -64,7 +71,8 @@ func runMailThread(cmd *cobra.Command, args []string) error {
 		if i > 0 {
 			fmt.Printf("  %s\n", style.Dim.Render("│"))
 		}
-		fmt.Printf("  %s %s%s%s\n", style.Bold.Render("●"), msg.Subject, typeMarker, priorityMarker)
+		fmt.Printf("  %s %s%s%s %s\n", style.Bold.Render("●"), msg.Subject, typeMarker, priorityMarker,
+			style.Dim.Render(fmt.Sprintf("[%s]", v.Direction)))
 		fmt.Printf("    %s from %s to %s\n",
 			style.Dim.Render(msg.ID),
 			msg.From, msg.To)
@@ -79,10 +87,33 @@ func runMailThread(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// ThreadMessageView annotates a thread message with its direction relative
+// to the caller, since gt mail thread now pulls in both sides of a
+// conversation rather than just the caller's own mailbox.
+type ThreadMessageView struct {
+	*mail.Message
+	Direction string `json:"direction"` // "sent", "received", or "other" (neither side is the caller)
+}
+
+// threadDirection labels msg as sent/received/other relative to caller.
+func threadDirection(caller string, msg *mail.Message) string {
+	if mail.AddressToIdentity(msg.From) == caller {
+		return "sent"
+	}
+	if mail.AddressToIdentity(msg.To) == caller {
+		return "received"
+	}
+	return "other"
+}
+
 func runMailReply(cmd *cobra.Command, args []string) error {
-	if mailReplyMessage == "" {
-		return fmt.Errorf("required flag \"message\" or \"body\" not set")
+	if mailReplyFile != "" && mailReplyMessage != "" {
+		return fmt.Errorf("--file and -m/--body are mutually exclusive")
 	}
+	if mailReplyFile != "" && len(args) > 1 {
+		return fmt.Errorf("--file and a positional message are mutually exclusive")
+	}
+
 	msgID := args[0]
 
 	// Get message body from positional arg or flag (positional takes precedence)
@@ -91,9 +122,18 @@ func runMailReply(cmd *cobra.Command, args []string) error {
 		messageBody = args[1]
 	}
 
+	var fileSubject string
+	if mailReplyFile != "" {
+		raw, err := readMailBodyFile(mailReplyFile)
+		if err != nil {
+			return err
+		}
+		fileSubject, messageBody = splitMailFileSubject(raw)
+	}
+
 	// Validate message is provided
 	if messageBody == "" {
-		return fmt.Errorf("message body required: provide as second argument or use -m flag")
+		return fmt.Errorf("message body required: provide as second argument, use -m flag, or --file")
 	}
 
 	// All mail uses town beads (two-level architecture)
@@ -119,6 +159,9 @@ func runMailReply(cmd *cobra.Command, args []string) error {
 
 	// Build reply subject
 	subject := mailReplySubject
+	if subject == "" {
+		subject = fileSubject
+	}
 	if subject == "" {
 		if strings.HasPrefix(original.Subject, "Re: ") {
 			subject = original.Subject
@@ -1,12 +1,22 @@
 package cmd
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 )
 
 // Molecule command flags
 var (
-	moleculeJSON bool
+	moleculeJSON   bool
+	moleculeDryRun bool
+
+	moleculeInstantiateResume   bool
+	moleculeInstantiateForceNew bool
+
+	moleculeWatch         bool
+	moleculeWatchInterval time.Duration
+	moleculeWatchTimeout  time.Duration
 )
 
 var moleculeCmd = &cobra.Command{
@@ -39,7 +49,6 @@ TO DISPATCH WORK (with molecules):
   gt formulas               # List available formulas`,
 }
 
-
 var moleculeProgressCmd = &cobra.Command{
 	Use:   "progress <root-issue-id>",
 	Short: "Show progress through a molecule's steps",
@@ -47,17 +56,72 @@ var moleculeProgressCmd = &cobra.Command{
 
 Given a root issue (the parent of molecule steps), displays:
 - Total steps and completion status
-- Which steps are done, in-progress, ready, or blocked
-- Overall progress percentage
-
-This is useful for the Witness to monitor molecule execution.
+- Per-step classification: done, in_progress, ready, blocked-by-[ids], or
+  orphaned (a dependency was closed as wontfix, so the step can never
+  become ready)
+- Steps that have been in_progress longer than --stuck-after with no
+  assignee
+- A "Next actions" section suggesting what to run for ready and stuck steps
+
+This is useful for the Witness to monitor molecule execution and decide
+what to unblock.
+
+Pass --watch to block until the molecule reaches a terminal state instead
+of polling bd yourself - handy for orchestration scripts that spawn a
+polecat on a molecule and need to wait for it. Refreshes every --interval
+until every step is closed (exit 0), any step closed as wontfix (exit 2,
+a step failed and the molecule can't finish), or --timeout elapses (exit
+3). When stdout isn't a terminal, --watch prints one line per state
+change instead of redrawing the table each poll.
 
 Example:
-  gt molecule progress gt-abc`,
+  gt molecule progress gt-abc
+  gt molecule progress gt-abc --stuck-after=15m --json
+  gt molecule progress gt-abc --watch --interval 30s --timeout 2h`,
 	Args: cobra.ExactArgs(1),
 	RunE: runMoleculeProgress,
 }
 
+var moleculeInstantiateCmd = &cobra.Command{
+	Use:   "instantiate <molecule-id> <parent-issue-id>",
+	Short: "Pour a molecule's steps onto a parent issue",
+	Long: `Create step issues from a molecule template, parented under an issue.
+
+Running this twice on the same (molecule, parent) pair - easy to do after a
+crash - would normally create a second full set of step beads, and whoever
+picks up the parent then works duplicates. By default, instantiate checks
+for steps already created from the same molecule and refuses to run again,
+pointing at 'gt mol progress' to check what's there.
+
+  --resume     If steps already exist, report them instead of failing.
+               No new steps are created.
+  --force-new  Skip the check entirely and pour a fresh set of steps even
+               if the molecule was already instantiated onto this parent.
+
+Examples:
+  gt mol instantiate mol-review gt-42
+  gt mol instantiate mol-review gt-42 --resume`,
+	Args: cobra.ExactArgs(2),
+	RunE: runMoleculeInstantiate,
+}
+
+var moleculeReprioritizeCmd = &cobra.Command{
+	Use:   "reprioritize <root-issue-id> <priority>",
+	Short: "Change the priority of a molecule's root and its open steps",
+	Long: `Update the priority of a molecule root issue and every one of its open
+step children, so the whole molecule moves together in bd ready ordering.
+
+Steps created with an explicit priority pin (a "Priority:" line in the
+molecule definition) move too - reprioritize doesn't distinguish pinned
+from inherited steps, it's a blunt "change everything under this root"
+operation. Closed steps are left alone.
+
+Examples:
+  gt mol reprioritize gt-42 0`,
+	Args: cobra.ExactArgs(2),
+	RunE: runMoleculeReprioritize,
+}
+
 var moleculeAttachCmd = &cobra.Command{
 	Use:   "attach [pinned-bead-id] <molecule-id>",
 	Short: "Attach a molecule to a pinned bead",
@@ -125,12 +189,19 @@ Example:
 }
 
 var moleculeStatusCmd = &cobra.Command{
-	Use:   "status [target]",
+	Use:   "status [target|rig]",
 	Short: "Show what's on an agent's hook",
 	Long: `Show what's slung on an agent's hook.
 
 If no target is specified, shows the current agent's status based on
-the working directory (polecat, crew member, witness, etc.).
+the working directory (polecat, crew member, witness, etc.). --identity
+resolves a target the same way without relying on cwd, which lets the
+mayor (or a script running from the town root) inspect any agent's hook.
+
+--all walks every polecat and crew clone in a rig and prints a table of
+who has what hooked, including molecule step position when the hook
+references a molecule root. The rig comes from a positional argument,
+the rig segment of --identity, or (falling back) the cwd's rig.
 
 Output includes:
 - What's slung (molecule name, associated issue)
@@ -139,9 +210,11 @@ Output includes:
 - Next action hint
 
 Examples:
-  gt mol status                       # Show current agent's hook
-  gt mol status greenplace/nux        # Show specific polecat's hook
-  gt mol status greenplace/witness    # Show witness's hook`,
+  gt mol status                          # Show current agent's hook
+  gt mol status greenplace/nux           # Show specific polecat's hook
+  gt mol status --identity greenplace/nux
+  gt mol status --all greenplace         # Table of every hook in the rig
+  gt mol status --all greenplace --json`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runMoleculeStatus,
 }
@@ -171,36 +244,54 @@ Examples:
 	RunE: runMoleculeCurrent,
 }
 
-
 var moleculeBurnCmd = &cobra.Command{
-	Use:   "burn [target]",
-	Short: "Burn current molecule without creating a digest",
-	Long: `Burn (destroy) the current molecule attachment.
+	Use:   "burn [target|root-id]",
+	Short: "Burn a molecule without creating a digest",
+	Long: `Burn (destroy) a molecule: close its step beads and root, and clear
+any hook pointing at it. Discards the molecule without creating a
+permanent record - use this when abandoning work or when a molecule
+doesn't need an audit trail.
 
-This discards the molecule without creating a permanent record. Use this
-when abandoning work or when a molecule doesn't need an audit trail.
-
-If no target is specified, burns the current agent's attached molecule.
+The argument may be either an agent identity (whose hook holds the
+attached molecule) or a molecule's root issue ID directly. With no
+argument, burns the current agent's attached molecule.
 
 For wisps, burning is the default completion action. For regular molecules,
-consider using 'squash' instead to preserve an audit trail.`,
+consider using 'squash' instead to preserve an audit trail.
+
+Use --dry-run to see what would be closed without changing anything.
+
+Examples:
+  gt mol burn                # Burn the current agent's attached molecule
+  gt mol burn greenplace/nux # Burn what's attached to a specific agent
+  gt mol burn gt-wisp-xyz    # Burn a molecule by its root issue ID
+  gt mol burn gt-wisp-xyz --dry-run`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runMoleculeBurn,
 }
 
 var moleculeSquashCmd = &cobra.Command{
-	Use:   "squash [target]",
+	Use:   "squash [target|root-id]",
 	Short: "Compress molecule into a digest",
-	Long: `Squash the current molecule into a permanent digest.
+	Long: `Squash a molecule into a permanent digest: close its step beads and
+root, clear any hook pointing at it, and create a digest bead summarizing
+the run. The digest lists each step's title, outcome, and duration
+(created_at to closed_at), and is linked to the molecule's parent issue.
 
-This condenses a completed molecule's execution into a compact record.
-The digest preserves:
-- What molecule was executed
-- When it ran
-- Summary of results
+The argument may be either an agent identity (whose hook holds the
+attached molecule) or a molecule's root issue ID directly. With no
+argument, squashes the current agent's attached molecule.
 
 Use this for patrol cycles and other operational work that should have
-a permanent (but compact) record.`,
+a permanent (but compact) record.
+
+Use --dry-run to see what would be squashed without changing anything.
+
+Examples:
+  gt mol squash                # Squash the current agent's attached molecule
+  gt mol squash greenplace/nux # Squash what's attached to a specific agent
+  gt mol squash gt-wisp-xyz    # Squash a molecule by its root issue ID
+  gt mol squash gt-wisp-xyz --dry-run`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runMoleculeSquash,
 }
@@ -223,25 +314,43 @@ IMPORTANT: Always use 'gt mol step done' to complete steps. Do not manually
 close steps with 'bd close' - that skips the auto-continuation logic.`,
 }
 
-
 func init() {
+	// Instantiate flags
+	moleculeInstantiateCmd.Flags().BoolVar(&moleculeInstantiateResume, "resume", false,
+		"If already instantiated, report existing steps instead of failing")
+	moleculeInstantiateCmd.Flags().BoolVar(&moleculeInstantiateForceNew, "force-new", false,
+		"Pour a fresh set of steps even if already instantiated onto this parent")
+	moleculeInstantiateCmd.MarkFlagsMutuallyExclusive("resume", "force-new")
+
 	// Progress flags
 	moleculeProgressCmd.Flags().BoolVar(&moleculeJSON, "json", false, "Output as JSON")
+	moleculeProgressCmd.Flags().DurationVar(&moleculeStuckAfter, "stuck-after", 0,
+		"Flag unassigned in_progress steps older than this as stuck (default 1h)")
+	moleculeProgressCmd.Flags().BoolVar(&moleculeWatch, "watch", false,
+		"Block, refreshing until the molecule completes, a step fails, or --timeout elapses")
+	moleculeProgressCmd.Flags().DurationVar(&moleculeWatchInterval, "interval", 30*time.Second,
+		"Poll interval for --watch")
+	moleculeProgressCmd.Flags().DurationVar(&moleculeWatchTimeout, "timeout", 2*time.Hour,
+		"Give up and exit 3 after this long with --watch")
 
 	// Attachment flags
 	moleculeAttachmentCmd.Flags().BoolVar(&moleculeJSON, "json", false, "Output as JSON")
 
 	// Status flags
 	moleculeStatusCmd.Flags().BoolVar(&moleculeJSON, "json", false, "Output as JSON")
+	moleculeStatusCmd.Flags().StringVar(&moleculeStatusIdentity, "identity", "", "Agent identity to inspect (<rig>/<name>), instead of detecting from cwd")
+	moleculeStatusCmd.Flags().BoolVar(&moleculeStatusAll, "all", false, "Show a table of every polecat/crew hook in the rig")
 
 	// Current flags
 	moleculeCurrentCmd.Flags().BoolVar(&moleculeJSON, "json", false, "Output as JSON")
 
 	// Burn flags
 	moleculeBurnCmd.Flags().BoolVar(&moleculeJSON, "json", false, "Output as JSON")
+	moleculeBurnCmd.Flags().BoolVar(&moleculeDryRun, "dry-run", false, "Show what would be closed without making changes")
 
 	// Squash flags
 	moleculeSquashCmd.Flags().BoolVar(&moleculeJSON, "json", false, "Output as JSON")
+	moleculeSquashCmd.Flags().BoolVar(&moleculeDryRun, "dry-run", false, "Show what would be squashed without making changes")
 
 	// Add step subcommand with its children
 	moleculeStepCmd.AddCommand(moleculeStepDoneCmd)
@@ -253,6 +362,8 @@ func init() {
 	moleculeCmd.AddCommand(moleculeBurnCmd)
 	moleculeCmd.AddCommand(moleculeSquashCmd)
 	moleculeCmd.AddCommand(moleculeProgressCmd)
+	moleculeCmd.AddCommand(moleculeInstantiateCmd)
+	moleculeCmd.AddCommand(moleculeReprioritizeCmd)
 	moleculeCmd.AddCommand(moleculeAttachCmd)
 	moleculeCmd.AddCommand(moleculeDetachCmd)
 	moleculeCmd.AddCommand(moleculeAttachmentCmd)
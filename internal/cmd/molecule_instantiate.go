@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func runMoleculeInstantiate(cmd *cobra.Command, args []string) error {
+	molID, parentID := args[0], args[1]
+
+	workDir, err := findLocalBeadsDir()
+	if err != nil {
+		return fmt.Errorf("not in a beads workspace: %w", err)
+	}
+
+	b := beads.New(workDir)
+
+	mol, err := b.Show(molID)
+	if err != nil {
+		return fmt.Errorf("getting molecule %s: %w", molID, err)
+	}
+
+	parent, err := b.Show(parentID)
+	if err != nil {
+		return fmt.Errorf("getting parent issue %s: %w", parentID, err)
+	}
+
+	steps, err := b.InstantiateMolecule(mol, parent, beads.InstantiateOptions{
+		Resume:   moleculeInstantiateResume,
+		ForceNew: moleculeInstantiateForceNew,
+	})
+	if err != nil {
+		return err
+	}
+
+	if moleculeInstantiateResume {
+		fmt.Printf("Resumed %s on %s: %d step(s)\n", molID, parentID, len(steps))
+	} else {
+		fmt.Printf("Instantiated %s on %s: %d step(s)\n", molID, parentID, len(steps))
+	}
+	for _, step := range steps {
+		fmt.Printf("  %s: %s\n", step.ID, step.Title)
+	}
+
+	return nil
+}
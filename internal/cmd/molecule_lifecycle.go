@@ -13,7 +13,8 @@ import (
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
-// runMoleculeBurn burns (destroys) the current molecule attachment.
+// runMoleculeBurn burns (destroys) a molecule: its step beads and root are
+// closed, any hook pointing at it is cleared, and no digest is created.
 func runMoleculeBurn(cmd *cobra.Command, args []string) error {
 	cwd, err := os.Getwd()
 	if err != nil {
@@ -29,29 +30,6 @@ func runMoleculeBurn(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("not in a Gas Town workspace")
 	}
 
-	// Determine target agent
-	var target string
-	if len(args) > 0 {
-		target = args[0]
-	} else {
-		// Auto-detect using env-aware role detection
-		roleInfo, err := GetRoleWithContext(cwd, townRoot)
-		if err != nil {
-			return fmt.Errorf("detecting role: %w", err)
-		}
-		roleCtx := RoleContext{
-			Role:     roleInfo.Role,
-			Rig:      roleInfo.Rig,
-			Polecat:  roleInfo.Polecat,
-			TownRoot: townRoot,
-			WorkDir:  cwd,
-		}
-		target = buildAgentIdentity(roleCtx)
-		if target == "" {
-			return fmt.Errorf("cannot determine agent identity (role: %s)", roleCtx.Role)
-		}
-	}
-
 	// Find beads directory
 	workDir, err := findLocalBeadsDir()
 	if err != nil {
@@ -60,64 +38,62 @@ func runMoleculeBurn(cmd *cobra.Command, args []string) error {
 
 	b := beads.New(workDir)
 
-	// Find agent's pinned bead (handoff bead)
-	parts := strings.Split(target, "/")
-	role := parts[len(parts)-1]
-
-	handoff, err := b.FindHandoffBead(role)
+	resolved, err := resolveMoleculeTarget(b, cwd, townRoot, args)
 	if err != nil {
-		return fmt.Errorf("finding handoff bead: %w", err)
-	}
-	if handoff == nil {
-		return fmt.Errorf("no handoff bead found for %s", target)
+		return err
 	}
-
-	// Check for attached molecule
-	attachment := beads.ParseAttachmentFields(handoff)
-	if attachment == nil || attachment.AttachedMolecule == "" {
+	if resolved.MoleculeID == "" {
 		fmt.Printf("%s No molecule attached to %s - nothing to burn\n",
-			style.Dim.Render("ℹ"), target)
+			style.Dim.Render("ℹ"), resolved.Target)
 		return nil
 	}
 
-	moleculeID := attachment.AttachedMolecule
+	if moleculeDryRun {
+		steps, _ := b.List(beads.ListOptions{Parent: resolved.MoleculeID, Status: "all"})
+		printMoleculeLifecycleDryRun("burn", resolved, steps)
+		return nil
+	}
 
-	// Recursively close all descendant step issues before detaching
+	// Recursively close all descendant step issues, then the root itself.
 	// This prevents orphaned step issues from accumulating (gt-psj76.1)
-	childrenClosed := closeDescendants(b, moleculeID)
+	childrenClosed := closeDescendants(b, resolved.MoleculeID)
+	if err := b.CloseWithReason("molecule burned", resolved.MoleculeID); err != nil {
+		style.PrintWarning("could not close molecule root %s: %v", resolved.MoleculeID, err)
+	}
 
-	// Detach the molecule with audit logging (this "burns" it by removing the attachment)
-	_, err = b.DetachMoleculeWithAudit(handoff.ID, beads.DetachOptions{
-		Operation: "burn",
-		Agent:     target,
-		Reason:    "molecule burned by agent",
-	})
+	clearedHooks, err := detachMolecule(b, resolved, "burn", "molecule burned")
 	if err != nil {
-		return fmt.Errorf("detaching molecule: %w", err)
+		return err
 	}
 
 	if moleculeJSON {
 		result := map[string]interface{}{
-			"burned":          moleculeID,
-			"from":            target,
-			"handoff_id":      handoff.ID,
+			"burned":          resolved.MoleculeID,
+			"from":            resolved.Target,
+			"handoff_id":      resolved.HandoffID,
 			"children_closed": childrenClosed,
+			"hooks_cleared":   clearedHooks,
 		}
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
 		return enc.Encode(result)
 	}
 
-	fmt.Printf("%s Burned molecule %s from %s\n",
-		style.Bold.Render("🔥"), moleculeID, target)
+	fmt.Printf("%s Burned molecule %s\n", style.Bold.Render("🔥"), resolved.MoleculeID)
 	if childrenClosed > 0 {
 		fmt.Printf("  Closed %d step issues\n", childrenClosed)
 	}
+	if len(clearedHooks) > 0 {
+		fmt.Printf("  Cleared hook(s): %s\n", strings.Join(clearedHooks, ", "))
+	}
 
 	return nil
 }
 
-// runMoleculeSquash squashes the current molecule into a digest.
+// runMoleculeSquash squashes a molecule into a digest: its step beads and
+// root are closed, a digest bead summarizing the steps is created and
+// linked to the molecule's parent issue, and any hook pointing at it is
+// cleared.
 func runMoleculeSquash(cmd *cobra.Command, args []string) error {
 	cwd, err := os.Getwd()
 	if err != nil {
@@ -133,29 +109,6 @@ func runMoleculeSquash(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("not in a Gas Town workspace")
 	}
 
-	// Determine target agent
-	var target string
-	if len(args) > 0 {
-		target = args[0]
-	} else {
-		// Auto-detect using env-aware role detection
-		roleInfo, err := GetRoleWithContext(cwd, townRoot)
-		if err != nil {
-			return fmt.Errorf("detecting role: %w", err)
-		}
-		roleCtx := RoleContext{
-			Role:     roleInfo.Role,
-			Rig:      roleInfo.Rig,
-			Polecat:  roleInfo.Polecat,
-			TownRoot: townRoot,
-			WorkDir:  cwd,
-		}
-		target = buildAgentIdentity(roleCtx)
-		if target == "" {
-			return fmt.Errorf("cannot determine agent identity (role: %s)", roleCtx.Role)
-		}
-	}
-
 	// Find beads directory
 	workDir, err := findLocalBeadsDir()
 	if err != nil {
@@ -164,66 +117,58 @@ func runMoleculeSquash(cmd *cobra.Command, args []string) error {
 
 	b := beads.New(workDir)
 
-	// Find agent's pinned bead (handoff bead)
-	parts := strings.Split(target, "/")
-	role := parts[len(parts)-1]
-
-	handoff, err := b.FindHandoffBead(role)
+	resolved, err := resolveMoleculeTarget(b, cwd, townRoot, args)
 	if err != nil {
-		return fmt.Errorf("finding handoff bead: %w", err)
+		return err
 	}
-	if handoff == nil {
-		return fmt.Errorf("no handoff bead found for %s", target)
+	if resolved.MoleculeID == "" {
+		fmt.Printf("%s No molecule attached to %s - nothing to squash\n",
+			style.Dim.Render("ℹ"), resolved.Target)
+		return nil
 	}
 
-	// Check for attached molecule
-	attachment := beads.ParseAttachmentFields(handoff)
-	if attachment == nil || attachment.AttachedMolecule == "" {
-		fmt.Printf("%s No molecule attached to %s - nothing to squash\n",
-			style.Dim.Render("ℹ"), target)
+	// Steps must be read before closeDescendants runs, so the digest can
+	// report each step's pre-close status as its outcome.
+	steps, err := b.List(beads.ListOptions{Parent: resolved.MoleculeID, Status: "all"})
+	if err != nil {
+		style.PrintWarning("could not list steps of %s: %v", resolved.MoleculeID, err)
+	}
+
+	if moleculeDryRun {
+		printMoleculeLifecycleDryRun("squash", resolved, steps)
 		return nil
 	}
 
-	moleculeID := attachment.AttachedMolecule
+	root, err := b.Show(resolved.MoleculeID)
+	if err != nil {
+		style.PrintWarning("could not fetch molecule root %s: %v", resolved.MoleculeID, err)
+	}
 
-	// Recursively close all descendant step issues before squashing
+	// Recursively close all descendant step issues, then the root itself.
 	// This prevents orphaned step issues from accumulating (gt-psj76.1)
-	childrenClosed := closeDescendants(b, moleculeID)
-
-	// Get progress info for the digest
-	progress, _ := getMoleculeProgressInfo(b, moleculeID)
-
-	// Create a digest issue
-	digestTitle := fmt.Sprintf("Digest: %s", moleculeID)
-	digestDesc := fmt.Sprintf(`Squashed molecule execution.
-
-molecule: %s
-agent: %s
-squashed_at: %s
-`, moleculeID, target, time.Now().UTC().Format(time.RFC3339))
-
-	if progress != nil {
-		digestDesc += fmt.Sprintf(`
-## Execution Summary
-- Steps: %d/%d completed
-- Status: %s
-`, progress.DoneSteps, progress.TotalSteps, func() string {
-			if progress.Complete {
-				return "complete"
-			}
-			return "partial"
-		}())
+	childrenClosed := closeDescendants(b, resolved.MoleculeID)
+	if err := b.CloseWithReason("molecule squashed", resolved.MoleculeID); err != nil {
+		style.PrintWarning("could not close molecule root %s: %v", resolved.MoleculeID, err)
+	}
+
+	digestParent := resolved.MoleculeID
+	if root != nil && root.Parent != "" {
+		digestParent = root.Parent
 	}
 
+	digestTitle := fmt.Sprintf("Digest: %s", resolved.MoleculeID)
+	digestDesc := buildSquashDigest(resolved.MoleculeID, resolved.Target, steps)
+
 	// Create the digest bead (ephemeral to avoid JSONL pollution)
 	// Per-cycle digests are aggregated daily by 'gt patrol digest'
 	digestIssue, err := b.Create(beads.CreateOptions{
 		Title:       digestTitle,
 		Description: digestDesc,
 		Type:        "task",
-		Priority:    4,       // P4 - backlog priority for digests
-		Actor:       target,
-		Ephemeral:   true,    // Don't export to JSONL - daily aggregation handles permanent record
+		Priority:    4, // P4 - backlog priority for digests
+		Actor:       resolved.Target,
+		Parent:      digestParent,
+		Ephemeral:   true, // Don't export to JSONL - daily aggregation handles permanent record
 	})
 	if err != nil {
 		return fmt.Errorf("creating digest: %w", err)
@@ -243,23 +188,19 @@ squashed_at: %s
 		style.PrintWarning("Created digest but couldn't close it: %v", err)
 	}
 
-	// Detach the molecule from the handoff bead with audit logging
-	_, err = b.DetachMoleculeWithAudit(handoff.ID, beads.DetachOptions{
-		Operation: "squash",
-		Agent:     target,
-		Reason:    fmt.Sprintf("molecule squashed to digest %s", digestIssue.ID),
-	})
+	clearedHooks, err := detachMolecule(b, resolved, "squash", fmt.Sprintf("molecule squashed to digest %s", digestIssue.ID))
 	if err != nil {
-		return fmt.Errorf("detaching molecule: %w", err)
+		return err
 	}
 
 	if moleculeJSON {
 		result := map[string]interface{}{
-			"squashed":        moleculeID,
+			"squashed":        resolved.MoleculeID,
 			"digest_id":       digestIssue.ID,
-			"from":            target,
-			"handoff_id":      handoff.ID,
+			"from":            resolved.Target,
+			"handoff_id":      resolved.HandoffID,
 			"children_closed": childrenClosed,
+			"hooks_cleared":   clearedHooks,
 		}
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
@@ -267,14 +208,203 @@ squashed_at: %s
 	}
 
 	fmt.Printf("%s Squashed molecule %s → digest %s\n",
-		style.Bold.Render("📦"), moleculeID, digestIssue.ID)
+		style.Bold.Render("📦"), resolved.MoleculeID, digestIssue.ID)
 	if childrenClosed > 0 {
 		fmt.Printf("  Closed %d step issues\n", childrenClosed)
 	}
+	if len(clearedHooks) > 0 {
+		fmt.Printf("  Cleared hook(s): %s\n", strings.Join(clearedHooks, ", "))
+	}
 
 	return nil
 }
 
+// buildSquashDigest renders the digest description for a squashed
+// molecule: one line per step with its title, outcome (status at the time
+// of squash), and duration (created_at to closed_at, or to now if a step
+// was still open when squashed).
+func buildSquashDigest(moleculeID, target string, steps []*beads.Issue) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Squashed molecule execution.\n\nmolecule: %s\nagent: %s\nsquashed_at: %s\n",
+		moleculeID, target, time.Now().UTC().Format(time.RFC3339))
+
+	done := 0
+	for _, step := range steps {
+		if step.Status == "closed" {
+			done++
+		}
+	}
+
+	fmt.Fprintf(&b, "\n## Execution Summary\n- Steps: %d/%d completed\n- Status: %s\n",
+		done, len(steps), func() string {
+			if len(steps) > 0 && done == len(steps) {
+				return "complete"
+			}
+			return "partial"
+		}())
+
+	if len(steps) > 0 {
+		b.WriteString("\n## Steps\n")
+		for _, step := range steps {
+			fmt.Fprintf(&b, "- %s (%s): %s [%s]\n",
+				step.ID, step.Status, step.Title, stepDuration(step))
+		}
+	}
+
+	return b.String()
+}
+
+// stepDuration renders how long a step took: created_at to closed_at, or
+// to now if the step was never closed. Returns "unknown" if created_at
+// can't be parsed.
+func stepDuration(step *beads.Issue) string {
+	if step.CreatedAt.IsZero() {
+		return "unknown"
+	}
+
+	end := time.Now().UTC()
+	if !step.ClosedAt.IsZero() {
+		end = step.ClosedAt.Time
+	}
+
+	return end.Sub(step.CreatedAt.Time).Round(time.Second).String()
+}
+
+// resolvedMolecule identifies what gt mol burn/squash should act on.
+type resolvedMolecule struct {
+	MoleculeID string // Root issue ID of the molecule, empty if nothing to do
+	HandoffID  string // Pinned/handoff bead the molecule is attached to, empty for a direct root-id target
+	Target     string // Agent identity used to reach the molecule, empty for a direct root-id target
+}
+
+// resolveMoleculeTarget figures out which molecule burn/squash should act
+// on. If args[0] resolves directly to a bead with children, it's treated
+// as the molecule root itself (gt mol burn <root-id>). Otherwise args[0]
+// (or the current agent, if no arg) is treated as an agent identity whose
+// hook holds the attached molecule - the pre-existing behavior.
+func resolveMoleculeTarget(b *beads.Beads, cwd, townRoot string, args []string) (resolvedMolecule, error) {
+	if len(args) > 0 {
+		if rootID, ok := isMoleculeRoot(b, args[0]); ok {
+			return resolvedMolecule{MoleculeID: rootID}, nil
+		}
+	}
+
+	var target string
+	if len(args) > 0 {
+		target = args[0]
+	} else {
+		roleInfo, err := GetRoleWithContext(cwd, townRoot)
+		if err != nil {
+			return resolvedMolecule{}, fmt.Errorf("detecting role: %w", err)
+		}
+		roleCtx := RoleContext{
+			Role:     roleInfo.Role,
+			Rig:      roleInfo.Rig,
+			Polecat:  roleInfo.Polecat,
+			TownRoot: townRoot,
+			WorkDir:  cwd,
+		}
+		target = buildAgentIdentity(roleCtx)
+		if target == "" {
+			return resolvedMolecule{}, fmt.Errorf("cannot determine agent identity (role: %s)", roleCtx.Role)
+		}
+	}
+
+	parts := strings.Split(target, "/")
+	role := parts[len(parts)-1]
+
+	handoff, err := b.FindHandoffBead(role)
+	if err != nil {
+		return resolvedMolecule{}, fmt.Errorf("finding handoff bead: %w", err)
+	}
+	if handoff == nil {
+		return resolvedMolecule{}, fmt.Errorf("no handoff bead found for %s", target)
+	}
+
+	attachment := beads.ParseAttachmentFields(handoff)
+	if attachment == nil || attachment.AttachedMolecule == "" {
+		return resolvedMolecule{Target: target}, nil
+	}
+
+	return resolvedMolecule{MoleculeID: attachment.AttachedMolecule, HandoffID: handoff.ID, Target: target}, nil
+}
+
+// isMoleculeRoot reports whether id is itself a bead with step children,
+// i.e. a molecule root that gt mol burn/squash can act on directly.
+func isMoleculeRoot(b *beads.Beads, id string) (string, bool) {
+	if _, err := b.Show(id); err != nil {
+		return "", false
+	}
+	children, err := b.List(beads.ListOptions{Parent: id, Status: "all"})
+	if err != nil || len(children) == 0 {
+		return "", false
+	}
+	return id, true
+}
+
+// detachMolecule clears whatever is pointing at resolved.MoleculeID: the
+// handoff bead's attachment, if burn/squash was reached via an agent
+// target, or any bead independently hooked with that molecule attached,
+// if it was reached via a direct root-id.
+func detachMolecule(b *beads.Beads, resolved resolvedMolecule, operation, reason string) ([]string, error) {
+	if resolved.HandoffID != "" {
+		if _, err := b.DetachMoleculeWithAudit(resolved.HandoffID, beads.DetachOptions{
+			Operation: operation,
+			Agent:     resolved.Target,
+			Reason:    reason,
+		}); err != nil {
+			return nil, fmt.Errorf("detaching molecule: %w", err)
+		}
+		return []string{resolved.HandoffID}, nil
+	}
+
+	hooked, err := b.List(beads.ListOptions{Status: beads.StatusHooked})
+	if err != nil {
+		return nil, fmt.Errorf("listing hooked beads: %w", err)
+	}
+
+	var cleared []string
+	for _, issue := range hooked {
+		attachment := beads.ParseAttachmentFields(issue)
+		if attachment == nil || attachment.AttachedMolecule != resolved.MoleculeID {
+			continue
+		}
+		if _, err := b.DetachMoleculeWithAudit(issue.ID, beads.DetachOptions{
+			Operation: operation,
+			Reason:    reason,
+		}); err != nil {
+			style.PrintWarning("could not detach hook %s: %v", issue.ID, err)
+			continue
+		}
+		openStatus := "open"
+		if err := b.Update(issue.ID, beads.UpdateOptions{Status: &openStatus}); err != nil {
+			style.PrintWarning("could not unhook %s: %v", issue.ID, err)
+			continue
+		}
+		cleared = append(cleared, issue.ID)
+	}
+
+	return cleared, nil
+}
+
+// printMoleculeLifecycleDryRun reports what burn/squash would do without
+// making any changes.
+func printMoleculeLifecycleDryRun(op string, resolved resolvedMolecule, steps []*beads.Issue) {
+	fmt.Printf("%s Would %s molecule %s (dry run)\n", style.Dim.Render("○"), op, resolved.MoleculeID)
+	if resolved.HandoffID != "" {
+		fmt.Printf("  Would detach from handoff bead %s (%s)\n", resolved.HandoffID, resolved.Target)
+	} else {
+		fmt.Printf("  Would clear any hook pointing at %s\n", resolved.MoleculeID)
+	}
+	fmt.Printf("  Would close %d step issue(s) and the root\n", len(steps))
+	for _, step := range steps {
+		fmt.Printf("    - %s (%s): %s\n", step.ID, step.Status, step.Title)
+	}
+	if op == "squash" {
+		fmt.Printf("  Would create a digest bead summarizing the above\n")
+	}
+}
+
 // closeDescendants recursively closes all descendant issues of a parent.
 // Returns the count of issues closed. Logs warnings on errors but doesn't fail.
 func closeDescendants(b *beads.Beads, parentID string) int {
@@ -306,7 +436,7 @@ func closeDescendants(b *beads.Beads, parentID string) int {
 	}
 
 	if len(idsToClose) > 0 {
-		if closeErr := b.Close(idsToClose...); closeErr != nil {
+		if closeErr := b.CloseMany(idsToClose...); closeErr != nil {
 			style.PrintWarning("could not close children of %s: %v", parentID, closeErr)
 		} else {
 			totalClosed += len(idsToClose)
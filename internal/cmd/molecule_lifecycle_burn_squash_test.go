@@ -0,0 +1,259 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// newMoleculeLifecycleTownRoot creates a minimal Gas Town workspace rooted
+// at a temp dir and chdirs into it, returning the town root.
+func newMoleculeLifecycleTownRoot(t *testing.T) string {
+	t.Helper()
+
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "mayor"), 0755); err != nil {
+		t.Fatalf("mkdir mayor: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(townRoot, "mayor", "town.json"), []byte("{}"), 0644); err != nil {
+		t.Fatalf("write town.json: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(townRoot, ".beads"), 0755); err != nil {
+		t.Fatalf("mkdir .beads: %v", err)
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("getwd: %v", err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(cwd) })
+	if err := os.Chdir(townRoot); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+
+	return townRoot
+}
+
+// installThreeStepMoleculeBDStub puts a stubbed bd simulating a three-step
+// molecule (see threeStepMoleculeBDScript) on PATH for the duration of the
+// test, logging every invocation to bd.log under townRoot.
+func installThreeStepMoleculeBDStub(t *testing.T, townRoot string) {
+	t.Helper()
+
+	binDir := filepath.Join(townRoot, "bin")
+	if err := os.MkdirAll(binDir, 0755); err != nil {
+		t.Fatalf("mkdir bin: %v", err)
+	}
+	unix, windows := threeStepMoleculeBDScript(townRoot)
+	writeBDStub(t, binDir, unix, windows)
+
+	t.Setenv("BD_LOG", filepath.Join(townRoot, "bd.log"))
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+// threeStepMoleculeBDScript stubs bd for a molecule root (gt-root1, parent
+// gt-parent1) with three step children, one still open. It logs closes to
+// closes.log and updates to updates.log under townRoot, and returns a
+// fixed digest ID from create.
+func threeStepMoleculeBDScript(townRoot string) (unix, windows string) {
+	closesPath := filepath.Join(townRoot, "closes.log")
+	updatesPath := filepath.Join(townRoot, "updates.log")
+	createPath := filepath.Join(townRoot, "create.log")
+
+	unix = fmt.Sprintf(`#!/bin/sh
+echo "$*" >> "${BD_LOG}"
+while [ "$1" = "--no-daemon" ] || [ "$1" = "--allow-stale" ]; do
+  shift
+done
+cmd="$1"
+shift || true
+case "$cmd" in
+  show)
+    beadID="$1"
+    case "$beadID" in
+      gt-root1)
+        echo '[{"id":"gt-root1","title":"mol-example","status":"open","parent":"gt-parent1"}]'
+        ;;
+      gt-hookedbead)
+        echo '[{"id":"gt-hookedbead","title":"Hooked work","status":"hooked","description":"attached_molecule: gt-root1"}]'
+        ;;
+      *)
+        echo '[]'
+        ;;
+    esac
+    ;;
+  list)
+    parent=""
+    status=""
+    for arg in "$@"; do
+      case "$arg" in
+        --parent=*) parent="${arg#--parent=}" ;;
+        --status=*) status="${arg#--status=}" ;;
+      esac
+    done
+    if [ "$parent" = "gt-root1" ]; then
+      echo '[
+        {"id":"gt-root1.1","title":"Step one","status":"closed","created_at":"2026-01-01T00:00:00Z","closed_at":"2026-01-01T00:05:00Z"},
+        {"id":"gt-root1.2","title":"Step two","status":"closed","created_at":"2026-01-01T00:05:00Z","closed_at":"2026-01-01T00:12:00Z"},
+        {"id":"gt-root1.3","title":"Step three","status":"open","created_at":"2026-01-01T00:12:00Z","closed_at":""}
+      ]'
+    elif [ "$status" = "hooked" ]; then
+      echo '[{"id":"gt-hookedbead","title":"Hooked work","status":"hooked","description":"attached_molecule: gt-root1"}]'
+    else
+      echo '[]'
+    fi
+    ;;
+  close)
+    for arg in "$@"; do
+      case "$arg" in
+        --*) ;;
+        *) echo "$arg" >> "%s" ;;
+      esac
+    done
+    ;;
+  update)
+    echo "$*" >> "%s"
+    ;;
+  create)
+    echo "$*" >> "%s"
+    echo '{"id":"gt-digest1","title":"Digest: gt-root1","status":"open"}'
+    ;;
+esac
+exit 0
+`, closesPath, updatesPath, createPath)
+
+	windows = `@echo off
+exit /b 0
+`
+	return unix, windows
+}
+
+func TestMoleculeBurn_RootID_ClosesStepsRootAndClearsHook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub is a POSIX shell script")
+	}
+
+	townRoot := newMoleculeLifecycleTownRoot(t)
+	installThreeStepMoleculeBDStub(t, townRoot)
+
+	prevJSON, prevDryRun := moleculeJSON, moleculeDryRun
+	t.Cleanup(func() { moleculeJSON, moleculeDryRun = prevJSON, prevDryRun })
+	moleculeJSON = false
+	moleculeDryRun = false
+
+	if err := runMoleculeBurn(nil, []string{"gt-root1"}); err != nil {
+		t.Fatalf("runMoleculeBurn: %v", err)
+	}
+
+	closes, err := os.ReadFile(filepath.Join(townRoot, "closes.log"))
+	if err != nil {
+		t.Fatalf("read closes.log: %v", err)
+	}
+	closedLines := strings.Split(strings.TrimSpace(string(closes)), "\n")
+	closedSet := map[string]bool{}
+	for _, line := range closedLines {
+		closedSet[line] = true
+	}
+	// Steps one and two start out already closed, so only the still-open
+	// step and the root itself go through bd close.
+	for _, id := range []string{"gt-root1.3", "gt-root1"} {
+		if !closedSet[id] {
+			t.Errorf("expected %s to be closed, closes.log: %v", id, closedLines)
+		}
+	}
+	if closedSet["gt-root1.1"] || closedSet["gt-root1.2"] {
+		t.Errorf("steps already closed shouldn't be re-closed, closes.log: %v", closedLines)
+	}
+
+	updates, err := os.ReadFile(filepath.Join(townRoot, "updates.log"))
+	if err != nil {
+		t.Fatalf("read updates.log: %v", err)
+	}
+	if !strings.Contains(string(updates), "gt-hookedbead") {
+		t.Errorf("expected the hooked bead gt-hookedbead to be updated (unhooked), updates.log:\n%s", updates)
+	}
+}
+
+func TestMoleculeSquash_RootID_CreatesDigestWithStepDetails(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub is a POSIX shell script")
+	}
+
+	townRoot := newMoleculeLifecycleTownRoot(t)
+	installThreeStepMoleculeBDStub(t, townRoot)
+
+	prevJSON, prevDryRun := moleculeJSON, moleculeDryRun
+	t.Cleanup(func() { moleculeJSON, moleculeDryRun = prevJSON, prevDryRun })
+	moleculeJSON = false
+	moleculeDryRun = false
+
+	if err := runMoleculeSquash(nil, []string{"gt-root1"}); err != nil {
+		t.Fatalf("runMoleculeSquash: %v", err)
+	}
+
+	closes, err := os.ReadFile(filepath.Join(townRoot, "closes.log"))
+	if err != nil {
+		t.Fatalf("read closes.log: %v", err)
+	}
+	closedLines := strings.Split(strings.TrimSpace(string(closes)), "\n")
+	if !containsExactLine(closedLines, "gt-root1") {
+		t.Errorf("expected molecule root to be closed, closes.log: %v", closedLines)
+	}
+
+	created, err := os.ReadFile(filepath.Join(townRoot, "create.log"))
+	if err != nil {
+		t.Fatalf("read create.log: %v", err)
+	}
+	createArgs := string(created)
+
+	// The digest bead should have been created with a --parent pointing at
+	// the molecule root's own parent (gt-parent1), not the molecule root.
+	if !strings.Contains(createArgs, "--parent=gt-parent1") {
+		t.Errorf("expected the digest to be created with --parent=gt-parent1, create.log:\n%s", createArgs)
+	}
+	for _, want := range []string{"Step one", "Step two", "Step three"} {
+		if !strings.Contains(createArgs, want) {
+			t.Errorf("digest description missing %q, create.log:\n%s", want, createArgs)
+		}
+	}
+}
+
+func containsExactLine(lines []string, want string) bool {
+	for _, line := range lines {
+		if line == want {
+			return true
+		}
+	}
+	return false
+}
+
+func TestMoleculeBurnSquash_DryRun_MakesNoChanges(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub is a POSIX shell script")
+	}
+
+	townRoot := newMoleculeLifecycleTownRoot(t)
+	installThreeStepMoleculeBDStub(t, townRoot)
+
+	prevJSON, prevDryRun := moleculeJSON, moleculeDryRun
+	t.Cleanup(func() { moleculeJSON, moleculeDryRun = prevJSON, prevDryRun })
+	moleculeJSON = false
+	moleculeDryRun = true
+
+	if err := runMoleculeBurn(nil, []string{"gt-root1"}); err != nil {
+		t.Fatalf("runMoleculeBurn --dry-run: %v", err)
+	}
+	if err := runMoleculeSquash(nil, []string{"gt-root1"}); err != nil {
+		t.Fatalf("runMoleculeSquash --dry-run: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(townRoot, "closes.log")); !os.IsNotExist(err) {
+		t.Errorf("dry-run should not close anything, but closes.log exists")
+	}
+	if _, err := os.Stat(filepath.Join(townRoot, "updates.log")); !os.IsNotExist(err) {
+		t.Errorf("dry-run should not update anything, but updates.log exists")
+	}
+}
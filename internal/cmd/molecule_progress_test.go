@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// TestClassifyMoleculeSteps exercises a synthetic dependency graph with one
+// step of each classification: done, in_progress (stuck), ready, blocked,
+// and orphaned (its dependency was closed as wontfix).
+func TestClassifyMoleculeSteps(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	stuckThreshold := 30 * time.Minute
+
+	children := []*beads.Issue{
+		{
+			ID:     "gt-root1.1",
+			Title:  "Done step",
+			Status: "closed",
+		},
+		{
+			ID:     "gt-root1.2",
+			Title:  "Wontfix'd step",
+			Status: "closed",
+			Labels: []string{"wontfix"},
+		},
+		{
+			ID:        "gt-root1.3",
+			Title:     "Stuck step",
+			Status:    "in_progress",
+			Assignee:  "",
+			UpdatedAt: beads.Timestamp{Time: now.Add(-90 * time.Minute)},
+		},
+		{
+			ID:        "gt-root1.4",
+			Title:     "Active step",
+			Status:    "in_progress",
+			Assignee:  "greenplace/nux",
+			UpdatedAt: beads.Timestamp{Time: now.Add(-90 * time.Minute)},
+		},
+		{
+			ID:        "gt-root1.5",
+			Title:     "Ready step",
+			Status:    "open",
+			DependsOn: []string{"gt-root1.1"},
+		},
+		{
+			ID:        "gt-root1.6",
+			Title:     "Blocked step",
+			Status:    "open",
+			DependsOn: []string{"gt-root1.3"},
+		},
+		{
+			ID:        "gt-root1.7",
+			Title:     "Orphaned step",
+			Status:    "open",
+			DependsOn: []string{"gt-root1.2"},
+		},
+	}
+
+	steps, nextActions := classifyMoleculeSteps(children, stuckThreshold, now)
+
+	got := make(map[string]StepStatus, len(steps))
+	for _, s := range steps {
+		got[s.ID] = s
+	}
+
+	if c := got["gt-root1.1"].Classification; c != "done" {
+		t.Errorf("gt-root1.1 classification = %q, want done", c)
+	}
+
+	if s := got["gt-root1.3"]; s.Classification != "in_progress" || !s.Stuck {
+		t.Errorf("gt-root1.3 = %+v, want in_progress and stuck", s)
+	}
+
+	if s := got["gt-root1.4"]; s.Classification != "in_progress" || s.Stuck {
+		t.Errorf("gt-root1.4 = %+v, want in_progress and NOT stuck (has an assignee)", s)
+	}
+
+	if s := got["gt-root1.5"]; s.Classification != "ready" {
+		t.Errorf("gt-root1.5 classification = %q, want ready", s.Classification)
+	}
+
+	if s := got["gt-root1.6"]; s.Classification != "blocked" || len(s.BlockedBy) != 1 || s.BlockedBy[0] != "gt-root1.3" {
+		t.Errorf("gt-root1.6 = %+v, want blocked by gt-root1.3", s)
+	}
+
+	if s := got["gt-root1.7"]; s.Classification != "orphaned" || len(s.BlockedBy) != 1 || s.BlockedBy[0] != "gt-root1.2" {
+		t.Errorf("gt-root1.7 = %+v, want orphaned by gt-root1.2", s)
+	}
+
+	foundReadyAction := false
+	foundStuckAction := false
+	for _, action := range nextActions {
+		if action == "gt spawn --issue gt-root1.5" {
+			foundReadyAction = true
+		}
+		if contains(action, "gt-root1.3") && contains(action, "release it") {
+			foundStuckAction = true
+		}
+	}
+	if !foundReadyAction {
+		t.Errorf("expected a next action to spawn the ready step, got %v", nextActions)
+	}
+	if !foundStuckAction {
+		t.Errorf("expected a next action to release the stuck step, got %v", nextActions)
+	}
+}
+
+func TestClassifyMoleculeSteps_NoStuckActionWhenUpdatedAtMissing(t *testing.T) {
+	now := time.Now()
+	children := []*beads.Issue{
+		{
+			ID:     "gt-root2.1",
+			Title:  "No timestamp",
+			Status: "in_progress",
+		},
+	}
+
+	steps, nextActions := classifyMoleculeSteps(children, time.Hour, now)
+	if steps[0].Stuck {
+		t.Errorf("step with no UpdatedAt should not be flagged stuck: %+v", steps[0])
+	}
+	if len(nextActions) != 0 {
+		t.Errorf("expected no next actions, got %v", nextActions)
+	}
+}
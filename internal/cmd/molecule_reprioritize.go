@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func runMoleculeReprioritize(cmd *cobra.Command, args []string) error {
+	rootID := args[0]
+
+	priority, err := strconv.Atoi(args[1])
+	if err != nil || priority < 0 || priority > 4 {
+		return fmt.Errorf("priority must be an integer 0-4, got %q", args[1])
+	}
+
+	workDir, err := findLocalBeadsDir()
+	if err != nil {
+		return fmt.Errorf("not in a beads workspace: %w", err)
+	}
+
+	b := beads.New(workDir)
+
+	steps, err := b.List(beads.ListOptions{Parent: rootID, Status: "open", Priority: -1})
+	if err != nil {
+		return fmt.Errorf("listing open steps under %s: %w", rootID, err)
+	}
+
+	ids := make([]string, 0, len(steps)+1)
+	ids = append(ids, rootID)
+	for _, step := range steps {
+		ids = append(ids, step.ID)
+	}
+
+	if err := b.BulkUpdate(ids, beads.UpdateOptions{Priority: &priority}); err != nil {
+		return err
+	}
+
+	fmt.Printf("Reprioritized %s and %d open step(s) to P%d\n", rootID, len(steps), priority)
+	return nil
+}
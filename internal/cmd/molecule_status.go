@@ -3,16 +3,34 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
+	"golang.org/x/term"
+)
+
+// defaultStuckThreshold is how long a step can sit in_progress with no
+// assignee session before runMoleculeProgress flags it as stuck. Mirrors
+// deacon.DefaultStaleHookConfig's MaxAge default for the analogous
+// hooked-bead staleness check.
+const defaultStuckThreshold = 1 * time.Hour
+
+// moleculeStuckAfter is the --stuck-after override for defaultStuckThreshold.
+var moleculeStuckAfter time.Duration
+
+// Status command flags.
+var (
+	moleculeStatusIdentity string
+	moleculeStatusAll      bool
 )
 
 // Note: Agent field parsing is now in internal/beads/fields.go (AgentFields, ParseAgentFieldsFromDescription)
@@ -109,6 +127,36 @@ type MoleculeProgressInfo struct {
 	BlockedSteps []string `json:"blocked_steps"`
 	Percent      int      `json:"percent_complete"`
 	Complete     bool     `json:"complete"`
+
+	// Steps, StuckSteps, OrphanedSteps, and NextActions are populated by
+	// runMoleculeProgress (not getMoleculeProgressInfo's lighter-weight
+	// callers, gt mol status/current) so `gt mol progress` can answer "why
+	// is this stuck" instead of just reporting counts.
+	Steps         []StepStatus `json:"steps,omitempty"`
+	StuckSteps    []string     `json:"stuck_steps,omitempty"`
+	OrphanedSteps []string     `json:"orphaned_steps,omitempty"`
+	FailedSteps   []string     `json:"failed_steps,omitempty"`
+	NextActions   []string     `json:"next_actions,omitempty"`
+}
+
+// StepStatus classifies a single molecule step for gt mol progress.
+type StepStatus struct {
+	ID             string   `json:"id"`
+	Title          string   `json:"title"`
+	Status         string   `json:"status"`         // raw bd status
+	Classification string   `json:"classification"` // done, failed, in_progress, ready, blocked, orphaned
+	BlockedBy      []string `json:"blocked_by,omitempty"`
+	Assignee       string   `json:"assignee,omitempty"`
+	Stuck          bool     `json:"stuck,omitempty"`
+	StuckFor       string   `json:"stuck_for,omitempty"`
+}
+
+// moleculeProgressBeads is the subset of *beads.Beads that computing and
+// watching molecule progress needs, extracted so tests can drive a state
+// machine across polls with a stub instead of a real bd process.
+type moleculeProgressBeads interface {
+	Show(id string) (*beads.Issue, error)
+	List(opts beads.ListOptions) ([]*beads.Issue, error)
 }
 
 // MoleculeStatusInfo contains status information for an agent's work.
@@ -150,10 +198,42 @@ func runMoleculeProgress(cmd *cobra.Command, args []string) error {
 
 	b := beads.New(workDir)
 
+	stuckThreshold := defaultStuckThreshold
+	if moleculeStuckAfter > 0 {
+		stuckThreshold = moleculeStuckAfter
+	}
+
+	if moleculeWatch {
+		exitCode, err := watchMoleculeProgress(b, rootID, stuckThreshold, moleculeWatchInterval, moleculeWatchTimeout, os.Stdout)
+		if err != nil {
+			return err
+		}
+		os.Exit(exitCode)
+	}
+
+	progress, err := computeMoleculeProgress(b, rootID, stuckThreshold, time.Now())
+	if err != nil {
+		return err
+	}
+
+	// JSON output
+	if moleculeJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(progress)
+	}
+
+	return printMoleculeProgressText(os.Stdout, progress, stuckThreshold)
+}
+
+// computeMoleculeProgress fetches a molecule's root and step issues and
+// classifies them into a MoleculeProgressInfo. Shared by the single-shot
+// and --watch code paths so both see the same numbers.
+func computeMoleculeProgress(b moleculeProgressBeads, rootID string, stuckThreshold time.Duration, now time.Time) (*MoleculeProgressInfo, error) {
 	// Get the root issue
 	root, err := b.Show(rootID)
 	if err != nil {
-		return fmt.Errorf("getting root issue: %w", err)
+		return nil, fmt.Errorf("getting root issue: %w", err)
 	}
 
 	// Find all children of the root issue
@@ -163,15 +243,15 @@ func runMoleculeProgress(cmd *cobra.Command, args []string) error {
 		Priority: -1,
 	})
 	if err != nil {
-		return fmt.Errorf("listing children: %w", err)
+		return nil, fmt.Errorf("listing children: %w", err)
 	}
 
 	if len(children) == 0 {
-		return fmt.Errorf("no steps found for %s (not a molecule root?)", rootID)
+		return nil, fmt.Errorf("no steps found for %s (not a molecule root?)", rootID)
 	}
 
 	// Build progress info
-	progress := MoleculeProgressInfo{
+	progress := &MoleculeProgressInfo{
 		RootID:    rootID,
 		RootTitle: root.Title,
 	}
@@ -225,44 +305,219 @@ func runMoleculeProgress(cmd *cobra.Command, args []string) error {
 	}
 	progress.Complete = progress.DoneSteps == progress.TotalSteps
 
-	// JSON output
-	if moleculeJSON {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(progress)
+	progress.Steps, progress.NextActions = classifyMoleculeSteps(children, stuckThreshold, now)
+	for _, step := range progress.Steps {
+		if step.Stuck {
+			progress.StuckSteps = append(progress.StuckSteps, step.ID)
+		}
+		switch step.Classification {
+		case "orphaned":
+			progress.OrphanedSteps = append(progress.OrphanedSteps, step.ID)
+		case "failed":
+			progress.FailedSteps = append(progress.FailedSteps, step.ID)
+		}
 	}
 
-	// Human-readable output
-	fmt.Printf("\n%s %s\n\n", style.Bold.Render("🧬 Molecule Progress:"), root.Title)
-	fmt.Printf("  Root: %s\n", rootID)
+	return progress, nil
+}
+
+// printMoleculeProgressText renders a MoleculeProgressInfo as the
+// human-readable table gt mol progress has always shown.
+func printMoleculeProgressText(w io.Writer, progress *MoleculeProgressInfo, stuckThreshold time.Duration) error {
+	fmt.Fprintf(w, "\n%s %s\n\n", style.Bold.Render("🧬 Molecule Progress:"), progress.RootTitle)
+	fmt.Fprintf(w, "  Root: %s\n", progress.RootID)
 	if progress.MoleculeID != "" {
-		fmt.Printf("  Molecule: %s\n", progress.MoleculeID)
+		fmt.Fprintf(w, "  Molecule: %s\n", progress.MoleculeID)
 	}
-	fmt.Println()
+	fmt.Fprintln(w)
 
 	// Progress bar
 	barWidth := 20
 	filled := (progress.Percent * barWidth) / 100
 	bar := strings.Repeat("█", filled) + strings.Repeat("░", barWidth-filled)
-	fmt.Printf("  [%s] %d%% (%d/%d)\n\n", bar, progress.Percent, progress.DoneSteps, progress.TotalSteps)
+	fmt.Fprintf(w, "  [%s] %d%% (%d/%d)\n\n", bar, progress.Percent, progress.DoneSteps, progress.TotalSteps)
 
 	// Step status
-	fmt.Printf("  Done:        %d\n", progress.DoneSteps)
-	fmt.Printf("  In Progress: %d\n", progress.InProgress)
-	fmt.Printf("  Ready:       %d", len(progress.ReadySteps))
+	fmt.Fprintf(w, "  Done:        %d\n", progress.DoneSteps)
+	fmt.Fprintf(w, "  In Progress: %d\n", progress.InProgress)
+	fmt.Fprintf(w, "  Ready:       %d", len(progress.ReadySteps))
 	if len(progress.ReadySteps) > 0 {
-		fmt.Printf(" (%s)", strings.Join(progress.ReadySteps, ", "))
+		fmt.Fprintf(w, " (%s)", strings.Join(progress.ReadySteps, ", "))
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "  Blocked:     %d\n", len(progress.BlockedSteps))
+	if len(progress.OrphanedSteps) > 0 {
+		fmt.Fprintf(w, "  Orphaned:    %d (%s)\n", len(progress.OrphanedSteps), strings.Join(progress.OrphanedSteps, ", "))
+	}
+	if len(progress.FailedSteps) > 0 {
+		fmt.Fprintf(w, "  %s %d (%s)\n", style.Error.Render("✗ Failed:"), len(progress.FailedSteps), strings.Join(progress.FailedSteps, ", "))
+	}
+	if len(progress.StuckSteps) > 0 {
+		fmt.Fprintf(w, "  %s %d (%s) - in progress longer than %s with no assignee\n",
+			style.Bold.Render("⚠ Stuck:"), len(progress.StuckSteps), strings.Join(progress.StuckSteps, ", "), stuckThreshold)
 	}
-	fmt.Println()
-	fmt.Printf("  Blocked:     %d\n", len(progress.BlockedSteps))
 
 	if progress.Complete {
-		fmt.Printf("\n  %s\n", style.Bold.Render("✓ Molecule complete!"))
+		fmt.Fprintf(w, "\n  %s\n", style.Bold.Render("✓ Molecule complete!"))
+	} else if len(progress.FailedSteps) > 0 {
+		fmt.Fprintf(w, "\n  %s\n", style.Error.Render("✗ Molecule cannot complete - a step failed."))
+	} else if len(progress.NextActions) > 0 {
+		fmt.Fprintf(w, "\n  %s\n", style.Bold.Render("Next actions:"))
+		for _, action := range progress.NextActions {
+			fmt.Fprintf(w, "    - %s\n", action)
+		}
 	}
 
 	return nil
 }
 
+// watchMoleculeProgress polls computeMoleculeProgress every interval until
+// the molecule completes (exit 0), a step fails/wontfixes (exit 2), or
+// timeout elapses (exit 3). When stdout is a terminal it redraws the full
+// table each poll; otherwise it prints one line per state change, since a
+// redrawn table is meaningless in a log file or CI job output.
+func watchMoleculeProgress(b moleculeProgressBeads, rootID string, stuckThreshold, interval, timeout time.Duration, w io.Writer) (int, error) {
+	isTTY := term.IsTerminal(int(os.Stdout.Fd()))
+	deadline := time.Now().Add(timeout)
+	lastLine := ""
+
+	for {
+		progress, err := computeMoleculeProgress(b, rootID, stuckThreshold, time.Now())
+		if err != nil {
+			return 0, err
+		}
+
+		if isTTY {
+			fmt.Fprint(w, "\033[H\033[2J")
+			if err := printMoleculeProgressText(w, progress, stuckThreshold); err != nil {
+				return 0, err
+			}
+		} else if line := moleculeProgressSummaryLine(progress); line != lastLine {
+			fmt.Fprintf(w, "[%s] %s\n", time.Now().Format("15:04:05"), line)
+			lastLine = line
+		}
+
+		if len(progress.FailedSteps) > 0 {
+			return 2, nil
+		}
+		if progress.Complete {
+			return 0, nil
+		}
+		if time.Now().After(deadline) {
+			return 3, nil
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// moleculeProgressSummaryLine is the one-line, non-TTY summary watchMoleculeProgress
+// prints on each state change.
+func moleculeProgressSummaryLine(progress *MoleculeProgressInfo) string {
+	line := fmt.Sprintf("%d/%d done, %d in progress, %d ready, %d blocked",
+		progress.DoneSteps, progress.TotalSteps, progress.InProgress, len(progress.ReadySteps), len(progress.BlockedSteps))
+	if len(progress.FailedSteps) > 0 {
+		line += fmt.Sprintf(", %d failed (%s)", len(progress.FailedSteps), strings.Join(progress.FailedSteps, ", "))
+	}
+	if progress.Complete {
+		line += " - complete"
+	}
+	return line
+}
+
+// classifyMoleculeSteps classifies each step of a molecule for gt mol
+// progress: done / failed (closed with the "wontfix" label) / in_progress /
+// ready / blocked-by-[ids] / orphaned (a dependency was closed as wontfix,
+// so this step can never become ready), and flags in_progress steps
+// unassigned and stuck longer than stuckThreshold. It also proposes a next
+// action per ready, unassigned step, and one per stuck step.
+func classifyMoleculeSteps(children []*beads.Issue, stuckThreshold time.Duration, now time.Time) ([]StepStatus, []string) {
+	closedIDs := make(map[string]bool)
+	wontfixIDs := make(map[string]bool)
+	for _, child := range children {
+		if child.Status == "closed" {
+			closedIDs[child.ID] = true
+			if hasLabel(child.Labels, "wontfix") {
+				wontfixIDs[child.ID] = true
+			}
+		}
+	}
+
+	var steps []StepStatus
+	var nextActions []string
+
+	for _, child := range children {
+		step := StepStatus{
+			ID:       child.ID,
+			Title:    child.Title,
+			Status:   child.Status,
+			Assignee: child.Assignee,
+		}
+
+		switch child.Status {
+		case "closed":
+			if hasLabel(child.Labels, "wontfix") {
+				step.Classification = "failed"
+			} else {
+				step.Classification = "done"
+			}
+
+		case "in_progress":
+			step.Classification = "in_progress"
+			if child.Assignee == "" {
+				if updated := child.UpdatedAt.Time; !updated.IsZero() {
+					if age := now.Sub(updated); age >= stuckThreshold {
+						step.Stuck = true
+						step.StuckFor = age.Round(time.Minute).String()
+						nextActions = append(nextActions, fmt.Sprintf(
+							"%s has been in progress for %s with no assignee - release it: bd update %s --status=open --assignee=",
+							child.ID, step.StuckFor, child.ID))
+					}
+				}
+			}
+
+		default: // "open" and any other not-yet-closed status
+			var orphanedBy []string
+			var blockedBy []string
+			for _, depID := range child.DependsOn {
+				if wontfixIDs[depID] {
+					orphanedBy = append(orphanedBy, depID)
+				} else if !closedIDs[depID] {
+					blockedBy = append(blockedBy, depID)
+				}
+			}
+
+			switch {
+			case len(orphanedBy) > 0:
+				step.Classification = "orphaned"
+				step.BlockedBy = orphanedBy
+			case len(blockedBy) > 0:
+				step.Classification = "blocked"
+				step.BlockedBy = blockedBy
+			default:
+				step.Classification = "ready"
+				if child.Assignee == "" {
+					nextActions = append(nextActions, fmt.Sprintf("gt spawn --issue %s", child.ID))
+				}
+			}
+		}
+
+		steps = append(steps, step)
+	}
+
+	return steps, nextActions
+}
+
+// hasLabel reports whether labels contains label.
+func hasLabel(labels []string, label string) bool {
+	for _, l := range labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
 // extractMoleculeID extracts the molecule ID from an issue's description.
 func extractMoleculeID(description string) string {
 	lines := strings.Split(description, "\n")
@@ -276,6 +531,10 @@ func extractMoleculeID(description string) string {
 }
 
 func runMoleculeStatus(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 && moleculeStatusIdentity != "" {
+		return fmt.Errorf("specify a target either positionally or via --identity, not both")
+	}
+
 	cwd, err := os.Getwd()
 	if err != nil {
 		return fmt.Errorf("getting current directory: %w", err)
@@ -290,42 +549,84 @@ func runMoleculeStatus(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("not in a Gas Town workspace")
 	}
 
+	// Find beads directory
+	workDir, err := findLocalBeadsDir()
+	if err != nil {
+		return fmt.Errorf("not in a beads workspace: %w", err)
+	}
+
+	b := beads.New(workDir)
+
+	if moleculeStatusAll {
+		rig := moleculeStatusRig(args, townRoot, cwd)
+		if rig == "" {
+			return fmt.Errorf("cannot determine which rig to scan - pass it positionally (gt mol status --all <rig>) or via --identity <rig>/...")
+		}
+		return runMoleculeStatusAll(b, townRoot, rig)
+	}
+
 	// Determine target agent
 	var target string
-	var roleCtx RoleContext
+	var role Role
 
-	if len(args) > 0 {
-		// Explicit target provided
+	switch {
+	case moleculeStatusIdentity != "":
+		target = moleculeStatusIdentity
+	case len(args) > 0:
 		target = args[0]
-	} else {
+	default:
 		// Use cwd-based detection for status display
 		// This ensures we show the hook for the agent whose directory we're in,
 		// not the agent from the GT_ROLE env var (which might be different if
 		// we cd'd into another rig's crew/polecat directory)
-		roleCtx = detectRole(cwd, townRoot)
+		roleCtx := detectRole(cwd, townRoot)
+		role = roleCtx.Role
 		target = buildAgentIdentity(roleCtx)
 		if target == "" {
 			return fmt.Errorf("cannot determine agent identity (role: %s)", roleCtx.Role)
 		}
 	}
 
-	// Find beads directory
-	workDir, err := findLocalBeadsDir()
-	if err != nil {
-		return fmt.Errorf("not in a beads workspace: %w", err)
+	status := buildMoleculeStatusInfo(b, target, role, townRoot)
+
+	// JSON output
+	if moleculeJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(status)
 	}
 
-	b := beads.New(workDir)
+	// Human-readable output
+	return outputMoleculeStatus(status)
+}
 
-	// Build status info
+// moleculeStatusRig figures out which rig `gt mol status --all` should scan:
+// a positional rig name, the rig segment of --identity, or (failing those)
+// the rig cwd is inside.
+func moleculeStatusRig(args []string, townRoot, cwd string) string {
+	if len(args) > 0 {
+		return args[0]
+	}
+	if moleculeStatusIdentity != "" {
+		rig, _, _ := strings.Cut(moleculeStatusIdentity, "/")
+		return rig
+	}
+	return detectRole(cwd, townRoot).Rig
+}
+
+// buildMoleculeStatusInfo looks up target's hook and, if it points at an
+// attached molecule, that molecule's progress. It's the reusable core of
+// runMoleculeStatus, shared with the --all table so per-agent status is
+// computed identically whether resolved from cwd, --identity, or a rig scan.
+func buildMoleculeStatusInfo(b *beads.Beads, target string, role Role, townRoot string) MoleculeStatusInfo {
 	status := MoleculeStatusInfo{
 		Target: target,
-		Role:   string(roleCtx.Role),
+		Role:   string(role),
 	}
 
 	// Try to find agent bead and read hook slot
 	// This is the preferred method - agent beads have a hook_bead field
-	agentBeadID := buildAgentBeadID(target, roleCtx.Role, townRoot)
+	agentBeadID := buildAgentBeadID(target, role, townRoot)
 	var hookBead *beads.Issue
 
 	if agentBeadID != "" {
@@ -382,7 +683,7 @@ func runMoleculeStatus(cmd *cobra.Command, args []string) error {
 			Priority: -1,
 		})
 		if err != nil {
-			return fmt.Errorf("listing hooked beads: %w", err)
+			hookedBeads = nil
 		}
 
 		// If no hooked beads found, also check in_progress beads assigned to this agent.
@@ -439,15 +740,72 @@ func runMoleculeStatus(cmd *cobra.Command, args []string) error {
 		status.NextAction = "Attach a molecule to start work: gt mol attach <bead-id> <molecule-id>"
 	}
 
-	// JSON output
+	return status
+}
+
+// runMoleculeStatusAll walks every polecat and crew clone in rig and prints
+// (or, with --json, encodes) a table of who has what hooked.
+func runMoleculeStatusAll(b *beads.Beads, townRoot, rig string) error {
+	identities := listRigAgentIdentities(townRoot, rig)
+	if len(identities) == 0 {
+		return fmt.Errorf("no polecat or crew clones found under %s", filepath.Join(townRoot, rig, "polecats"))
+	}
+
+	statuses := make([]MoleculeStatusInfo, 0, len(identities))
+	for _, identity := range identities {
+		statuses = append(statuses, buildMoleculeStatusInfo(b, identity, RoleUnknown, townRoot))
+	}
+
 	if moleculeJSON {
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
-		return enc.Encode(status)
+		return enc.Encode(statuses)
 	}
 
-	// Human-readable output
-	return outputMoleculeStatus(status)
+	fmt.Printf("\n%s %s\n\n", style.Bold.Render("🪝 Hooks in rig:"), rig)
+	fmt.Printf("  %-28s %-14s %-16s %s\n", "IDENTITY", "HOOKED", "MOLECULE", "STEP")
+	for _, status := range statuses {
+		hooked := "-"
+		if status.PinnedBead != nil {
+			hooked = status.PinnedBead.ID
+		}
+		molecule := "-"
+		if status.AttachedMolecule != "" {
+			molecule = status.AttachedMolecule
+		}
+		step := "-"
+		if status.Progress != nil {
+			step = fmt.Sprintf("%d/%d", status.Progress.DoneSteps, status.Progress.TotalSteps)
+		}
+		fmt.Printf("  %-28s %-14s %-16s %s\n", status.Target, hooked, molecule, step)
+	}
+
+	return nil
+}
+
+// listRigAgentIdentities returns "<rig>/<name>" for every polecat clone and
+// "<rig>/crew/<name>" for every crew clone under rig, following the same
+// <rig>/polecats/<name> and <rig>/crew/<name> layout discoverHooks scans.
+func listRigAgentIdentities(townRoot, rig string) []string {
+	var identities []string
+
+	rigPath := filepath.Join(townRoot, rig)
+	if polecats, err := os.ReadDir(filepath.Join(rigPath, "polecats")); err == nil {
+		for _, p := range polecats {
+			if p.IsDir() && !strings.HasPrefix(p.Name(), ".") {
+				identities = append(identities, rig+"/"+p.Name())
+			}
+		}
+	}
+	if crew, err := os.ReadDir(filepath.Join(rigPath, "crew")); err == nil {
+		for _, c := range crew {
+			if c.IsDir() && !strings.HasPrefix(c.Name(), ".") {
+				identities = append(identities, rig+"/crew/"+c.Name())
+			}
+		}
+	}
+
+	return identities
 }
 
 // buildAgentIdentity constructs the agent identity string from role context.
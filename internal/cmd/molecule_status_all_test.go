@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// twoPolecatStatusBDScript stubs bd for a rig "greenplace" with two polecat
+// agent beads: nux (hooked to a bead with an attached molecule that's 1/2
+// steps done) and toast (hooked to a plain bead with no molecule attached).
+func twoPolecatStatusBDScript() (unix, windows string) {
+	unix = `#!/bin/sh
+while [ "$1" = "--no-daemon" ] || [ "$1" = "--allow-stale" ]; do
+  shift
+done
+cmd="$1"
+shift || true
+case "$cmd" in
+  show)
+    id="$1"
+    case "$id" in
+      gt-greenplace-polecat-nux)
+        echo '[{"id":"gt-greenplace-polecat-nux","title":"nux","status":"open","issue_type":"agent","hook_bead":"gt-hook-nux"}]'
+        ;;
+      gt-hook-nux)
+        echo '[{"id":"gt-hook-nux","title":"Work for nux","status":"hooked","description":"attached_molecule: gt-mol-nux"}]'
+        ;;
+      gt-mol-nux)
+        echo '[{"id":"gt-mol-nux","title":"nux molecule","status":"open"}]'
+        ;;
+      gt-greenplace-polecat-toast)
+        echo '[{"id":"gt-greenplace-polecat-toast","title":"toast","status":"open","issue_type":"agent","hook_bead":"gt-hook-toast"}]'
+        ;;
+      gt-hook-toast)
+        echo '[{"id":"gt-hook-toast","title":"Plain work for toast","status":"hooked","description":""}]'
+        ;;
+      *)
+        echo '[]'
+        ;;
+    esac
+    ;;
+  list)
+    parent=""
+    for arg in "$@"; do
+      case "$arg" in
+        --parent=*) parent="${arg#--parent=}" ;;
+      esac
+    done
+    if [ "$parent" = "gt-mol-nux" ]; then
+      echo '[
+        {"id":"gt-mol-nux.1","title":"Step one","status":"closed"},
+        {"id":"gt-mol-nux.2","title":"Step two","status":"open"}
+      ]'
+    else
+      echo '[]'
+    fi
+    ;;
+esac
+exit 0
+`
+	windows = `@echo off
+exit /b 0
+`
+	return unix, windows
+}
+
+// setupTwoPolecatRig creates a temp town with a "greenplace" rig containing
+// two polecat clones (nux, toast) and stubs bd with distinct hooks for each,
+// chdir'd into the town root.
+func setupTwoPolecatRig(t *testing.T) (townRoot string) {
+	t.Helper()
+
+	townRoot = t.TempDir()
+	for _, dir := range []string{
+		filepath.Join(townRoot, "mayor"),
+		filepath.Join(townRoot, ".beads"),
+		filepath.Join(townRoot, "greenplace", "polecats", "nux"),
+		filepath.Join(townRoot, "greenplace", "polecats", "toast"),
+	} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("mkdir %s: %v", dir, err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(townRoot, "mayor", "town.json"), []byte("{}"), 0644); err != nil {
+		t.Fatalf("write town.json: %v", err)
+	}
+
+	binDir := filepath.Join(townRoot, "bin")
+	if err := os.MkdirAll(binDir, 0755); err != nil {
+		t.Fatalf("mkdir bin: %v", err)
+	}
+	unix, windows := twoPolecatStatusBDScript()
+	writeBDStub(t, binDir, unix, windows)
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("getwd: %v", err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(cwd) })
+	if err := os.Chdir(townRoot); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+
+	return townRoot
+}
+
+func TestMoleculeStatus_Identity_ResolvesWithoutCwdDetection(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub is a POSIX shell script")
+	}
+
+	townRoot := setupTwoPolecatRig(t)
+	b := beads.New(filepath.Join(townRoot, ".beads"))
+
+	status := buildMoleculeStatusInfo(b, "greenplace/nux", RoleUnknown, townRoot)
+	if !status.HasWork || status.PinnedBead == nil || status.PinnedBead.ID != "gt-hook-nux" {
+		t.Fatalf("status = %+v, want hooked to gt-hook-nux", status)
+	}
+	if status.AttachedMolecule != "gt-mol-nux" {
+		t.Errorf("AttachedMolecule = %q, want gt-mol-nux", status.AttachedMolecule)
+	}
+	if status.Progress == nil || status.Progress.TotalSteps != 2 || status.Progress.DoneSteps != 1 {
+		t.Errorf("Progress = %+v, want 1/2 steps done", status.Progress)
+	}
+}
+
+func TestMoleculeStatus_All_ListsBothPolecatsWithDifferentHooks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub is a POSIX shell script")
+	}
+
+	townRoot := setupTwoPolecatRig(t)
+	b := beads.New(filepath.Join(townRoot, ".beads"))
+
+	identities := listRigAgentIdentities(townRoot, "greenplace")
+	if len(identities) != 2 {
+		t.Fatalf("listRigAgentIdentities = %v, want 2 entries", identities)
+	}
+
+	statuses := make(map[string]MoleculeStatusInfo, 2)
+	for _, identity := range identities {
+		statuses[identity] = buildMoleculeStatusInfo(b, identity, RoleUnknown, townRoot)
+	}
+
+	nux := statuses["greenplace/nux"]
+	if nux.AttachedMolecule != "gt-mol-nux" {
+		t.Errorf("nux.AttachedMolecule = %q, want gt-mol-nux", nux.AttachedMolecule)
+	}
+
+	toast := statuses["greenplace/toast"]
+	if toast.PinnedBead == nil || toast.PinnedBead.ID != "gt-hook-toast" {
+		t.Errorf("toast status = %+v, want hooked to gt-hook-toast", toast)
+	}
+	if toast.AttachedMolecule != "" {
+		t.Errorf("toast.AttachedMolecule = %q, want empty (no molecule attached)", toast.AttachedMolecule)
+	}
+}
+
+func TestRunMoleculeStatusAll_JSONOutputsBothPolecats(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub is a POSIX shell script")
+	}
+
+	setupTwoPolecatRig(t)
+
+	prevJSON, prevAll := moleculeJSON, moleculeStatusAll
+	t.Cleanup(func() { moleculeJSON, moleculeStatusAll = prevJSON, prevAll })
+	moleculeJSON = true
+	moleculeStatusAll = true
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("pipe: %v", err)
+	}
+	origStdout := os.Stdout
+	os.Stdout = w
+
+	runErr := runMoleculeStatus(nil, []string{"greenplace"})
+	w.Close()
+	os.Stdout = origStdout
+
+	data, readErr := io.ReadAll(r)
+	if readErr != nil {
+		t.Fatalf("read pipe: %v", readErr)
+	}
+	if runErr != nil {
+		t.Fatalf("runMoleculeStatus --all: %v", runErr)
+	}
+
+	var statuses []MoleculeStatusInfo
+	if err := json.Unmarshal(data, &statuses); err != nil {
+		t.Fatalf("unmarshal output %q: %v", data, err)
+	}
+	if len(statuses) != 2 {
+		t.Fatalf("got %d statuses, want 2: %s", len(statuses), data)
+	}
+	found := map[string]bool{}
+	for _, s := range statuses {
+		found[s.Target] = true
+	}
+	if !found["greenplace/nux"] || !found["greenplace/toast"] {
+		t.Errorf("statuses = %v, want both greenplace/nux and greenplace/toast", statuses)
+	}
+}
+
+func TestRunMoleculeStatus_PositionalAndIdentityBothSetIsError(t *testing.T) {
+	prevIdentity := moleculeStatusIdentity
+	t.Cleanup(func() { moleculeStatusIdentity = prevIdentity })
+	moleculeStatusIdentity = "greenplace/nux"
+
+	if err := runMoleculeStatus(nil, []string{"greenplace/toast"}); err == nil {
+		t.Error("expected an error when both a positional target and --identity are given")
+	}
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// fakeProgressBeads implements moleculeProgressBeads over a fixed root plus
+// a sequence of child-issue snapshots, one per List call, so a test can walk
+// a molecule through states across successive polls without a real bd
+// process.
+type fakeProgressBeads struct {
+	root      *beads.Issue
+	snapshots [][]*beads.Issue
+	calls     int
+}
+
+func (f *fakeProgressBeads) Show(id string) (*beads.Issue, error) {
+	return f.root, nil
+}
+
+func (f *fakeProgressBeads) List(opts beads.ListOptions) ([]*beads.Issue, error) {
+	idx := f.calls
+	if idx >= len(f.snapshots) {
+		idx = len(f.snapshots) - 1
+	}
+	f.calls++
+	return f.snapshots[idx], nil
+}
+
+func progressStepIssue(id, status string, dependsOn []string, labels []string) *beads.Issue {
+	return &beads.Issue{
+		ID:        id,
+		Title:     id,
+		Type:      "task",
+		Status:    status,
+		Priority:  2,
+		Parent:    "gt-mol",
+		DependsOn: dependsOn,
+		Labels:    labels,
+		CreatedAt: beads.Timestamp{Time: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)},
+		UpdatedAt: beads.Timestamp{Time: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)},
+	}
+}
+
+func TestComputeMoleculeProgress_ClassifiesFailedStep(t *testing.T) {
+	f := &fakeProgressBeads{
+		root: &beads.Issue{ID: "gt-mol", Title: "Test Molecule"},
+		snapshots: [][]*beads.Issue{{
+			progressStepIssue("gt-mol.1", "closed", nil, []string{"wontfix"}),
+			progressStepIssue("gt-mol.2", "open", []string{"gt-mol.1"}, nil),
+		}},
+	}
+
+	progress, err := computeMoleculeProgress(f, "gt-mol", time.Hour, time.Now())
+	if err != nil {
+		t.Fatalf("computeMoleculeProgress: %v", err)
+	}
+
+	if len(progress.FailedSteps) != 1 || progress.FailedSteps[0] != "gt-mol.1" {
+		t.Errorf("FailedSteps = %v, want [gt-mol.1]", progress.FailedSteps)
+	}
+	if len(progress.OrphanedSteps) != 1 || progress.OrphanedSteps[0] != "gt-mol.2" {
+		t.Errorf("OrphanedSteps = %v, want [gt-mol.2] (blocked by a failed dep)", progress.OrphanedSteps)
+	}
+}
+
+func TestWatchMoleculeProgress_ExitsZeroOnCompletion(t *testing.T) {
+	f := &fakeProgressBeads{
+		root: &beads.Issue{ID: "gt-mol", Title: "Test Molecule"},
+		snapshots: [][]*beads.Issue{
+			{progressStepIssue("gt-mol.1", "in_progress", nil, nil)},
+			{progressStepIssue("gt-mol.1", "in_progress", nil, nil)},
+			{progressStepIssue("gt-mol.1", "closed", nil, nil)},
+		},
+	}
+
+	var buf bytes.Buffer
+	code, err := watchMoleculeProgress(f, "gt-mol", time.Hour, time.Millisecond, time.Minute, &buf)
+	if err != nil {
+		t.Fatalf("watchMoleculeProgress: %v", err)
+	}
+	if code != 0 {
+		t.Errorf("exit code = %d, want 0", code)
+	}
+	if f.calls != 3 {
+		t.Errorf("polled %d times, want 3 (should stop as soon as it completes)", f.calls)
+	}
+}
+
+func TestWatchMoleculeProgress_ExitsTwoOnFailedStep(t *testing.T) {
+	f := &fakeProgressBeads{
+		root: &beads.Issue{ID: "gt-mol", Title: "Test Molecule"},
+		snapshots: [][]*beads.Issue{
+			{progressStepIssue("gt-mol.1", "in_progress", nil, nil)},
+			{progressStepIssue("gt-mol.1", "closed", nil, []string{"wontfix"})},
+		},
+	}
+
+	var buf bytes.Buffer
+	code, err := watchMoleculeProgress(f, "gt-mol", time.Hour, time.Millisecond, time.Minute, &buf)
+	if err != nil {
+		t.Fatalf("watchMoleculeProgress: %v", err)
+	}
+	if code != 2 {
+		t.Errorf("exit code = %d, want 2", code)
+	}
+}
+
+func TestWatchMoleculeProgress_ExitsThreeOnTimeout(t *testing.T) {
+	f := &fakeProgressBeads{
+		root: &beads.Issue{ID: "gt-mol", Title: "Test Molecule"},
+		snapshots: [][]*beads.Issue{
+			{progressStepIssue("gt-mol.1", "in_progress", nil, nil)},
+		},
+	}
+
+	var buf bytes.Buffer
+	code, err := watchMoleculeProgress(f, "gt-mol", time.Hour, time.Millisecond, 5*time.Millisecond, &buf)
+	if err != nil {
+		t.Fatalf("watchMoleculeProgress: %v", err)
+	}
+	if code != 3 {
+		t.Errorf("exit code = %d, want 3", code)
+	}
+}
+
+func TestWatchMoleculeProgress_PrintsOneLinePerStateChange(t *testing.T) {
+	f := &fakeProgressBeads{
+		root: &beads.Issue{ID: "gt-mol", Title: "Test Molecule"},
+		snapshots: [][]*beads.Issue{
+			{progressStepIssue("gt-mol.1", "in_progress", nil, nil)},
+			{progressStepIssue("gt-mol.1", "in_progress", nil, nil)}, // no change - shouldn't print again
+			{progressStepIssue("gt-mol.1", "closed", nil, nil)},
+		},
+	}
+
+	var buf bytes.Buffer
+	if _, err := watchMoleculeProgress(f, "gt-mol", time.Hour, time.Millisecond, time.Minute, &buf); err != nil {
+		t.Fatalf("watchMoleculeProgress: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2 (one per distinct state, repeated state suppressed):\n%s", len(lines), buf.String())
+	}
+	if !strings.Contains(lines[1], "complete") {
+		t.Errorf("final line = %q, want it to mention completion", lines[1])
+	}
+}
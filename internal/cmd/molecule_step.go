@@ -109,7 +109,7 @@ func runMoleculeStepDone(cmd *cobra.Command, args []string) error {
 		fmt.Printf("[dry-run] Would close step: %s\n", stepID)
 		result.StepClosed = true
 	} else {
-		if err := b.Close(stepID); err != nil {
+		if err := b.Close(stepID, ""); err != nil {
 			return fmt.Errorf("closing step: %w", err)
 		}
 		result.StepClosed = true
@@ -186,7 +186,8 @@ func extractMoleculeIDFromStep(stepID string) string {
 	return stepID[:lastDot]
 }
 
-// findNextReadyStep finds the next ready step in a molecule.
+// findNextReadyStep finds the next ready step in a molecule, honoring phase
+// gating (see beads.ReadySteps) on top of plain Needs dependencies.
 // Returns (nextStep, allComplete, error).
 // If all steps are complete, returns (nil, true, nil).
 // If no steps are ready but some are blocked/in_progress, returns (nil, false, nil).
@@ -205,48 +206,28 @@ func findNextReadyStep(b *beads.Beads, moleculeID string) (*beads.Issue, bool, e
 		return nil, true, nil // No steps = complete
 	}
 
-	// Build set of closed step IDs and collect open steps
-	// Note: "open" means not started. "in_progress" means someone's working on it.
-	// We only consider "open" steps as candidates for the next step.
-	closedIDs := make(map[string]bool)
-	var openSteps []*beads.Issue
-	hasNonClosedSteps := false
-
+	// Check if all complete
+	allClosed := true
 	for _, child := range children {
-		switch child.Status {
-		case "closed":
-			closedIDs[child.ID] = true
-		case "open":
-			openSteps = append(openSteps, child)
-			hasNonClosedSteps = true
-		default:
-			// in_progress or other status - not closed, not available
-			hasNonClosedSteps = true
+		if child.Status != "closed" {
+			allClosed = false
+			break
 		}
 	}
-
-	// Check if all complete
-	if !hasNonClosedSteps {
+	if allClosed {
 		return nil, true, nil
 	}
 
-	// Find ready steps (open steps with all dependencies closed)
-	for _, step := range openSteps {
-		allDepsClosed := true
-		for _, depID := range step.DependsOn {
-			if !closedIDs[depID] {
-				allDepsClosed = false
-				break
-			}
-		}
-
-		if len(step.DependsOn) == 0 || allDepsClosed {
-			return step, false, nil
-		}
+	ready, err := b.ReadySteps(moleculeID)
+	if err != nil {
+		return nil, false, fmt.Errorf("finding ready steps: %w", err)
+	}
+	if len(ready) == 0 {
+		// No ready steps (all blocked, in_progress, or phase-gated)
+		return nil, false, nil
 	}
 
-	// No ready steps (all blocked or in_progress)
-	return nil, false, nil
+	return ready[0], false, nil
 }
 
 // handleStepContinue handles continuing to the next step.
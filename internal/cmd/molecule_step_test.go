@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"testing"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/beads"
 )
@@ -117,15 +118,12 @@ func (m *mockBeadsForStep) List(opts beads.ListOptions) ([]*beads.Issue, error)
 	return result, nil
 }
 
-func (m *mockBeadsForStep) Close(ids ...string) error {
-	for _, id := range ids {
-		if issue, ok := m.issues[id]; ok {
-			issue.Status = "closed"
-		} else {
-			return beads.ErrNotFound
-		}
+func (m *mockBeadsForStep) Close(id, reason string) error {
+	if issue, ok := m.issues[id]; ok {
+		issue.Status = "closed"
+		return nil
 	}
-	return nil
+	return beads.ErrNotFound
 }
 
 // makeStepIssue creates a test step issue
@@ -138,8 +136,8 @@ func makeStepIssue(id, title, parent, status string, dependsOn []string) *beads.
 		Priority:  2,
 		Parent:    parent,
 		DependsOn: dependsOn,
-		CreatedAt: "2025-01-01T12:00:00Z",
-		UpdatedAt: "2025-01-01T12:00:00Z",
+		CreatedAt: beads.Timestamp{Time: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)},
+		UpdatedAt: beads.Timestamp{Time: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)},
 	}
 }
 
@@ -368,7 +366,7 @@ func TestStepDoneScenarios(t *testing.T) {
 			}
 
 			// Simulate closing the step
-			if err := m.Close(tt.stepID); err != nil {
+			if err := m.Close(tt.stepID, ""); err != nil {
 				t.Fatalf("failed to close step: %v", err)
 			}
 
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/refinery"
@@ -158,6 +159,21 @@ Examples:
 	RunE: runMQReject,
 }
 
+var mqUnrejectCmd = &cobra.Command{
+	Use:   "unreject <rig> <branch>",
+	Short: "Lift a manual rejection on a branch",
+	Long: `Lift an active rejection so the branch can be resubmitted to the merge queue.
+
+'gt mq reject' blocks a branch from being resubmitted as a fresh MR until its
+rejection expires (see merge_queue.rejection_expiry_days in rig settings,
+default 7 days). Use this once the worker has addressed the rejection reason.
+
+Examples:
+  gt mq unreject greenplace polecat/Nux/gp-xyz`,
+	Args: cobra.ExactArgs(2),
+	RunE: runMQUnreject,
+}
+
 var mqStatusCmd = &cobra.Command{
 	Use:   "status <id>",
 	Short: "Show detailed merge request status",
@@ -300,6 +316,7 @@ func init() {
 	mqCmd.AddCommand(mqRetryCmd)
 	mqCmd.AddCommand(mqListCmd)
 	mqCmd.AddCommand(mqRejectCmd)
+	mqCmd.AddCommand(mqUnrejectCmd)
 	mqCmd.AddCommand(mqStatusCmd)
 
 	// Integration branch subcommands
@@ -321,6 +338,19 @@ func init() {
 	rootCmd.AddCommand(mqCmd)
 }
 
+// rejectionExpiryDays returns the configured number of days a manual MR
+// rejection stays active before FindActiveRejection stops blocking
+// resubmission, falling back to config.DefaultMergeQueueConfig()'s value
+// when the rig has no merge_queue settings.
+func rejectionExpiryDays(townRoot, rigName string) int {
+	settingsPath := filepath.Join(townRoot, rigName, "settings", "config.json")
+	settings, err := config.LoadRigSettings(settingsPath)
+	if err != nil || settings.MergeQueue == nil || settings.MergeQueue.RejectionExpiryDays <= 0 {
+		return config.DefaultMergeQueueConfig().RejectionExpiryDays
+	}
+	return settings.MergeQueue.RejectionExpiryDays
+}
+
 // findCurrentRig determines the current rig from the working directory.
 // Returns the rig name and rig object, or an error if not in a rig.
 func findCurrentRig(townRoot string) (string, *rig.Rig, error) {
@@ -432,3 +462,29 @@ func runMQReject(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func runMQUnreject(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
+	branch := args[1]
+
+	townRoot, r, err := getRig(rigName)
+	if err != nil {
+		return err
+	}
+
+	bd := beads.New(r.BeadsPath())
+	issue, err := bd.UnrejectMR(branch, rejectionExpiryDays(townRoot, rigName))
+	if err != nil {
+		return fmt.Errorf("lifting rejection: %w", err)
+	}
+	if issue == nil {
+		fmt.Printf("%s No active rejection found for %s\n", style.Dim.Render("-"), branch)
+		return nil
+	}
+
+	fmt.Printf("%s Rejection lifted: %s\n", style.Bold.Render("✓"), branch)
+	fmt.Printf("  MR:  %s\n", issue.ID)
+	fmt.Printf("  %s\n", style.Dim.Render("Branch can now be resubmitted with 'gt done' or 'gt mq submit'"))
+
+	return nil
+}
@@ -503,7 +503,7 @@ func runMqIntegrationLand(cmd *cobra.Command, args []string) error {
 
 	// 8. Update epic status
 	fmt.Printf("Updating epic status...\n")
-	if err := bd.Close(epicID); err != nil {
+	if err := bd.Close(epicID, ""); err != nil {
 		fmt.Printf("  %s\n", style.Dim.Render(fmt.Sprintf("(could not close epic: %v)", err)))
 	} else {
 		fmt.Printf("  %s Epic closed\n", style.Bold.Render("✓"))
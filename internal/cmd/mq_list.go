@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
-	"os"
 	"sort"
 	"strings"
 	"time"
@@ -211,7 +209,7 @@ func runMQList(cmd *cobra.Command, args []string) error {
 		scoreStr := fmt.Sprintf("%.1f", item.score)
 
 		// Calculate age
-		age := formatMRAge(issue.CreatedAt)
+		age := formatMRAge(issue.CreatedAt.Time)
 
 		// Truncate ID if needed
 		displayID := issue.ID
@@ -245,17 +243,12 @@ func runMQList(cmd *cobra.Command, args []string) error {
 }
 
 // formatMRAge formats the age of an MR from its created_at timestamp.
-func formatMRAge(createdAt string) string {
-	t, err := time.Parse(time.RFC3339, createdAt)
-	if err != nil {
-		// Try other formats
-		t, err = time.Parse("2006-01-02T15:04:05Z", createdAt)
-		if err != nil {
-			return "?"
-		}
+func formatMRAge(createdAt time.Time) string {
+	if createdAt.IsZero() {
+		return "?"
 	}
 
-	d := time.Since(t)
+	d := time.Since(createdAt)
 
 	if d < time.Minute {
 		return fmt.Sprintf("%ds", int(d.Seconds()))
@@ -269,23 +262,13 @@ func formatMRAge(createdAt string) string {
 	return fmt.Sprintf("%dd", int(d.Hours()/24))
 }
 
-// outputJSON outputs data as JSON.
-func outputJSON(data interface{}) error {
-	enc := json.NewEncoder(os.Stdout)
-	enc.SetIndent("", "  ")
-	return enc.Encode(data)
-}
-
 // calculateMRScore computes the priority score for an MR using the refinery scoring function.
 // Higher scores mean higher priority (process first).
 func calculateMRScore(issue *beads.Issue, fields *beads.MRFields, now time.Time) float64 {
 	// Parse MR creation time
-	mrCreatedAt, err := time.Parse(time.RFC3339, issue.CreatedAt)
-	if err != nil {
-		mrCreatedAt, err = time.Parse("2006-01-02T15:04:05Z", issue.CreatedAt)
-		if err != nil {
-			mrCreatedAt = now // Fallback to now if parsing fails
-		}
+	mrCreatedAt := issue.CreatedAt.Time
+	if mrCreatedAt.IsZero() {
+		mrCreatedAt = now // Fallback to now if bd didn't report a created_at
 	}
 
 	// Build score input
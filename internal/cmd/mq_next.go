@@ -96,9 +96,7 @@ func runMQNext(cmd *cobra.Command, args []string) error {
 	if mqNextStrategy == "fifo" {
 		// FIFO: oldest first by creation time
 		sort.Slice(ready, func(i, j int) bool {
-			ti, _ := time.Parse(time.RFC3339, ready[i].CreatedAt)
-			tj, _ := time.Parse(time.RFC3339, ready[j].CreatedAt)
-			return ti.Before(tj)
+			return ready[i].CreatedAt.Before(ready[j].CreatedAt.Time)
 		})
 	} else {
 		// Priority: highest score first
@@ -161,7 +159,7 @@ func runMQNext(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	fmt.Printf("  Age:      %s\n", formatMRAge(next.CreatedAt))
+	fmt.Printf("  Age:      %s\n", formatMRAge(next.CreatedAt.Time))
 
 	if len(ready) > 1 {
 		fmt.Printf("\n  %s\n", style.Dim.Render(fmt.Sprintf("(%d more in queue)", len(ready)-1)))
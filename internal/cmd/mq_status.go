@@ -81,9 +81,9 @@ func runMqStatus(cmd *cobra.Command, args []string) error {
 		Priority:  issue.Priority,
 		Type:      issue.Type,
 		Assignee:  issue.Assignee,
-		CreatedAt: issue.CreatedAt,
-		UpdatedAt: issue.UpdatedAt,
-		ClosedAt:  issue.ClosedAt,
+		CreatedAt: formatTimestampField(issue.CreatedAt),
+		UpdatedAt: formatTimestampField(issue.UpdatedAt),
+		ClosedAt:  formatTimestampField(issue.ClosedAt),
 	}
 
 	// Add MR fields if present
@@ -130,6 +130,14 @@ func runMqStatus(cmd *cobra.Command, args []string) error {
 	return printMqStatus(issue, mrFields)
 }
 
+// formatTimestampField renders a beads.Timestamp as RFC3339, or empty if unset.
+func formatTimestampField(t beads.Timestamp) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
 // printMqStatus prints detailed MR status in human-readable format.
 func printMqStatus(issue *beads.Issue, mrFields *beads.MRFields) error {
 	// Header
@@ -150,14 +158,14 @@ func printMqStatus(issue *beads.Issue, mrFields *beads.MRFields) error {
 
 	// Timestamps
 	fmt.Printf("\n%s\n", style.Bold.Render("Timeline"))
-	if issue.CreatedAt != "" {
-		fmt.Printf("   Created: %s %s\n", issue.CreatedAt, formatTimeAgo(issue.CreatedAt))
+	if !issue.CreatedAt.IsZero() {
+		fmt.Printf("   Created: %s %s\n", issue.CreatedAt.Format(time.RFC3339), formatTimeAgo(issue.CreatedAt.Time))
 	}
-	if issue.UpdatedAt != "" && issue.UpdatedAt != issue.CreatedAt {
-		fmt.Printf("   Updated: %s %s\n", issue.UpdatedAt, formatTimeAgo(issue.UpdatedAt))
+	if !issue.UpdatedAt.IsZero() && !issue.UpdatedAt.Time.Equal(issue.CreatedAt.Time) {
+		fmt.Printf("   Updated: %s %s\n", issue.UpdatedAt.Format(time.RFC3339), formatTimeAgo(issue.UpdatedAt.Time))
 	}
-	if issue.ClosedAt != "" {
-		fmt.Printf("   Closed:  %s %s\n", issue.ClosedAt, formatTimeAgo(issue.ClosedAt))
+	if !issue.ClosedAt.IsZero() {
+		fmt.Printf("   Closed:  %s %s\n", issue.ClosedAt.Format(time.RFC3339), formatTimeAgo(issue.ClosedAt.Time))
 	}
 
 	// MR-specific fields
@@ -254,25 +262,8 @@ func getStatusIcon(status string) string {
 }
 
 // formatTimeAgo formats a timestamp as a relative time string.
-func formatTimeAgo(timestamp string) string {
-	// Try parsing common formats
-	formats := []string{
-		time.RFC3339,
-		"2006-01-02T15:04:05Z",
-		"2006-01-02T15:04:05",
-		"2006-01-02 15:04:05",
-		"2006-01-02",
-	}
-
-	var t time.Time
-	var err error
-	for _, format := range formats {
-		t, err = time.Parse(format, timestamp)
-		if err == nil {
-			break
-		}
-	}
-	if err != nil {
+func formatTimeAgo(t time.Time) string {
+	if t.IsZero() {
 		return "" // Can't parse, return empty
 	}
 
@@ -281,18 +272,7 @@ func formatTimeAgo(timestamp string) string {
 		return style.Dim.Render("(in the future)")
 	}
 
-	var ago string
-	if d < time.Minute {
-		ago = fmt.Sprintf("%ds ago", int(d.Seconds()))
-	} else if d < time.Hour {
-		ago = fmt.Sprintf("%dm ago", int(d.Minutes()))
-	} else if d < 24*time.Hour {
-		ago = fmt.Sprintf("%dh ago", int(d.Hours()))
-	} else {
-		ago = fmt.Sprintf("%dd ago", int(d.Hours()/24))
-	}
-
-	return style.Dim.Render("(" + ago + ")")
+	return style.Dim.Render("(" + beads.HumanizeAge(d) + ")")
 }
 
 // truncateString truncates a string to maxLen, adding "..." if truncated.
@@ -179,6 +179,14 @@ func runMqSubmit(cmd *cobra.Command, args []string) error {
 		mrIssue = existingMR
 		fmt.Printf("%s MR already exists (idempotent)\n", style.Bold.Render("✓"))
 	} else {
+		// Refuse to resubmit a branch under an active manual rejection - the
+		// worker needs to address the rejection reason (or someone needs to
+		// run `gt mq unreject`) before it comes back around the merge queue.
+		if rejection, err := bd.FindActiveRejection(branch, rejectionExpiryDays(townRoot, rigName)); err == nil && rejection != nil {
+			fields := beads.ParseMRFields(rejection)
+			return fmt.Errorf("branch %q was rejected (%s): %s\nRun 'gt mq unreject %s' once this is addressed", branch, rejection.ID, fields.RejectReason, branch)
+		}
+
 		// Create MR bead (ephemeral wisp - will be cleaned up after merge)
 		mrIssue, err = bd.Create(beads.CreateOptions{
 			Title:       title,
@@ -322,7 +330,7 @@ Please verify state and execute lifecycle action.
 				fmt.Println(style.Dim.Render("  - Use Ctrl+C to abort and manually exit"))
 			}
 		case <-timeout:
-			fmt.Printf("%s Timeout waiting for polecat retirement\n", style.WarningPrefix)
+			fmt.Printf("%s Timeout waiting for polecat retirement\n", style.WarningPrefix())
 			fmt.Println(style.Dim.Render("  The polecat may have already terminated, or witness is unresponsive."))
 			fmt.Println(style.Dim.Render("  You can verify with: gt polecat status"))
 			return nil // Don't fail the MR submission just because cleanup timed out
@@ -123,31 +123,23 @@ func TestParseBranchName(t *testing.T) {
 func TestFormatMRAge(t *testing.T) {
 	tests := []struct {
 		name      string
-		createdAt string
-		wantOk    bool // just check it doesn't panic/error
+		createdAt time.Time
 	}{
 		{
-			name:      "RFC3339 format",
-			createdAt: "2025-01-01T12:00:00Z",
-			wantOk:    true,
+			name:      "recent",
+			createdAt: time.Now().Add(-5 * time.Minute),
 		},
 		{
-			name:      "alternative format",
-			createdAt: "2025-01-01T12:00:00",
-			wantOk:    true,
-		},
-		{
-			name:      "invalid format",
-			createdAt: "not-a-date",
-			wantOk:    true, // returns "?" for invalid
+			name:      "zero value",
+			createdAt: time.Time{}, // returns "?" for unset
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			result := formatMRAge(tt.createdAt)
-			if tt.wantOk && result == "" {
-				t.Errorf("formatMRAge() returned empty for %s", tt.createdAt)
+			if result == "" {
+				t.Errorf("formatMRAge() returned empty for %v", tt.createdAt)
 			}
 		})
 	}
@@ -301,37 +293,17 @@ func TestGetStatusIcon(t *testing.T) {
 func TestFormatTimeAgo(t *testing.T) {
 	tests := []struct {
 		name      string
-		timestamp string
+		timestamp time.Time
 		wantEmpty bool
 	}{
 		{
-			name:      "RFC3339 format",
-			timestamp: "2025-01-01T12:00:00Z",
-			wantEmpty: false,
-		},
-		{
-			name:      "RFC3339 with timezone",
-			timestamp: "2025-01-01T12:00:00-08:00",
-			wantEmpty: false,
-		},
-		{
-			name:      "date only format",
-			timestamp: "2025-01-01",
-			wantEmpty: false,
-		},
-		{
-			name:      "datetime without Z",
-			timestamp: "2025-01-01T12:00:00",
+			name:      "recent time",
+			timestamp: time.Now().Add(-time.Hour),
 			wantEmpty: false,
 		},
 		{
-			name:      "invalid format returns empty",
-			timestamp: "not-a-date",
-			wantEmpty: true,
-		},
-		{
-			name:      "empty string returns empty",
-			timestamp: "",
+			name:      "zero value returns empty",
+			timestamp: time.Time{},
 			wantEmpty: true,
 		},
 	}
@@ -340,10 +312,10 @@ func TestFormatTimeAgo(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			got := formatTimeAgo(tt.timestamp)
 			if tt.wantEmpty && got != "" {
-				t.Errorf("formatTimeAgo(%q) = %q, want empty", tt.timestamp, got)
+				t.Errorf("formatTimeAgo(%v) = %q, want empty", tt.timestamp, got)
 			}
 			if !tt.wantEmpty && got == "" {
-				t.Errorf("formatTimeAgo(%q) returned empty, want non-empty", tt.timestamp)
+				t.Errorf("formatTimeAgo(%v) returned empty, want non-empty", tt.timestamp)
 			}
 		})
 	}
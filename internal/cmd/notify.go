@@ -3,9 +3,12 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/notify"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -34,10 +37,82 @@ Related: gt dnd - quick toggle for DND mode`,
 	RunE: runNotify,
 }
 
+var notifyTestRig string
+
+var notifyTestCmd = &cobra.Command{
+	Use:   "test <event-type>",
+	Short: "Fire a synthetic event through the notification rules",
+	Long: `Fire a synthetic event of the given type and run every notification rule
+that matches it (see "notifications" in mayor/config.json or a rig's
+settings/config.json), printing whether each rule's command succeeded.
+Unlike a real event, this waits for the commands to finish and ignores
+the normal per-command rate limit, so it's safe to use repeatedly while
+testing a rule.
+
+Examples:
+  gt notify test merged
+  gt notify test merge_failed --rig gastown`,
+	Args: cobra.ExactArgs(1),
+	RunE: runNotifyTest,
+}
+
 func init() {
+	// Wire the events package's notification hook to this subsystem. This
+	// lives here (rather than in internal/events) so events doesn't need
+	// to depend on notify's config-loading and exec machinery.
+	events.Notify = notify.Dispatch
+
+	notifyTestCmd.Flags().StringVar(&notifyTestRig, "rig", "", "Also apply this rig's notification rules")
+	notifyCmd.AddCommand(notifyTestCmd)
 	rootCmd.AddCommand(notifyCmd)
 }
 
+func runNotifyTest(cmd *cobra.Command, args []string) error {
+	eventType := args[0]
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	rules, err := notify.LoadRules(townRoot, notifyTestRig)
+	if err != nil {
+		return fmt.Errorf("loading notification rules: %w", err)
+	}
+
+	payload := map[string]interface{}{"synthetic": true}
+	if notifyTestRig != "" {
+		payload["rig"] = notifyTestRig
+	}
+	event := events.Event{
+		Timestamp:  time.Now().UTC().Format(time.RFC3339),
+		Source:     "gt",
+		Type:       eventType,
+		Actor:      "notify-test",
+		Payload:    payload,
+		Visibility: events.VisibilityAudit,
+	}
+
+	results, err := notify.NewDispatcher().FireAndWait(rules, event)
+	if err != nil {
+		return fmt.Errorf("firing test event: %w", err)
+	}
+
+	if len(results) == 0 {
+		fmt.Printf("No notification rule matches event type %q\n", eventType)
+		return nil
+	}
+
+	for _, r := range results {
+		if r.Error != nil {
+			fmt.Printf("FAIL  %s: %v\n", r.Command, r.Error)
+		} else {
+			fmt.Printf("OK    %s\n", r.Command)
+		}
+	}
+	return nil
+}
+
 func runNotify(cmd *cobra.Command, args []string) error {
 	// Get current agent bead ID
 	cwd, err := os.Getwd()
@@ -96,7 +171,7 @@ func runNotify(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("setting notification level: %w", err)
 	}
 
-	fmt.Printf("%s Notification level set to %s\n", style.SuccessPrefix, style.Bold.Render(newLevel))
+	fmt.Printf("%s Notification level set to %s\n", style.SuccessPrefix(), style.Bold.Render(newLevel))
 	showNotificationLevelDescription(newLevel)
 
 	return nil
@@ -305,7 +305,7 @@ func runNudgeChannel(channelName, message string) error {
 	}
 
 	if len(targets) == 0 {
-		fmt.Printf("%s No sessions match channel %q patterns\n", style.WarningPrefix, channelName)
+		fmt.Printf("%s No sessions match channel %q patterns\n", style.WarningPrefix(), channelName)
 		return nil
 	}
 
@@ -320,10 +320,10 @@ func runNudgeChannel(channelName, message string) error {
 		if err := t.NudgeSession(sessionName, prefixedMessage); err != nil {
 			failed++
 			failures = append(failures, fmt.Sprintf("%s: %v", sessionName, err))
-			fmt.Printf("  %s %s\n", style.ErrorPrefix, sessionName)
+			fmt.Printf("  %s %s\n", style.ErrorPrefix(), sessionName)
 		} else {
 			succeeded++
-			fmt.Printf("  %s %s\n", style.SuccessPrefix, sessionName)
+			fmt.Printf("  %s %s\n", style.SuccessPrefix(), sessionName)
 		}
 
 		// Small delay between nudges
@@ -339,14 +339,14 @@ func runNudgeChannel(channelName, message string) error {
 
 	if failed > 0 {
 		fmt.Printf("%s Channel nudge complete: %d succeeded, %d failed\n",
-			style.WarningPrefix, succeeded, failed)
+			style.WarningPrefix(), succeeded, failed)
 		for _, f := range failures {
 			fmt.Printf("  %s\n", style.Dim.Render(f))
 		}
 		return fmt.Errorf("%d nudge(s) failed", failed)
 	}
 
-	fmt.Printf("%s Channel nudge complete: %d target(s) nudged\n", style.SuccessPrefix, succeeded)
+	fmt.Printf("%s Channel nudge complete: %d target(s) nudged\n", style.SuccessPrefix(), succeeded)
 	return nil
 }
 
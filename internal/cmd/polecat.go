@@ -246,6 +246,26 @@ Examples:
 	RunE: runPolecatCheckRecovery,
 }
 
+var polecatHistoryJSON bool
+
+var polecatHistoryCmd = &cobra.Command{
+	Use:   "history <rig>/<polecat>",
+	Short: "Show recorded agent_state transitions for a polecat",
+	Long: `Show the transition-history log recorded on a polecat's agent bead.
+
+Every agent_state change made through UpdateAgentState, plus reopens and
+closes, appends a timestamped "old -> new" entry to the agent bead's
+description. The log is capped at the most recent 20 transitions, so this
+can't answer "what happened a month ago" but is enough to reconstruct
+"when did this polecat go idle and why" for a recent incident.
+
+Examples:
+  gt polecat history greenplace/Toast
+  gt polecat history greenplace/Toast --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPolecatHistory,
+}
+
 var (
 	polecatStaleJSON      bool
 	polecatStaleThreshold int
@@ -278,6 +298,19 @@ Examples:
 }
 
 func init() {
+	// Completions
+	polecatListCmd.ValidArgsFunction = completeRigArg
+	polecatAddCmd.ValidArgsFunction = completeRigArg
+	polecatGCCmd.ValidArgsFunction = completeRigArg
+	polecatStaleCmd.ValidArgsFunction = completeRigArg
+	polecatRemoveCmd.ValidArgsFunction = completeRigSlashAgent
+	polecatSyncCmd.ValidArgsFunction = completeRigSlashAgent
+	polecatStatusCmd.ValidArgsFunction = completeRigSlashAgent
+	polecatNukeCmd.ValidArgsFunction = completeRigSlashAgent
+	polecatGitStateCmd.ValidArgsFunction = completeRigSlashAgent
+	polecatCheckRecoveryCmd.ValidArgsFunction = completeRigSlashAgent
+	polecatHistoryCmd.ValidArgsFunction = completeRigSlashAgent
+
 	// List flags
 	polecatListCmd.Flags().BoolVar(&polecatListJSON, "json", false, "Output as JSON")
 	polecatListCmd.Flags().BoolVar(&polecatListAll, "all", false, "List polecats in all rigs")
@@ -307,6 +340,9 @@ func init() {
 	// Check-recovery flags
 	polecatCheckRecoveryCmd.Flags().BoolVar(&polecatCheckRecoveryJSON, "json", false, "Output as JSON")
 
+	// History flags
+	polecatHistoryCmd.Flags().BoolVar(&polecatHistoryJSON, "json", false, "Output as JSON")
+
 	// Stale flags
 	polecatStaleCmd.Flags().BoolVar(&polecatStaleJSON, "json", false, "Output as JSON")
 	polecatStaleCmd.Flags().IntVar(&polecatStaleThreshold, "threshold", 20, "Commits behind main to consider stale")
@@ -320,6 +356,7 @@ func init() {
 	polecatCmd.AddCommand(polecatStatusCmd)
 	polecatCmd.AddCommand(polecatGitStateCmd)
 	polecatCmd.AddCommand(polecatCheckRecoveryCmd)
+	polecatCmd.AddCommand(polecatHistoryCmd)
 	polecatCmd.AddCommand(polecatGCCmd)
 	polecatCmd.AddCommand(polecatNukeCmd)
 	polecatCmd.AddCommand(polecatStaleCmd)
@@ -333,6 +370,7 @@ type PolecatListItem struct {
 	Name           string        `json:"name"`
 	State          polecat.State `json:"state"`
 	Issue          string        `json:"issue,omitempty"`
+	AssignedAt     time.Time     `json:"assigned_at,omitempty"`
 	SessionRunning bool          `json:"session_running"`
 }
 
@@ -394,6 +432,7 @@ func runPolecatList(cmd *cobra.Command, args []string) error {
 				Name:           p.Name,
 				State:          p.State,
 				Issue:          p.Issue,
+				AssignedAt:     p.AssignedAt,
 				SessionRunning: running,
 			})
 		}
@@ -437,7 +476,11 @@ func runPolecatList(cmd *cobra.Command, args []string) error {
 
 		fmt.Printf("  %s %s/%s  %s\n", sessionStatus, p.Rig, p.Name, stateStr)
 		if p.Issue != "" {
-			fmt.Printf("    %s\n", style.Dim.Render(p.Issue))
+			issueLine := p.Issue
+			if !p.AssignedAt.IsZero() {
+				issueLine += fmt.Sprintf(" (%s)", formatDuration(time.Since(p.AssignedAt)))
+			}
+			fmt.Printf("    %s\n", style.Dim.Render(issueLine))
 		}
 	}
 
@@ -465,7 +508,7 @@ func runPolecatAdd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("adding polecat: %w", err)
 	}
 
-	fmt.Printf("%s Polecat %s added.\n", style.SuccessPrefix, p.Name)
+	fmt.Printf("%s Polecat %s added.\n", style.SuccessPrefix(), p.Name)
 	fmt.Printf("  %s\n", style.Dim.Render(p.ClonePath))
 	fmt.Printf("  Branch: %s\n", style.Dim.Render(p.Branch))
 
@@ -523,7 +566,7 @@ func runPolecatRemove(cmd *cobra.Command, args []string) error {
 	}
 
 	if removed > 0 {
-		fmt.Printf("\n%s Removed %d polecat(s).\n", style.SuccessPrefix, removed)
+		fmt.Printf("\n%s Removed %d polecat(s).\n", style.SuccessPrefix(), removed)
 	}
 
 	if len(removeErrors) > 0 {
@@ -1052,6 +1095,73 @@ func runPolecatCheckRecovery(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// AgentHistoryEntryOut is the JSON shape for one entry from `gt polecat history`.
+type AgentHistoryEntryOut struct {
+	At    string `json:"at"`
+	From  string `json:"from,omitempty"`
+	To    string `json:"to"`
+	Actor string `json:"actor,omitempty"`
+}
+
+func runPolecatHistory(cmd *cobra.Command, args []string) error {
+	rigName, polecatName, err := parseAddress(args[0])
+	if err != nil {
+		return err
+	}
+
+	_, r, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	bd := beads.New(r.Path)
+	agentBeadID := polecatBeadIDForRig(r, rigName, polecatName)
+	issue, _, err := bd.GetAgentBead(agentBeadID)
+	if err != nil {
+		return fmt.Errorf("reading agent bead %s: %w", agentBeadID, err)
+	}
+	if issue == nil {
+		return fmt.Errorf("no agent bead found for %s/%s", rigName, polecatName)
+	}
+
+	entries := beads.ParseAgentHistory(issue.Description)
+
+	if polecatHistoryJSON {
+		out := make([]AgentHistoryEntryOut, len(entries))
+		for i, e := range entries {
+			out[i] = AgentHistoryEntryOut{
+				At:    e.At.Format(time.RFC3339),
+				From:  e.From,
+				To:    e.To,
+				Actor: e.Actor,
+			}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	}
+
+	fmt.Printf("%s\n\n", style.Bold.Render(fmt.Sprintf("History: %s/%s", rigName, polecatName)))
+	if len(entries) == 0 {
+		fmt.Println(style.Dim.Render("  (no recorded transitions)"))
+		return nil
+	}
+	for _, e := range entries {
+		from := e.From
+		if from == "" {
+			from = "-"
+		}
+		actor := e.Actor
+		if actor == "" {
+			actor = style.Dim.Render("(unknown actor)")
+		}
+		fmt.Printf("  %s  %s -> %s  %s\n",
+			e.At.Local().Format("2006-01-02 15:04:05"), from, e.To, actor)
+	}
+
+	return nil
+}
+
 func runPolecatGC(cmd *cobra.Command, args []string) error {
 	rigName := args[0]
 
@@ -1112,7 +1222,7 @@ func runPolecatGC(cmd *cobra.Command, args []string) error {
 	if deleted == 0 {
 		fmt.Println("No stale branches to clean up.")
 	} else {
-		fmt.Printf("%s Deleted %d stale branch(es).\n", style.SuccessPrefix, deleted)
+		fmt.Printf("%s Deleted %d stale branch(es).\n", style.SuccessPrefix(), deleted)
 	}
 
 	return nil
@@ -1266,7 +1376,7 @@ func runPolecatNuke(cmd *cobra.Command, args []string) error {
 	}
 
 	if nuked > 0 {
-		fmt.Printf("\n%s Nuked %d polecat(s).\n", style.SuccessPrefix, nuked)
+		fmt.Printf("\n%s Nuked %d polecat(s).\n", style.SuccessPrefix(), nuked)
 	}
 
 	// Final cleanup: Kill any orphaned Claude processes that escaped the session termination.
@@ -1421,7 +1531,7 @@ func runPolecatStale(cmd *cobra.Command, args []string) error {
 					nuked++
 				}
 			}
-			fmt.Printf("\n%s Nuked %d stale polecat(s).\n", style.SuccessPrefix, nuked)
+			fmt.Printf("\n%s Nuked %d stale polecat(s).\n", style.SuccessPrefix(), nuked)
 		}
 	}
 
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+// setupCleanupTestRig creates a bare-ish rig on disk with a mayor/rig clone
+// (used as origin) and returns the rig plus a helper to add a polecat.
+// If merge is true, the polecat's commits are folded back into origin's
+// main (simulating a landed PR) so it's no longer ahead; otherwise the
+// commits are left on the polecat branch only.
+func setupCleanupTestRig(t *testing.T) (*rig.Rig, func(name string, merge bool) *polecat.Polecat) {
+	t.Helper()
+
+	root := t.TempDir()
+	mayorRig := filepath.Join(root, "mayor", "rig")
+	if err := os.MkdirAll(mayorRig, 0755); err != nil {
+		t.Fatalf("mkdir mayor/rig: %v", err)
+	}
+
+	run := func(dir string, args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@test.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@test.com")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	run(mayorRig, "init", "-b", "main")
+	if err := os.WriteFile(filepath.Join(mayorRig, "README.md"), []byte("# test\n"), 0644); err != nil {
+		t.Fatalf("write README.md: %v", err)
+	}
+	run(mayorRig, "add", "README.md")
+	run(mayorRig, "commit", "-m", "initial commit")
+	run(mayorRig, "remote", "add", "origin", mayorRig)
+	run(mayorRig, "update-ref", "refs/remotes/origin/main", "HEAD")
+
+	r := &rig.Rig{Name: "test-rig", Path: root}
+	mgr := polecat.NewManager(r, git.NewGit(root), nil)
+
+	addPolecat := func(name string, merge bool) *polecat.Polecat {
+		t.Helper()
+		p, err := mgr.AddWithOptions(name, polecat.AddOptions{})
+		if err != nil {
+			t.Fatalf("AddWithOptions(%q): %v", name, err)
+		}
+		// Real polecat branches have no upstream tracking configured (see
+		// Git.UnpushedCommits), but this single-repo test fixture ends up
+		// tracking origin/main. Unset it so "ahead" only comes from
+		// CommitsAhead against origin's default branch, like production.
+		run(p.ClonePath, "branch", "--unset-upstream")
+		// AddWithOptions leaves behind untracked setup files (.gastown,
+		// .gitignore) since there's no real beads DB in this test fixture;
+		// commit them so the worktree is clean and the branch's commits
+		// reflect only what the test itself adds.
+		run(p.ClonePath, "add", "-A")
+		run(p.ClonePath, "commit", "-m", "polecat setup")
+
+		if merge {
+			// Land the branch on origin's main, as if the PR had merged,
+			// then update the origin/main tracking ref to match.
+			run(mayorRig, "merge", "--no-ff", "-m", "merge "+name, p.Branch)
+			run(mayorRig, "update-ref", "refs/remotes/origin/main", "HEAD")
+		}
+		return p
+	}
+
+	return r, addPolecat
+}
+
+func TestPlanPolecatCleanup_UnmergedBranchIsKept(t *testing.T) {
+	r, addPolecat := setupCleanupTestRig(t)
+	merged := addPolecat("Merged", true)
+	unmerged := addPolecat("Unmerged", false)
+
+	items := planPolecatCleanup([]*rig.Rig{r}, false)
+
+	byName := map[string]polecatCleanupItem{}
+	for _, item := range items {
+		byName[item.polecat.Name] = item
+	}
+
+	if got := byName[merged.Name]; got.action != cleanupWillRemove {
+		t.Errorf("merged polecat action = %q, want %q", got.action, cleanupWillRemove)
+	}
+	unmergedItem, ok := byName[unmerged.Name]
+	if !ok {
+		t.Fatalf("expected an item for %q", unmerged.Name)
+	}
+	if unmergedItem.action != cleanupKeepUnmerged {
+		t.Errorf("unmerged polecat action = %q, want %q", unmergedItem.action, cleanupKeepUnmerged)
+	}
+	if unmergedItem.ahead < 1 {
+		t.Errorf("unmerged polecat ahead = %d, want >= 1", unmergedItem.ahead)
+	}
+}
+
+func TestPlanPolecatCleanup_NuclearRemovesUnmergedToo(t *testing.T) {
+	r, addPolecat := setupCleanupTestRig(t)
+	unmerged := addPolecat("Unmerged", false)
+
+	items := planPolecatCleanup([]*rig.Rig{r}, true)
+
+	if len(items) != 1 || items[0].polecat.Name != unmerged.Name {
+		t.Fatalf("unexpected items: %+v", items)
+	}
+	if items[0].action != cleanupWillRemove {
+		t.Errorf("nuclear mode action = %q, want %q", items[0].action, cleanupWillRemove)
+	}
+}
+
+func TestExecutePolecatCleanup_RemovesWorktreeAndBranch(t *testing.T) {
+	r, addPolecat := setupCleanupTestRig(t)
+	merged := addPolecat("Merged", true)
+
+	items := planPolecatCleanup([]*rig.Rig{r}, false)
+	if len(items) != 1 || items[0].action != cleanupWillRemove {
+		t.Fatalf("expected one removable item, got %+v", items)
+	}
+
+	cleaned, failed := executePolecatCleanup(items)
+	if cleaned != 1 || failed != 0 {
+		t.Errorf("cleaned=%d failed=%d, want 1/0", cleaned, failed)
+	}
+
+	if _, err := os.Stat(merged.ClonePath); !os.IsNotExist(err) {
+		t.Errorf("expected worktree at %q to be removed", merged.ClonePath)
+	}
+
+	mayorGit := git.NewGit(filepath.Join(r.Path, "mayor", "rig"))
+	exists, err := mayorGit.BranchExists(merged.Branch)
+	if err != nil {
+		t.Fatalf("BranchExists: %v", err)
+	}
+	if exists {
+		t.Errorf("expected branch %q to be deleted", merged.Branch)
+	}
+}
@@ -251,7 +251,7 @@ func runPolecatIdentityAdd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("creating identity bead: %w", err)
 	}
 
-	fmt.Printf("%s Created identity bead: %s\n", style.SuccessPrefix, issue.ID)
+	fmt.Printf("%s Created identity bead: %s\n", style.SuccessPrefix(), issue.ID)
 	fmt.Printf("  Polecat: %s\n", polecatName)
 	fmt.Printf("  Rig:     %s\n", rigName)
 
@@ -430,8 +430,8 @@ func runPolecatIdentityShow(cmd *cobra.Command, args []string) error {
 				SessionRunning: sessionRunning,
 			},
 			Title:     issue.Title,
-			CreatedAt: issue.CreatedAt,
-			UpdatedAt: issue.UpdatedAt,
+			CreatedAt: formatTimestampField(issue.CreatedAt),
+			UpdatedAt: formatTimestampField(issue.UpdatedAt),
 			CV:        cv,
 		}
 		if output.HookBead == "" {
@@ -494,11 +494,11 @@ func runPolecatIdentityShow(cmd *cobra.Command, args []string) error {
 	}
 
 	// Timestamps
-	if issue.CreatedAt != "" {
-		fmt.Printf("  Created:       %s\n", style.Dim.Render(issue.CreatedAt))
+	if !issue.CreatedAt.IsZero() {
+		fmt.Printf("  Created:       %s\n", style.Dim.Render(issue.CreatedAt.Format(time.RFC3339)))
 	}
-	if issue.UpdatedAt != "" {
-		fmt.Printf("  Updated:       %s\n", style.Dim.Render(issue.UpdatedAt))
+	if !issue.UpdatedAt.IsZero() {
+		fmt.Printf("  Updated:       %s\n", style.Dim.Render(issue.UpdatedAt.Format(time.RFC3339)))
 	}
 
 	// CV Summary section with enhanced analytics
@@ -614,7 +614,7 @@ func runPolecatIdentityRename(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("closing old identity bead: %w", err)
 	}
 
-	fmt.Printf("%s Renamed identity:\n", style.SuccessPrefix)
+	fmt.Printf("%s Renamed identity:\n", style.SuccessPrefix())
 	fmt.Printf("  Old: %s\n", oldBeadID)
 	fmt.Printf("  New: %s\n", newBeadID)
 	fmt.Printf("\n%s Note: If a worktree exists for %s, you'll need to recreate it with the new name.\n",
@@ -702,7 +702,7 @@ func runPolecatIdentityRemove(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("closing identity bead: %w", err)
 	}
 
-	fmt.Printf("%s Removed identity bead: %s\n", style.SuccessPrefix, beadID)
+	fmt.Printf("%s Removed identity bead: %s\n", style.SuccessPrefix(), beadID)
 	return nil
 }
 
@@ -727,8 +727,8 @@ func buildCVSummary(rigPath, rigName, polecatName, identityBeadID, clonePath str
 	bd := beads.New(beadsQueryPath)
 	agentBead, _, err := bd.GetAgentBead(identityBeadID)
 	if err == nil && agentBead != nil {
-		if agentBead.CreatedAt != "" && len(agentBead.CreatedAt) >= 10 {
-			cv.Created = agentBead.CreatedAt[:10] // Just the date part
+		if !agentBead.CreatedAt.IsZero() {
+			cv.Created = agentBead.CreatedAt.Format("2006-01-02") // Just the date part
 		}
 	}
 
@@ -6,10 +6,13 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/plugins"
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/style"
@@ -76,6 +79,12 @@ func SpawnPolecatForSling(rigName string, opts SlingSpawnOptions) (*SpawnedPolec
 	}
 	fmt.Printf("Allocated polecat: %s\n", polecatName)
 
+	// Track this spawn's progress under the rig's .runtime dir so a crash
+	// or step failure partway through (e.g. a hooked bead claimed but the
+	// session never starting) can be rolled back instead of leaving
+	// claimed-but-unstarted work behind. See rollbackSpawn.
+	txn := newSpawnTransaction(r.Path, rigName, polecatName, opts.HookBead)
+
 	// Check if polecat already exists (shouldn't happen - indicates stale state needing repair)
 	existingPolecat, err := polecatMgr.Get(polecatName)
 
@@ -91,8 +100,8 @@ func SpawnPolecatForSling(rigName string, opts SlingSpawnOptions) (*SpawnedPolec
 			pGit := git.NewGit(existingPolecat.ClonePath)
 			workStatus, checkErr := pGit.CheckUncommittedWork()
 			if checkErr == nil && !workStatus.Clean() {
-				return nil, fmt.Errorf("polecat '%s' has uncommitted work: %s\nUse --force to proceed anyway",
-					polecatName, workStatus.String())
+				return nil, fmt.Errorf("polecat '%s' has uncommitted work: %s\n%s\nUse --force to proceed anyway",
+					polecatName, workStatus.String(), workStatus.Detail())
 			}
 		}
 		fmt.Printf("Repairing stale polecat %s with fresh worktree...\n", polecatName)
@@ -109,24 +118,44 @@ func SpawnPolecatForSling(rigName string, opts SlingSpawnOptions) (*SpawnedPolec
 		return nil, fmt.Errorf("getting polecat: %w", err)
 	}
 
+	// The polecat record now exists (and, if requested, the hook bead is
+	// pinned to it) - from here on a failure needs to roll that back.
+	if err := txn.record(StepClaimPolecat); err != nil {
+		fmt.Printf("Warning: could not persist spawn transaction: %v\n", err)
+	}
+
 	// Get polecat object for path info
 	polecatObj, err := polecatMgr.Get(polecatName)
 	if err != nil {
-		return nil, fmt.Errorf("getting polecat after creation: %w", err)
+		return nil, rollbackSpawn(txn, fmt.Errorf("getting polecat after creation: %w", err))
 	}
 
-	// Resolve account for runtime config
+	// Resolve account for runtime config. When no account is explicitly
+	// requested, this consults the configured allocation strategy
+	// (round_robin/least_used) using current per-account session counts, so
+	// spawns spread across accounts instead of piling onto the default.
 	accountsPath := constants.MayorAccountsPath(townRoot)
-	claudeConfigDir, accountHandle, err := config.ResolveAccountConfigDir(accountsPath, opts.Account)
+	b := beads.New(r.BeadsPath()).WithActor("mayor")
+	sessionCounts, _ := b.CountSessionsByAccount()
+	claudeConfigDir, accountHandle, err := config.ResolveAccountForSpawn(accountsPath, opts.Account, sessionCounts)
 	if err != nil {
-		return nil, fmt.Errorf("resolving account: %w", err)
+		return nil, rollbackSpawn(txn, fmt.Errorf("resolving account: %w", err))
 	}
 	if accountHandle != "" {
 		fmt.Printf("Using account: %s\n", accountHandle)
+		agentID := beads.AgentBeadID(r.Name, "polecat", polecatName)
+		if err := b.UpdateAgentAccount(agentID, accountHandle); err != nil {
+			fmt.Printf("Warning: could not record account on agent bead: %v\n", err)
+		}
+	}
+	if err := txn.record(StepResolveAccount); err != nil {
+		fmt.Printf("Warning: could not persist spawn transaction: %v\n", err)
 	}
 
 	// Start session (reuse tmux from manager)
 	polecatSessMgr := polecat.NewSessionManager(t, r)
+	sessionName := polecatSessMgr.SessionName(polecatName)
+	txn.SessionName = sessionName
 
 	// Check if already running
 	running, _ := polecatSessMgr.IsRunning(polecatName)
@@ -138,26 +167,62 @@ func SpawnPolecatForSling(rigName string, opts SlingSpawnOptions) (*SpawnedPolec
 		if opts.Agent != "" {
 			cmd, err := config.BuildPolecatStartupCommandWithAgentOverride(rigName, polecatName, r.Path, "", opts.Agent)
 			if err != nil {
-				return nil, err
+				return nil, rollbackSpawn(txn, err)
 			}
 			startOpts.Command = cmd
 		}
 		if err := polecatSessMgr.Start(polecatName, startOpts); err != nil {
-			return nil, fmt.Errorf("starting session: %w", err)
+			return nil, rollbackSpawn(txn, fmt.Errorf("starting session: %w", err))
 		}
 	}
+	if err := txn.record(StepStartSession); err != nil {
+		fmt.Printf("Warning: could not persist spawn transaction: %v\n", err)
+	}
 
 	// Get session name and pane
-	sessionName := polecatSessMgr.SessionName(polecatName)
 	pane, err := getSessionPane(sessionName)
 	if err != nil {
-		return nil, fmt.Errorf("getting pane for %s: %w", sessionName, err)
+		return nil, rollbackSpawn(txn, fmt.Errorf("getting pane for %s: %w", sessionName, err))
 	}
 
 	fmt.Printf("%s Polecat %s spawned\n", style.Bold.Render("✓"), polecatName)
 
+	// Mail the new polecat its hooked work. Best-effort, like the event
+	// logging and on-spawn plugin below: the polecat has already spawned
+	// and can still discover the hook via `gt prime` even if this fails.
+	if opts.HookBead != "" {
+		router := mail.NewRouter(r.Path)
+		msg := &mail.Message{
+			From:    fmt.Sprintf("mayor/%s", rigName),
+			To:      fmt.Sprintf("%s/polecats/%s", rigName, polecatName),
+			Subject: fmt.Sprintf("Assignment: %s", opts.HookBead),
+			Body:    fmt.Sprintf("You've been spawned and hooked to %s.\n\nRun `gt prime` to pick it up.", opts.HookBead),
+			Type:    mail.TypeTask,
+		}
+		if err := router.Send(msg); err != nil {
+			fmt.Printf("Warning: could not send assignment mail: %v\n", err)
+		} else if err := txn.record(StepSendAssignmentMail); err != nil {
+			fmt.Printf("Warning: could not persist spawn transaction: %v\n", err)
+		}
+	}
+
 	// Log spawn event to activity feed
 	_ = events.LogFeed(events.TypeSpawn, "gt", events.SpawnPayload(rigName, polecatName))
+	_ = events.LogFeed(events.TypeAgentSpawned, "gt", events.AgentSpawnedPayload(rigName, polecatName))
+
+	// Run the on-spawn lifecycle plugin, if any. Failures are logged and
+	// non-fatal - the polecat has already spawned successfully.
+	runner := plugins.NewRunner(townRoot, r.Path)
+	if result := runner.Run(plugins.EventOnSpawn, map[string]string{
+		"rig":     rigName,
+		"polecat": polecatName,
+	}); result.Failed() {
+		fmt.Printf("Warning: on-spawn plugin failed: %s\n", result.FailureDetail())
+	}
+
+	// Spawn completed successfully - drop the transaction file so
+	// .runtime doesn't accumulate one per spawn.
+	txn.remove()
 
 	return &SpawnedPolecatInfo{
 		RigName:     rigName,
@@ -2,13 +2,13 @@ package cmd
 
 import (
 	"bytes"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
@@ -23,6 +23,8 @@ var primeDryRun bool
 var primeState bool
 var primeStateJSON bool
 var primeExplain bool
+var primeFast bool
+var primeDebug bool
 
 // Role represents a detected agent role.
 type Role string
@@ -62,7 +64,17 @@ HOOK MODE (--hook):
   Claude Code sends JSON on stdin:
     {"session_id": "uuid", "transcript_path": "/path", "source": "startup|resume"}
 
-  Other agents can set GT_SESSION_ID environment variable instead.`,
+  Other agents can set GT_SESSION_ID environment variable instead.
+
+PERFORMANCE:
+  prime's bd/git queries (role beads, hook status, mail, escalations) run
+  concurrently under a shared timeout instead of shelling out one at a time.
+  Use --fast to additionally skip the escalations check (Mayor-only, the
+  most expensive query) - this is what the SessionStart hook should pass:
+
+    "SessionStart": [{"hooks": [{"type": "command", "command": "gt prime --hook --fast"}]}]
+
+  Use --debug to print a timing breakdown of the gather phase.`,
 	RunE: runPrime,
 }
 
@@ -77,6 +89,10 @@ func init() {
 		"Output state as JSON (requires --state)")
 	primeCmd.Flags().BoolVar(&primeExplain, "explain", false,
 		"Show why each section was included")
+	primeCmd.Flags().BoolVar(&primeFast, "fast", false,
+		"Skip expensive sections (escalations check) - recommended for the SessionStart hook")
+	primeCmd.Flags().BoolVar(&primeDebug, "debug", false,
+		"Print a timing breakdown of prime's data-gathering phase")
 	rootCmd.AddCommand(primeCmd)
 }
 
@@ -199,6 +215,13 @@ func runPrime(cmd *cobra.Command, args []string) error {
 		emitSessionEvent(ctx)
 	}
 
+	// Record a heartbeat so witness/deacon staleness checks can tell "Claude
+	// is alive and responsive" apart from "the tmux pane exists." Best
+	// effort: a bd hiccup here shouldn't block priming.
+	if !primeDryRun {
+		recordPrimeHeartbeat(ctx)
+	}
+
 	// Output session metadata for seance discovery
 	explain(true, "Session metadata: always included for seance discovery")
 	outputSessionMetadata(ctx)
@@ -209,15 +232,33 @@ func runPrime(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// Gather prime's independent bd/git queries (role beads, hook status,
+	// mail, escalations) concurrently instead of shelling out serially -
+	// this is what makes prime take 5-15s on a cold cache. --fast additionally
+	// drops the escalations query.
+	var timing *primeTiming
+	if primeDebug {
+		timing = &primeTiming{}
+	}
+	var gathered *primeGathered
+	if primeDryRun {
+		explain(true, "bd/git gather phase: skipped in dry-run mode")
+	} else {
+		gathered = gatherPrimeData(ctx, cwd, primeFast, timing)
+	}
+	if gathered == nil {
+		gathered = &primeGathered{}
+	}
+
 	// Output handoff content if present
-	outputHandoffContent(ctx)
+	outputHandoffContent(ctx, gathered.handoffIssue)
 
 	// Output attachment status (for autonomous work detection)
-	outputAttachmentStatus(ctx)
+	outputAttachmentStatus(ctx, gathered.pinnedIssue)
 
 	// Check for slung work on hook (from gt sling)
 	// If found, we're in autonomous mode - skip normal startup directive
-	hasSlungWork := checkSlungWork(ctx)
+	hasSlungWork := checkSlungWork(ctx, gathered.hookedIssue)
 	explain(hasSlungWork, "Autonomous mode: hooked/in-progress work detected")
 
 	// Output molecule context if working on a molecule step
@@ -226,23 +267,25 @@ func runPrime(cmd *cobra.Command, args []string) error {
 	// Output previous session checkpoint for crash recovery
 	outputCheckpointContext(ctx)
 
-	// Run bd prime to output beads workflow context
+	// Print bd prime's beads workflow context
 	if !primeDryRun {
-		runBdPrime(cwd)
+		printBdPrimeOutput(gathered.bdPrimeOutput)
 	} else {
 		explain(true, "bd prime: skipped in dry-run mode")
 	}
 
-	// Run gt mail check --inject to inject any pending mail
+	// Print any pending mail injected by gt mail check --inject
 	if !primeDryRun {
-		runMailCheckInject(cwd)
+		printMailCheckOutput(gathered.mailOutput)
 	} else {
 		explain(true, "gt mail check --inject: skipped in dry-run mode")
 	}
 
-	// For Mayor, check for pending escalations
-	if ctx.Role == RoleMayor {
-		checkPendingEscalations(ctx)
+	// For Mayor, show pending escalations (skipped entirely under --fast)
+	if ctx.Role == RoleMayor && !primeFast {
+		printPendingEscalations(gathered.escalations)
+	} else if ctx.Role == RoleMayor {
+		explain(true, "Escalations check: skipped in --fast mode")
 	}
 
 	// Output startup directive for roles that should announce themselves
@@ -252,9 +295,31 @@ func runPrime(cmd *cobra.Command, args []string) error {
 		outputStartupDirective(ctx)
 	}
 
+	timing.print()
+
 	return nil
 }
 
+// detectRoleWithMarker is detectRole, but consults a .gastown marker file
+// (see workspace.FindMarker) before falling back to the cwd path heuristics
+// in detectRole. The path heuristics assume cwd is inside townRoot, which
+// isn't true for polecat/crew clones created outside the town directory
+// tree - the marker records role/rig/name directly so those clones still
+// resolve correctly.
+func detectRoleWithMarker(cwd, townRoot string) RoleInfo {
+	if marker, err := workspace.FindMarker(cwd); err == nil && marker != nil {
+		return RoleInfo{
+			Role:     Role(marker.Role),
+			Rig:      marker.Rig,
+			Polecat:  marker.Name,
+			TownRoot: townRoot,
+			WorkDir:  cwd,
+			Source:   "marker",
+		}
+	}
+	return detectRole(cwd, townRoot)
+}
+
 func detectRole(cwd, townRoot string) RoleInfo {
 	ctx := RoleInfo{
 		Role:     RoleUnknown,
@@ -340,96 +405,34 @@ func detectRole(cwd, townRoot string) RoleInfo {
 	return ctx
 }
 
-// runBdPrime runs `bd prime` and outputs the result.
-// This provides beads workflow context to the agent.
-func runBdPrime(workDir string) {
-	cmd := exec.Command("bd", "prime")
-	cmd.Dir = workDir
-
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	if err := cmd.Run(); err != nil {
-		// Skip if bd prime fails (beads might not be available)
-		// But log stderr if present for debugging
-		if errMsg := strings.TrimSpace(stderr.String()); errMsg != "" {
-			fmt.Fprintf(os.Stderr, "bd prime: %s\n", errMsg)
-		}
-		return
-	}
-
-	output := strings.TrimSpace(stdout.String())
+// printBdPrimeOutput prints the beads workflow context gathered by
+// fetchBdPrimeOutput. No-op if the gather step found nothing (bd prime
+// failed or beads isn't available).
+func printBdPrimeOutput(output string) {
 	if output != "" {
 		fmt.Println()
 		fmt.Println(output)
 	}
 }
 
-// runMailCheckInject runs `gt mail check --inject` and outputs the result.
-// This injects any pending mail into the agent's context.
-func runMailCheckInject(workDir string) {
-	cmd := exec.Command("gt", "mail", "check", "--inject")
-	cmd.Dir = workDir
-
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	if err := cmd.Run(); err != nil {
-		// Skip if mail check fails, but log stderr for debugging
-		if errMsg := strings.TrimSpace(stderr.String()); errMsg != "" {
-			fmt.Fprintf(os.Stderr, "gt mail check: %s\n", errMsg)
-		}
-		return
-	}
-
-	output := strings.TrimSpace(stdout.String())
+// printMailCheckOutput prints any pending mail gathered by
+// fetchMailCheckInjectOutput. No-op if there's nothing to inject.
+func printMailCheckOutput(output string) {
 	if output != "" {
 		fmt.Println()
 		fmt.Println(output)
 	}
 }
 
-// checkSlungWork checks for hooked work on the agent's hook.
-// If found, displays AUTONOMOUS WORK MODE and tells the agent to execute immediately.
-// Returns true if hooked work was found (caller should skip normal startup directive).
-func checkSlungWork(ctx RoleContext) bool {
-	// Determine agent identity
-	agentID := getAgentIdentity(ctx)
-	if agentID == "" {
+// checkSlungWork displays AUTONOMOUS WORK MODE for a bead already found on
+// the agent's hook (see findHookedBead) and tells the agent to execute
+// immediately. Returns true if hooked work was found (caller should skip
+// the normal startup directive). hookedBead is nil when there's none.
+func checkSlungWork(ctx RoleContext, hookedBead *beads.Issue) bool {
+	if hookedBead == nil {
 		return false
 	}
 
-	// Check for hooked beads (work on the agent's hook)
-	b := beads.New(ctx.WorkDir)
-	hookedBeads, err := b.List(beads.ListOptions{
-		Status:   beads.StatusHooked,
-		Assignee: agentID,
-		Priority: -1,
-	})
-	if err != nil {
-		return false
-	}
-
-	// If no hooked beads found, also check in_progress beads assigned to this agent.
-	// This handles the case where work was claimed (status changed to in_progress)
-	// but the session was interrupted before completion. The hook should persist.
-	if len(hookedBeads) == 0 {
-		inProgressBeads, err := b.List(beads.ListOptions{
-			Status:   "in_progress",
-			Assignee: agentID,
-			Priority: -1,
-		})
-		if err != nil || len(inProgressBeads) == 0 {
-			return false
-		}
-		hookedBeads = inProgressBeads
-	}
-
-	// Use the first hooked bead (agents typically have one)
-	hookedBead := hookedBeads[0]
-
 	// Build the role announcement string
 	roleAnnounce := buildRoleAnnouncement(ctx)
 
@@ -626,6 +629,18 @@ func acquireIdentityLock(ctx RoleContext) error {
 	return nil
 }
 
+// recordPrimeHeartbeat updates the current agent's last_heartbeat field.
+// Best effort: a missing agent bead or a bd error is swallowed since prime
+// must not fail just because liveness tracking couldn't be written.
+func recordPrimeHeartbeat(ctx RoleContext) {
+	agentBeadID := getAgentBeadID(ctx)
+	if agentBeadID == "" {
+		return
+	}
+	bd := beads.New(ctx.TownRoot)
+	_ = bd.UpdateAgentHeartbeat(agentBeadID, time.Now())
+}
+
 // getAgentBeadID returns the agent bead ID for the current role.
 // Town-level agents (mayor, deacon) use hq- prefix; rig-scoped agents use the rig's prefix.
 // Returns empty string for unknown roles.
@@ -687,33 +702,11 @@ func ensureBeadsRedirect(ctx RoleContext) {
 	_ = beads.SetupRedirect(ctx.TownRoot, ctx.WorkDir)
 }
 
-// checkPendingEscalations queries for open escalation beads and displays them prominently.
-// This is called on Mayor startup to surface issues needing human attention.
-func checkPendingEscalations(ctx RoleContext) {
-	// Query for open escalations using bd list with tag filter
-	cmd := exec.Command("bd", "list", "--status=open", "--tag=escalation", "--json")
-	cmd.Dir = ctx.WorkDir
-
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	if err := cmd.Run(); err != nil {
-		// Silently skip - escalation check is best-effort
-		return
-	}
-
-	// Parse JSON output
-	var escalations []struct {
-		ID          string `json:"id"`
-		Title       string `json:"title"`
-		Priority    int    `json:"priority"`
-		Description string `json:"description"`
-		Created     string `json:"created"`
-	}
-
-	if err := json.Unmarshal(stdout.Bytes(), &escalations); err != nil || len(escalations) == 0 {
-		// No escalations or parse error
+// printPendingEscalations displays escalations gathered by fetchEscalations
+// prominently. This is called on Mayor startup to surface issues needing
+// human attention. No-op if there are none.
+func printPendingEscalations(escalations []escalationInfo) {
+	if len(escalations) == 0 {
 		return
 	}
 
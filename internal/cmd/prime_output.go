@@ -226,21 +226,12 @@ func outputUnknownContext(ctx RoleContext) {
 	fmt.Printf("Town root: %s\n", style.Dim.Render(ctx.TownRoot))
 }
 
-// outputHandoffContent reads and displays the pinned handoff bead for the role.
-func outputHandoffContent(ctx RoleContext) {
+// outputHandoffContent displays the pinned handoff bead gathered for the
+// role (see gatherPrimeData). issue is nil when there's no handoff.
+func outputHandoffContent(ctx RoleContext, issue *beads.Issue) {
 	if ctx.Role == RoleUnknown {
 		return
 	}
-
-	// Get role key for handoff bead lookup
-	roleKey := string(ctx.Role)
-
-	bd := beads.New(ctx.TownRoot)
-	issue, err := bd.FindHandoffBead(roleKey)
-	if err != nil {
-		// Silently skip if beads lookup fails (might not be a beads repo)
-		return
-	}
 	if issue == nil || issue.Description == "" {
 		// No handoff content
 		return
@@ -329,37 +320,19 @@ func outputStartupDirective(ctx RoleContext) {
 	}
 }
 
-// outputAttachmentStatus checks for attached work molecule and outputs status.
+// outputAttachmentStatus displays attached-work status for the pinned bead
+// gathered for the role (see gatherPrimeData). pinnedIssue is nil when the
+// agent has no pinned bead (interactive mode).
 // This is key for the autonomous overnight work pattern.
 // The Propulsion Principle: "If you find something on your hook, YOU RUN IT."
-func outputAttachmentStatus(ctx RoleContext) {
+func outputAttachmentStatus(ctx RoleContext, pinnedIssue *beads.Issue) {
 	// Skip only unknown roles - all valid roles can have pinned work
-	if ctx.Role == RoleUnknown {
-		return
-	}
-
-	// Check for pinned beads with attachments
-	b := beads.New(ctx.WorkDir)
-
-	// Build assignee string based on role (same as getAgentIdentity)
-	assignee := getAgentIdentity(ctx)
-	if assignee == "" {
-		return
-	}
-
-	// Find pinned beads for this agent
-	pinnedBeads, err := b.List(beads.ListOptions{
-		Status:   beads.StatusPinned,
-		Assignee: assignee,
-		Priority: -1,
-	})
-	if err != nil || len(pinnedBeads) == 0 {
-		// No pinned beads - interactive mode
+	if ctx.Role == RoleUnknown || pinnedIssue == nil {
 		return
 	}
 
-	// Check first pinned bead for attachment
-	attachment := beads.ParseAttachmentFields(pinnedBeads[0])
+	// Check the pinned bead for attachment
+	attachment := beads.ParseAttachmentFields(pinnedIssue)
 	if attachment == nil || attachment.AttachedMolecule == "" {
 		// No attachment - interactive mode
 		return
@@ -368,7 +341,7 @@ func outputAttachmentStatus(ctx RoleContext) {
 	// Has attached work - output prominently with current step
 	fmt.Println()
 	fmt.Printf("%s\n\n", style.Bold.Render("## 🎯 ATTACHED WORK DETECTED"))
-	fmt.Printf("Pinned bead: %s\n", pinnedBeads[0].ID)
+	fmt.Printf("Pinned bead: %s\n", pinnedIssue.ID)
 	fmt.Printf("Attached molecule: %s\n", attachment.AttachedMolecule)
 	if attachment.AttachedAt != "" {
 		fmt.Printf("Attached at: %s\n", attachment.AttachedAt)
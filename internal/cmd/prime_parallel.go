@@ -0,0 +1,260 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// primeGatherTimeout bounds the total wall-clock time spent on prime's
+// concurrent bd/git queries. If it's exceeded, whichever queries haven't
+// finished are simply left with their zero value - prime always prints
+// something rather than hanging a SessionStart hook indefinitely.
+const primeGatherTimeout = 8 * time.Second
+
+// primeTask is one named unit of prime's data-gathering phase. Tasks are
+// independent by construction (each touches only its own field of a
+// result struct), which is what makes running them concurrently safe.
+type primeTask struct {
+	name string
+	fn   func()
+}
+
+// runPrimeTasks runs every task concurrently and waits for all of them to
+// finish (or for ctx to be canceled - tasks are expected to check ctx
+// themselves for anything that can block, e.g. via exec.CommandContext).
+// When timing is non-nil, each task's wall-clock duration is recorded for
+// the --debug breakdown.
+func runPrimeTasks(ctx context.Context, timing *primeTiming, tasks []primeTask) {
+	var wg sync.WaitGroup
+	for _, task := range tasks {
+		wg.Add(1)
+		go func(task primeTask) {
+			defer wg.Done()
+			start := time.Now()
+			task.fn()
+			if timing != nil {
+				timing.record(task.name, time.Since(start))
+			}
+		}(task)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		// Timed out - let stragglers keep running in the background (they'll
+		// exit on their own once their exec.CommandContext calls unblock);
+		// we just stop waiting so prime doesn't hang.
+	}
+}
+
+// primeTiming records how long each gather task took, for the --debug
+// timing breakdown. Safe for concurrent use by runPrimeTasks.
+type primeTiming struct {
+	mu    sync.Mutex
+	steps []primeTimingStep
+}
+
+type primeTimingStep struct {
+	Name     string
+	Duration time.Duration
+}
+
+func (t *primeTiming) record(name string, d time.Duration) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.steps = append(t.steps, primeTimingStep{Name: name, Duration: d})
+}
+
+// print outputs the recorded timings and the effective wall-clock time
+// (the slowest task, since tasks run concurrently).
+func (t *primeTiming) print() {
+	if t == nil || len(t.steps) == 0 {
+		return
+	}
+	fmt.Println()
+	fmt.Printf("%s\n", style.Bold.Render("## ⏱ gt prime timing (--debug)"))
+	var wallClock time.Duration
+	for _, s := range t.steps {
+		fmt.Printf("  %-16s %v\n", s.Name, s.Duration.Round(time.Millisecond))
+		if s.Duration > wallClock {
+			wallClock = s.Duration
+		}
+	}
+	fmt.Printf("  %-16s %v (gather phase, run concurrently)\n", "wall clock", wallClock.Round(time.Millisecond))
+}
+
+// primeGathered holds the results of prime's concurrent data-gathering
+// phase. Each field is written by exactly one primeTask, so no locking is
+// needed beyond runPrimeTasks' own WaitGroup.
+type primeGathered struct {
+	handoffIssue  *beads.Issue
+	pinnedIssue   *beads.Issue
+	hookedIssue   *beads.Issue
+	bdPrimeOutput string
+	mailOutput    string
+	escalations   []escalationInfo
+}
+
+// gatherPrimeData runs prime's independent bd/git queries concurrently
+// under a shared timeout, instead of shelling out to each one serially.
+// fast skips the escalations query (the most expensive of the bunch, and
+// only relevant to Mayor), matching --fast / SessionStart hook usage.
+func gatherPrimeData(rctx RoleContext, cwd string, fast bool, timing *primeTiming) *primeGathered {
+	gctx, cancel := context.WithTimeout(context.Background(), primeGatherTimeout)
+	defer cancel()
+
+	result := &primeGathered{}
+	assignee := getAgentIdentity(rctx)
+
+	var tasks []primeTask
+
+	if rctx.Role != RoleUnknown {
+		tasks = append(tasks, primeTask{name: "handoff bead", fn: func() {
+			bd := beads.New(rctx.TownRoot)
+			issue, err := bd.FindHandoffBead(string(rctx.Role))
+			if err == nil {
+				result.handoffIssue = issue
+			}
+		}})
+	}
+
+	if assignee != "" {
+		tasks = append(tasks, primeTask{name: "pinned bead", fn: func() {
+			b := beads.New(rctx.WorkDir)
+			pinned, err := b.List(beads.ListOptions{Status: beads.StatusPinned, Assignee: assignee, Priority: -1})
+			if err == nil && len(pinned) > 0 {
+				result.pinnedIssue = pinned[0]
+			}
+		}})
+
+		tasks = append(tasks, primeTask{name: "hooked bead", fn: func() {
+			result.hookedIssue = findHookedBead(rctx.WorkDir, assignee)
+		}})
+	}
+
+	tasks = append(tasks, primeTask{name: "bd prime", fn: func() {
+		result.bdPrimeOutput = fetchBdPrimeOutput(gctx, cwd)
+	}})
+
+	tasks = append(tasks, primeTask{name: "mail inject", fn: func() {
+		result.mailOutput = fetchMailCheckInjectOutput(gctx, cwd)
+	}})
+
+	if !fast && rctx.Role == RoleMayor {
+		tasks = append(tasks, primeTask{name: "escalations", fn: func() {
+			result.escalations = fetchEscalations(gctx, rctx.WorkDir)
+		}})
+	}
+
+	runPrimeTasks(gctx, timing, tasks)
+	return result
+}
+
+// findHookedBead returns the bead on the agent's hook, falling back to an
+// in_progress bead assigned to them (handles a session that claimed work
+// but was interrupted before the hook was cleared). Returns nil if neither
+// is found.
+func findHookedBead(workDir, assignee string) *beads.Issue {
+	b := beads.New(workDir)
+	hookedBeads, err := b.List(beads.ListOptions{
+		Status:   beads.StatusHooked,
+		Assignee: assignee,
+		Priority: -1,
+	})
+	if err != nil {
+		return nil
+	}
+	if len(hookedBeads) == 0 {
+		inProgressBeads, err := b.List(beads.ListOptions{
+			Status:   "in_progress",
+			Assignee: assignee,
+			Priority: -1,
+		})
+		if err != nil || len(inProgressBeads) == 0 {
+			return nil
+		}
+		hookedBeads = inProgressBeads
+	}
+	return hookedBeads[0]
+}
+
+// fetchBdPrimeOutput runs `bd prime` and returns its trimmed stdout, or
+// empty string if it fails or produces nothing.
+func fetchBdPrimeOutput(ctx context.Context, workDir string) string {
+	cmd := exec.CommandContext(ctx, "bd", "prime")
+	cmd.Dir = workDir
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(stdout.String())
+}
+
+// fetchMailCheckInjectOutput runs `gt mail check --inject` and returns its
+// trimmed stdout, or empty string if it fails or produces nothing.
+func fetchMailCheckInjectOutput(ctx context.Context, workDir string) string {
+	cmd := exec.CommandContext(ctx, "gt", "mail", "check", "--inject")
+	cmd.Dir = workDir
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(stdout.String())
+}
+
+// escalationInfo is the subset of an escalation bead's fields prime needs
+// to display the pending-escalations summary.
+type escalationInfo struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Priority    int    `json:"priority"`
+	Description string `json:"description"`
+	Created     string `json:"created"`
+}
+
+// fetchEscalations queries open escalation beads. Returns nil on any
+// error - escalation reporting is best-effort.
+func fetchEscalations(ctx context.Context, workDir string) []escalationInfo {
+	cmd := exec.CommandContext(ctx, "bd", "list", "--status=open", "--tag=escalation", "--json")
+	cmd.Dir = workDir
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil
+	}
+
+	var escalations []escalationInfo
+	if err := json.Unmarshal(stdout.Bytes(), &escalations); err != nil {
+		return nil
+	}
+	return escalations
+}
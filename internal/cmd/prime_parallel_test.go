@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestRunPrimeTasks_RunsConcurrently is a benchmark-style test that stubs a
+// slow data-gathering layer (in place of real bd/git calls) and asserts the
+// gather phase's wall-clock time tracks the slowest task, not the sum of
+// all of them - the whole point of moving prime off serial exec.Command
+// calls.
+func TestRunPrimeTasks_RunsConcurrently(t *testing.T) {
+	const (
+		taskCount   = 5
+		taskLatency = 50 * time.Millisecond
+	)
+
+	timing := &primeTiming{}
+	tasks := make([]primeTask, taskCount)
+	for i := range tasks {
+		tasks[i] = primeTask{
+			name: "stub",
+			fn:   func() { time.Sleep(taskLatency) },
+		}
+	}
+
+	start := time.Now()
+	runPrimeTasks(context.Background(), timing, tasks)
+	elapsed := time.Since(start)
+
+	serial := taskLatency * taskCount
+	if elapsed >= serial {
+		t.Fatalf("gather phase took %v, expected well under the serial sum of %v (parallelism isn't working)", elapsed, serial)
+	}
+	// Generous upper bound: a few task-latencies of slack for scheduling
+	// jitter, but nowhere near the serial sum.
+	if elapsed > taskLatency*3 {
+		t.Fatalf("gather phase took %v, expected close to one task's latency (%v)", elapsed, taskLatency)
+	}
+
+	if len(timing.steps) != taskCount {
+		t.Fatalf("expected %d timing entries, got %d", taskCount, len(timing.steps))
+	}
+}
+
+// TestRunPrimeTasks_TimeoutStopsWaiting verifies that a hung task doesn't
+// block the gather phase past the shared context deadline.
+func TestRunPrimeTasks_TimeoutStopsWaiting(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	hung := make(chan struct{})
+	t.Cleanup(func() { close(hung) })
+
+	tasks := []primeTask{
+		{name: "hung", fn: func() { <-hung }},
+		{name: "fast", fn: func() {}},
+	}
+
+	start := time.Now()
+	runPrimeTasks(ctx, nil, tasks)
+	elapsed := time.Since(start)
+
+	if elapsed > 200*time.Millisecond {
+		t.Fatalf("runPrimeTasks took %v, expected to stop waiting around the 20ms deadline", elapsed)
+	}
+}
+
+// TestGatherPrimeData_FastSkipsEscalations verifies --fast drops the
+// escalations task for Mayor without touching the other gathered fields.
+func TestGatherPrimeData_FastSkipsEscalations(t *testing.T) {
+	townRoot := t.TempDir()
+	ctx := RoleContext{Role: RoleMayor, TownRoot: townRoot, WorkDir: townRoot}
+
+	timing := &primeTiming{}
+	gatherPrimeData(ctx, townRoot, true, timing)
+
+	for _, s := range timing.steps {
+		if s.Name == "escalations" {
+			t.Fatalf("expected escalations task to be skipped under --fast, got timing entry for it")
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/checkpoint"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 func writeTestRoutes(t *testing.T, townRoot string, routes []beads.Route) {
@@ -27,6 +28,53 @@ func writeTestRoutes(t *testing.T, townRoot string, routes []beads.Route) {
 	}
 }
 
+// TestDetectRoleWithMarker_OutOfTreeClone covers the scenario a .gastown
+// marker exists for: a polecat clone that lives outside townRoot's
+// directory tree, where the path heuristics in detectRole can't work
+// because filepath.Rel(townRoot, cwd) never lands inside the rig layout.
+func TestDetectRoleWithMarker_OutOfTreeClone(t *testing.T) {
+	townRoot := t.TempDir()
+	clonePath := t.TempDir() // deliberately not under townRoot
+
+	if err := workspace.WriteMarker(clonePath, workspace.Marker{
+		TownRoot: townRoot,
+		Rig:      "myrig",
+		Role:     "polecat",
+		Name:     "Toast",
+	}); err != nil {
+		t.Fatalf("WriteMarker: %v", err)
+	}
+
+	ctx := detectRoleWithMarker(clonePath, townRoot)
+	if ctx.Role != RolePolecat {
+		t.Errorf("Role = %q, want %q", ctx.Role, RolePolecat)
+	}
+	if ctx.Rig != "myrig" {
+		t.Errorf("Rig = %q, want %q", ctx.Rig, "myrig")
+	}
+	if ctx.Polecat != "Toast" {
+		t.Errorf("Polecat = %q, want %q", ctx.Polecat, "Toast")
+	}
+}
+
+// TestDetectRoleWithMarker_NoMarkerFallsBackToPath verifies that without a
+// marker, detectRoleWithMarker behaves exactly like detectRole.
+func TestDetectRoleWithMarker_NoMarkerFallsBackToPath(t *testing.T) {
+	townRoot := t.TempDir()
+	cwd := filepath.Join(townRoot, "myrig", "witness")
+	if err := os.MkdirAll(cwd, 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	ctx := detectRoleWithMarker(cwd, townRoot)
+	if ctx.Role != RoleWitness {
+		t.Errorf("Role = %q, want %q", ctx.Role, RoleWitness)
+	}
+	if ctx.Rig != "myrig" {
+		t.Errorf("Rig = %q, want %q", ctx.Rig, "myrig")
+	}
+}
+
 func TestGetAgentBeadID_UsesRigPrefix(t *testing.T) {
 	townRoot := t.TempDir()
 	writeTestRoutes(t, townRoot, []beads.Route{
@@ -1,17 +1,26 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/refinery"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
+	"golang.org/x/term"
 )
 
 // Refinery command flags
@@ -19,7 +28,12 @@ var (
 	refineryForeground    bool
 	refineryStatusJSON    bool
 	refineryQueueJSON     bool
+	refineryQueueSummary  bool
+	refineryQueueWatch    bool
+	refineryQueueInterval time.Duration
 	refineryAgentOverride string
+	refineryStatsJSON     bool
+	refineryStatsLimit    int
 )
 
 var refineryCmd = &cobra.Command{
@@ -92,11 +106,61 @@ var refineryQueueCmd = &cobra.Command{
 	Long: `Show the merge queue for a rig.
 
 Lists all pending merge requests waiting to be processed.
-If rig is not specified, infers it from the current directory.`,
+If rig is not specified, infers it from the current directory.
+
+Pass --watch to refresh continuously (--interval controls how often, default
+15s), and --summary to print a single line like "queue: 3 pending,
+1 in-progress (gt-abc, 4m), last merge 22m ago" instead of the full listing -
+handy for tmux status bars and mail subjects. In agent mode (GT_AGENT_MODE=1
+or inside Claude Code) both read the Refinery agent's last-reported snapshot
+instead of re-running branch discovery on every refresh.
+
+Examples:
+  gt refinery queue
+  gt refinery queue --watch --interval 30s
+  gt refinery queue --summary`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runRefineryQueue,
 }
 
+var refineryReportErrors []string
+
+var refineryReportCmd = &cobra.Command{
+	Use:   "report [rig]",
+	Short: "Persist a queue snapshot for the agent's current cycle",
+	Long: `Persist a snapshot of the merge queue as the Refinery agent sees it.
+
+Intended to be run by the Refinery agent itself after each processing
+cycle. Queue()/gt refinery queue already read the merge queue live from
+beads, so this does not replace that - it records the agent's per-MR
+LastError, which has no field in the beads issue, so it can be shown
+alongside the beads-derived queue. Snapshots older than 10 minutes are
+flagged as stale and ignored.
+
+Use --error to record the last error hit while processing an MR:
+
+Examples:
+  gt refinery report
+  gt refinery report --error gt-abc123="tests failed: TestFoo"`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runRefineryReport,
+}
+
+var refineryStatsCmd = &cobra.Command{
+	Use:   "stats [rig]",
+	Short: "Show merge queue throughput averages",
+	Long: `Show average queue wait and processing time for a rig's merge queue.
+
+Derives its averages from merged/merge_failed events on the activity feed
+(queue_wait_seconds: registration to processing start, processing_seconds:
+start to completion). Only events carrying these fields are counted, so
+older events logged before they existed are silently skipped.
+
+If rig is not specified, infers it from the current directory.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runRefineryStats,
+}
+
 var refineryAttachCmd = &cobra.Command{
 	Use:   "attach [rig]",
 	Short: "Attach to refinery session",
@@ -217,6 +281,25 @@ Examples:
 
 var refineryBlockedJSON bool
 
+var refineryConfigJSON bool
+
+var refineryConfigCmd = &cobra.Command{
+	Use:   "config [rig]",
+	Short: "Show the effective merge queue configuration",
+	Long: `Show the merge_queue configuration a rig's Refinery is currently using.
+
+Loads config.json the same way the Refinery agent does, so this reflects
+the effective values after defaults and overrides - useful for confirming
+an edit to config.json actually took effect.
+If rig is not specified, infers it from the current directory.
+
+Examples:
+  gt refinery config
+  gt refinery config greenplace --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runRefineryConfig,
+}
+
 func init() {
 	// Start flags
 	refineryStartCmd.Flags().BoolVar(&refineryForeground, "foreground", false, "Run in foreground (default: background)")
@@ -233,6 +316,16 @@ func init() {
 
 	// Queue flags
 	refineryQueueCmd.Flags().BoolVar(&refineryQueueJSON, "json", false, "Output as JSON")
+	refineryQueueCmd.Flags().BoolVar(&refineryQueueSummary, "summary", false, "Print a single summary line instead of the full queue")
+	refineryQueueCmd.Flags().BoolVarP(&refineryQueueWatch, "watch", "w", false, "Refresh the queue continuously")
+	refineryQueueCmd.Flags().DurationVar(&refineryQueueInterval, "interval", 15*time.Second, "Refresh interval for --watch")
+
+	// Report flags
+	refineryReportCmd.Flags().StringArrayVar(&refineryReportErrors, "error", nil, "Record a last error for an MR, as <mr-id>=<message> (repeatable)")
+
+	// Stats flags
+	refineryStatsCmd.Flags().BoolVar(&refineryStatsJSON, "json", false, "Output as JSON")
+	refineryStatsCmd.Flags().IntVar(&refineryStatsLimit, "limit", 200, "Maximum number of recent merge events to consider")
 
 	// Unclaimed flags
 	refineryUnclaimedCmd.Flags().BoolVar(&refineryUnclaimedJSON, "json", false, "Output as JSON")
@@ -243,18 +336,24 @@ func init() {
 	// Blocked flags
 	refineryBlockedCmd.Flags().BoolVar(&refineryBlockedJSON, "json", false, "Output as JSON")
 
+	// Config flags
+	refineryConfigCmd.Flags().BoolVar(&refineryConfigJSON, "json", false, "Output as JSON")
+
 	// Add subcommands
 	refineryCmd.AddCommand(refineryStartCmd)
 	refineryCmd.AddCommand(refineryStopCmd)
 	refineryCmd.AddCommand(refineryRestartCmd)
 	refineryCmd.AddCommand(refineryStatusCmd)
 	refineryCmd.AddCommand(refineryQueueCmd)
+	refineryCmd.AddCommand(refineryReportCmd)
+	refineryCmd.AddCommand(refineryStatsCmd)
 	refineryCmd.AddCommand(refineryAttachCmd)
 	refineryCmd.AddCommand(refineryClaimCmd)
 	refineryCmd.AddCommand(refineryReleaseCmd)
 	refineryCmd.AddCommand(refineryUnclaimedCmd)
 	refineryCmd.AddCommand(refineryReadyCmd)
 	refineryCmd.AddCommand(refineryBlockedCmd)
+	refineryCmd.AddCommand(refineryConfigCmd)
 
 	rootCmd.AddCommand(refineryCmd)
 }
@@ -339,10 +438,13 @@ func runRefineryStop(cmd *cobra.Command, args []string) error {
 
 // RefineryStatusOutput is the JSON output format for refinery status.
 type RefineryStatusOutput struct {
-	Running     bool   `json:"running"`
-	RigName     string `json:"rig_name"`
-	Session     string `json:"session,omitempty"`
-	QueueLength int    `json:"queue_length"`
+	Running       bool                   `json:"running"`
+	RigName       string                 `json:"rig_name"`
+	Session       string                 `json:"session,omitempty"`
+	QueueLength   int                    `json:"queue_length"`
+	CurrentMR     *refinery.MergeRequest `json:"current_mr,omitempty"`
+	LastMerge     *refinery.MergeRequest `json:"last_merge,omitempty"`
+	SnapshotStale bool                   `json:"snapshot_stale,omitempty"`
 }
 
 func runRefineryStatus(cmd *cobra.Command, args []string) error {
@@ -364,19 +466,25 @@ func runRefineryStatus(cmd *cobra.Command, args []string) error {
 	queue, _ := mgr.Queue()
 	queueLen := len(queue)
 
+	snapshot, _ := mgr.LoadSnapshot()
+	snapshotStale := snapshot != nil && snapshot.IsStale(time.Now(), refinery.DefaultSnapshotStaleAfter)
+
 	// JSON output
 	if refineryStatusJSON {
+		currentMR, _ := mgr.CurrentMR()
+		lastMerge, _ := mgr.LastMerge()
 		output := RefineryStatusOutput{
-			Running:     running,
-			RigName:     rigName,
-			QueueLength: queueLen,
+			Running:       running,
+			RigName:       rigName,
+			QueueLength:   queueLen,
+			CurrentMR:     currentMR,
+			LastMerge:     lastMerge,
+			SnapshotStale: snapshotStale,
 		}
 		if sessionInfo != nil {
 			output.Session = sessionInfo.Name
 		}
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(output)
+		return outputJSON(output)
 	}
 
 	// Human-readable output
@@ -392,6 +500,9 @@ func runRefineryStatus(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("\n  Queue: %d pending\n", queueLen)
+	if snapshotStale {
+		fmt.Printf("  %s\n", style.Dim.Render(fmt.Sprintf("⚠ last agent report was %s ago - LastError detail may be stale", formatDuration(time.Since(snapshot.GeneratedAt)))))
+	}
 
 	return nil
 }
@@ -402,28 +513,89 @@ func runRefineryQueue(cmd *cobra.Command, args []string) error {
 		rigName = args[0]
 	}
 
-	mgr, _, rigName, err := getRefineryManager(rigName)
+	mgr, r, rigName, err := getRefineryManager(rigName)
 	if err != nil {
 		return err
 	}
 
-	queue, err := mgr.Queue()
+	if refineryQueueJSON && (refineryQueueWatch || refineryQueueSummary) {
+		return fmt.Errorf("--json cannot be combined with --watch or --summary")
+	}
+
+	if refineryQueueWatch {
+		if refineryQueueInterval <= 0 {
+			return fmt.Errorf("--interval must be positive, got %s", refineryQueueInterval)
+		}
+		return watchRefineryQueue(mgr, r, rigName)
+	}
+
+	return renderRefineryQueueOnce(os.Stdout, mgr, r, rigName)
+}
+
+// loadRefineryQueueForDisplay fetches the queue items to render, and the
+// last merge if needSummary requests one. In agent mode it prefers the
+// Refinery agent's last-reported snapshot over Manager.Queue() so a fast
+// refresh loop (--watch/--summary) doesn't redo branch discovery (a beads
+// query plus, when reworked branches need deduping, git branch-tip lookups)
+// on every tick; interactive use always queries live so the numbers are
+// never more than one poll interval stale in the way that matters.
+func loadRefineryQueueForDisplay(mgr *refinery.Manager, needSummary bool) ([]refinery.QueueItem, *refinery.MergeRequest, error) {
+	var queue []refinery.QueueItem
+	var err error
+	if ui.IsAgentMode() {
+		queue, err = mgr.QueueFromSnapshot()
+		if err != nil {
+			return nil, nil, fmt.Errorf("loading queue snapshot: %w", err)
+		}
+	}
+	if queue == nil {
+		queue, err = mgr.Queue()
+		if err != nil {
+			return nil, nil, fmt.Errorf("getting queue: %w", err)
+		}
+	}
+
+	var lastMerge *refinery.MergeRequest
+	if needSummary {
+		lastMerge, _ = mgr.LastMerge()
+	}
+	return queue, lastMerge, nil
+}
+
+// renderRefineryQueueOnce prints one snapshot of the queue - the full
+// listing, --json, or --summary - shared by the single-shot command and
+// each tick of --watch.
+func renderRefineryQueueOnce(w io.Writer, mgr *refinery.Manager, r *rig.Rig, rigName string) error {
+	queue, lastMerge, err := loadRefineryQueueForDisplay(mgr, refineryQueueSummary)
 	if err != nil {
-		return fmt.Errorf("getting queue: %w", err)
+		return err
+	}
+
+	if refineryQueueSummary {
+		fmt.Fprintln(w, refinery.QueueSummaryLine(queue, lastMerge, time.Now()))
+		return nil
+	}
+
+	requireReview := false
+	settingsPath := filepath.Join(r.Path, "settings", "config.json")
+	if settings, err := config.LoadRigSettings(settingsPath); err == nil && settings.MergeQueue != nil {
+		requireReview = settings.MergeQueue.RequireReview
 	}
 
 	// JSON output
 	if refineryQueueJSON {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(queue)
+		return outputJSON(queue)
 	}
 
 	// Human-readable output
-	fmt.Printf("%s Merge queue for '%s':\n\n", style.Bold.Render("📋"), rigName)
+	fmt.Fprintf(w, "%s Merge queue for '%s':\n", style.Bold.Render("📋"), rigName)
+	if snapshot, _ := mgr.LoadSnapshot(); snapshot != nil && snapshot.IsStale(time.Now(), refinery.DefaultSnapshotStaleAfter) {
+		fmt.Fprintf(w, "  %s\n", style.Dim.Render(fmt.Sprintf("⚠ last agent report was %s ago - LastError detail may be stale", formatDuration(time.Since(snapshot.GeneratedAt)))))
+	}
+	fmt.Fprintln(w)
 
 	if len(queue) == 0 {
-		fmt.Printf("  %s\n", style.Dim.Render("(empty)"))
+		fmt.Fprintf(w, "  %s\n", style.Dim.Render("(empty)"))
 		return nil
 	}
 
@@ -437,9 +609,12 @@ func runRefineryQueue(cmd *cobra.Command, args []string) error {
 		} else {
 			switch item.MR.Status {
 			case refinery.MROpen:
-				if item.MR.Error != "" {
+				switch {
+				case requireReview && item.MR.ReviewedBy == "":
+					status = style.Dim.Render("[awaiting-review]")
+				case item.MR.Error != "":
 					status = style.Dim.Render("[needs-rework]")
-				} else {
+				default:
 					status = style.Dim.Render("[pending]")
 				}
 			case refinery.MRInProgress:
@@ -465,13 +640,193 @@ func runRefineryQueue(cmd *cobra.Command, args []string) error {
 			issueInfo = fmt.Sprintf(" (%s)", item.MR.IssueID)
 		}
 
-		fmt.Printf("%s %s %s/%s%s %s\n",
+		fmt.Fprintf(w, "%s %s %s/%s%s %s\n",
 			prefix,
 			status,
 			item.MR.Worker,
 			item.MR.Branch,
 			issueInfo,
 			style.Dim.Render(item.Age))
+
+		if len(item.SupersededIDs) > 0 {
+			fmt.Fprintf(w, "       %s\n", style.Dim.Render(fmt.Sprintf("supersedes %s (stale rework/respawn)", strings.Join(item.SupersededIDs, ", "))))
+		}
+	}
+
+	return nil
+}
+
+// watchRefineryQueue refreshes the queue view on a ticker until interrupted,
+// mirroring runStatusWatchWith's clear-screen/header/Ctrl+C handling.
+func watchRefineryQueue(mgr *refinery.Manager, r *rig.Rig, rigName string) error {
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigChan)
+
+	ticker := time.NewTicker(refineryQueueInterval)
+	defer ticker.Stop()
+
+	isTTY := term.IsTerminal(int(os.Stdout.Fd()))
+
+	for {
+		if isTTY {
+			fmt.Print("\033[H\033[2J") // ANSI: cursor home + clear screen
+		}
+
+		timestamp := time.Now().Format("15:04:05")
+		header := fmt.Sprintf("[%s] gt refinery queue --watch (every %s, Ctrl+C to stop)", timestamp, refineryQueueInterval)
+		if isTTY {
+			fmt.Printf("%s\n\n", style.Dim.Render(header))
+		} else {
+			fmt.Printf("%s\n\n", header)
+		}
+
+		if err := renderRefineryQueueOnce(os.Stdout, mgr, r, rigName); err != nil {
+			fmt.Printf("Error: %v\n", err)
+		}
+
+		select {
+		case <-sigChan:
+			if isTTY {
+				fmt.Println("\nStopped.")
+			}
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func runRefineryReport(cmd *cobra.Command, args []string) error {
+	rigName := ""
+	if len(args) > 0 {
+		rigName = args[0]
+	}
+
+	mgr, _, rigName, err := getRefineryManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	queue, err := mgr.Queue()
+	if err != nil {
+		return fmt.Errorf("getting queue: %w", err)
+	}
+
+	for _, spec := range refineryReportErrors {
+		id, msg, ok := strings.Cut(spec, "=")
+		if !ok {
+			return fmt.Errorf("invalid --error %q, expected <mr-id>=<message>", spec)
+		}
+		for _, item := range queue {
+			if item.MR != nil && item.MR.ID == id {
+				item.MR.Error = msg
+			}
+		}
+	}
+
+	if err := mgr.WriteSnapshot(queue); err != nil {
+		return fmt.Errorf("writing queue snapshot: %w", err)
+	}
+
+	fmt.Printf("%s Reported queue snapshot for '%s' (%d item(s))\n", style.Bold.Render("✓"), rigName, len(queue))
+	return nil
+}
+
+// RefineryStats summarizes merge queue throughput derived from the
+// merged/merge_failed activity feed events.
+type RefineryStats struct {
+	Rig              string  `json:"rig"`
+	Merged           int     `json:"merged"`
+	Failed           int     `json:"failed"`
+	AvgQueueWaitSecs float64 `json:"avg_queue_wait_seconds,omitempty"`
+	AvgProcessingSec float64 `json:"avg_processing_seconds,omitempty"`
+	SampleSize       int     `json:"sample_size"`
+
+	// queueWaitCount/processingCount are how many events actually carried
+	// each duration field, used to render "(no data)" vs an average of 0.
+	queueWaitCount  int
+	processingCount int
+}
+
+// computeRefineryStats derives merge queue throughput averages from a set
+// of merged/merge_failed events. Events without queue_wait_seconds or
+// processing_seconds (e.g. logged before those fields existed, or
+// merge_skipped events which never carry them) simply don't contribute to
+// the corresponding average.
+func computeRefineryStats(rigName string, evts []events.Event) RefineryStats {
+	stats := RefineryStats{Rig: rigName}
+	var queueWaitTotal, processingTotal float64
+	for _, e := range evts {
+		switch e.Type {
+		case events.TypeMerged:
+			stats.Merged++
+		case events.TypeMergeFailed:
+			stats.Failed++
+		default:
+			continue
+		}
+		if v, ok := e.Payload["queue_wait_seconds"].(float64); ok {
+			queueWaitTotal += v
+			stats.queueWaitCount++
+		}
+		if v, ok := e.Payload["processing_seconds"].(float64); ok {
+			processingTotal += v
+			stats.processingCount++
+		}
+	}
+	if stats.queueWaitCount > 0 {
+		stats.AvgQueueWaitSecs = queueWaitTotal / float64(stats.queueWaitCount)
+	}
+	if stats.processingCount > 0 {
+		stats.AvgProcessingSec = processingTotal / float64(stats.processingCount)
+	}
+	stats.SampleSize = stats.Merged + stats.Failed
+	return stats
+}
+
+func runRefineryStats(cmd *cobra.Command, args []string) error {
+	rigName := ""
+	if len(args) > 0 {
+		rigName = args[0]
+	}
+
+	_, _, rigName, err := getRefineryManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	evts, err := events.Query(townRoot, events.QueryOptions{
+		Rig:   rigName,
+		Limit: refineryStatsLimit,
+	})
+	if err != nil {
+		return fmt.Errorf("querying merge events: %w", err)
+	}
+
+	stats := computeRefineryStats(rigName, evts)
+	queueWaitCount, processingCount := stats.queueWaitCount, stats.processingCount
+
+	if refineryStatsJSON {
+		return outputJSON(stats)
+	}
+
+	fmt.Printf("%s Merge queue stats for '%s':\n\n", style.Bold.Render("📊"), rigName)
+	fmt.Printf("  merged:              %d\n", stats.Merged)
+	fmt.Printf("  failed:              %d\n", stats.Failed)
+	if queueWaitCount > 0 {
+		fmt.Printf("  avg queue wait:      %s (n=%d)\n", formatDuration(time.Duration(stats.AvgQueueWaitSecs*float64(time.Second))), queueWaitCount)
+	} else {
+		fmt.Printf("  avg queue wait:      %s\n", style.Dim.Render("(no data)"))
+	}
+	if processingCount > 0 {
+		fmt.Printf("  avg processing time: %s (n=%d)\n", formatDuration(time.Duration(stats.AvgProcessingSec*float64(time.Second))), processingCount)
+	} else {
+		fmt.Printf("  avg processing time: %s\n", style.Dim.Render("(no data)"))
 	}
 
 	return nil
@@ -554,11 +909,11 @@ func runRefineryClaim(cmd *cobra.Command, args []string) error {
 	// Find beads from current working directory
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
-		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+		return fmt.Errorf("not in a Gas Town workspace: %w: %w", ErrNoWorkspace, err)
 	}
 	rigName, err := inferRigFromCwd(townRoot)
 	if err != nil {
-		return fmt.Errorf("could not determine rig: %w", err)
+		return fmt.Errorf("could not determine rig: %w: %w", ErrPrecondition, err)
 	}
 
 	_, r, err := getRig(rigName)
@@ -568,7 +923,7 @@ func runRefineryClaim(cmd *cobra.Command, args []string) error {
 
 	eng := refinery.NewEngineer(r)
 	if err := eng.ClaimMR(mrID, workerID); err != nil {
-		return fmt.Errorf("claiming MR: %w", err)
+		return fmt.Errorf("claiming MR: %w: %w", ErrNotFound, err)
 	}
 
 	fmt.Printf("%s Claimed %s for %s\n", style.Bold.Render("✓"), mrID, workerID)
@@ -581,11 +936,11 @@ func runRefineryRelease(cmd *cobra.Command, args []string) error {
 	// Find beads from current working directory
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
-		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+		return fmt.Errorf("not in a Gas Town workspace: %w: %w", ErrNoWorkspace, err)
 	}
 	rigName, err := inferRigFromCwd(townRoot)
 	if err != nil {
-		return fmt.Errorf("could not determine rig: %w", err)
+		return fmt.Errorf("could not determine rig: %w: %w", ErrPrecondition, err)
 	}
 
 	_, r, err := getRig(rigName)
@@ -595,7 +950,7 @@ func runRefineryRelease(cmd *cobra.Command, args []string) error {
 
 	eng := refinery.NewEngineer(r)
 	if err := eng.ReleaseMR(mrID); err != nil {
-		return fmt.Errorf("releasing MR: %w", err)
+		return fmt.Errorf("releasing MR: %w: %w", ErrNotFound, err)
 	}
 
 	fmt.Printf("%s Released %s back to queue\n", style.Bold.Render("✓"), mrID)
@@ -646,9 +1001,7 @@ func runRefineryUnclaimed(cmd *cobra.Command, args []string) error {
 
 	// JSON output
 	if refineryUnclaimedJSON {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(unclaimed)
+		return outputJSON(unclaimed)
 	}
 
 	// Human-readable output
@@ -690,9 +1043,7 @@ func runRefineryReady(cmd *cobra.Command, args []string) error {
 
 	// JSON output
 	if refineryReadyJSON {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(ready)
+		return outputJSON(ready)
 	}
 
 	// Human-readable output
@@ -734,9 +1085,7 @@ func runRefineryBlocked(cmd *cobra.Command, args []string) error {
 
 	// JSON output
 	if refineryBlockedJSON {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(blocked)
+		return outputJSON(blocked)
 	}
 
 	// Human-readable output
@@ -758,3 +1107,46 @@ func runRefineryBlocked(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func runRefineryConfig(cmd *cobra.Command, args []string) error {
+	rigName := ""
+	if len(args) > 0 {
+		rigName = args[0]
+	}
+
+	_, r, rigName, err := getRefineryManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	eng := refinery.NewEngineer(r)
+	if err := eng.LoadConfig(); err != nil {
+		return fmt.Errorf("loading merge queue config: %w", err)
+	}
+	cfg := eng.Config()
+
+	if refineryConfigJSON {
+		return outputJSON(cfg)
+	}
+
+	fmt.Printf("%s Merge queue config for '%s':\n\n", style.Bold.Render("⚙"), rigName)
+	fmt.Printf("  enabled:                %v\n", cfg.Enabled)
+	fmt.Printf("  target_branch:          %s\n", cfg.TargetBranch)
+	fmt.Printf("  integration_branches:   %v\n", cfg.IntegrationBranches)
+	fmt.Printf("  on_conflict:            %s\n", cfg.OnConflict)
+	if len(cfg.AutoResolvePaths) > 0 {
+		fmt.Printf("  auto_resolve_paths:     %s\n", strings.Join(cfg.AutoResolvePaths, ", "))
+	}
+	fmt.Printf("  run_tests:              %v\n", cfg.RunTests)
+	if cfg.TestCommand != "" {
+		fmt.Printf("  test_command:           %s\n", cfg.TestCommand)
+	}
+	fmt.Printf("  delete_merged_branches: %v\n", cfg.DeleteMergedBranches)
+	fmt.Printf("  retry_flaky_tests:      %d\n", cfg.RetryFlakyTests)
+	fmt.Printf("  poll_interval:          %s\n", cfg.PollInterval)
+	fmt.Printf("  max_concurrent:         %d\n", cfg.MaxConcurrent)
+	fmt.Printf("  require_review:         %v\n", cfg.RequireReview)
+	fmt.Printf("  close_issue_on_merge:   %s\n", cfg.CloseIssueOnMerge)
+
+	return nil
+}
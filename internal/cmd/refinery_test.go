@@ -3,6 +3,9 @@ package cmd
 import (
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
 )
 
 func TestRefineryStartAgentFlag(t *testing.T) {
@@ -31,6 +34,51 @@ func TestRefineryAttachAgentFlag(t *testing.T) {
 	}
 }
 
+func TestComputeRefineryStats_CountsAndAverages(t *testing.T) {
+	evts := []events.Event{
+		{Type: events.TypeMerged, Payload: events.MergePayload("mr-1", "nux", "b1", "gt-1", "", 60*time.Second, 30*time.Second)},
+		{Type: events.TypeMerged, Payload: events.MergePayload("mr-2", "slit", "b2", "gt-2", "", 120*time.Second, 60*time.Second)},
+		{Type: events.TypeMergeFailed, Payload: events.MergePayload("mr-3", "nux", "b3", "gt-3", "tests failed", 0, 0)},
+		{Type: events.TypeMergeSkipped, Payload: events.MergePayload("mr-4", "nux", "b4", "gt-4", "superseded", 0, 0)},
+	}
+
+	stats := computeRefineryStats("myrig", evts)
+
+	if stats.Rig != "myrig" {
+		t.Errorf("Rig = %q, want %q", stats.Rig, "myrig")
+	}
+	if stats.Merged != 2 {
+		t.Errorf("Merged = %d, want 2", stats.Merged)
+	}
+	if stats.Failed != 1 {
+		t.Errorf("Failed = %d, want 1", stats.Failed)
+	}
+	if stats.SampleSize != 3 {
+		t.Errorf("SampleSize = %d, want 3 (merge_skipped excluded)", stats.SampleSize)
+	}
+	if stats.AvgQueueWaitSecs != 90 {
+		t.Errorf("AvgQueueWaitSecs = %v, want 90 (avg of 60,120)", stats.AvgQueueWaitSecs)
+	}
+	if stats.AvgProcessingSec != 45 {
+		t.Errorf("AvgProcessingSec = %v, want 45 (avg of 30,60)", stats.AvgProcessingSec)
+	}
+}
+
+func TestComputeRefineryStats_NoDurationsLeavesAveragesZero(t *testing.T) {
+	evts := []events.Event{
+		{Type: events.TypeMergeFailed, Payload: events.MergePayload("mr-1", "nux", "b1", "gt-1", "tests failed", 0, 0)},
+	}
+
+	stats := computeRefineryStats("myrig", evts)
+
+	if stats.queueWaitCount != 0 || stats.processingCount != 0 {
+		t.Errorf("expected zero duration samples, got queueWaitCount=%d processingCount=%d", stats.queueWaitCount, stats.processingCount)
+	}
+	if stats.AvgQueueWaitSecs != 0 || stats.AvgProcessingSec != 0 {
+		t.Errorf("expected zero averages with no duration data, got %+v", stats)
+	}
+}
+
 func TestRefineryRestartAgentFlag(t *testing.T) {
 	flag := refineryRestartCmd.Flags().Lookup("agent")
 	if flag == nil {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -12,8 +13,12 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/crew"
+	"github.com/steveyegge/gastown/internal/deacon"
 	"github.com/steveyegge/gastown/internal/deps"
 	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/identity"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/plugins"
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/refinery"
 	"github.com/steveyegge/gastown/internal/rig"
@@ -41,10 +46,16 @@ A rig is a container for managing a project and its agents:
 }
 
 var rigAddCmd = &cobra.Command{
-	Use:   "add <name> <git-url>",
+	Use:   "add <name> <git-url|local-path>",
 	Short: "Add a new rig to the workspace",
 	Long: `Add a new rig by cloning a repository.
 
+The second argument can be a git URL, or the path to an existing local
+checkout. When a local path is given, it's cloned directly (no network
+needed for the initial clone) and its "origin" remote is recorded as the
+rig's canonical git URL, so future fetches, pushes, and crew/polecat
+clones still go against the real remote rather than the local path.
+
 This creates a rig container with:
   - config.json           Rig configuration
   - .beads/               Rig-level issue tracking (initialized)
@@ -62,7 +73,9 @@ The command also:
 
 Example:
   gt rig add gastown https://github.com/steveyegge/gastown
-  gt rig add my-project git@github.com:user/repo.git --prefix mp`,
+  gt rig add my-project git@github.com:user/repo.git --prefix mp
+  gt rig add my-project ./repos/my-project
+  gt rig add big-repo https://github.com/org/big-repo --mirror ~/repos/big-repo`,
 	Args: cobra.ExactArgs(2),
 	RunE: runRigAdd,
 }
@@ -70,7 +83,13 @@ Example:
 var rigListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all rigs in the workspace",
-	RunE:  runRigList,
+	Long: `List all rigs in the workspace.
+
+Examples:
+  gt rig list
+  gt rig list --json
+  gt rig list --verbose`,
+	RunE: runRigList,
 }
 
 var rigRemoveCmd = &cobra.Command{
@@ -87,11 +106,18 @@ var rigResetCmd = &cobra.Command{
 
 By default, resets all resettable state. Use flags to reset specific items.
 
+By default, --mail only clears mail addressed to the detected or specified
+identity - it never sweeps the whole town's mailboxes. Pinned messages
+(like handoff content) are left untouched unless --include-pinned is given.
+
 Examples:
   gt rig reset              # Reset all state
   gt rig reset --handoff    # Clear handoff content only
-  gt rig reset --mail       # Clear stale mail messages only
+  gt rig reset --mail       # Clear stale mail for the detected identity only
+  gt rig reset --mail --identity gastown/witness  # Clear a specific identity's mail
+  gt rig reset --mail --all-identities            # Clear mail town-wide
   gt rig reset --stale      # Reset orphaned in_progress issues
+  gt rig reset --hooks      # Unhook beads whose agent is gone
   gt rig reset --stale --dry-run  # Preview what would be reset`,
 	RunE: runRigReset,
 }
@@ -165,12 +191,18 @@ Before shutdown, checks all polecats for uncommitted work:
 - Stashes
 - Unpushed commits
 
+After stopping sessions, clears each polecat's hook wisp and sends a
+permanent shutdown notice to its mail inbox recording what (if anything)
+was on the hook, so the context isn't lost on the next spawn. Use
+--preserve-hooks to leave hooks alone when the hooked issue is still open.
+
 Use --force to skip graceful shutdown and kill immediately.
 Use --nuclear to bypass ALL safety checks (will lose work!).
 
 Examples:
   gt rig shutdown greenplace
   gt rig shutdown greenplace --force
+  gt rig shutdown greenplace --preserve-hooks
   gt rig shutdown greenplace --nuclear  # DANGER: loses uncommitted work`,
 	Args: cobra.ExactArgs(1),
 	RunE: runRigShutdown,
@@ -253,20 +285,27 @@ Examples:
 
 // Flags
 var (
-	rigAddPrefix       string
-	rigAddLocalRepo    string
-	rigAddBranch       string
-	rigResetHandoff    bool
-	rigResetMail       bool
-	rigResetStale      bool
-	rigResetDryRun     bool
-	rigResetRole       string
-	rigShutdownForce   bool
-	rigShutdownNuclear bool
-	rigStopForce       bool
-	rigStopNuclear     bool
-	rigRestartForce    bool
-	rigRestartNuclear  bool
+	rigAddPrefix             string
+	rigAddLocalRepo          string
+	rigAddBranch             string
+	rigResetHandoff          bool
+	rigResetMail             bool
+	rigResetStale            bool
+	rigResetHooks            bool
+	rigResetDryRun           bool
+	rigResetRole             string
+	rigResetIdentity         string
+	rigResetAllIdentities    bool
+	rigResetIncludePinned    bool
+	rigShutdownForce         bool
+	rigShutdownNuclear       bool
+	rigShutdownPreserveHooks bool
+	rigStopForce             bool
+	rigStopNuclear           bool
+	rigRestartForce          bool
+	rigRestartNuclear        bool
+	rigListJSON              bool
+	rigListVerbose           bool
 )
 
 func init() {
@@ -283,18 +322,29 @@ func init() {
 	rigCmd.AddCommand(rigStatusCmd)
 	rigCmd.AddCommand(rigStopCmd)
 
+	rigListCmd.Flags().BoolVar(&rigListJSON, "json", false, "Output as JSON")
+	rigListCmd.Flags().BoolVar(&rigListVerbose, "verbose", false, "Show additional details like polecat capacity usage")
+
 	rigAddCmd.Flags().StringVar(&rigAddPrefix, "prefix", "", "Beads issue prefix (default: derived from name)")
-	rigAddCmd.Flags().StringVar(&rigAddLocalRepo, "local-repo", "", "Local repo path to share git objects (optional)")
+	rigAddCmd.Flags().StringVar(&rigAddLocalRepo, "mirror", "", "Local bare/reference repo to share git objects with, avoiding a full re-clone (usable with a URL)")
+	rigAddCmd.Flags().StringVar(&rigAddLocalRepo, "local-repo", "", "Deprecated: use --mirror")
+	_ = rigAddCmd.Flags().MarkDeprecated("local-repo", "use --mirror instead")
+	_ = rigAddCmd.Flags().MarkHidden("local-repo")
 	rigAddCmd.Flags().StringVar(&rigAddBranch, "branch", "", "Default branch name (default: auto-detected from remote)")
 
 	rigResetCmd.Flags().BoolVar(&rigResetHandoff, "handoff", false, "Clear handoff content")
 	rigResetCmd.Flags().BoolVar(&rigResetMail, "mail", false, "Clear stale mail messages")
 	rigResetCmd.Flags().BoolVar(&rigResetStale, "stale", false, "Reset orphaned in_progress issues (no active session)")
+	rigResetCmd.Flags().BoolVar(&rigResetHooks, "hooks", false, "Unhook beads whose assignee has no active session")
 	rigResetCmd.Flags().BoolVar(&rigResetDryRun, "dry-run", false, "Show what would be reset without making changes")
 	rigResetCmd.Flags().StringVar(&rigResetRole, "role", "", "Role to reset (default: auto-detect from cwd)")
+	rigResetCmd.Flags().StringVar(&rigResetIdentity, "identity", "", "Address whose mail to clear (default: auto-detect from cwd)")
+	rigResetCmd.Flags().BoolVar(&rigResetAllIdentities, "all-identities", false, "Clear mail for every identity, not just the detected/specified one")
+	rigResetCmd.Flags().BoolVar(&rigResetIncludePinned, "include-pinned", false, "Also clear pinned/permanent messages (e.g. handoff content)")
 
 	rigShutdownCmd.Flags().BoolVarP(&rigShutdownForce, "force", "f", false, "Force immediate shutdown")
 	rigShutdownCmd.Flags().BoolVar(&rigShutdownNuclear, "nuclear", false, "DANGER: Bypass ALL safety checks (loses uncommitted work!)")
+	rigShutdownCmd.Flags().BoolVar(&rigShutdownPreserveHooks, "preserve-hooks", false, "Leave a polecat's hook in place if the hooked issue is still open")
 
 	rigRebootCmd.Flags().BoolVarP(&rigShutdownForce, "force", "f", false, "Force immediate shutdown during reboot")
 
@@ -305,9 +355,54 @@ func init() {
 	rigRestartCmd.Flags().BoolVar(&rigRestartNuclear, "nuclear", false, "DANGER: Bypass ALL safety checks (loses uncommitted work!)")
 }
 
+// resolveRigSource interprets rig add's second argument, which can be a git
+// URL or the path to an existing local checkout. For a local path, it
+// returns the path itself as sourcePath (so AddRig clones directly from it,
+// no network needed) and the checkout's "origin" remote as gitURL (the
+// canonical URL recorded in rigs.json, so future fetches/pushes/clones
+// still target the real remote). A local repo with no origin falls back to
+// using the path itself as gitURL, with a warning.
+func resolveRigSource(source string) (gitURL, sourcePath string, err error) {
+	if strings.Contains(source, "://") || strings.HasPrefix(source, "git@") {
+		return source, "", nil
+	}
+
+	info, statErr := os.Stat(source)
+	if statErr != nil || !info.IsDir() {
+		// Not an existing local directory - treat it as a URL and let git
+		// produce its own error if it's not reachable.
+		return source, "", nil
+	}
+
+	abs, err := filepath.Abs(source)
+	if err != nil {
+		return "", "", fmt.Errorf("resolving local repo path: %w", err)
+	}
+	abs, err = filepath.EvalSymlinks(abs)
+	if err != nil {
+		return "", "", fmt.Errorf("resolving local repo path: %w", err)
+	}
+
+	sourceGit := git.NewGit(abs)
+	if !sourceGit.IsRepo() {
+		return "", "", fmt.Errorf("%s is not a git repository", source)
+	}
+
+	origin, err := sourceGit.RemoteURL("origin")
+	if err != nil || origin == "" {
+		fmt.Printf("  Warning: local repo %s has no origin remote; using it as the rig's git URL\n", abs)
+		return abs, abs, nil
+	}
+	return origin, abs, nil
+}
+
 func runRigAdd(cmd *cobra.Command, args []string) error {
 	name := args[0]
-	gitURL := args[1]
+
+	gitURL, sourcePath, err := resolveRigSource(args[1])
+	if err != nil {
+		return err
+	}
 
 	// Ensure beads (bd) is available before proceeding
 	if err := deps.EnsureBeads(true); err != nil {
@@ -320,46 +415,40 @@ func runRigAdd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("not in a Gas Town workspace: %w", err)
 	}
 
-	// Load rigs config
 	rigsPath := filepath.Join(townRoot, "mayor", "rigs.json")
-	rigsConfig, err := config.LoadRigsConfig(rigsPath)
-	if err != nil {
-		// Create new if doesn't exist
-		rigsConfig = &config.RigsConfig{
-			Version: 1,
-			Rigs:    make(map[string]config.RigEntry),
-		}
-	}
-
-	// Create rig manager
 	g := git.NewGit(townRoot)
-	mgr := rig.NewManager(townRoot, rigsConfig, g)
 
 	fmt.Printf("Creating rig %s...\n", style.Bold.Render(name))
 	fmt.Printf("  Repository: %s\n", gitURL)
+	if sourcePath != "" {
+		fmt.Printf("  Cloning from local checkout: %s\n", sourcePath)
+	}
 	if rigAddLocalRepo != "" {
-		fmt.Printf("  Local repo: %s\n", rigAddLocalRepo)
+		fmt.Printf("  Mirror: %s\n", rigAddLocalRepo)
 	}
 
 	startTime := time.Now()
 
-	// Add the rig
-	newRig, err := mgr.AddRig(rig.AddRigOptions{
-		Name:          name,
-		GitURL:        gitURL,
-		BeadsPrefix:   rigAddPrefix,
-		LocalRepo:     rigAddLocalRepo,
-		DefaultBranch: rigAddBranch,
+	// Add the rig under the rigs.json lock, so a concurrent `gt rig add`
+	// or mayor-driven write can't clobber this one.
+	var newRig *rig.Rig
+	err = config.WithRigsConfig(rigsPath, func(rigsConfig *config.RigsConfig) error {
+		mgr := rig.NewManager(townRoot, rigsConfig, g)
+		var addErr error
+		newRig, addErr = mgr.AddRig(rig.AddRigOptions{
+			Name:          name,
+			GitURL:        gitURL,
+			SourcePath:    sourcePath,
+			BeadsPrefix:   rigAddPrefix,
+			LocalRepo:     rigAddLocalRepo,
+			DefaultBranch: rigAddBranch,
+		})
+		return addErr
 	})
 	if err != nil {
 		return fmt.Errorf("adding rig: %w", err)
 	}
 
-	// Save updated rigs config
-	if err := config.SaveRigsConfig(rigsPath, rigsConfig); err != nil {
-		return fmt.Errorf("saving rigs config: %w", err)
-	}
-
 	// Add route to town-level routes.jsonl for prefix-based routing.
 	// Route points to the canonical beads location:
 	// - If source repo has .beads/ tracked in git, route to mayor/rig
@@ -457,9 +546,27 @@ func runRigList(cmd *cobra.Command, args []string) error {
 	g := git.NewGit(townRoot)
 	mgr := rig.NewManager(townRoot, rigsConfig, g)
 
+	names := make([]string, 0, len(rigsConfig.Rigs))
+	for name := range rigsConfig.Rigs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if rigListJSON {
+		summaries := make([]rig.RigSummary, 0, len(names))
+		for _, name := range names {
+			r, err := mgr.GetRig(name)
+			if err != nil {
+				continue
+			}
+			summaries = append(summaries, r.Summary())
+		}
+		return outputJSON(summaries)
+	}
+
 	fmt.Printf("Rigs in %s:\n\n", townRoot)
 
-	for name := range rigsConfig.Rigs {
+	for _, name := range names {
 		r, err := mgr.GetRig(name)
 		if err != nil {
 			fmt.Printf("  %s %s\n", style.Warning.Render("!"), name)
@@ -470,18 +577,16 @@ func runRigList(cmd *cobra.Command, args []string) error {
 		fmt.Printf("  %s\n", style.Bold.Render(name))
 		fmt.Printf("    Polecats: %d  Crew: %d\n", summary.PolecatCount, summary.CrewCount)
 
-		agents := []string{}
-		if summary.HasRefinery {
-			agents = append(agents, "refinery")
-		}
-		if summary.HasWitness {
-			agents = append(agents, "witness")
-		}
-		if r.HasMayor {
-			agents = append(agents, "mayor")
+		if rigListVerbose {
+			if summary.MaxPolecats > 0 {
+				fmt.Printf("    polecats %d/%d\n", summary.PolecatCount, summary.MaxPolecats)
+			} else {
+				fmt.Printf("    polecats %d/unlimited\n", summary.PolecatCount)
+			}
 		}
-		if len(agents) > 0 {
-			fmt.Printf("    Agents: %v\n", agents)
+
+		if len(summary.Agents) > 0 {
+			fmt.Printf("    Agents: %v\n", summary.Agents)
 		}
 		fmt.Println()
 	}
@@ -498,26 +603,17 @@ func runRigRemove(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("not in a Gas Town workspace: %w", err)
 	}
 
-	// Load rigs config
 	rigsPath := filepath.Join(townRoot, "mayor", "rigs.json")
-	rigsConfig, err := config.LoadRigsConfig(rigsPath)
-	if err != nil {
-		return fmt.Errorf("loading rigs config: %w", err)
-	}
-
-	// Create rig manager
 	g := git.NewGit(townRoot)
-	mgr := rig.NewManager(townRoot, rigsConfig, g)
 
-	if err := mgr.RemoveRig(name); err != nil {
+	err = config.WithRigsConfig(rigsPath, func(rigsConfig *config.RigsConfig) error {
+		mgr := rig.NewManager(townRoot, rigsConfig, g)
+		return mgr.RemoveRig(name)
+	})
+	if err != nil {
 		return fmt.Errorf("removing rig: %w", err)
 	}
 
-	// Save updated config
-	if err := config.SaveRigsConfig(rigsPath, rigsConfig); err != nil {
-		return fmt.Errorf("saving rigs config: %w", err)
-	}
-
 	fmt.Printf("%s Rig %s removed from registry\n", style.Success.Render("✓"), name)
 	fmt.Printf("\nNote: Files at %s were NOT deleted.\n", filepath.Join(townRoot, name))
 	fmt.Printf("To delete: %s\n", style.Dim.Render(fmt.Sprintf("rm -rf %s", filepath.Join(townRoot, name))))
@@ -537,27 +633,69 @@ func runRigReset(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("getting current directory: %w", err)
 	}
 
+	// Detect role/rig/name from cwd once - used both to derive roleKey
+	// (for --handoff) and the mailbox address (for --mail) below.
+	roleInfo, err := GetRoleWithContext(cwd, townRoot)
+	if err != nil {
+		return fmt.Errorf("detecting role: %w", err)
+	}
+
 	// Determine role to reset
 	roleKey := rigResetRole
 	if roleKey == "" {
-		// Auto-detect using env-aware role detection
-		roleInfo, err := GetRoleWithContext(cwd, townRoot)
-		if err != nil {
-			return fmt.Errorf("detecting role: %w", err)
-		}
 		if roleInfo.Role == RoleUnknown {
 			return fmt.Errorf("could not detect role; use --role to specify")
 		}
 		roleKey = string(roleInfo.Role)
 	}
 
+	if rigResetIdentity != "" && rigResetAllIdentities {
+		return fmt.Errorf("--identity and --all-identities are mutually exclusive")
+	}
+
+	// Determine which mailbox(es) --mail should clear. Defaults to the
+	// caller's own detected identity, so a rig reset never sweeps mail
+	// belonging to other agents (e.g. the mayor's pinned handoff context)
+	// unless explicitly asked to.
+	var mailAssignee, mailScope string
+	switch {
+	case rigResetAllIdentities:
+		mailScope = "all identities"
+	case rigResetIdentity != "":
+		mailAssignee = rigResetIdentity
+		mailScope = rigResetIdentity
+	default:
+		if roleInfo.Role == RoleUnknown {
+			return fmt.Errorf("could not detect identity for --mail; use --identity to specify")
+		}
+		mailAssignee = identity.Identity{
+			Role: identity.Role(roleInfo.Role),
+			Rig:  roleInfo.Rig,
+			Name: roleInfo.Polecat,
+		}.Address()
+		mailScope = mailAssignee
+	}
+
 	// If no specific flags, reset all; otherwise only reset what's specified
-	resetAll := !rigResetHandoff && !rigResetMail && !rigResetStale
+	resetAll := !rigResetHandoff && !rigResetMail && !rigResetStale && !rigResetHooks
+
+	// The identity performing the reset, so cleared handoff/mail/hook state
+	// is attributed to whoever ran `gt rig reset`, not to whatever identity
+	// (or --identity/--all-identities target) it's clearing. Distinct from
+	// mailAssignee above, which is the target being reset.
+	var resetterActor string
+	if roleInfo.Role != RoleUnknown {
+		resetterActor = identity.Identity{
+			Role: identity.Role(roleInfo.Role),
+			Rig:  roleInfo.Rig,
+			Name: roleInfo.Polecat,
+		}.Address()
+	}
 
 	// Town beads for handoff/mail operations
-	townBd := beads.New(townRoot)
+	townBd := beads.New(townRoot).WithActor(resetterActor)
 	// Rig beads for issue operations (uses cwd to find .beads/)
-	rigBd := beads.New(cwd)
+	rigBd := beads.New(cwd).WithActor(resetterActor)
 
 	// Reset handoff content
 	if resetAll || rigResetHandoff {
@@ -567,17 +705,21 @@ func runRigReset(cmd *cobra.Command, args []string) error {
 		fmt.Printf("%s Cleared handoff content for %s\n", style.Success.Render("✓"), roleKey)
 	}
 
-	// Clear stale mail messages
+	// Clear stale mail messages, scoped to mailAssignee ("" means town-wide)
 	if resetAll || rigResetMail {
-		result, err := townBd.ClearMail("Cleared during reset")
+		result, err := townBd.ClearMail(beads.ClearMailOptions{
+			Reason:        "Cleared during reset",
+			Assignee:      mailAssignee,
+			IncludePinned: rigResetIncludePinned,
+		})
 		if err != nil {
 			return fmt.Errorf("clearing mail: %w", err)
 		}
 		if result.Closed > 0 || result.Cleared > 0 {
-			fmt.Printf("%s Cleared mail: %d closed, %d pinned cleared\n",
-				style.Success.Render("✓"), result.Closed, result.Cleared)
+			fmt.Printf("%s Cleared mail for %s: %d closed, %d pinned cleared\n",
+				style.Success.Render("✓"), mailScope, result.Closed, result.Cleared)
 		} else {
-			fmt.Printf("%s No mail to clear\n", style.Success.Render("✓"))
+			fmt.Printf("%s No mail to clear for %s\n", style.Success.Render("✓"), mailScope)
 		}
 	}
 
@@ -588,6 +730,40 @@ func runRigReset(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Unhook beads left hooked by an agent whose session is gone
+	if resetAll || rigResetHooks {
+		if err := runResetStaleHooks(townRoot, rigResetDryRun); err != nil {
+			return fmt.Errorf("resetting stale hooks: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// runResetStaleHooks unhooks beads whose assignee no longer has an active
+// session, using the same detection deacon uses for `gt deacon
+// stale-hooks`. Unlike that command, reset passes MaxAge 0: a rig reset
+// is already the operator saying this rig's runtime state can't be
+// trusted, so any hook with a dead assignee counts, not just old ones.
+func runResetStaleHooks(townRoot string, dryRun bool) error {
+	result, err := deacon.ScanStaleHooks(townRoot, &deacon.StaleHookConfig{MaxAge: 0, DryRun: dryRun})
+	if err != nil {
+		return fmt.Errorf("scanning hooked beads: %w", err)
+	}
+
+	if result.TotalHooked == 0 {
+		fmt.Printf("%s No hooked beads found\n", style.Success.Render("✓"))
+		return nil
+	}
+
+	if dryRun {
+		fmt.Printf("%s Would unhook %d stale bead(s) of %d hooked\n",
+			style.Success.Render("✓"), result.StaleCount, result.TotalHooked)
+		return nil
+	}
+
+	fmt.Printf("%s Unhooked %d stale bead(s) of %d hooked\n",
+		style.Success.Render("✓"), result.Unhooked, result.TotalHooked)
 	return nil
 }
 
@@ -696,22 +872,11 @@ func runResetStale(bd *beads.Beads, dryRun bool) error {
 // assigneeToSessionName converts an assignee (rig/name or rig/crew/name) to tmux session name.
 // Returns the session name and whether this is a persistent identity (crew).
 func assigneeToSessionName(assignee string) (sessionName string, isPersistent bool) {
-	parts := strings.Split(assignee, "/")
-
-	switch len(parts) {
-	case 2:
-		// rig/polecatName -> gt-rig-polecatName
-		return fmt.Sprintf("gt-%s-%s", parts[0], parts[1]), false
-	case 3:
-		// rig/crew/name -> gt-rig-crew-name
-		if parts[1] == "crew" {
-			return fmt.Sprintf("gt-%s-crew-%s", parts[0], parts[2]), true
-		}
-		// Other 3-part formats not recognized
-		return "", false
-	default:
+	id, err := identity.FromAddress(assignee)
+	if err != nil {
 		return "", false
 	}
+	return id.SessionName(), id.Role == identity.RoleCrew
 }
 
 // Helper to check if path exists
@@ -910,7 +1075,7 @@ func runRigShutdown(cmd *cobra.Command, args []string) error {
 	// Find workspace
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
-		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+		return fmt.Errorf("not in a Gas Town workspace: %w: %w", ErrNoWorkspace, err)
 	}
 
 	// Load rigs config and get rig
@@ -924,7 +1089,7 @@ func runRigShutdown(cmd *cobra.Command, args []string) error {
 	rigMgr := rig.NewManager(townRoot, rigsConfig, g)
 	r, err := rigMgr.GetRig(rigName)
 	if err != nil {
-		return fmt.Errorf("rig '%s' not found", rigName)
+		return fmt.Errorf("rig '%s' not found: %w", rigName, ErrNotFound)
 	}
 
 	// Check all polecats for uncommitted work (unless nuclear)
@@ -952,14 +1117,25 @@ func runRigShutdown(cmd *cobra.Command, args []string) error {
 			if len(problemPolecats) > 0 {
 				fmt.Printf("\n%s Cannot shutdown - polecats have uncommitted work:\n\n", style.Warning.Render("⚠"))
 				for _, pp := range problemPolecats {
-					fmt.Printf("  %s: %s\n", style.Bold.Render(pp.name), pp.status.String())
+					fmt.Printf("  %s: %s\n%s\n", style.Bold.Render(pp.name), pp.status.String(), pp.status.Detail())
 				}
 				fmt.Printf("\nUse %s to force shutdown (DANGER: will lose work!)\n", style.Bold.Render("--nuclear"))
-				return fmt.Errorf("refusing to shutdown with uncommitted work")
+				return fmt.Errorf("refusing to shutdown with uncommitted work: %w", ErrDirtyWorktree)
 			}
 		}
 	}
 
+	// Give a pre-shutdown plugin a chance to veto the shutdown (e.g. a
+	// deploy in progress). A non-veto failure is logged and ignored.
+	pluginRunner := plugins.NewRunner(townRoot, r.Path)
+	preShutdownResult := pluginRunner.Run(plugins.EventPreShutdown, map[string]string{"rig": rigName})
+	if preShutdownResult.Vetoed() {
+		return fmt.Errorf("shutdown vetoed by pre-shutdown plugin: %s", strings.TrimSpace(preShutdownResult.Stderr))
+	}
+	if preShutdownResult.Failed() {
+		fmt.Printf("Warning: pre-shutdown plugin failed: %s\n", preShutdownResult.FailureDetail())
+	}
+
 	fmt.Printf("Shutting down rig %s...\n", style.Bold.Render(rigName))
 
 	var errors []string
@@ -975,7 +1151,16 @@ func runRigShutdown(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// 2. Stop the refinery
+	// 2. Clear polecat hook wisps and send shutdown notices
+	hookResult, err := clearPolecatHooks(r, townRoot, rigShutdownPreserveHooks)
+	if err != nil {
+		errors = append(errors, fmt.Sprintf("hook cleanup: %v", err))
+	} else if hookResult.Total > 0 {
+		fmt.Printf("  Cleared %d hook(s), preserved %d, notified %d polecat(s)\n",
+			hookResult.Cleared, hookResult.Preserved, hookResult.Notified)
+	}
+
+	// 3. Stop the refinery
 	refMgr := refinery.NewManager(r)
 	if running, _ := refMgr.IsRunning(); running {
 		fmt.Printf("  Stopping refinery...\n")
@@ -984,7 +1169,7 @@ func runRigShutdown(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// 3. Stop the witness
+	// 4. Stop the witness
 	witMgr := witness.NewManager(r)
 	if running, _ := witMgr.IsRunning(); running {
 		fmt.Printf("  Stopping witness...\n")
@@ -1005,6 +1190,106 @@ func runRigShutdown(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// hookClearResult summarizes the outcome of clearPolecatHooks.
+type hookClearResult struct {
+	Total     int // polecats with an agent bead
+	Cleared   int // hooks cleared
+	Preserved int // hooks left in place (--preserve-hooks + issue still open)
+	Notified  int // shutdown notices successfully sent
+}
+
+// planHookNotice decides what clearPolecatHooks should do with a single
+// polecat's hook and what to tell it in the shutdown notice. hookBead is
+// empty if the polecat had nothing hooked; hookTitle/hookStatus come from
+// looking up hookBead and are ignored when hookBead is empty.
+func planHookNotice(hookBead, hookTitle, hookStatus string, preserveHooks bool) (shouldClear, preserved bool, hookLine string) {
+	if hookBead == "" {
+		return false, false, "No work was on the hook."
+	}
+
+	hookDesc := hookBead
+	if hookTitle != "" {
+		hookDesc = fmt.Sprintf("%s (%s)", hookBead, hookTitle)
+	}
+
+	if preserveHooks && hookStatus == "open" {
+		return false, true, fmt.Sprintf("Hook preserved (--preserve-hooks, issue still open): %s", hookDesc)
+	}
+
+	return true, false, fmt.Sprintf("Hook cleared: %s", hookDesc)
+}
+
+// clearPolecatHooks clears each polecat's hook wisp on shutdown and sends a
+// permanent mail to the polecat's inbox recording what was on the hook, so
+// the next spawn doesn't see stale "you have work hooked" state and the
+// context isn't silently lost.
+//
+// A hook is left in place if preserveHooks is true and the hooked issue is
+// still open; the notice is still sent either way.
+func clearPolecatHooks(r *rig.Rig, townRoot string, preserveHooks bool) (hookClearResult, error) {
+	var result hookClearResult
+
+	polecatMgr := polecat.NewManager(r, git.NewGit(r.Path), nil) // nil tmux: just listing
+	polecats, err := polecatMgr.List()
+	if err != nil || len(polecats) == 0 {
+		return result, nil
+	}
+
+	bd := beads.New(townRoot)
+	router := mail.NewRouter(townRoot)
+	prefix := beads.GetPrefixForRig(townRoot, r.Name)
+
+	var errs []string
+	for _, p := range polecats {
+		agentBeadID := beads.PolecatBeadIDWithPrefix(prefix, r.Name, p.Name)
+		_, fields, err := bd.GetAgentBead(agentBeadID)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", p.Name, err))
+			continue
+		}
+		if fields == nil {
+			continue // no agent bead for this polecat
+		}
+		result.Total++
+
+		hookTitle, hookStatus := "", ""
+		if fields.HookBead != "" {
+			if hookIssue, err := bd.Show(fields.HookBead); err == nil {
+				hookTitle, hookStatus = hookIssue.Title, hookIssue.Status
+			}
+		}
+
+		shouldClear, preserved, hookLine := planHookNotice(fields.HookBead, hookTitle, hookStatus, preserveHooks)
+		if preserved {
+			result.Preserved++
+		}
+		if shouldClear {
+			if err := bd.ClearHookBead(agentBeadID); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: clearing hook: %v", p.Name, err))
+				continue
+			}
+			result.Cleared++
+		}
+
+		msg := mail.NewMessage(
+			"gt-rig-shutdown",
+			fmt.Sprintf("%s/%s", r.Name, p.Name),
+			fmt.Sprintf("Rig %s shut down", r.Name),
+			fmt.Sprintf("Rig %s was shut down at %s.\n\n%s", r.Name, time.Now().Format(time.RFC3339), hookLine),
+		)
+		if err := router.Send(msg); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: sending notice: %v", p.Name, err))
+			continue
+		}
+		result.Notified++
+	}
+
+	if len(errs) > 0 {
+		return result, fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return result, nil
+}
+
 func runRigReboot(cmd *cobra.Command, args []string) error {
 	rigName := args[0]
 
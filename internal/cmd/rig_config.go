@@ -10,6 +10,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/wisp"
@@ -175,6 +176,12 @@ func runRigConfigSet(cmd *cobra.Command, args []string) error {
 
 	value := args[2]
 
+	if key == "polecat_branch_template" {
+		if err := polecat.ValidateBranchTemplate(value); err != nil {
+			return err
+		}
+	}
+
 	if rigConfigSetGlobal {
 		// Set in bead layer (rig identity bead labels)
 		if err := setBeadLabel(townRoot, r, key, value); err != nil {
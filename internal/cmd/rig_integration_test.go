@@ -521,6 +521,69 @@ func TestRigAddUpdatesRigsJson(t *testing.T) {
 	}
 }
 
+// TestRigAddSourcePathClonesLocallyAndRecordsCanonicalURL verifies that
+// when SourcePath is set (a local checkout distinct from GitURL), AddRig
+// clones from the local checkout but records GitURL as the canonical
+// remote and repoints "origin" on the resulting clones at it - so the rig
+// stays usable once the local checkout is gone.
+func TestRigAddSourcePathClonesLocallyAndRecordsCanonicalURL(t *testing.T) {
+	_ = mockBdCommand(t)
+	townRoot := setupTestTown(t)
+
+	canonicalURL := createTestGitRepo(t, "canonical")
+
+	// A local checkout of the canonical repo, as if a developer already
+	// had it cloned on disk.
+	localCheckout := filepath.Join(t.TempDir(), "local-checkout")
+	cloneCmd := exec.Command("git", "clone", canonicalURL, localCheckout)
+	if out, err := cloneCmd.CombinedOutput(); err != nil {
+		t.Fatalf("git clone: %v\n%s", err, out)
+	}
+
+	rigsPath := filepath.Join(townRoot, "mayor", "rigs.json")
+	rigsConfig, err := config.LoadRigsConfig(rigsPath)
+	if err != nil {
+		t.Fatalf("load rigs.json: %v", err)
+	}
+
+	g := git.NewGit(townRoot)
+	mgr := rig.NewManager(townRoot, rigsConfig, g)
+
+	_, err = mgr.AddRig(rig.AddRigOptions{
+		Name:        "sourcepathtest",
+		GitURL:      canonicalURL,
+		SourcePath:  localCheckout,
+		BeadsPrefix: "sp",
+	})
+	if err != nil {
+		t.Fatalf("AddRig: %v", err)
+	}
+
+	rigPath := filepath.Join(townRoot, "sourcepathtest")
+
+	mayorGit := git.NewGitWithDir("", filepath.Join(rigPath, "mayor", "rig"))
+	if origin, err := mayorGit.RemoteURL("origin"); err != nil {
+		t.Errorf("mayor origin: %v", err)
+	} else if origin != canonicalURL {
+		t.Errorf("mayor origin = %q, want canonical URL %q", origin, canonicalURL)
+	}
+
+	bareGit := git.NewGitWithDir(filepath.Join(rigPath, ".repo.git"), "")
+	if origin, err := bareGit.RemoteURL("origin"); err != nil {
+		t.Errorf("bare repo origin: %v", err)
+	} else if origin != canonicalURL {
+		t.Errorf("bare repo origin = %q, want canonical URL %q", origin, canonicalURL)
+	}
+
+	entry, ok := rigsConfig.Rigs["sourcepathtest"]
+	if !ok {
+		t.Fatal("rig 'sourcepathtest' not found in rigs.json")
+	}
+	if entry.GitURL != canonicalURL {
+		t.Errorf("recorded GitURL = %q, want canonical URL %q", entry.GitURL, canonicalURL)
+	}
+}
+
 // TestRigAddDerivesPrefix verifies that when no prefix is specified,
 // one is derived from the rig name.
 func TestRigAddDerivesPrefix(t *testing.T) {
@@ -0,0 +1,60 @@
+package cmd
+
+import "testing"
+
+func TestPlanHookNotice(t *testing.T) {
+	tests := []struct {
+		name          string
+		hookBead      string
+		hookTitle     string
+		hookStatus    string
+		preserveHooks bool
+		wantClear     bool
+		wantPreserved bool
+	}{
+		{
+			name:      "polecat with no hook",
+			hookBead:  "",
+			wantClear: false,
+		},
+		{
+			name:       "polecat with hook, no --preserve-hooks",
+			hookBead:   "gt-123",
+			hookTitle:  "Fix the thing",
+			hookStatus: "open",
+			wantClear:  true,
+		},
+		{
+			name:          "polecat with open hook and --preserve-hooks",
+			hookBead:      "gt-123",
+			hookTitle:     "Fix the thing",
+			hookStatus:    "open",
+			preserveHooks: true,
+			wantClear:     false,
+			wantPreserved: true,
+		},
+		{
+			name:          "polecat with closed hook and --preserve-hooks still clears",
+			hookBead:      "gt-123",
+			hookTitle:     "Fix the thing",
+			hookStatus:    "closed",
+			preserveHooks: true,
+			wantClear:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shouldClear, preserved, hookLine := planHookNotice(tt.hookBead, tt.hookTitle, tt.hookStatus, tt.preserveHooks)
+			if shouldClear != tt.wantClear {
+				t.Errorf("shouldClear = %v, want %v", shouldClear, tt.wantClear)
+			}
+			if preserved != tt.wantPreserved {
+				t.Errorf("preserved = %v, want %v", preserved, tt.wantPreserved)
+			}
+			if hookLine == "" {
+				t.Error("hookLine should never be empty")
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveRigSource_URL(t *testing.T) {
+	for _, url := range []string{
+		"https://github.com/steveyegge/gastown",
+		"git@github.com:user/repo.git",
+	} {
+		gitURL, sourcePath, err := resolveRigSource(url)
+		if err != nil {
+			t.Fatalf("resolveRigSource(%q): %v", url, err)
+		}
+		if gitURL != url {
+			t.Errorf("resolveRigSource(%q) gitURL = %q, want %q", url, gitURL, url)
+		}
+		if sourcePath != "" {
+			t.Errorf("resolveRigSource(%q) sourcePath = %q, want empty", url, sourcePath)
+		}
+	}
+}
+
+func TestResolveRigSource_NonexistentPathTreatedAsURL(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "does-not-exist")
+	gitURL, sourcePath, err := resolveRigSource(missing)
+	if err != nil {
+		t.Fatalf("resolveRigSource: %v", err)
+	}
+	if gitURL != missing || sourcePath != "" {
+		t.Errorf("resolveRigSource(%q) = (%q, %q), want (%q, \"\")", missing, gitURL, sourcePath, missing)
+	}
+}
+
+func TestResolveRigSource_LocalRepoWithOrigin(t *testing.T) {
+	repoDir := filepath.Join(t.TempDir(), "local-repo")
+	if err := os.MkdirAll(repoDir, 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repoDir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	run("init", "--initial-branch=main")
+	run("config", "user.email", "test@test.com")
+	run("config", "user.name", "Test User")
+	run("remote", "add", "origin", "https://example.com/org/repo.git")
+
+	gitURL, sourcePath, err := resolveRigSource(repoDir)
+	if err != nil {
+		t.Fatalf("resolveRigSource: %v", err)
+	}
+	if gitURL != "https://example.com/org/repo.git" {
+		t.Errorf("gitURL = %q, want the repo's origin URL", gitURL)
+	}
+	resolvedRepoDir, err := filepath.EvalSymlinks(repoDir)
+	if err != nil {
+		t.Fatalf("EvalSymlinks: %v", err)
+	}
+	if sourcePath != resolvedRepoDir {
+		t.Errorf("sourcePath = %q, want %q", sourcePath, resolvedRepoDir)
+	}
+}
+
+func TestResolveRigSource_LocalRepoWithoutOriginFallsBackToPath(t *testing.T) {
+	repoDir := filepath.Join(t.TempDir(), "local-repo-no-origin")
+	if err := os.MkdirAll(repoDir, 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	cmd := exec.Command("git", "init", "--initial-branch=main")
+	cmd.Dir = repoDir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v\n%s", err, out)
+	}
+
+	gitURL, sourcePath, err := resolveRigSource(repoDir)
+	if err != nil {
+		t.Fatalf("resolveRigSource: %v", err)
+	}
+	resolvedRepoDir, err := filepath.EvalSymlinks(repoDir)
+	if err != nil {
+		t.Fatalf("EvalSymlinks: %v", err)
+	}
+	if gitURL != resolvedRepoDir {
+		t.Errorf("gitURL = %q, want %q (the repo path itself)", gitURL, resolvedRepoDir)
+	}
+	if sourcePath != resolvedRepoDir {
+		t.Errorf("sourcePath = %q, want %q", sourcePath, resolvedRepoDir)
+	}
+}
+
+func TestResolveRigSource_NonRepoDirectoryErrors(t *testing.T) {
+	dir := t.TempDir()
+	if _, _, err := resolveRigSource(dir); err == nil {
+		t.Error("resolveRigSource on a non-git directory should error")
+	}
+}
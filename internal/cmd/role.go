@@ -9,6 +9,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/identity"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -28,12 +29,12 @@ type RoleInfo struct {
 	Home          string `json:"home"`
 	Rig           string `json:"rig,omitempty"`
 	Polecat       string `json:"polecat,omitempty"`
-	EnvRole       string `json:"env_role,omitempty"`    // Value of GT_ROLE if set
-	CwdRole       Role   `json:"cwd_role,omitempty"`    // Role detected from cwd
-	Mismatch      bool   `json:"mismatch,omitempty"`    // True if env != cwd detection
+	EnvRole       string `json:"env_role,omitempty"`       // Value of GT_ROLE if set
+	CwdRole       Role   `json:"cwd_role,omitempty"`       // Role detected from cwd
+	Mismatch      bool   `json:"mismatch,omitempty"`       // True if env != cwd detection
 	EnvIncomplete bool   `json:"env_incomplete,omitempty"` // True if env was set but missing rig/polecat, filled from cwd
 	TownRoot      string `json:"town_root,omitempty"`
-	WorkDir       string `json:"work_dir,omitempty"`    // Current working directory
+	WorkDir       string `json:"work_dir,omitempty"` // Current working directory
 }
 
 var roleCmd = &cobra.Command{
@@ -167,8 +168,11 @@ func GetRoleWithContext(cwd, townRoot string) (RoleInfo, error) {
 	envRole := os.Getenv(EnvGTRole)
 	info.EnvRole = envRole
 
-	// Always detect from cwd for comparison/fallback
-	cwdCtx := detectRole(cwd, townRoot)
+	// Always detect from cwd for comparison/fallback. A .gastown marker
+	// (written into polecat/crew clones, see workspace.WriteMarker) takes
+	// precedence over path heuristics - it's the only reliable signal once
+	// the clone lives outside townRoot's directory tree.
+	cwdCtx := detectRoleWithMarker(cwd, townRoot)
 	info.CwdRole = cwdCtx.Role
 
 	// Determine authoritative role
@@ -227,7 +231,16 @@ func GetRoleWithContext(cwd, townRoot string) (RoleInfo, error) {
 	return info, nil
 }
 
-// parseRoleString parses a role string like "mayor", "gastown/witness", or "gastown/polecats/alpha".
+// parseRoleString parses a role string like "mayor", "gastown/witness", or
+// "gastown/polecats/alpha".
+//
+// This is deliberately more permissive than identity.FromAddress: callers
+// rely on it to accept a bare "rig/polecats" or "rig/crew" (no name yet)
+// and to fall back to Role(s), "", "" for anything else it can't parse,
+// rather than erroring - GetRoleWithContext needs a role to try before it
+// falls back to cwd detection, not a hard failure. The one shape the two
+// agree on (short-form "rig/polecatName") delegates to identity.FromAddress
+// so that agreement doesn't drift.
 func parseRoleString(s string) (Role, string, string) {
 	s = strings.TrimSpace(s)
 
@@ -264,7 +277,10 @@ func parseRoleString(s string) (Role, string, string) {
 		}
 		return RoleCrew, rig, ""
 	default:
-		// Might be rig/polecatName format
+		// rig/polecatName short form.
+		if id, err := identity.FromAddress(s); err == nil {
+			return Role(id.Role), id.Rig, id.Name
+		}
 		return RolePolecat, rig, parts[1]
 	}
 }
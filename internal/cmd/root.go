@@ -9,6 +9,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/version"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -64,8 +65,26 @@ var branchCheckExemptCommands = map[string]bool{
 	"git-init":   true, // Git setup
 }
 
+// townFlag is the --town persistent flag: a workspace path or a name
+// registered via `gt town add`. Set, it short-circuits cwd-based town
+// discovery for every command (see workspace.SetTownOverride).
+var townFlag string
+
+// plainFlag is the --plain persistent flag: forces raw, uncolored,
+// emoji-free output, for logs and hook-injected text that get parsed
+// downstream (see ui.SetPlain).
+var plainFlag bool
+
 // persistentPreRun runs before every command.
 func persistentPreRun(cmd *cobra.Command, args []string) error {
+	if plainFlag {
+		ui.SetPlain(true)
+	}
+
+	if townFlag != "" {
+		workspace.SetTownOverride(townFlag)
+	}
+
 	// Get the root command name being run
 	cmdName := cmd.Name()
 
@@ -171,8 +190,8 @@ func checkStaleBinaryWarning() {
 			msg = fmt.Sprintf("gt binary is %d commits behind (built from %s, repo at %s)",
 				info.CommitsBehind, version.ShortCommit(info.BinaryCommit), version.ShortCommit(info.RepoCommit))
 		}
-		fmt.Fprintf(os.Stderr, "%s %s\n", style.WarningPrefix, msg)
-		fmt.Fprintf(os.Stderr, "    %s Run 'gt install' to update\n", style.ArrowPrefix)
+		fmt.Fprintf(os.Stderr, "%s %s\n", style.WarningPrefix(), msg)
+		fmt.Fprintf(os.Stderr, "    %s Run 'gt install' to update\n", style.ArrowPrefix())
 	}
 }
 
@@ -185,7 +204,7 @@ func Execute() int {
 			return code
 		}
 		// Other errors already printed by cobra
-		return 1
+		return ExitCodeForError(err)
 	}
 	return 0
 }
@@ -220,8 +239,9 @@ func init() {
 	rootCmd.SetHelpCommandGroupID(GroupDiag)
 	rootCmd.SetCompletionCommandGroupID(GroupConfig)
 
-	// Global flags can be added here
-	// rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file")
+	// Global flags
+	rootCmd.PersistentFlags().StringVar(&townFlag, "town", "", "Path or registered name of the town to operate on (overrides cwd-based discovery; see GT_TOWN and `gt town`)")
+	rootCmd.PersistentFlags().BoolVar(&plainFlag, "plain", false, "Force raw, uncolored, emoji-free output (also respects NO_COLOR and non-TTY stdout)")
 }
 
 // buildCommandPath walks the command hierarchy to build the full command path.
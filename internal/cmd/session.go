@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -12,8 +11,10 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/suggest"
 	"github.com/steveyegge/gastown/internal/tmux"
@@ -23,13 +24,16 @@ import (
 
 // Session command flags
 var (
-	sessionIssue     string
-	sessionForce     bool
-	sessionLines     int
-	sessionMessage   string
-	sessionFile      string
-	sessionRigFilter string
-	sessionListJSON  bool
+	sessionIssue       string
+	sessionForce       bool
+	sessionLines       int
+	sessionMessage     string
+	sessionFile        string
+	sessionRigFilter   string
+	sessionListJSON    bool
+	sessionMaxIdle     time.Duration
+	sessionStopDryRun  bool
+	sessionWaitTimeout time.Duration
 )
 
 var sessionCmd = &cobra.Command{
@@ -74,12 +78,23 @@ Use --force to skip graceful shutdown.`,
 }
 
 var sessionAtCmd = &cobra.Command{
-	Use:     "at <rig>/<polecat>",
+	Use:     "at <target>",
 	Aliases: []string{"attach"},
-	Short:   "Attach to a running session",
-	Long: `Attach to a running polecat session.
+	Short:   "Attach to a session by rig/polecat, role shortcut, or raw session name",
+	Long: `Attach to a running session, resolving the target the same way 'gt handoff --to' does.
 
-Attaches the current terminal to the tmux session. Detach with Ctrl-B D.`,
+Accepts any identity form:
+  - rig/polecat paths:    gt session at wyvern/Toast
+  - role shortcuts:       gt session at witness   (needs GT_RIG, or run from rig context)
+  - raw tmux session names, passed through unchanged
+
+Witness, refinery, mayor, deacon, and crew targets are started automatically
+if not already running. Polecat targets are not auto-started; use
+'gt session start' first. Typos get "did you mean" suggestions instead of a
+raw tmux error.
+
+Switches to the session with tmux switch-client if already inside tmux,
+otherwise attaches directly. Detach with Ctrl-B D.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runSessionAttach,
 }
@@ -166,9 +181,60 @@ Examples:
 	RunE: runSessionCheck,
 }
 
+var sessionStopIdleCmd = &cobra.Command{
+	Use:   "stop-idle [rig]",
+	Short: "Stop polecat sessions idle beyond a threshold",
+	Long: `Stop polecat sessions that have finished their work but never got
+cleaned up (tmux session idle beyond --max-idle).
+
+A session is only stopped if it's actually safe to: the polecat's agent
+bead must report agent_state "done" and cleanup_status "clean" (the same
+signals used to decide a worktree is safe to remove). A polecat that's
+still working, stuck, or has uncommitted changes is left alone no matter
+how long its session has been idle.
+
+Without --max-idle, falls back to the rig's idle_polecat_timeout setting
+(settings/config.json); if neither is set, the command errors.
+
+Examples:
+  gt session stop-idle --max-idle 2h
+  gt session stop-idle wyvern --max-idle 30m --dry-run`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runSessionStopIdle,
+}
+
+var sessionWaitCmd = &cobra.Command{
+	Use:   "wait <rig>/<polecat>",
+	Short: "Wait for a session to become ready",
+	Long: `Wait for a polecat's tmux session to reach the Claude prompt.
+
+Polls the session's pane for the prompt with exponential backoff instead
+of a fixed sleep. Useful after 'gt session start' when a caller needs to
+know Claude has finished booting before injecting work.
+
+Examples:
+  gt session wait wyvern/Toast
+  gt session wait wyvern/Toast --timeout 30s`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSessionWait,
+}
+
 func init() {
+	// Completions
+	sessionStartCmd.ValidArgsFunction = completeRigSlashAgent
+	sessionStopCmd.ValidArgsFunction = completeRigSlashAgent
+	sessionAtCmd.ValidArgsFunction = completeRigSlashAgent
+	sessionCaptureCmd.ValidArgsFunction = completeRigSlashAgent
+	sessionInjectCmd.ValidArgsFunction = completeRigSlashAgent
+	sessionRestartCmd.ValidArgsFunction = completeRigSlashAgent
+	sessionStatusCmd.ValidArgsFunction = completeRigSlashAgent
+	sessionCheckCmd.ValidArgsFunction = completeRigArg
+	sessionStopIdleCmd.ValidArgsFunction = completeRigArg
+	sessionWaitCmd.ValidArgsFunction = completeRigSlashAgent
+
 	// Start flags
 	sessionStartCmd.Flags().StringVar(&sessionIssue, "issue", "", "Issue ID to work on")
+	_ = sessionStartCmd.RegisterFlagCompletionFunc("issue", completeBeadIDs)
 
 	// Stop flags
 	sessionStopCmd.Flags().BoolVarP(&sessionForce, "force", "f", false, "Force immediate shutdown")
@@ -187,6 +253,13 @@ func init() {
 	// Restart flags
 	sessionRestartCmd.Flags().BoolVarP(&sessionForce, "force", "f", false, "Force immediate shutdown")
 
+	// Stop-idle flags
+	sessionStopIdleCmd.Flags().DurationVar(&sessionMaxIdle, "max-idle", 0, "Idle threshold (e.g. 2h); falls back to the rig's idle_polecat_timeout setting")
+	sessionStopIdleCmd.Flags().BoolVar(&sessionStopDryRun, "dry-run", false, "Show what would be stopped without stopping anything")
+
+	// Wait flags
+	sessionWaitCmd.Flags().DurationVar(&sessionWaitTimeout, "timeout", 60*time.Second, "How long to wait for readiness")
+
 	// Add subcommands
 	sessionCmd.AddCommand(sessionStartCmd)
 	sessionCmd.AddCommand(sessionStopCmd)
@@ -197,6 +270,8 @@ func init() {
 	sessionCmd.AddCommand(sessionRestartCmd)
 	sessionCmd.AddCommand(sessionStatusCmd)
 	sessionCmd.AddCommand(sessionCheckCmd)
+	sessionCmd.AddCommand(sessionStopIdleCmd)
+	sessionCmd.AddCommand(sessionWaitCmd)
 
 	rootCmd.AddCommand(sessionCmd)
 }
@@ -320,19 +395,109 @@ func runSessionStop(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runSessionAttach(cmd *cobra.Command, args []string) error {
-	rigName, polecatName, err := parseAddress(args[0])
+// sessionExistence is the subset of *tmux.Tmux that target resolution needs.
+// Narrowed to an interface so the resolution matrix can be tested against a
+// stub instead of a real tmux server.
+type sessionExistence interface {
+	HasSession(name string) (bool, error)
+	ListSessions() ([]string, error)
+}
+
+// attachResolution describes how a 'gt session attach' target resolved.
+type attachResolution struct {
+	SessionName string
+	// Identity is nil when the target couldn't be parsed into a known
+	// role/rig/name (e.g. a raw or typo'd session name).
+	Identity    *session.AgentIdentity
+	Exists      bool
+	Suggestions []string
+}
+
+// roleShortcuts are the bare words resolveRoleToSession treats specially.
+var roleShortcuts = []string{"mayor", "may", "deacon", "dea", "witness", "wit", "refinery", "ref", "crew"}
+
+// resolveAttachTarget resolves any identity form (role shortcut, rig/name
+// path, bare polecat name, or raw session name) into a session name, using
+// the same resolver as 'gt handoff --to'. If the session doesn't exist and
+// isn't one gt session attach can auto-start, it gathers fuzzy suggestions
+// from known role shortcuts and currently running sessions.
+func resolveAttachTarget(target string, t sessionExistence) (*attachResolution, error) {
+	sessionName, err := resolveRoleToSession(target)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	identity, _ := session.ParseSessionName(sessionName) // nil if unparseable; treated as a raw/opaque name
 
-	polecatMgr, _, err := getSessionManager(rigName)
+	exists, err := t.HasSession(sessionName)
+	if err != nil {
+		return nil, fmt.Errorf("checking session: %w", err)
+	}
+
+	// A bare word that didn't resolve to a known or running session gets one
+	// more try as a rig/polecat shorthand with the rig inferred from cwd -
+	// the same convenience 'gt session at Toast' has always had from within
+	// a rig directory. Skip this for anything that already looks like a
+	// literal tmux session name, so real raw names/typos aren't hijacked.
+	if identity == nil && !exists && !strings.Contains(target, "/") &&
+		!strings.HasPrefix(target, session.Prefix) && !strings.HasPrefix(target, session.HQPrefix) {
+		if rigName, polecatName, addrErr := parseAddress(target); addrErr == nil {
+			inferred := session.PolecatSessionName(rigName, polecatName)
+			if inferredExists, hasErr := t.HasSession(inferred); hasErr == nil {
+				sessionName = inferred
+				identity = &session.AgentIdentity{Role: session.RolePolecat, Rig: rigName, Name: polecatName}
+				exists = inferredExists
+			}
+		}
+	}
+
+	res := &attachResolution{SessionName: sessionName, Identity: identity, Exists: exists}
+
+	if !exists && (identity == nil || identity.Role == session.RolePolecat) {
+		candidates := append([]string{}, roleShortcuts...)
+		if sessions, err := t.ListSessions(); err == nil {
+			candidates = append(candidates, sessions...)
+		}
+		res.Suggestions = suggest.FindSimilar(target, candidates, 3)
+	}
+
+	return res, nil
+}
+
+func runSessionAttach(cmd *cobra.Command, args []string) error {
+	target := args[0]
+	t := tmux.NewTmux()
+
+	res, err := resolveAttachTarget(target, t)
 	if err != nil {
 		return err
 	}
 
-	// Attach (this replaces the process)
-	return polecatMgr.Attach(polecatName)
+	// Witness, refinery, mayor, deacon, and crew attach commands already
+	// know how to auto-start their own session, so just delegate to them.
+	if res.Identity != nil {
+		switch res.Identity.Role {
+		case session.RoleMayor:
+			return runMayorAttach(cmd, nil)
+		case session.RoleDeacon:
+			return runDeaconAttach(cmd, nil)
+		case session.RoleWitness:
+			return runWitnessAttach(cmd, []string{res.Identity.Rig})
+		case session.RoleRefinery:
+			return runRefineryAttach(cmd, []string{res.Identity.Rig})
+		case session.RoleCrew:
+			return runCrewAt(cmd, []string{fmt.Sprintf("%s/%s", res.Identity.Rig, res.Identity.Name)})
+		}
+	}
+
+	if !res.Exists {
+		hint := "Usage: gt session attach <rig>/<polecat>, or a role shortcut (witness, refinery, mayor, deacon, crew)"
+		if res.Identity != nil && res.Identity.Role == session.RolePolecat {
+			hint = fmt.Sprintf("Start with: gt session start %s/%s", res.Identity.Rig, res.Identity.Name)
+		}
+		return fmt.Errorf("%s", suggest.FormatSuggestion("Session", target, res.Suggestions, hint))
+	}
+
+	return attachToTmuxSession(res.SessionName)
 }
 
 // SessionListItem represents a session in list output.
@@ -399,9 +564,7 @@ func runSessionList(cmd *cobra.Command, args []string) error {
 
 	// Output
 	if sessionListJSON {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(allSessions)
+		return outputJSON(allSessions)
 	}
 
 	if len(allSessions) == 0 {
@@ -594,6 +757,30 @@ func formatDuration(d time.Duration) string {
 	return fmt.Sprintf("%dh %dm", hours, mins)
 }
 
+func runSessionWait(cmd *cobra.Command, args []string) error {
+	rigName, polecatName, err := parseAddress(args[0])
+	if err != nil {
+		return err
+	}
+
+	_, r, err := getRig(rigName)
+	if err != nil {
+		return err
+	}
+
+	t := tmux.NewTmux()
+	polecatMgr := polecat.NewSessionManager(t, r)
+	sessionName := polecatMgr.SessionName(polecatName)
+
+	fmt.Printf("Waiting for %s/%s to become ready...\n", rigName, polecatName)
+	if err := session.WaitForReady(t, sessionName, sessionWaitTimeout); err != nil {
+		return fmt.Errorf("waiting for session: %w: %w", ErrPrecondition, err)
+	}
+
+	fmt.Printf("%s %s/%s is ready\n", style.Bold.Render("✓"), rigName, polecatName)
+	return nil
+}
+
 func runSessionCheck(cmd *cobra.Command, args []string) error {
 	// Find town root
 	townRoot, err := workspace.FindFromCwdOrError()
@@ -685,3 +872,104 @@ func runSessionCheck(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func runSessionStopIdle(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	rigsConfigPath := filepath.Join(townRoot, "mayor", "rigs.json")
+	rigsConfig, err := config.LoadRigsConfig(rigsConfigPath)
+	if err != nil {
+		rigsConfig = &config.RigsConfig{Rigs: make(map[string]config.RigEntry)}
+	}
+
+	g := git.NewGit(townRoot)
+	rigMgr := rig.NewManager(townRoot, rigsConfig, g)
+	rigs, err := rigMgr.DiscoverRigs()
+	if err != nil {
+		return fmt.Errorf("discovering rigs: %w", err)
+	}
+
+	if len(args) > 0 {
+		rigFilter := args[0]
+		var filtered []*rig.Rig
+		for _, r := range rigs {
+			if r.Name == rigFilter {
+				filtered = append(filtered, r)
+			}
+		}
+		if len(filtered) == 0 {
+			return fmt.Errorf("rig not found: %s", rigFilter)
+		}
+		rigs = filtered
+	}
+
+	t := tmux.NewTmux()
+	var stopped []string
+
+	for _, r := range rigs {
+		maxIdle := sessionMaxIdle
+		if maxIdle <= 0 {
+			settings, err := config.LoadRigSettings(config.RigSettingsPath(r.Path))
+			if err == nil {
+				maxIdle = settings.GetIdlePolecatTimeout()
+			}
+		}
+		if maxIdle <= 0 {
+			townSettings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+			if err == nil {
+				maxIdle = townSettings.GetIdlePolecatTimeout()
+			}
+		}
+		if maxIdle <= 0 {
+			fmt.Printf("%s %s: no --max-idle given and no idle_polecat_timeout configured, skipping\n",
+				style.Dim.Render("-"), r.Name)
+			continue
+		}
+
+		polecatMgr := polecat.NewManager(r, git.NewGit(r.Path), t)
+		results, err := polecatMgr.StopIdle(maxIdle, sessionStopDryRun)
+		if err != nil {
+			style.PrintWarning("%s: checking idle sessions: %v", r.Name, err)
+			continue
+		}
+
+		for _, info := range results {
+			if !info.Eligible {
+				continue
+			}
+			verb := "would stop"
+			if info.Stopped {
+				verb = "stopped"
+				stopped = append(stopped, fmt.Sprintf("%s/%s", r.Name, info.Name))
+			}
+			fmt.Printf("  %s %s/%s: %s (%s)\n", style.Bold.Render("●"), r.Name, info.Name, verb, info.Reason)
+		}
+	}
+
+	if len(stopped) == 0 {
+		fmt.Println("No idle sessions to stop.")
+		return nil
+	}
+
+	if sessionStopDryRun {
+		return nil
+	}
+
+	fmt.Printf("%s Stopped %d idle session(s)\n", style.Bold.Render("✓"), len(stopped))
+
+	router := mail.NewRouter(townRoot)
+	summary := &mail.Message{
+		To:      "mayor",
+		From:    "gt session stop-idle",
+		Subject: fmt.Sprintf("Stopped %d idle polecat session(s)", len(stopped)),
+		Body:    strings.Join(stopped, "\n"),
+	}
+	if err := router.Send(summary); err != nil {
+		style.PrintWarning("could not mail mayor a summary: %v", err)
+	}
+
+	return nil
+}
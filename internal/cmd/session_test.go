@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+// stubSessionExistence is a stub sessionExistence for exercising the
+// 'gt session attach' resolution matrix without a real tmux server.
+type stubSessionExistence struct {
+	running map[string]bool
+}
+
+func (s stubSessionExistence) HasSession(name string) (bool, error) {
+	return s.running[name], nil
+}
+
+func (s stubSessionExistence) ListSessions() ([]string, error) {
+	names := make([]string, 0, len(s.running))
+	for name, running := range s.running {
+		if running {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func TestResolveAttachTarget(t *testing.T) {
+	t.Setenv("GT_RIG", "wyvern")
+
+	stub := stubSessionExistence{running: map[string]bool{
+		"gt-wyvern-toast":   true,
+		"gt-wyvern-witness": true,
+		"hq-mayor":          true,
+	}}
+
+	tests := []struct {
+		name        string
+		target      string
+		wantSession string
+		wantRole    session.Role
+		wantExists  bool
+	}{
+		{
+			name:        "rig/polecat path",
+			target:      "wyvern/Toast",
+			wantSession: "gt-wyvern-toast",
+			wantRole:    session.RolePolecat,
+			wantExists:  true,
+		},
+		{
+			name:        "rig/polecat path not running",
+			target:      "wyvern/Furiosa",
+			wantSession: "gt-wyvern-furiosa",
+			wantRole:    session.RolePolecat,
+			wantExists:  false,
+		},
+		{
+			name:        "witness shortcut resolves via GT_RIG",
+			target:      "witness",
+			wantSession: "gt-wyvern-witness",
+			wantRole:    session.RoleWitness,
+			wantExists:  true,
+		},
+		{
+			name:        "refinery shortcut resolves via GT_RIG, not running",
+			target:      "refinery",
+			wantSession: "gt-wyvern-refinery",
+			wantRole:    session.RoleRefinery,
+			wantExists:  false,
+		},
+		{
+			name:        "mayor shortcut",
+			target:      "mayor",
+			wantSession: "hq-mayor",
+			wantRole:    session.RoleMayor,
+			wantExists:  true,
+		},
+		{
+			name:        "explicit polecats path",
+			target:      "wyvern/polecats/Toast",
+			wantSession: "gt-wyvern-toast",
+			wantRole:    session.RolePolecat,
+			wantExists:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := resolveAttachTarget(tt.target, stub)
+			if err != nil {
+				t.Fatalf("resolveAttachTarget() error = %v", err)
+			}
+			if res.SessionName != tt.wantSession {
+				t.Errorf("SessionName = %q, want %q", res.SessionName, tt.wantSession)
+			}
+			if res.Identity == nil {
+				t.Fatalf("Identity = nil, want role %q", tt.wantRole)
+			}
+			if res.Identity.Role != tt.wantRole {
+				t.Errorf("Identity.Role = %q, want %q", res.Identity.Role, tt.wantRole)
+			}
+			if res.Exists != tt.wantExists {
+				t.Errorf("Exists = %v, want %v", res.Exists, tt.wantExists)
+			}
+		})
+	}
+}
+
+func TestResolveAttachTarget_RawSessionNameSuggestsOnTypo(t *testing.T) {
+	stub := stubSessionExistence{running: map[string]bool{
+		"gt-wyvern-witness": true,
+	}}
+
+	// "gt-wyvern-witnes" parses as a well-formed (but nonexistent) polecat
+	// session name; the resolver should still surface a fuzzy suggestion
+	// rather than a raw tmux error.
+	res, err := resolveAttachTarget("gt-wyvern-witnes", stub)
+	if err != nil {
+		t.Fatalf("resolveAttachTarget() error = %v", err)
+	}
+	if res.Exists {
+		t.Fatal("Exists = true, want false for a typo'd session name")
+	}
+	if len(res.Suggestions) == 0 {
+		t.Fatal("Suggestions = empty, want at least one fuzzy match")
+	}
+	if res.Suggestions[0] != "gt-wyvern-witness" {
+		t.Errorf("Suggestions[0] = %q, want %q", res.Suggestions[0], "gt-wyvern-witness")
+	}
+}
+
+func TestResolveAttachTarget_TotallyOpaqueNameIsUnparsed(t *testing.T) {
+	// Run outside any Gas Town workspace so the bare-name cwd-inference
+	// fallback can't kick in and claim this is a polecat shorthand.
+	origCwd, _ := os.Getwd()
+	os.Chdir(os.TempDir())
+	defer os.Chdir(origCwd)
+
+	stub := stubSessionExistence{running: map[string]bool{}}
+
+	res, err := resolveAttachTarget("my-custom-session", stub)
+	if err != nil {
+		t.Fatalf("resolveAttachTarget() error = %v", err)
+	}
+	if res.Identity != nil {
+		t.Fatalf("Identity = %+v, want nil (no gt-/hq- prefix, no rig context to infer from)", res.Identity)
+	}
+	if res.Exists {
+		t.Fatal("Exists = true, want false")
+	}
+}
+
+func TestResolveAttachTarget_PolecatMissingSuggestsRoleShortcuts(t *testing.T) {
+	stub := stubSessionExistence{running: map[string]bool{}}
+
+	res, err := resolveAttachTarget("wyvern/witnes", stub)
+	if err != nil {
+		t.Fatalf("resolveAttachTarget() error = %v", err)
+	}
+	if res.Exists {
+		t.Fatal("Exists = true, want false")
+	}
+	if len(res.Suggestions) == 0 {
+		t.Fatal("Suggestions = empty, want at least one fuzzy match")
+	}
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCategorizeSessionsRigScoping(t *testing.T) {
+	mayorSession := "hq-mayor"
+	deaconSession := "hq-deacon"
+
+	sessions := []string{
+		mayorSession,
+		deaconSession,
+		"gt-wasteland-witness",
+		"gt-wasteland-refinery",
+		"gt-wasteland-Nux",
+		"gt-wasteland-crew-Toast",
+		"gt-gastown-witness",
+		"gt-gastown-Furiosa",
+	}
+
+	tests := []struct {
+		name          string
+		rigFilter     []string
+		includeTown   bool
+		wantToStop    []string
+		wantPreserved []string
+	}{
+		{
+			name:          "no filter stops everything except crew, town-wide",
+			rigFilter:     nil,
+			wantToStop:    []string{mayorSession, deaconSession, "gt-wasteland-witness", "gt-wasteland-refinery", "gt-wasteland-Nux", "gt-gastown-witness", "gt-gastown-Furiosa"},
+			wantPreserved: []string{"gt-wasteland-crew-Toast"},
+		},
+		{
+			name:          "scoped to wasteland excludes gastown and town",
+			rigFilter:     []string{"wasteland"},
+			wantToStop:    []string{"gt-wasteland-witness", "gt-wasteland-refinery", "gt-wasteland-Nux"},
+			wantPreserved: []string{"gt-wasteland-crew-Toast"},
+		},
+		{
+			name:          "scoped to wasteland with include-town also stops mayor/deacon",
+			rigFilter:     []string{"wasteland"},
+			includeTown:   true,
+			wantToStop:    []string{mayorSession, deaconSession, "gt-wasteland-witness", "gt-wasteland-refinery", "gt-wasteland-Nux"},
+			wantPreserved: []string{"gt-wasteland-crew-Toast"},
+		},
+		{
+			name:          "scoped to multiple rigs",
+			rigFilter:     []string{"wasteland", "gastown"},
+			wantToStop:    []string{"gt-wasteland-witness", "gt-wasteland-refinery", "gt-wasteland-Nux", "gt-gastown-witness", "gt-gastown-Furiosa"},
+			wantPreserved: []string{"gt-wasteland-crew-Toast"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toStop, preserved := categorizeSessions(sessions, mayorSession, deaconSession, tt.rigFilter, tt.includeTown)
+			if !reflect.DeepEqual(toStop, tt.wantToStop) {
+				t.Errorf("toStop = %v, want %v", toStop, tt.wantToStop)
+			}
+			if !reflect.DeepEqual(preserved, tt.wantPreserved) {
+				t.Errorf("preserved = %v, want %v", preserved, tt.wantPreserved)
+			}
+		})
+	}
+}
+
+func TestGroupSessionsByRig(t *testing.T) {
+	sessions := []string{"hq-mayor", "gt-wasteland-witness", "gt-wasteland-Nux", "gt-gastown-witness"}
+
+	groups := groupSessionsByRig(sessions)
+
+	want := []sessionRigGroup{
+		{rig: "town", sessions: []string{"hq-mayor"}},
+		{rig: "wasteland", sessions: []string{"gt-wasteland-witness", "gt-wasteland-Nux"}},
+		{rig: "gastown", sessions: []string{"gt-gastown-witness"}},
+	}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("groupSessionsByRig() = %+v, want %+v", groups, want)
+	}
+}
@@ -6,12 +6,14 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -44,6 +46,12 @@ Target Resolution:
   gt sling gt-abc mayor                 # Mayor
   gt sling gt-abc deacon/dogs           # Auto-dispatch to idle dog
   gt sling gt-abc deacon/dogs/alpha     # Specific dog
+  gt sling gt-abc greenplace/crew/max   # Crew member, online or not
+
+Crew members are never auto-spawned like polecats. If a crew member's
+session isn't running, sling hooks the work directly in their clone
+(no worktree, no tmux session) and they pick it up via gt prime. Slinging
+onto a crew member who already has work hooked fails unless --force.
 
 Spawning Options (when target is a rig):
   gt sling gp-abc greenplace --create               # Create polecat if missing
@@ -96,6 +104,9 @@ var (
 	slingAccount  string // --account: Claude Code account handle to use
 	slingAgent    string // --agent: override runtime agent for this sling/spawn
 	slingNoConvoy bool   // --no-convoy: skip auto-convoy creation
+
+	slingWatch        bool   // --watch: tail a freshly spawned polecat's pane until it reads its assignment
+	slingWatchTimeout string // --watch-timeout: how long to tail before giving up
 )
 
 func init() {
@@ -113,6 +124,8 @@ func init() {
 	slingCmd.Flags().StringVar(&slingAgent, "agent", "", "Override agent/runtime for this sling (e.g., claude, gemini, codex, or custom alias)")
 	slingCmd.Flags().BoolVar(&slingNoConvoy, "no-convoy", false, "Skip auto-convoy creation for single-issue sling")
 	slingCmd.Flags().BoolVar(&slingHookRawBead, "hook-raw-bead", false, "Hook raw bead without default formula (expert mode)")
+	slingCmd.Flags().BoolVar(&slingWatch, "watch", false, "Tail the freshly spawned polecat's pane until it reads its assignment")
+	slingCmd.Flags().StringVar(&slingWatchTimeout, "watch-timeout", "60s", "How long --watch tails before giving up, e.g. 30s or 2m")
 
 	rootCmd.AddCommand(slingCmd)
 }
@@ -127,7 +140,7 @@ func runSling(cmd *cobra.Command, args []string) error {
 	// This ensures hq-* beads are accessible even when running from polecat worktree
 	townRoot, err := workspace.FindFromCwd()
 	if err != nil {
-		return fmt.Errorf("finding town root: %w", err)
+		return fmt.Errorf("finding town root: %w: %w", ErrNoWorkspace, err)
 	}
 	townBeadsDir := filepath.Join(townRoot, ".beads")
 
@@ -183,7 +196,7 @@ func runSling(cmd *cobra.Command, args []string) error {
 				beadID = firstArg
 			} else {
 				// Neither bead nor formula
-				return fmt.Errorf("'%s' is not a valid bead or formula", firstArg)
+				return fmt.Errorf("'%s' is not a valid bead or formula: %w", firstArg, ErrNotFound)
 			}
 		}
 	}
@@ -191,8 +204,9 @@ func runSling(cmd *cobra.Command, args []string) error {
 	// Determine target agent (self or specified)
 	var targetAgent string
 	var targetPane string
-	var hookWorkDir string        // Working directory for running bd hook commands
-	var hookSetAtomically bool    // True if hook was set during polecat spawn (skip redundant update)
+	var hookWorkDir string                 // Working directory for running bd hook commands
+	var hookSetAtomically bool             // True if hook was set during polecat spawn (skip redundant update)
+	var spawnedPolecat *SpawnedPolecatInfo // Set when this sling spawned a fresh polecat; used by --watch
 
 	if len(args) > 1 {
 		target := args[1]
@@ -250,6 +264,7 @@ func runSling(cmd *cobra.Command, args []string) error {
 				targetPane = spawnInfo.Pane
 				hookWorkDir = spawnInfo.ClonePath // Run bd commands from polecat's worktree
 				hookSetAtomically = true          // Hook was set during spawn (GH #gt-mzyk5)
+				spawnedPolecat = spawnInfo
 
 				// Wake witness and refinery to monitor the new polecat
 				wakeRigAgents(rigName)
@@ -282,14 +297,25 @@ func runSling(cmd *cobra.Command, args []string) error {
 						targetPane = spawnInfo.Pane
 						hookWorkDir = spawnInfo.ClonePath
 						hookSetAtomically = true // Hook was set during spawn (GH #gt-mzyk5)
+						spawnedPolecat = spawnInfo
 
 						// Wake witness and refinery to monitor the new polecat
 						wakeRigAgents(rigName)
 					} else {
-						return fmt.Errorf("resolving target: %w", err)
+						return fmt.Errorf("resolving target: %w: %w", ErrNotFound, err)
+					}
+				} else if crewRig, crewName, isCrew := isCrewTarget(target); isCrew {
+					// Crew member has no active session - hook the work directly
+					// in their clone rather than failing. Crew are never
+					// auto-spawned like polecats; they pick up hooked work
+					// next time they run gt prime.
+					fmt.Printf("Crew member %s has no active session, hooking work directly...\n", target)
+					targetAgent, hookWorkDir, err = hookCrewWithoutSession(townRoot, crewRig, crewName, slingForce)
+					if err != nil {
+						return fmt.Errorf("hooking crew member: %w", err)
 					}
 				} else {
-					return fmt.Errorf("resolving target: %w", err)
+					return fmt.Errorf("resolving target: %w: %w", ErrNotFound, err)
 				}
 			}
 			// Use target's working directory for bd commands (needed for redirect-based routing)
@@ -327,7 +353,7 @@ func runSling(cmd *cobra.Command, args []string) error {
 		if assignee == "" {
 			assignee = "(unknown)"
 		}
-		return fmt.Errorf("bead %s is already %s to %s\nUse --force to re-sling", beadID, info.Status, assignee)
+		return fmt.Errorf("bead %s is already %s to %s: %w\nUse --force to re-sling", beadID, info.Status, assignee, ErrConflict)
 	}
 
 	// Handle --force when bead is already hooked: send shutdown to old polecat and unhook
@@ -403,7 +429,12 @@ func runSling(cmd *cobra.Command, args []string) error {
 	// This ensures polecats get structured work guidance through formula-on-bead.
 	// Use --hook-raw-bead to bypass for expert/debugging scenarios.
 	if formulaName == "" && !slingHookRawBead && strings.Contains(targetAgent, "/polecats/") {
-		formulaName = "mol-polecat-work"
+		rigName := strings.SplitN(targetAgent, "/", 2)[0]
+		isSwarmDispatch := os.Getenv("GT_SWARM_DISPATCH") != ""
+		formulaName, err = resolveWorkMolecule(rigName, isSwarmDispatch, slingArgs != "")
+		if err != nil {
+			return err
+		}
 		fmt.Printf("  Auto-applying %s for polecat work...\n", formulaName)
 	}
 
@@ -534,5 +565,18 @@ func runSling(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if slingWatch && spawnedPolecat != nil {
+		timeout, err := time.ParseDuration(slingWatchTimeout)
+		if err != nil {
+			return fmt.Errorf("invalid --watch-timeout %q: %w", slingWatchTimeout, err)
+		}
+		marker := slingSubject
+		if marker == "" {
+			marker = beadID
+		}
+		watchPolecatStartup(tmux.NewTmux(), spawnedPolecat.SessionName, spawnedPolecat.PolecatName,
+			marker, timeout, defaultWatchPoll, os.Stdout)
+	}
+
 	return nil
 }
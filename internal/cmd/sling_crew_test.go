@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestIsCrewTarget(t *testing.T) {
+	tests := []struct {
+		target     string
+		wantRig    string
+		wantCrew   string
+		wantIsCrew bool
+	}{
+		{"gastown/crew/max", "gastown", "max", true},
+		{"gastown/polecats/Toast", "", "", false},
+		{"gastown/witness", "", "", false},
+		{"crew", "", "", false},
+	}
+
+	for _, tt := range tests {
+		rig, crew, ok := isCrewTarget(tt.target)
+		if ok != tt.wantIsCrew || rig != tt.wantRig || crew != tt.wantCrew {
+			t.Errorf("isCrewTarget(%q) = (%q, %q, %v), want (%q, %q, %v)",
+				tt.target, rig, crew, ok, tt.wantRig, tt.wantCrew, tt.wantIsCrew)
+		}
+	}
+}
+
+// setupTestTownForCrewHook builds a minimal town/rig layout with a crew
+// member directory (no state.json, no tmux session, no agent bead) - enough
+// for getCrewManager + crew.Manager.Get to resolve a clone path.
+func setupTestTownForCrewHook(t *testing.T, rigName, crewName string) string {
+	t.Helper()
+
+	townRoot := t.TempDir()
+	mayorDir := filepath.Join(townRoot, "mayor")
+	if err := os.MkdirAll(mayorDir, 0755); err != nil {
+		t.Fatalf("mkdir mayor: %v", err)
+	}
+
+	rigsConfig := &config.RigsConfig{
+		Version: config.CurrentRigsVersion,
+		Rigs: map[string]config.RigEntry{
+			rigName: {GitURL: "https://example.com/" + rigName + ".git"},
+		},
+	}
+	if err := config.SaveRigsConfig(filepath.Join(mayorDir, "rigs.json"), rigsConfig); err != nil {
+		t.Fatalf("save rigs.json: %v", err)
+	}
+
+	crewDir := filepath.Join(townRoot, rigName, "crew", crewName)
+	if err := os.MkdirAll(crewDir, 0755); err != nil {
+		t.Fatalf("mkdir crew dir: %v", err)
+	}
+
+	return townRoot
+}
+
+func TestHookCrewWithoutSession_ResolvesClonePath(t *testing.T) {
+	townRoot := setupTestTownForCrewHook(t, "gastown", "max")
+
+	originalWd, _ := os.Getwd()
+	defer func() { _ = os.Chdir(originalWd) }()
+	if err := os.Chdir(townRoot); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+
+	agentID, workDir, err := hookCrewWithoutSession(townRoot, "gastown", "max", false)
+	if err != nil {
+		t.Fatalf("hookCrewWithoutSession failed: %v", err)
+	}
+	if agentID != "gastown/crew/max" {
+		t.Errorf("agentID = %q, want %q", agentID, "gastown/crew/max")
+	}
+	wantWorkDir := filepath.Join(townRoot, "gastown", "crew", "max")
+	if workDir != wantWorkDir {
+		t.Errorf("workDir = %q, want %q", workDir, wantWorkDir)
+	}
+}
+
+func TestHookCrewWithoutSession_UnknownCrewMember(t *testing.T) {
+	townRoot := setupTestTownForCrewHook(t, "gastown", "max")
+
+	originalWd, _ := os.Getwd()
+	defer func() { _ = os.Chdir(originalWd) }()
+	if err := os.Chdir(townRoot); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+
+	if _, _, err := hookCrewWithoutSession(townRoot, "gastown", "nobody", false); err == nil {
+		t.Fatal("expected error for unknown crew member, got nil")
+	}
+}
@@ -459,6 +459,50 @@ func isPolecatTarget(target string) bool {
 	return len(parts) >= 3 && parts[1] == "polecats"
 }
 
+// isCrewTarget checks if the target string refers to a crew member.
+// Returns the rig and crew names if the target format is "rig/crew/name".
+// This is used to hook work onto an offline crew member instead of failing
+// when slinging work, since crew (unlike polecats) are never auto-spawned.
+func isCrewTarget(target string) (rigName, crewName string, ok bool) {
+	parts := strings.Split(target, "/")
+	if len(parts) >= 3 && parts[1] == "crew" {
+		return parts[0], parts[2], true
+	}
+	return "", "", false
+}
+
+// hookCrewWithoutSession resolves a crew member's agent ID and clone path for
+// slinging work when their tmux session isn't running. Unlike the polecat
+// path, there's no worktree to recreate or session to start - crew clones
+// are persistent workspaces, and the crew member discovers the work next
+// time they run gt prime.
+//
+// Refuses if the crew member already has work hooked, unless force is set.
+func hookCrewWithoutSession(townRoot, rigName, crewName string, force bool) (agentID, workDir string, err error) {
+	crewMgr, _, err := getCrewManager(rigName)
+	if err != nil {
+		return "", "", fmt.Errorf("loading rig %s: %w", rigName, err)
+	}
+	worker, err := crewMgr.Get(crewName)
+	if err != nil {
+		return "", "", fmt.Errorf("crew member %s/crew/%s not found: %w: %w", rigName, crewName, ErrNotFound, err)
+	}
+
+	agentID = fmt.Sprintf("%s/crew/%s", rigName, crewName)
+
+	if !force {
+		agentBeadID := agentIDToBeadID(agentID, townRoot)
+		if agentBeadID != "" {
+			bd := beads.New(beads.ResolveHookDir(townRoot, agentBeadID, worker.ClonePath))
+			if agentBead, showErr := bd.Show(agentBeadID); showErr == nil && agentBead.HookBead != "" {
+				return "", "", fmt.Errorf("crew member %s already has %s hooked: %w\nUse --force to re-sling", agentID, agentBead.HookBead, ErrConflict)
+			}
+		}
+	}
+
+	return agentID, worker.ClonePath, nil
+}
+
 // FormulaOnBeadResult contains the result of instantiating a formula on a bead.
 type FormulaOnBeadResult struct {
 	WispRootID string // The wisp root ID (compound root after bonding)
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// defaultPolecatWorkMolecule is the town-wide fallback formula gt sling
+// auto-applies when handing a bare bead to a polecat and the rig has no
+// workflow override configured.
+const defaultPolecatWorkMolecule = "mol-polecat-work"
+
+// resolveWorkMolecule picks which formula gt sling should auto-apply when
+// handing a bare bead to a polecat, consulting the rig's workflow overrides
+// (settings/config.json) before falling back to the town-wide default.
+//
+// Precedence: swarm task override (when dispatched via gt swarm) > freeform
+// override (when --args was used) > the rig's default work molecule > the
+// hardcoded town-wide default. A configured override that names a formula
+// that doesn't exist is a clear error rather than a silent fallback.
+func resolveWorkMolecule(rigName string, isSwarmDispatch, isFreeform bool) (string, error) {
+	return resolveWorkMoleculeWithChecker(rigName, isSwarmDispatch, isFreeform, verifyFormulaExists)
+}
+
+// resolveWorkMoleculeWithChecker is resolveWorkMolecule with the formula
+// existence check factored out so tests can exercise the precedence and
+// fallback logic without shelling out to bd.
+func resolveWorkMoleculeWithChecker(rigName string, isSwarmDispatch, isFreeform bool, checkExists func(string) error) (string, error) {
+	rigPath, err := rigPathByName(rigName)
+	if err != nil {
+		// No rig to consult settings for - fall back to the town-wide default.
+		return defaultPolecatWorkMolecule, nil
+	}
+
+	settings, err := config.LoadRigSettings(config.RigSettingsPath(rigPath))
+	if err != nil {
+		return defaultPolecatWorkMolecule, nil
+	}
+
+	return pickWorkMolecule(rigName, settings.Workflow, isSwarmDispatch, isFreeform, checkExists)
+}
+
+// pickWorkMolecule applies the precedence rules over an already-loaded
+// workflow config. Split out from resolveWorkMoleculeWithChecker so tests
+// can drive it with an in-memory config.WorkflowConfig instead of a rig on
+// disk.
+func pickWorkMolecule(rigName string, wf *config.WorkflowConfig, isSwarmDispatch, isFreeform bool, checkExists func(string) error) (string, error) {
+	if wf == nil {
+		return defaultPolecatWorkMolecule, nil
+	}
+
+	molecule := ""
+	switch {
+	case isSwarmDispatch && wf.SwarmTaskMolecule != "":
+		molecule = wf.SwarmTaskMolecule
+	case isFreeform && wf.FreeformMolecule != "":
+		molecule = wf.FreeformMolecule
+	case wf.DefaultWorkMolecule != "":
+		molecule = wf.DefaultWorkMolecule
+	default:
+		return defaultPolecatWorkMolecule, nil
+	}
+
+	if err := checkExists(molecule); err != nil {
+		return "", fmt.Errorf("rig %q workflow override references missing formula: %w", rigName, err)
+	}
+	return molecule, nil
+}
+
+// rigPathByName resolves a rig's directory from rigs.json.
+func rigPathByName(rigName string) (string, error) {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return "", err
+	}
+	rigsConfigPath := filepath.Join(townRoot, "mayor", "rigs.json")
+	rigsConfig, err := config.LoadRigsConfig(rigsConfigPath)
+	if err != nil {
+		return "", err
+	}
+	g := git.NewGit(townRoot)
+	rigMgr := rig.NewManager(townRoot, rigsConfig, g)
+	r, err := rigMgr.GetRig(rigName)
+	if err != nil {
+		return "", err
+	}
+	return r.Path, nil
+}
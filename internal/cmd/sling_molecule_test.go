@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestPickWorkMolecule_AbsentFallsBackToDefault(t *testing.T) {
+	molecule, err := pickWorkMolecule("greenplace", nil, false, false, func(string) error {
+		t.Fatal("checkExists should not be called when no override is configured")
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if molecule != defaultPolecatWorkMolecule {
+		t.Fatalf("expected fallback to %q, got %q", defaultPolecatWorkMolecule, molecule)
+	}
+}
+
+func TestPickWorkMolecule_OverridePresentAndExists(t *testing.T) {
+	wf := &config.WorkflowConfig{DefaultWorkMolecule: "mol-custom-work"}
+	molecule, err := pickWorkMolecule("greenplace", wf, false, false, func(name string) error {
+		if name != "mol-custom-work" {
+			t.Fatalf("expected checkExists called with mol-custom-work, got %q", name)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if molecule != "mol-custom-work" {
+		t.Fatalf("expected mol-custom-work, got %q", molecule)
+	}
+}
+
+func TestPickWorkMolecule_OverrideMissingFormulaIsClearError(t *testing.T) {
+	wf := &config.WorkflowConfig{DefaultWorkMolecule: "mol-nonexistent"}
+	_, err := pickWorkMolecule("greenplace", wf, false, false, func(string) error {
+		return errors.New("formula 'mol-nonexistent' not found (check 'bd formula list')")
+	})
+	if err == nil {
+		t.Fatal("expected an error for a missing formula, got nil")
+	}
+	if !strings.Contains(err.Error(), "mol-nonexistent") {
+		t.Fatalf("expected error to name the missing formula, got: %v", err)
+	}
+}
+
+func TestPickWorkMolecule_SwarmTakesPrecedenceOverDefault(t *testing.T) {
+	wf := &config.WorkflowConfig{
+		DefaultWorkMolecule: "mol-default-work",
+		SwarmTaskMolecule:   "mol-swarm-work",
+	}
+	molecule, err := pickWorkMolecule("greenplace", wf, true, false, func(string) error { return nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if molecule != "mol-swarm-work" {
+		t.Fatalf("expected swarm override to take precedence, got %q", molecule)
+	}
+}
+
+func TestPickWorkMolecule_FreeformTakesPrecedenceOverDefault(t *testing.T) {
+	wf := &config.WorkflowConfig{
+		DefaultWorkMolecule: "mol-default-work",
+		FreeformMolecule:    "mol-freeform-work",
+	}
+	molecule, err := pickWorkMolecule("greenplace", wf, false, true, func(string) error { return nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if molecule != "mol-freeform-work" {
+		t.Fatalf("expected freeform override to take precedence, got %q", molecule)
+	}
+}
+
+func TestPickWorkMolecule_DefaultUsedWhenNeitherSwarmNorFreeform(t *testing.T) {
+	wf := &config.WorkflowConfig{
+		DefaultWorkMolecule: "mol-default-work",
+		SwarmTaskMolecule:   "mol-swarm-work",
+		FreeformMolecule:    "mol-freeform-work",
+	}
+	molecule, err := pickWorkMolecule("greenplace", wf, false, false, func(string) error { return nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if molecule != "mol-default-work" {
+		t.Fatalf("expected rig default, got %q", molecule)
+	}
+}
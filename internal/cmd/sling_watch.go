@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+)
+
+// defaultWatchPoll is how often gt sling --watch re-captures the polecat's
+// pane while tailing its startup.
+const defaultWatchPoll = 2 * time.Second
+
+// watchSessionSource is the narrow tmux surface gt sling --watch needs.
+// Defined here instead of depending on *tmux.Tmux directly so tests can
+// drive it with a stub, following the pattern in witness/patrol.go.
+type watchSessionSource interface {
+	CapturePaneLines(session string, lines int) ([]string, error)
+}
+
+// watchPolecatStartup tails session's pane, printing new output lines
+// prefixed with label, until marker appears (the polecat has read its work
+// assignment) or timeout elapses. A timeout is reported as a warning, not
+// an error - the polecat is still spawned and working, gt sling --watch is
+// just a convenience for operators who'd otherwise blind-wait and attach.
+// Ctrl-C stops watching without touching the session (plain detach).
+func watchPolecatStartup(t watchSessionSource, session, label, marker string, timeout, poll time.Duration, out io.Writer) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	fmt.Fprintf(out, "Watching %s for up to %s (Ctrl-C to detach)...\n", label, timeout)
+
+	deadline := time.Now().Add(timeout)
+	var seen []string
+
+	for {
+		lines, err := t.CapturePaneLines(session, 200)
+		if err == nil {
+			var fresh []string
+			seen, fresh = diffNewLines(seen, lines)
+			for _, line := range fresh {
+				fmt.Fprintf(out, "[%s] %s\n", label, line)
+			}
+			if marker != "" {
+				for _, line := range fresh {
+					if strings.Contains(line, marker) {
+						fmt.Fprintf(out, "%s picked up its assignment\n", label)
+						return
+					}
+				}
+			}
+		}
+
+		if time.Now().After(deadline) {
+			fmt.Fprintf(out, "Warning: timed out after %s waiting for %s to read its assignment\n", timeout, label)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			fmt.Fprintf(out, "Detached from %s (session left running)\n", label)
+			return
+		case <-time.After(poll):
+		}
+	}
+}
+
+// diffNewLines compares a fresh pane capture against the previous one and
+// returns the updated "seen" slice along with any lines newly appended
+// since the last capture. It assumes the pane only grows between polls,
+// which holds for the startup tail this is used for.
+func diffNewLines(prev, curr []string) (seen, fresh []string) {
+	if len(curr) > len(prev) {
+		fresh = curr[len(prev):]
+	}
+	return curr, fresh
+}
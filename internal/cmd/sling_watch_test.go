@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// stubWatchSessionSource returns a canned sequence of pane captures, one
+// per call to CapturePaneLines, so tests can control exactly which poll
+// sees the work-assignment marker appear.
+type stubWatchSessionSource struct {
+	captures [][]string
+	calls    int
+}
+
+func (s *stubWatchSessionSource) CapturePaneLines(session string, lines int) ([]string, error) {
+	if s.calls >= len(s.captures) {
+		s.calls++
+		return s.captures[len(s.captures)-1], nil
+	}
+	c := s.captures[s.calls]
+	s.calls++
+	return c, nil
+}
+
+func TestWatchPolecatStartup_MarkerOnFourthPoll(t *testing.T) {
+	stub := &stubWatchSessionSource{
+		captures: [][]string{
+			{"Claude Code starting..."},
+			{"Claude Code starting...", "Loading context"},
+			{"Claude Code starting...", "Loading context", "Reading inbox"},
+			{"Claude Code starting...", "Loading context", "Reading inbox", "Work slung: gt-abc (fix the thing)"},
+		},
+	}
+
+	var out strings.Builder
+	watchPolecatStartup(stub, "gt-greenplace-p-Toast", "Toast", "fix the thing", time.Minute, time.Millisecond, &out)
+
+	if stub.calls != 4 {
+		t.Fatalf("expected marker to be found on the 4th poll, got %d polls", stub.calls)
+	}
+	if !strings.Contains(out.String(), "picked up its assignment") {
+		t.Fatalf("expected output to report the marker was found, got: %s", out.String())
+	}
+	if !strings.Contains(out.String(), "[Toast] Reading inbox") {
+		t.Fatalf("expected new lines to be prefixed with the label, got: %s", out.String())
+	}
+}
+
+func TestWatchPolecatStartup_TimesOut(t *testing.T) {
+	stub := &stubWatchSessionSource{captures: [][]string{{"still starting..."}}}
+
+	var out strings.Builder
+	watchPolecatStartup(stub, "gt-greenplace-p-Toast", "Toast", "never appears", 5*time.Millisecond, time.Millisecond, &out)
+
+	if !strings.Contains(out.String(), "Warning: timed out") {
+		t.Fatalf("expected a timeout warning, got: %s", out.String())
+	}
+}
+
+func TestDiffNewLines(t *testing.T) {
+	seen, fresh := diffNewLines(nil, []string{"a", "b"})
+	if len(fresh) != 2 {
+		t.Fatalf("expected 2 fresh lines from empty prev, got %v", fresh)
+	}
+
+	seen, fresh = diffNewLines(seen, []string{"a", "b", "c"})
+	if len(fresh) != 1 || fresh[0] != "c" {
+		t.Fatalf("expected 1 new line \"c\", got %v", fresh)
+	}
+
+	_, fresh = diffNewLines(seen, []string{"a", "b", "c"})
+	if len(fresh) != 0 {
+		t.Fatalf("expected no new lines when capture is unchanged, got %v", fresh)
+	}
+}
@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// SpawnStep identifies one stage of SpawnPolecatForSling's transaction.
+type SpawnStep string
+
+const (
+	// StepClaimPolecat covers allocating/creating (or repairing) the
+	// polecat and, when a hook bead was requested, claiming it atomically
+	// as part of that record - this is the "pour": the bead is pinned to
+	// this polecat before anything else happens.
+	StepClaimPolecat SpawnStep = "claim_polecat"
+
+	// StepResolveAccount covers picking a Claude Code account for the new
+	// session.
+	StepResolveAccount SpawnStep = "resolve_account"
+
+	// StepStartSession covers starting the polecat's tmux session.
+	StepStartSession SpawnStep = "start_session"
+
+	// StepSendAssignmentMail covers mailing the new polecat its hooked
+	// work. This is the last step - once it completes the spawn is done
+	// and the transaction file is removed.
+	StepSendAssignmentMail SpawnStep = "send_assignment_mail"
+)
+
+// SpawnTransaction records the progress of a single SpawnPolecatForSling
+// call under the rig's .runtime directory. If a step fails partway
+// through, the transaction file left on disk is what runRollbackSpawn
+// reads to undo exactly the steps that completed, instead of guessing at
+// how far the spawn got.
+type SpawnTransaction struct {
+	ID          string      `json:"id"`
+	RigName     string      `json:"rig_name"`
+	PolecatName string      `json:"polecat_name"`
+	SessionName string      `json:"session_name,omitempty"`
+	HookBead    string      `json:"hook_bead,omitempty"`
+	StartedAt   time.Time   `json:"started_at"`
+	Completed   []SpawnStep `json:"completed"`
+
+	rigPath string // not persisted; used to locate the .runtime dir
+}
+
+// newSpawnTransaction starts a transaction for spawning polecatName in
+// rigName, rooted at rigPath/.runtime.
+func newSpawnTransaction(rigPath, rigName, polecatName, hookBead string) *SpawnTransaction {
+	return &SpawnTransaction{
+		ID:          generateSpawnTxnID(),
+		RigName:     rigName,
+		PolecatName: polecatName,
+		HookBead:    hookBead,
+		StartedAt:   time.Now().UTC(),
+		rigPath:     rigPath,
+	}
+}
+
+// generateSpawnTxnID creates a random transaction ID, following the same
+// crypto/rand-with-time-fallback convention as mail.generateID.
+func generateSpawnTxnID() string {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("txn-%x", time.Now().UnixNano())
+	}
+	return "txn-" + hex.EncodeToString(b)
+}
+
+// path returns where this transaction is persisted.
+func (t *SpawnTransaction) path() string {
+	return filepath.Join(t.rigPath, ".runtime", "spawn-"+t.ID+".json")
+}
+
+// record appends step to Completed and persists the transaction, so a
+// crash immediately after a step still leaves an accurate file behind.
+func (t *SpawnTransaction) record(step SpawnStep) error {
+	t.Completed = append(t.Completed, step)
+	return t.save()
+}
+
+// save writes the transaction to its .runtime file.
+func (t *SpawnTransaction) save() error {
+	dir := filepath.Join(t.rigPath, ".runtime")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("creating .runtime dir: %w", err)
+	}
+	data, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling spawn transaction: %w", err)
+	}
+	if err := os.WriteFile(t.path(), data, 0o644); err != nil {
+		return fmt.Errorf("writing spawn transaction: %w", err)
+	}
+	return nil
+}
+
+// remove deletes the transaction file once the spawn has fully succeeded.
+func (t *SpawnTransaction) remove() {
+	_ = os.Remove(t.path())
+}
+
+// hasCompleted reports whether step finished before the spawn failed.
+func (t *SpawnTransaction) hasCompleted(step SpawnStep) bool {
+	for _, s := range t.Completed {
+		if s == step {
+			return true
+		}
+	}
+	return false
+}
+
+// rollbackSpawn undoes whatever steps completed before spawnErr occurred,
+// leaving no claimed work behind: it clears the hook wisp from the
+// polecat's agent bead, unpins the hooked bead and resets it to open, and
+// kills the tmux session if one was started. All compensating actions are
+// best-effort - a rollback failure is reported alongside the original
+// error rather than replacing it, so a human can finish the cleanup by
+// hand using the transaction file left under .runtime.
+func rollbackSpawn(t *SpawnTransaction, spawnErr error) error {
+	var warnings []string
+
+	if t.hasCompleted(StepStartSession) && t.SessionName != "" {
+		if err := tmux.NewTmux().KillSession(t.SessionName); err != nil {
+			warnings = append(warnings, fmt.Sprintf("could not stop session %s: %v", t.SessionName, err))
+		}
+	}
+
+	if t.hasCompleted(StepClaimPolecat) {
+		b := beads.New(t.rigPath)
+		if t.HookBead != "" {
+			openStatus := "open"
+			emptyAssignee := ""
+			if err := b.Update(t.HookBead, beads.UpdateOptions{Status: &openStatus, Assignee: &emptyAssignee}); err != nil {
+				warnings = append(warnings, fmt.Sprintf("could not unpin %s: %v", t.HookBead, err))
+			}
+		}
+		agentID := beads.AgentBeadID(t.RigName, "polecat", t.PolecatName)
+		if err := b.ClearHookBead(agentID); err != nil {
+			warnings = append(warnings, fmt.Sprintf("could not clear hook wisp on %s: %v", agentID, err))
+		}
+	}
+
+	summary := fmt.Sprintf("spawn %s failed after completing %v (rolled back): %v\nTransaction record: %s",
+		t.PolecatName, t.Completed, spawnErr, t.path())
+	if len(warnings) > 0 {
+		summary += "\nRollback warnings:\n  - " + strings.Join(warnings, "\n  - ")
+	}
+	return fmt.Errorf("%s", summary)
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSpawnTransaction_RecordPersistsAndRemoveCleansUp(t *testing.T) {
+	rigPath := t.TempDir()
+	txn := newSpawnTransaction(rigPath, "gastown", "Toast", "gt-abc")
+
+	if err := txn.record(StepClaimPolecat); err != nil {
+		t.Fatalf("record: %v", err)
+	}
+
+	data, err := os.ReadFile(txn.path())
+	if err != nil {
+		t.Fatalf("reading transaction file: %v", err)
+	}
+	var onDisk SpawnTransaction
+	if err := json.Unmarshal(data, &onDisk); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if onDisk.ID != txn.ID || onDisk.PolecatName != "Toast" || onDisk.HookBead != "gt-abc" {
+		t.Fatalf("unexpected persisted transaction: %+v", onDisk)
+	}
+	if !txn.hasCompleted(StepClaimPolecat) {
+		t.Fatal("expected StepClaimPolecat to be recorded")
+	}
+	if txn.hasCompleted(StepStartSession) {
+		t.Fatal("did not expect StepStartSession to be recorded yet")
+	}
+
+	txn.remove()
+	if _, err := os.Stat(txn.path()); !os.IsNotExist(err) {
+		t.Fatalf("expected transaction file removed, stat err=%v", err)
+	}
+}
+
+func TestRollbackSpawn_UnclaimedBeforeClaimStep(t *testing.T) {
+	rigPath := t.TempDir()
+	txn := newSpawnTransaction(rigPath, "gastown", "Toast", "gt-abc")
+
+	// Nothing recorded yet, so rollback should be a no-op besides the
+	// error summary - no bd invocation should happen.
+	binDir := filepath.Join(rigPath, "bin")
+	if err := os.MkdirAll(binDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	logPath := filepath.Join(rigPath, "bd.log")
+	writeBDStub(t, binDir, "#!/bin/sh\necho \"$*\" >> \"$BD_LOG\"\nexit 0\n", "")
+	t.Setenv("BD_LOG", logPath)
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	err := rollbackSpawn(txn, os.ErrInvalid)
+	if err == nil {
+		t.Fatal("expected rollbackSpawn to return an error summarizing the failure")
+	}
+	if !strings.Contains(err.Error(), txn.path()) {
+		t.Fatalf("expected transaction file path in error, got: %v", err)
+	}
+	if _, statErr := os.Stat(logPath); !os.IsNotExist(statErr) {
+		t.Fatalf("expected no bd invocation before StepClaimPolecat completed, got log at %s", logPath)
+	}
+}
+
+func TestRollbackSpawn_ClearsHookAfterClaim(t *testing.T) {
+	rigPath := t.TempDir()
+	txn := newSpawnTransaction(rigPath, "gastown", "Toast", "gt-abc")
+	if err := txn.record(StepClaimPolecat); err != nil {
+		t.Fatalf("record: %v", err)
+	}
+
+	binDir := filepath.Join(rigPath, "bin")
+	if err := os.MkdirAll(binDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	logPath := filepath.Join(rigPath, "bd.log")
+	writeBDStub(t, binDir, "#!/bin/sh\necho \"$*\" >> \"$BD_LOG\"\nexit 0\n", "")
+	t.Setenv("BD_LOG", logPath)
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	err := rollbackSpawn(txn, os.ErrInvalid)
+	if err == nil {
+		t.Fatal("expected rollbackSpawn to return an error summarizing the failure")
+	}
+
+	data, readErr := os.ReadFile(logPath)
+	if readErr != nil {
+		t.Fatalf("reading bd log: %v", readErr)
+	}
+	log := string(data)
+	if !strings.Contains(log, "update gt-abc") || !strings.Contains(log, "--status=open") || !strings.Contains(log, "--assignee=") {
+		t.Fatalf("expected hook bead to be unpinned and reassigned to open, got log:\n%s", log)
+	}
+	if !strings.Contains(log, "slot clear") {
+		t.Fatalf("expected hook wisp to be cleared, got log:\n%s", log)
+	}
+}
@@ -44,6 +44,9 @@ var (
 	shutdownNuclear             bool
 	shutdownCleanupOrphans      bool
 	shutdownCleanupOrphansGrace int
+	shutdownRigs                []string
+	shutdownIncludeTown         bool
+	shutdownNoCleanup           bool
 )
 
 var startCmd = &cobra.Command{
@@ -93,8 +96,14 @@ Shutdown levels (progressively more aggressive):
 Use --force or --yes to skip confirmation prompt.
 Use --graceful to allow agents time to save state before killing.
 Use --nuclear to force cleanup even if polecats have uncommitted work (DANGER).
+Use --no-cleanup to skip the polecat cleanup phase entirely.
 Use --cleanup-orphans to kill orphaned Claude processes (TTY-less, older than 60s).
-Use --cleanup-orphans-grace-secs to set the grace period (default 60s).`,
+Use --cleanup-orphans-grace-secs to set the grace period (default 60s).
+
+Use --rig <name> (repeatable) to scope shutdown to specific rigs: only
+sessions and polecats belonging to those rigs are stopped/cleaned up, and
+Mayor/Deacon are left running. Pass --include-town alongside --rig to also
+stop Mayor/Deacon.`,
 	RunE: runShutdown,
 }
 
@@ -141,10 +150,16 @@ func init() {
 		"Only stop polecats (minimal shutdown)")
 	shutdownCmd.Flags().BoolVar(&shutdownNuclear, "nuclear", false,
 		"Force cleanup even if polecats have uncommitted work (DANGER: may lose work)")
+	shutdownCmd.Flags().BoolVar(&shutdownNoCleanup, "no-cleanup", false,
+		"Skip the polecat worktree/branch cleanup phase entirely")
 	shutdownCmd.Flags().BoolVar(&shutdownCleanupOrphans, "cleanup-orphans", false,
 		"Clean up orphaned Claude processes (TTY-less processes older than 60s)")
 	shutdownCmd.Flags().IntVar(&shutdownCleanupOrphansGrace, "cleanup-orphans-grace-secs", 60,
 		"Grace period in seconds between SIGTERM and SIGKILL when cleaning orphans (default 60)")
+	shutdownCmd.Flags().StringArrayVar(&shutdownRigs, "rig", nil,
+		"Scope shutdown to this rig's sessions/polecats (can be used multiple times; default: whole town)")
+	shutdownCmd.Flags().BoolVar(&shutdownIncludeTown, "include-town", false,
+		"With --rig, also stop Mayor/Deacon (by default they're left running when scoped to a rig)")
 
 	rootCmd.AddCommand(startCmd)
 	rootCmd.AddCommand(shutdownCmd)
@@ -450,16 +465,21 @@ func runShutdown(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("listing sessions: %w", err)
 	}
 
+	if len(shutdownRigs) == 0 && shutdownIncludeTown {
+		return fmt.Errorf("--include-town only makes sense with --rig")
+	}
+
 	// Get session names for categorization
 	mayorSession := getMayorSessionName()
 	deaconSession := getDeaconSessionName()
-	toStop, preserved := categorizeSessions(sessions, mayorSession, deaconSession)
+	toStop, preserved := categorizeSessions(sessions, mayorSession, deaconSession, shutdownRigs, shutdownIncludeTown)
 
 	if len(toStop) == 0 {
 		fmt.Printf("%s Gas Town was not running\n", style.Dim.Render("○"))
 
 		// Still check for orphaned daemons even if no sessions are running
-		if townRoot != "" {
+		// (but not when scoped to specific rigs - the daemon is town-wide).
+		if townRoot != "" && len(shutdownRigs) == 0 {
 			fmt.Println()
 			fmt.Println("Checking for orphaned daemon...")
 			stopDaemonIfRunning(townRoot)
@@ -468,10 +488,13 @@ func runShutdown(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	// Show what will happen
+	// Show what will happen, grouped by rig.
 	fmt.Println("Sessions to stop:")
-	for _, sess := range toStop {
-		fmt.Printf("  %s %s\n", style.Bold.Render("→"), sess)
+	for _, group := range groupSessionsByRig(toStop) {
+		fmt.Printf("  %s\n", style.Bold.Render(group.rig))
+		for _, sess := range group.sessions {
+			fmt.Printf("    %s %s\n", style.Bold.Render("→"), sess)
+		}
 	}
 	if len(preserved) > 0 && !shutdownAll {
 		fmt.Println()
@@ -500,21 +523,52 @@ func runShutdown(cmd *cobra.Command, args []string) error {
 	return runImmediateShutdown(t, toStop, townRoot)
 }
 
+// sessionRig extracts the rig name from a rig-level session, reusing
+// categorizeSession's parsing. Returns "" for town-level (hq-) sessions or
+// anything that doesn't parse as a Gas Town session.
+func sessionRig(sess string) string {
+	agent := categorizeSession(sess)
+	if agent == nil {
+		return ""
+	}
+	return agent.Rig
+}
+
 // categorizeSessions splits sessions into those to stop and those to preserve.
 // mayorSession and deaconSession are the dynamic session names for the current town.
-func categorizeSessions(sessions []string, mayorSession, deaconSession string) (toStop, preserved []string) {
+// rigFilter, if non-empty, restricts toStop/preserved to sessions belonging to
+// those rigs; Mayor/Deacon are excluded entirely unless includeTown is set.
+func categorizeSessions(sessions []string, mayorSession, deaconSession string, rigFilter []string, includeTown bool) (toStop, preserved []string) {
+	scope := make(map[string]bool, len(rigFilter))
+	for _, r := range rigFilter {
+		scope[r] = true
+	}
+	scoped := len(scope) > 0
+
 	for _, sess := range sessions {
 		// Gas Town sessions use gt- (rig-level) or hq- (town-level) prefix
 		if !strings.HasPrefix(sess, "gt-") && !strings.HasPrefix(sess, "hq-") {
 			continue // Not a Gas Town session
 		}
 
+		if sess == mayorSession || sess == deaconSession {
+			if scoped && !includeTown {
+				continue // Town-level agents are left untouched when scoped to a rig
+			}
+			toStop = append(toStop, sess)
+			continue
+		}
+
+		if scoped && !scope[sessionRig(sess)] {
+			continue // Not in one of the named rigs
+		}
+
 		// Check if it's a crew session (pattern: gt-<rig>-crew-<name>)
 		isCrew := strings.Contains(sess, "-crew-")
 
 		// Check if it's a polecat session (pattern: gt-<rig>-<name> where name is not crew/witness/refinery)
 		isPolecat := false
-		if !isCrew && sess != mayorSession && sess != deaconSession {
+		if !isCrew {
 			parts := strings.Split(sess, "-")
 			if len(parts) >= 3 {
 				role := parts[2]
@@ -547,6 +601,36 @@ func categorizeSessions(sessions []string, mayorSession, deaconSession string) (
 	return
 }
 
+// sessionRigGroup is one rig's worth of sessions, for the grouped shutdown
+// confirmation listing. Town-level (Mayor/Deacon) sessions are grouped under
+// "town".
+type sessionRigGroup struct {
+	rig      string
+	sessions []string
+}
+
+// groupSessionsByRig groups sessions by rig for display, in first-seen order.
+func groupSessionsByRig(sessions []string) []sessionRigGroup {
+	var groups []sessionRigGroup
+	index := map[string]int{}
+
+	for _, sess := range sessions {
+		rig := sessionRig(sess)
+		if rig == "" {
+			rig = "town"
+		}
+		i, ok := index[rig]
+		if !ok {
+			i = len(groups)
+			index[rig] = i
+			groups = append(groups, sessionRigGroup{rig: rig})
+		}
+		groups[i].sessions = append(groups[i].sessions, sess)
+	}
+
+	return groups
+}
+
 func runGracefulShutdown(t *tmux.Tmux, gtSessions []string, townRoot string) error {
 	fmt.Printf("Graceful shutdown of Gas Town (waiting up to %ds)...\n\n", shutdownWait)
 
@@ -595,14 +679,17 @@ func runGracefulShutdown(t *tmux.Tmux, gtSessions []string, townRoot string) err
 	}
 
 	// Phase 6: Cleanup polecat worktrees and branches
-	fmt.Printf("\nPhase 6: Cleaning up polecats...\n")
-	if townRoot != "" {
-		cleanupPolecats(townRoot)
+	if !shutdownNoCleanup {
+		fmt.Printf("\nPhase 6: Cleaning up polecats...\n")
+		if townRoot != "" {
+			cleanupPolecats(townRoot)
+		}
 	}
 
-	// Phase 7: Stop the daemon
-	fmt.Printf("\nPhase 7: Stopping daemon...\n")
-	if townRoot != "" {
+	// Phase 7: Stop the daemon (town-wide infra, so skip it when scoped to
+	// specific rigs unless --include-town says otherwise)
+	if townRoot != "" && (len(shutdownRigs) == 0 || shutdownIncludeTown) {
+		fmt.Printf("\nPhase 7: Stopping daemon...\n")
 		stopDaemonIfRunning(townRoot)
 	}
 
@@ -626,14 +713,15 @@ func runImmediateShutdown(t *tmux.Tmux, gtSessions []string, townRoot string) er
 	}
 
 	// Cleanup polecat worktrees and branches
-	if townRoot != "" {
+	if !shutdownNoCleanup && townRoot != "" {
 		fmt.Println()
 		fmt.Println("Cleaning up polecats...")
 		cleanupPolecats(townRoot)
 	}
 
-	// Stop the daemon
-	if townRoot != "" {
+	// Stop the daemon (town-wide infra, so skip it when scoped to specific
+	// rigs unless --include-town says otherwise)
+	if townRoot != "" && (len(shutdownRigs) == 0 || shutdownIncludeTown) {
 		fmt.Println()
 		fmt.Println("Stopping daemon...")
 		stopDaemonIfRunning(townRoot)
@@ -715,8 +803,134 @@ func killSessionsInOrder(t *tmux.Tmux, sessions []string, mayorSession, deaconSe
 	return stopped
 }
 
+// polecatCleanupAction is the disposition planPolecatCleanup assigns to a
+// polecat: whether it will actually be removed, or kept and why.
+type polecatCleanupAction string
+
+const (
+	cleanupWillRemove      polecatCleanupAction = "remove"
+	cleanupKeepUncommitted polecatCleanupAction = "keep-uncommitted"
+	cleanupKeepUnmerged    polecatCleanupAction = "keep-unmerged"
+	cleanupSkipCheckFailed polecatCleanupAction = "skip-error"
+)
+
+// polecatCleanupItem is one polecat's disposition in a cleanup plan, along
+// with everything executePolecatCleanup needs to actually remove it.
+type polecatCleanupItem struct {
+	rig     *rig.Rig
+	polecat *polecat.Polecat
+	action  polecatCleanupAction
+	ahead   int    // commits ahead of origin/<default branch>, when known
+	detail  string // uncommitted-work summary or error text, when relevant
+}
+
+// planPolecatCleanup walks every polecat in rigs and decides, without
+// touching disk, whether it will be removed, kept for uncommitted work, or
+// kept for having unmerged commits (ahead of origin's default branch).
+// Nuclear mode removes both kinds of holdouts.
+func planPolecatCleanup(rigs []*rig.Rig, nuclear bool) []polecatCleanupItem {
+	var items []polecatCleanupItem
+
+	for _, r := range rigs {
+		polecatGit := git.NewGit(r.Path)
+		polecatMgr := polecat.NewManager(r, polecatGit, nil) // nil tmux: just listing, not allocating
+
+		polecats, err := polecatMgr.List()
+		if err != nil {
+			continue
+		}
+
+		mayorGit := git.NewGit(filepath.Join(r.Path, "mayor", "rig"))
+		target := "origin/" + mayorGit.RemoteDefaultBranch()
+
+		for _, p := range polecats {
+			item := polecatCleanupItem{rig: r, polecat: p}
+
+			pGit := git.NewGit(p.ClonePath)
+			status, err := pGit.CheckUncommittedWork()
+			switch {
+			case err != nil && !nuclear:
+				item.action = cleanupSkipCheckFailed
+				item.detail = err.Error()
+				items = append(items, item)
+				continue
+			case err == nil && !status.Clean() && !nuclear:
+				item.action = cleanupKeepUncommitted
+				item.detail = fmt.Sprintf("%s\n%s", status.String(), status.Detail())
+				items = append(items, item)
+				continue
+			}
+
+			if ahead, err := pGit.CommitsAhead(target, "HEAD"); err == nil {
+				item.ahead = ahead
+			}
+			if item.ahead > 0 && !nuclear {
+				item.action = cleanupKeepUnmerged
+				items = append(items, item)
+				continue
+			}
+
+			item.action = cleanupWillRemove
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// printCleanupPlan shows exactly which worktrees/branches will be removed
+// and which are being kept (and why) before any deletion happens.
+func printCleanupPlan(items []polecatCleanupItem) {
+	fmt.Println("  Polecat cleanup plan:")
+	for _, item := range items {
+		switch item.action {
+		case cleanupWillRemove:
+			fmt.Printf("    %s %s/%s (branch %s): remove, %d commit(s) ahead of main\n",
+				style.Bold.Render("→"), item.rig.Name, item.polecat.Name, item.polecat.Branch, item.ahead)
+		case cleanupKeepUnmerged:
+			fmt.Printf("    %s %s/%s (branch %s): kept, %d unmerged commit(s)\n",
+				style.Dim.Render("○"), item.rig.Name, item.polecat.Name, item.polecat.Branch, item.ahead)
+		case cleanupKeepUncommitted:
+			fmt.Printf("    %s %s/%s: kept, uncommitted work (%s)\n",
+				style.Dim.Render("○"), item.rig.Name, item.polecat.Name, item.detail)
+		case cleanupSkipCheckFailed:
+			fmt.Printf("    %s %s/%s: could not check status, skipping (%s)\n",
+				style.Dim.Render("○"), item.rig.Name, item.polecat.Name, item.detail)
+		}
+	}
+}
+
+// executePolecatCleanup removes the worktree and branch for every item
+// (all of which must already be cleanupWillRemove) and returns how many
+// succeeded vs. failed.
+func executePolecatCleanup(items []polecatCleanupItem) (cleaned, failed int) {
+	for _, item := range items {
+		polecatGit := git.NewGit(item.rig.Path)
+		polecatMgr := polecat.NewManager(item.rig, polecatGit, nil)
+
+		if err := polecatMgr.RemoveWithOptions(item.polecat.Name, true, shutdownNuclear); err != nil {
+			fmt.Printf("  %s %s/%s: cleanup failed: %v\n",
+				style.Dim.Render("○"), item.rig.Name, item.polecat.Name, err)
+			failed++
+			continue
+		}
+
+		// Delete the polecat branch from mayor's clone
+		mayorPath := filepath.Join(item.rig.Path, "mayor", "rig")
+		mayorGit := git.NewGit(mayorPath)
+		_ = mayorGit.DeleteBranch(item.polecat.Branch, true) // Ignore errors
+
+		fmt.Printf("  %s %s/%s: cleaned up\n", style.Bold.Render("✓"), item.rig.Name, item.polecat.Name)
+		cleaned++
+	}
+	return cleaned, failed
+}
+
 // cleanupPolecats removes polecat worktrees and branches for all rigs.
-// It refuses to clean up polecats with uncommitted work unless --nuclear is set.
+// It refuses to clean up polecats with uncommitted work, and keeps branches
+// that are ahead of origin's default branch (unmerged), unless --nuclear is
+// set. Shows a summary of exactly what will happen and asks for
+// confirmation before deleting anything, unless --yes/--force was passed.
 func cleanupPolecats(townRoot string) {
 	// Load rigs config
 	rigsConfigPath := filepath.Join(townRoot, "mayor", "rigs.json")
@@ -736,78 +950,53 @@ func cleanupPolecats(townRoot string) {
 		return
 	}
 
-	totalCleaned := 0
-	totalSkipped := 0
-	var uncommittedPolecats []string
-
-	for _, r := range rigs {
-		polecatGit := git.NewGit(r.Path)
-		polecatMgr := polecat.NewManager(r, polecatGit, nil) // nil tmux: just listing, not allocating
-
-		polecats, err := polecatMgr.List()
-		if err != nil {
-			continue
+	if len(shutdownRigs) > 0 {
+		rigScope := make(map[string]bool, len(shutdownRigs))
+		for _, name := range shutdownRigs {
+			rigScope[name] = true
 		}
-
-		for _, p := range polecats {
-			// Check for uncommitted work
-			pGit := git.NewGit(p.ClonePath)
-			status, err := pGit.CheckUncommittedWork()
-			if err != nil {
-				// Can't check, be safe and skip unless nuclear
-				if !shutdownNuclear {
-					fmt.Printf("  %s %s/%s: could not check status, skipping\n",
-						style.Dim.Render("○"), r.Name, p.Name)
-					totalSkipped++
-					continue
-				}
-			} else if !status.Clean() {
-				// Has uncommitted work
-				if !shutdownNuclear {
-					uncommittedPolecats = append(uncommittedPolecats,
-						fmt.Sprintf("%s/%s (%s)", r.Name, p.Name, status.String()))
-					totalSkipped++
-					continue
-				}
-				// Nuclear mode: warn but proceed
-				fmt.Printf("  %s %s/%s: NUCLEAR - removing despite %s\n",
-					style.Bold.Render("⚠"), r.Name, p.Name, status.String())
+		scoped := rigs[:0]
+		for _, r := range rigs {
+			if rigScope[r.Name] {
+				scoped = append(scoped, r)
 			}
+		}
+		rigs = scoped
+	}
 
-			// Clean: remove worktree and branch
-			if err := polecatMgr.RemoveWithOptions(p.Name, true, shutdownNuclear); err != nil {
-				fmt.Printf("  %s %s/%s: cleanup failed: %v\n",
-					style.Dim.Render("○"), r.Name, p.Name, err)
-				totalSkipped++
-				continue
-			}
+	items := planPolecatCleanup(rigs, shutdownNuclear)
+	if len(items) == 0 {
+		fmt.Printf("  %s No polecats to clean up\n", style.Dim.Render("○"))
+		return
+	}
 
-			// Delete the polecat branch from mayor's clone
-			branchName := fmt.Sprintf("polecat/%s", p.Name)
-			mayorPath := filepath.Join(r.Path, "mayor", "rig")
-			mayorGit := git.NewGit(mayorPath)
-			_ = mayorGit.DeleteBranch(branchName, true) // Ignore errors
+	printCleanupPlan(items)
 
-			fmt.Printf("  %s %s/%s: cleaned up\n", style.Bold.Render("✓"), r.Name, p.Name)
-			totalCleaned++
+	var removable []polecatCleanupItem
+	for _, item := range items {
+		if item.action == cleanupWillRemove {
+			removable = append(removable, item)
 		}
 	}
 
-	// Summary
-	if len(uncommittedPolecats) > 0 {
-		fmt.Println()
-		fmt.Printf("  %s Polecats with uncommitted work (use --nuclear to force):\n",
-			style.Bold.Render("⚠"))
-		for _, pc := range uncommittedPolecats {
-			fmt.Printf("    • %s\n", pc)
-		}
+	if len(removable) == 0 {
+		fmt.Printf("  Cleaned: 0, Kept: %d\n", len(items))
+		return
 	}
 
-	if totalCleaned > 0 || totalSkipped > 0 {
-		fmt.Printf("  Cleaned: %d, Skipped: %d\n", totalCleaned, totalSkipped)
-	} else {
-		fmt.Printf("  %s No polecats to clean up\n", style.Dim.Render("○"))
+	if !shutdownYes && !shutdownForce {
+		fmt.Printf("  Remove %d polecat worktree(s)? [y/N] ", len(removable))
+		reader := bufio.NewReader(os.Stdin)
+		response, _ := reader.ReadString('\n')
+		response = strings.TrimSpace(strings.ToLower(response))
+		if response != "y" && response != "yes" {
+			fmt.Println("  Polecat cleanup skipped.")
+			return
+		}
 	}
+
+	cleaned, failed := executePolecatCleanup(removable)
+	fmt.Printf("  Cleaned: %d, Kept: %d, Failed: %d\n", cleaned, len(items)-len(removable), failed)
 }
 
 // stopDaemonIfRunning stops the daemon if it is running.
@@ -131,6 +131,12 @@ type StatusSum struct {
 }
 
 func runStatus(cmd *cobra.Command, args []string) error {
+	if statusTown {
+		if statusWatch {
+			return runStatusWatchWith(cmd, args, runTownDashboardOnce)
+		}
+		return runTownDashboardOnce(cmd, args)
+	}
 	if statusWatch {
 		return runStatusWatch(cmd, args)
 	}
@@ -138,6 +144,13 @@ func runStatus(cmd *cobra.Command, args []string) error {
 }
 
 func runStatusWatch(cmd *cobra.Command, args []string) error {
+	return runStatusWatchWith(cmd, args, runStatusOnce)
+}
+
+// runStatusWatchWith drives the watch loop (clear screen, header, ticker,
+// Ctrl+C handling) around a render function, shared by both the per-agent
+// status view and the town dashboard view.
+func runStatusWatchWith(cmd *cobra.Command, args []string, render func(*cobra.Command, []string) error) error {
 	if statusJSON {
 		return fmt.Errorf("--json and --watch cannot be used together")
 	}
@@ -167,7 +180,7 @@ func runStatusWatch(cmd *cobra.Command, args []string) error {
 			fmt.Printf("%s\n\n", header)
 		}
 
-		if err := runStatusOnce(cmd, args); err != nil {
+		if err := render(cmd, args); err != nil {
 			fmt.Printf("Error: %v\n", err)
 		}
 
@@ -209,6 +222,8 @@ func runStatusOnce(_ *cobra.Command, _ []string) error {
 		rigsConfig = &config.RigsConfig{Rigs: make(map[string]config.RigEntry)}
 	}
 
+	prefixWarnings := prefixCollisionWarnings(rigsConfig)
+
 	// Create rig manager
 	g := git.NewGit(townRoot)
 	mgr := rig.NewManager(townRoot, rigsConfig, g)
@@ -410,9 +425,29 @@ func runStatusOnce(_ *cobra.Command, _ []string) error {
 		fmt.Printf("  Run 'bd daemon killall && bd daemon start' to restart daemons\n")
 	}
 
+	for _, w := range prefixWarnings {
+		fmt.Printf("%s %s\n", style.Warning.Render("⚠"), w)
+	}
+
 	return nil
 }
 
+// prefixCollisionWarnings extracts the beads prefix-collision problems from
+// config.Validate as plain warning strings, ignoring the other Problem kinds
+// (missing git_url, etc.) that gt doctor already surfaces in more detail.
+// The messages already name every colliding rig, so no further formatting
+// is needed here.
+func prefixCollisionWarnings(rigsConfig *config.RigsConfig) []string {
+	var warnings []string
+	for _, p := range config.Validate(rigsConfig) {
+		if strings.Contains(p.Field, "beads.prefix") &&
+			(strings.Contains(p.Message, "shared by rigs") || strings.Contains(p.Message, "town-level")) {
+			warnings = append(warnings, p.Message)
+		}
+	}
+	return warnings
+}
+
 func outputStatusJSON(status TownStatus) error {
 	enc := json.NewEncoder(os.Stdout)
 	enc.SetIndent("", "  ")
@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/deacon"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/refinery"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var statusTown bool
+
+func init() {
+	statusCmd.Flags().BoolVar(&statusTown, "town", false, "Show the mayor's aggregated town dashboard across all rigs")
+}
+
+// TownDashboard is the mayor's one-screen view of the whole town: per-rig
+// issue counts, running sessions, merge queue depth, and any quarantined
+// agents. Unlike TownStatus (per-agent detail), this is built for a quick
+// go/no-go scan and is stable enough for external dashboards to poll.
+type TownDashboard struct {
+	Name            string         `json:"name"`
+	UnreadMayorMail int            `json:"unread_mayor_mail"`
+	Rigs            []RigDashboard `json:"rigs"`
+	Warnings        []string       `json:"warnings,omitempty"`
+}
+
+// RigDashboard summarizes one rig's work and health for the town dashboard.
+type RigDashboard struct {
+	Name            string   `json:"name"`
+	OpenIssues      int      `json:"open_issues"`
+	ReadyIssues     int      `json:"ready_issues"`
+	InProgress      int      `json:"in_progress"`
+	RunningSessions int      `json:"running_sessions"`
+	PendingMRs      int      `json:"pending_mrs"`
+	Quarantined     []string `json:"quarantined,omitempty"`
+}
+
+// BuildTownDashboard fans out across all registered rigs concurrently,
+// gathering issue counts (beads), running sessions (tmux), merge queue
+// depth (refinery), and quarantined agents (deacon's restart ledger).
+func BuildTownDashboard(townRoot string) (*TownDashboard, error) {
+	townConfigPath := constants.MayorTownPath(townRoot)
+	townConfig, err := config.LoadTownConfig(townConfigPath)
+	if err != nil {
+		townConfig = &config.TownConfig{Name: townRoot}
+	}
+
+	rigsConfigPath := constants.MayorRigsPath(townRoot)
+	rigsConfig, err := config.LoadRigsConfig(rigsConfigPath)
+	if err != nil {
+		rigsConfig = &config.RigsConfig{Rigs: make(map[string]config.RigEntry)}
+	}
+
+	g := git.NewGit(townRoot)
+	mgr := rig.NewManager(townRoot, rigsConfig, g)
+	rigs, err := mgr.DiscoverRigs()
+	if err != nil {
+		return nil, fmt.Errorf("discovering rigs: %w", err)
+	}
+
+	dashboard := &TownDashboard{
+		Name: townConfig.Name,
+		Rigs: make([]RigDashboard, len(rigs)),
+	}
+
+	if mailRouter := mail.NewRouter(townRoot); mailRouter != nil {
+		if mailbox, err := mailRouter.GetMailbox("mayor"); err == nil {
+			_, unread, _ := mailbox.Count()
+			dashboard.UnreadMayorMail = unread
+		}
+	}
+
+	restartLedger, _ := deacon.LoadRestartLedger(townRoot)
+
+	var wg sync.WaitGroup
+	for i, r := range rigs {
+		wg.Add(1)
+		go func(idx int, r *rig.Rig) {
+			defer wg.Done()
+			dashboard.Rigs[idx] = buildRigDashboard(r, restartLedger)
+		}(i, r)
+	}
+	wg.Wait()
+
+	dashboard.Warnings = computeDashboardWarnings(dashboard.Rigs)
+
+	return dashboard, nil
+}
+
+func buildRigDashboard(r *rig.Rig, restartLedger *deacon.RestartLedger) RigDashboard {
+	rd := RigDashboard{Name: r.Name}
+
+	resolvedBeads := beads.ResolveBeadsDir(r.Path)
+	b := beads.NewWithBeadsDir(r.Path, resolvedBeads)
+
+	if issues, err := b.List(beads.ListOptions{Status: "open", Priority: -1}); err == nil {
+		rd.OpenIssues = len(issues)
+		for _, issue := range issues {
+			if issue.Status == "in_progress" {
+				rd.InProgress++
+			}
+		}
+	}
+
+	if ready, err := b.Ready(); err == nil {
+		rd.ReadyIssues = len(ready)
+	}
+
+	t := tmux.NewTmux()
+	for _, name := range r.Polecats {
+		if running, _ := t.HasSession(fmt.Sprintf("gt-%s-%s", r.Name, name)); running {
+			rd.RunningSessions++
+		}
+	}
+	for _, sessionSuffix := range []string{"witness", "refinery"} {
+		if running, _ := t.HasSession(fmt.Sprintf("gt-%s-%s", r.Name, sessionSuffix)); running {
+			rd.RunningSessions++
+		}
+	}
+
+	if queueLen, err := refinery.NewManager(r).QueueLen(); err == nil {
+		rd.PendingMRs = queueLen
+	}
+
+	if restartLedger != nil {
+		for session, e := range restartLedger.Sessions {
+			if e.Quarantined {
+				rd.Quarantined = append(rd.Quarantined, session)
+			}
+		}
+	}
+
+	return rd
+}
+
+// computeDashboardWarnings flags rigs that look stalled: ready work
+// sitting with nobody running to pick it up.
+func computeDashboardWarnings(rigs []RigDashboard) []string {
+	var warnings []string
+	for _, rd := range rigs {
+		if rd.ReadyIssues > 0 && rd.RunningSessions == 0 {
+			warnings = append(warnings, fmt.Sprintf("%s: %d ready issue(s) but no running sessions", rd.Name, rd.ReadyIssues))
+		}
+		if len(rd.Quarantined) > 0 {
+			warnings = append(warnings, fmt.Sprintf("%s: %d quarantined agent(s)", rd.Name, len(rd.Quarantined)))
+		}
+	}
+	return warnings
+}
+
+func runTownDashboardOnce(*cobra.Command, []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	dashboard, err := BuildTownDashboard(townRoot)
+	if err != nil {
+		return err
+	}
+
+	if statusJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(dashboard)
+	}
+
+	fmt.Printf("%s %s (unread mayor mail: %d)\n\n", style.Bold.Render("Town:"), dashboard.Name, dashboard.UnreadMayorMail)
+	fmt.Printf("%-16s %8s %8s %8s %10s %8s %s\n", "RIG", "OPEN", "READY", "WIP", "RUNNING", "MRS", "QUARANTINED")
+	for _, rd := range dashboard.Rigs {
+		fmt.Printf("%-16s %8d %8d %8d %10d %8d %d\n",
+			rd.Name, rd.OpenIssues, rd.ReadyIssues, rd.InProgress, rd.RunningSessions, rd.PendingMRs, len(rd.Quarantined))
+	}
+
+	if len(dashboard.Warnings) > 0 {
+		fmt.Println()
+		for _, w := range dashboard.Warnings {
+			fmt.Printf("%s %s\n", style.Bold.Render("⚠"), w)
+		}
+	}
+
+	return nil
+}
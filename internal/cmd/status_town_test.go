@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTownDashboard_JSONShape(t *testing.T) {
+	dashboard := &TownDashboard{
+		Name:            "gastown",
+		UnreadMayorMail: 2,
+		Rigs: []RigDashboard{
+			{
+				Name:            "greenplace",
+				OpenIssues:      10,
+				ReadyIssues:     3,
+				InProgress:      2,
+				RunningSessions: 0,
+				PendingMRs:      1,
+				Quarantined:     []string{"gt-greenplace-witness"},
+			},
+		},
+		Warnings: []string{"greenplace: 3 ready issue(s) but no running sessions"},
+	}
+
+	data, err := json.Marshal(dashboard)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	const want = `{"name":"gastown","unread_mayor_mail":2,"rigs":[{"name":"greenplace","open_issues":10,"ready_issues":3,"in_progress":2,"running_sessions":0,"pending_mrs":1,"quarantined":["gt-greenplace-witness"]}],"warnings":["greenplace: 3 ready issue(s) but no running sessions"]}`
+	if string(data) != want {
+		t.Errorf("TownDashboard JSON shape changed:\ngot:  %s\nwant: %s", data, want)
+	}
+
+	var round TownDashboard
+	if err := json.Unmarshal(data, &round); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if round.Rigs[0].Name != "greenplace" || round.Rigs[0].OpenIssues != 10 {
+		t.Errorf("round-trip mismatch: %+v", round.Rigs[0])
+	}
+}
+
+func TestRigDashboard_OmitsEmptyQuarantined(t *testing.T) {
+	rd := RigDashboard{Name: "greenplace"}
+
+	data, err := json.Marshal(rd)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	const want = `{"name":"greenplace","open_issues":0,"ready_issues":0,"in_progress":0,"running_sessions":0,"pending_mrs":0}`
+	if string(data) != want {
+		t.Errorf("RigDashboard JSON shape changed:\ngot:  %s\nwant: %s", data, want)
+	}
+}
+
+func TestComputeDashboardWarnings(t *testing.T) {
+	rigs := []RigDashboard{
+		{Name: "busy", ReadyIssues: 2, RunningSessions: 1},
+		{Name: "stalled", ReadyIssues: 4, RunningSessions: 0},
+		{Name: "clean"},
+		{Name: "sidelined", Quarantined: []string{"gt-sidelined-witness"}},
+	}
+
+	warnings := computeDashboardWarnings(rigs)
+
+	if len(warnings) != 2 {
+		t.Fatalf("expected 2 warnings, got %d: %v", len(warnings), warnings)
+	}
+	if warnings[0] != "stalled: 4 ready issue(s) but no running sessions" {
+		t.Errorf("unexpected warning: %q", warnings[0])
+	}
+	if warnings[1] != "sidelined: 1 quarantined agent(s)" {
+		t.Errorf("unexpected warning: %q", warnings[1])
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
@@ -84,13 +85,15 @@ func runStatusLine(cmd *cobra.Command, args []string) error {
 // runWorkerStatusLine outputs status for crew or polecat sessions.
 func runWorkerStatusLine(t *tmux.Tmux, session, rigName, polecat, crew, issue string) error {
 	// Determine agent type and identity
-	var icon, identity string
+	var icon, identity, name, role string
 	if polecat != "" {
 		icon = AgentTypeIcons[AgentPolecat]
 		identity = fmt.Sprintf("%s/%s", rigName, polecat)
+		name, role = polecat, "polecat"
 	} else if crew != "" {
 		icon = AgentTypeIcons[AgentCrew]
 		identity = fmt.Sprintf("%s/crew/%s", rigName, crew)
+		name, role = crew, "crew"
 	}
 
 	// Get pane's working directory to find workspace
@@ -105,11 +108,15 @@ func runWorkerStatusLine(t *tmux.Tmux, session, rigName, polecat, crew, issue st
 	// Build status parts
 	var parts []string
 
-	// Priority 1: Check for hooked work (use rig beads)
+	// Priority 1: Check for hooked work and agent lifecycle state (use rig beads)
 	hookedWork := ""
+	agentState := ""
+	var workingFor time.Duration
+	var rigBeadsDir string
 	if identity != "" && rigName != "" && townRoot != "" {
-		rigBeadsDir := filepath.Join(townRoot, rigName, "mayor", "rig")
+		rigBeadsDir = filepath.Join(townRoot, rigName, "mayor", "rig")
 		hookedWork = getHookedWork(identity, 40, rigBeadsDir)
+		agentState, workingFor = getAgentWorkState(rigBeadsDir, beads.AgentBeadID(rigName, role, name))
 	}
 
 	// Priority 2: Fall back to GT_ISSUE env var or in_progress beads
@@ -118,21 +125,21 @@ func runWorkerStatusLine(t *tmux.Tmux, session, rigName, polecat, crew, issue st
 		currentWork = getCurrentWork(t, session, 40)
 	}
 
-	// Show hooked work (takes precedence)
-	if hookedWork != "" {
-		if icon != "" {
-			parts = append(parts, fmt.Sprintf("%s 🪝 %s", icon, hookedWork))
-		} else {
-			parts = append(parts, fmt.Sprintf("🪝 %s", hookedWork))
-		}
-	} else if currentWork != "" {
+	switch {
+	case hookedWork != "" || agentState != "":
+		// Hooked work / agent state (takes precedence over generic in_progress work)
+		parts = append(parts, formatWorkerStatus(icon, name, hookedWork, agentState, workingFor))
+	case currentWork != "":
 		// Fall back to current work (in_progress)
 		if icon != "" {
 			parts = append(parts, fmt.Sprintf("%s %s", icon, currentWork))
 		} else {
 			parts = append(parts, currentWork)
 		}
-	} else if icon != "" {
+	case name != "":
+		// No hook, state, or in_progress work found - degrade to just the name
+		parts = append(parts, formatWorkerStatus(icon, name, "", "", 0))
+	case icon != "":
 		parts = append(parts, icon)
 	}
 
@@ -743,6 +750,59 @@ func getHookedWork(identity string, maxLen int, beadsDir string) string {
 	return display
 }
 
+// formatWorkerStatus renders the "<icon> <name> ▸ <hook> ▸ <state> <duration>"
+// status-line segment for a polecat or crew session, degrading gracefully as
+// data becomes unavailable: with no hooked work that segment is dropped, and
+// with neither hook nor agent state the line falls back to just the name.
+func formatWorkerStatus(icon, name, hookedWork, agentState string, workingFor time.Duration) string {
+	if name == "" {
+		return ""
+	}
+
+	label := name
+	if icon != "" {
+		label = fmt.Sprintf("%s %s", icon, name)
+	}
+
+	if hookedWork == "" && agentState == "" {
+		return label
+	}
+
+	parts := []string{label}
+	if hookedWork != "" {
+		parts = append(parts, hookedWork)
+	}
+	if agentState != "" {
+		state := agentState
+		if workingFor > 0 {
+			state = fmt.Sprintf("%s %s", agentState, formatDuration(workingFor))
+		}
+		parts = append(parts, state)
+	}
+	return strings.Join(parts, " ▸ ")
+}
+
+// getAgentWorkState returns an agent bead's lifecycle state (spawning,
+// working, done, stuck, needs_attention) and how long it's been assigned its
+// current work, for the "working 43m" status-line segment. Returns ("", 0)
+// if the agent bead can't be read or has no state recorded.
+func getAgentWorkState(beadsDir, agentBeadID string) (string, time.Duration) {
+	b := beads.New(beadsDir)
+	_, fields, err := b.GetAgentBead(agentBeadID)
+	if err != nil || fields == nil || fields.AgentState == "" {
+		return "", 0
+	}
+
+	var workingFor time.Duration
+	if fields.AssignedAt != "" {
+		if assignedAt, err := time.Parse(time.RFC3339, fields.AssignedAt); err == nil {
+			workingFor = time.Since(assignedAt)
+		}
+	}
+
+	return fields.AgentState, workingFor
+}
+
 // getCurrentWork returns a truncated title of the first in_progress issue.
 // Uses the pane's working directory to find the beads.
 func getCurrentWork(t *tmux.Tmux, session string, maxLen int) string {
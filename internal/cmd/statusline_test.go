@@ -1,6 +1,9 @@
 package cmd
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestCategorizeSessionRig(t *testing.T) {
 	tests := []struct {
@@ -84,3 +87,63 @@ func TestCategorizeSessionType(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatWorkerStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		icon       string
+		worker     string
+		hookedWork string
+		agentState string
+		workingFor time.Duration
+		want       string
+	}{
+		{
+			name:       "hooked work and working state with duration",
+			icon:       "😺",
+			worker:     "Toast",
+			hookedWork: "gt-abc: fix the thing",
+			agentState: "working",
+			workingFor: 43 * time.Minute,
+			want:       "😺 Toast ▸ gt-abc: fix the thing ▸ working 43m 0s",
+		},
+		{
+			name:       "state without a duration",
+			icon:       "😺",
+			worker:     "Toast",
+			agentState: "spawning",
+			want:       "😺 Toast ▸ spawning",
+		},
+		{
+			name:       "hooked work without agent state",
+			icon:       "😺",
+			worker:     "Toast",
+			hookedWork: "gt-abc: fix the thing",
+			want:       "😺 Toast ▸ gt-abc: fix the thing",
+		},
+		{
+			name:   "no hook or state degrades to icon and name",
+			icon:   "😺",
+			worker: "Toast",
+			want:   "😺 Toast",
+		},
+		{
+			name:   "no icon degrades to bare name",
+			worker: "Toast",
+			want:   "Toast",
+		},
+		{
+			name: "no name yields empty string",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := formatWorkerStatus(tt.icon, tt.worker, tt.hookedWork, tt.agentState, tt.workingFor)
+			if got != tt.want {
+				t.Errorf("formatWorkerStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -11,14 +12,17 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/runtime"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/swarm"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/witness"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -33,8 +37,20 @@ var (
 	swarmListStatus string
 	swarmListJSON   bool
 	swarmTarget     string
+	swarmStrict     bool
+	swarmSkipTests  bool
+
+	swarmFromReady         bool
+	swarmFromReadyCount    int
+	swarmFromReadyPriority int
+	swarmFromReadyLabel    string
 )
 
+// swarmAutoEpicLabel marks epics that --from-ready synthesized, so a
+// human skimming `bd list` (or later tooling) can tell them apart from a
+// hand-curated epic that just happens to share the "swarm" mol-type.
+const swarmAutoEpicLabel = "auto-swarm-epic"
+
 var swarmCmd = &cobra.Command{
 	Use:        "swarm",
 	GroupID:    GroupWork,
@@ -66,9 +82,20 @@ var swarmCreateCmd = &cobra.Command{
 Creates a swarm that coordinates multiple polecats working on tasks from
 a beads epic. All workers branch from the same base commit.
 
+If the epic already exists, its children are checked for at least one
+open, unassigned task before the swarm is created; tasks that are closed
+or already assigned elsewhere are reported but don't block creation
+unless --strict is set.
+
+With --from-ready, no pre-existing epic is needed: up to --count
+currently ready issues (optionally filtered by --priority and --label)
+are pulled in and parented under a freshly created epic, which is then
+used as the swarm root like any other epic.
+
 Examples:
   gt swarm create greenplace --epic gp-abc --worker Toast --worker Nux
-  gt swarm create greenplace --epic gp-abc --worker Toast --start`,
+  gt swarm create greenplace --epic gp-abc --worker Toast --start
+  gt swarm create greenplace --from-ready --count 3 --priority 1 --worker Toast`,
 	Args: cobra.ExactArgs(1),
 	RunE: runSwarmCreate,
 }
@@ -103,7 +130,10 @@ var swarmLandCmd = &cobra.Command{
 	Short: "Land a swarm to main",
 	Long: `Manually trigger landing for a completed swarm.
 
-Merges the integration branch to the target branch (usually main).
+Merges the integration branch to the target branch (usually main), after
+running the rig's merge_queue test command (or the swarm's own
+land-test-command: label) against the integration branch in a scratch
+worktree. Use --skip-tests to bypass the gate.
 Normally this is done automatically by the Refinery.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runSwarmLand,
@@ -149,11 +179,15 @@ var swarmDispatchRig string
 
 func init() {
 	// Create flags
-	swarmCreateCmd.Flags().StringVar(&swarmEpic, "epic", "", "Beads epic ID for this swarm (required)")
+	swarmCreateCmd.Flags().StringVar(&swarmEpic, "epic", "", "Beads epic ID for this swarm (required unless --from-ready)")
 	swarmCreateCmd.Flags().StringSliceVar(&swarmWorkers, "worker", nil, "Polecat names to assign (repeatable)")
 	swarmCreateCmd.Flags().BoolVar(&swarmStart, "start", false, "Start swarm immediately after creation")
 	swarmCreateCmd.Flags().StringVar(&swarmTarget, "target", "main", "Target branch for landing")
-	_ = swarmCreateCmd.MarkFlagRequired("epic") // cobra flags: error only at runtime if missing
+	swarmCreateCmd.Flags().BoolVar(&swarmStrict, "strict", false, "Fail instead of warning when the epic has no ready, unassigned tasks")
+	swarmCreateCmd.Flags().BoolVar(&swarmFromReady, "from-ready", false, "Build the swarm epic from currently ready issues instead of --epic")
+	swarmCreateCmd.Flags().IntVar(&swarmFromReadyCount, "count", 5, "Max ready issues to pull in with --from-ready")
+	swarmCreateCmd.Flags().IntVar(&swarmFromReadyPriority, "priority", -1, "With --from-ready, only include issues at or below this priority (0=highest; -1=no filter)")
+	swarmCreateCmd.Flags().StringVar(&swarmFromReadyLabel, "label", "", "With --from-ready, only include issues carrying this label")
 
 	// Status flags
 	swarmStatusCmd.Flags().BoolVar(&swarmStatusJSON, "json", false, "Output as JSON")
@@ -165,6 +199,9 @@ func init() {
 	// Dispatch flags
 	swarmDispatchCmd.Flags().StringVar(&swarmDispatchRig, "rig", "", "Rig to dispatch in (auto-detected from epic if not specified)")
 
+	// Land flags
+	swarmLandCmd.Flags().BoolVar(&swarmSkipTests, "skip-tests", false, "Skip the pre-merge landing test gate (dangerous)")
+
 	// Add subcommands
 	swarmCmd.AddCommand(swarmCreateCmd)
 	swarmCmd.AddCommand(swarmStartCmd)
@@ -223,21 +260,183 @@ func getAllRigs() ([]*rig.Rig, string, error) {
 	return rigs, townRoot, nil
 }
 
+// checkSwarmWorkerLimit validates that assigning the given workers to a
+// swarm won't push the rig past its max_polecats limit, so an oversized
+// --worker list fails up front instead of partway through spawning.
+func checkSwarmWorkerLimit(r *rig.Rig, workers []string) error {
+	limit := r.GetIntConfig("max_polecats")
+	if limit <= 0 {
+		return nil
+	}
+
+	polecatMgr := polecat.NewManager(r, git.NewGit(r.Path), tmux.NewTmux())
+	existing, err := polecatMgr.List()
+	if err != nil {
+		return nil // best-effort; don't block swarm creation on a listing error
+	}
+
+	existingNames := make(map[string]bool, len(existing))
+	for _, p := range existing {
+		existingNames[p.Name] = true
+	}
+
+	total := len(existing)
+	for _, w := range workers {
+		if !existingNames[w] {
+			total++
+		}
+	}
+
+	if total > limit {
+		return fmt.Errorf("%w: swarm would use %d polecats on rig %s, but max_polecats=%d",
+			polecat.ErrPolecatLimitReached, total, r.Name, limit)
+	}
+	return nil
+}
+
+// selectReadyIssues narrows a bd ready list down to at most count issues,
+// keeping only those at or below maxPriority (skipped when negative) and,
+// if label is set, carrying that label. Order from bd's ready front is
+// preserved, so earlier-surfaced issues win when count trims the list.
+func selectReadyIssues(ready []*beads.Issue, count, maxPriority int, label string) []*beads.Issue {
+	var selected []*beads.Issue
+	for _, issue := range ready {
+		if maxPriority >= 0 && issue.Priority > maxPriority {
+			continue
+		}
+		if label != "" && !hasLabel(issue.Labels, label) {
+			continue
+		}
+		selected = append(selected, issue)
+		if len(selected) == count {
+			break
+		}
+	}
+	return selected
+}
+
+// createSwarmEpicFromReady pulls up to count currently ready issues
+// (optionally filtered by maxPriority and label) and parents them under a
+// freshly created, labeled epic, so a --from-ready swarm slots into the
+// same epic-driven creation path as a hand-picked --epic. Returns the new
+// epic's ID.
+func createSwarmEpicFromReady(r *rig.Rig, beadsPath string, count, maxPriority int, label string) (string, error) {
+	bd := beads.NewWithBeadsDir(r.Path, beadsPath).WithActor("mayor")
+	ready, err := bd.Ready()
+	if err != nil {
+		return "", fmt.Errorf("listing ready issues: %w", err)
+	}
+
+	selected := selectReadyIssues(ready, count, maxPriority, label)
+	if len(selected) == 0 {
+		return "", errors.New("no ready issues match --from-ready filters")
+	}
+
+	createArgs := []string{
+		"create",
+		"--type=epic",
+		"--mol-type=swarm",
+		"--title", fmt.Sprintf("Auto-swarm: %d ready issue(s)", len(selected)),
+		"--label", swarmAutoEpicLabel,
+		"--silent",
+	}
+	createCmd := exec.Command("bd", createArgs...)
+	createCmd.Dir = beadsPath
+	var stderr bytes.Buffer
+	createCmd.Stderr = &stderr
+	idOut, err := createCmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("creating auto-swarm epic: %w (%s)", err, strings.TrimSpace(stderr.String()))
+	}
+	epicID := strings.TrimSpace(string(idOut))
+
+	for _, issue := range selected {
+		depCmd := exec.Command("bd", "dep", "add", epicID, issue.ID, "--type=parent-child")
+		depCmd.Dir = beadsPath
+		var depStderr bytes.Buffer
+		depCmd.Stderr = &depStderr
+		if err := depCmd.Run(); err != nil {
+			style.PrintWarning("couldn't parent %s under %s: %s", issue.ID, epicID, strings.TrimSpace(depStderr.String()))
+		}
+	}
+
+	fmt.Printf("%s Created auto-swarm epic %s from %d ready issue(s)\n\n", style.Bold.Render("✓"), epicID, len(selected))
+
+	return epicID, nil
+}
+
+// validateSwarmEpicTasks checks that a pre-existing epic has at least one
+// open, unassigned task before a swarm is created against it. With strict
+// set, a fully blocked epic (all children closed or already assigned) fails
+// the create instead of just warning.
+func validateSwarmEpicTasks(r *rig.Rig, epicID string, strict bool) error {
+	validation, err := swarm.NewManager(r).ValidateEpicTasks(epicID)
+	if err != nil {
+		return fmt.Errorf("validating epic tasks: %w", err)
+	}
+
+	if len(validation.Ready) == 0 {
+		msg := fmt.Sprintf("epic %s has no open, unassigned tasks to swarm", epicID)
+		for _, reason := range validation.Blocked {
+			msg += "\n  - " + reason
+		}
+		msg += fmt.Sprintf("\nInvestigate with: bd list --parent %s", epicID)
+		if strict {
+			return errors.New(msg)
+		}
+		fmt.Printf("%s Warning: %s\n\n", style.Bold.Render("⚠"), msg)
+		return nil
+	}
+
+	if len(validation.Blocked) > 0 {
+		fmt.Printf("Note: %d task(s) skipped (closed or already assigned):\n", len(validation.Blocked))
+		for _, reason := range validation.Blocked {
+			fmt.Printf("  - %s\n", reason)
+		}
+		fmt.Println()
+	}
+
+	return nil
+}
+
 func runSwarmCreate(cmd *cobra.Command, args []string) error {
 	rigName := args[0]
 
+	switch {
+	case swarmFromReady && swarmEpic != "":
+		return errors.New("--epic and --from-ready are mutually exclusive")
+	case !swarmFromReady && swarmEpic == "":
+		return errors.New("--epic or --from-ready is required")
+	}
+
 	r, townRoot, err := getSwarmRig(rigName)
 	if err != nil {
 		return err
 	}
 
+	if len(swarmWorkers) > 0 {
+		if err := checkSwarmWorkerLimit(r, swarmWorkers); err != nil {
+			return err
+		}
+	}
+
 	// Use beads to create the swarm molecule
 	// First check if the epic already exists (it may be pre-created)
 	// Use BeadsPath() to ensure we read from git-synced beads location
 	beadsPath := r.BeadsPath()
+
+	if swarmFromReady {
+		epicID, err := createSwarmEpicFromReady(r, beadsPath, swarmFromReadyCount, swarmFromReadyPriority, swarmFromReadyLabel)
+		if err != nil {
+			return err
+		}
+		swarmEpic = epicID
+	}
+
 	checkCmd := exec.Command("bd", "show", swarmEpic, "--json")
 	checkCmd.Dir = beadsPath
-	if err := checkCmd.Run(); err != nil {
+	epicExists := checkCmd.Run() == nil
+	if !epicExists {
 		// Epic doesn't exist, create it as a swarm molecule
 		createArgs := []string{
 			"create",
@@ -253,6 +452,8 @@ func runSwarmCreate(cmd *cobra.Command, args []string) error {
 		if err := createCmd.Run(); err != nil {
 			return fmt.Errorf("creating swarm epic: %w", err)
 		}
+	} else if err := validateSwarmEpicTasks(r, swarmEpic, swarmStrict); err != nil {
+		return err
 	}
 
 	// Get current git commit as base
@@ -469,6 +670,7 @@ func runSwarmDispatch(cmd *cobra.Command, args []string) error {
 	// Use gt sling to spawn a fresh polecat and assign the task
 	slingCmd := exec.Command("gt", "sling", task.ID, foundRig.Name)
 	slingCmd.Dir = townRoot
+	slingCmd.Env = append(os.Environ(), "GT_SWARM_DISPATCH=1")
 	slingCmd.Stdout = os.Stdout
 	slingCmd.Stderr = os.Stderr
 
@@ -509,6 +711,7 @@ func spawnSwarmWorkersFromBeads(r *rig.Rig, townRoot string, swarmID string, wor
 		// Use gt sling to assign task to worker (this updates beads)
 		slingCmd := exec.Command("gt", "sling", task.ID, fmt.Sprintf("%s/%s", r.Name, worker))
 		slingCmd.Dir = townRoot
+		slingCmd.Env = append(os.Environ(), "GT_SWARM_DISPATCH=1")
 		if err := slingCmd.Run(); err != nil {
 			style.PrintWarning("  couldn't sling %s to %s: %v", task.ID, worker, err)
 
@@ -530,7 +733,9 @@ func spawnSwarmWorkersFromBeads(r *rig.Rig, townRoot string, swarmID string, wor
 				continue
 			}
 			// Wait for Claude to initialize
-			time.Sleep(5 * time.Second)
+			if err := session.WaitForReady(t, polecatSessMgr.SessionName(worker), 30*time.Second); err != nil {
+				style.PrintWarning("  %s not ready: %v", worker, err)
+			}
 		}
 
 		// Inject work assignment
@@ -585,7 +790,62 @@ func runSwarmStatus(cmd *cobra.Command, args []string) error {
 	bdCmd.Stdout = os.Stdout
 	bdCmd.Stderr = os.Stderr
 
-	return bdCmd.Run()
+	if err := bdCmd.Run(); err != nil {
+		return err
+	}
+
+	if !swarmStatusJSON {
+		printSwarmStallWarnings(foundRig, swarmID)
+	}
+
+	return nil
+}
+
+// printSwarmStallWarnings lists the swarm's in_progress child tasks that
+// have been assigned longer than the rig's stall threshold, using the
+// assigned_at field AssignIssue writes into each task's description.
+// Best-effort: failures here shouldn't hide the swarm status already printed.
+func printSwarmStallWarnings(r *rig.Rig, swarmID string) {
+	settingsPath := filepath.Join(r.Path, "settings", "config.json")
+	settings, err := config.LoadRigSettings(settingsPath)
+	threshold := witness.DefaultStallThreshold
+	if err == nil {
+		if configured := settings.GetStallThreshold(); configured > 0 {
+			threshold = configured
+		}
+	}
+
+	b := beads.NewWithBeadsDir(r.Path, r.BeadsPath())
+	tasks, err := b.List(beads.ListOptions{Status: "open", Parent: swarmID})
+	if err != nil {
+		return
+	}
+
+	var stalled []string
+	for _, task := range tasks {
+		if task.Status != "in_progress" {
+			continue
+		}
+		fields := beads.ParseWorkFields(task)
+		if fields == nil || fields.AssignedAt == "" {
+			continue
+		}
+		assignedAt, err := time.Parse(time.RFC3339, fields.AssignedAt)
+		if err != nil {
+			continue
+		}
+		if elapsed := time.Since(assignedAt); elapsed >= threshold {
+			stalled = append(stalled, fmt.Sprintf("  %s %s in_progress for %s (assignee %s)",
+				style.Warning.Render("!"), task.ID, formatDuration(elapsed), task.Assignee))
+		}
+	}
+
+	if len(stalled) > 0 {
+		fmt.Printf("\n%s\n", style.Bold.Render("Stalled tasks:"))
+		for _, line := range stalled {
+			fmt.Println(line)
+		}
+	}
 }
 
 func runSwarmList(cmd *cobra.Command, args []string) error {
@@ -749,6 +1009,10 @@ func runSwarmLand(cmd *cobra.Command, args []string) error {
 			len(status.Ready), len(status.Active), len(status.Blocked))
 	}
 
+	if swarmSkipTests {
+		style.PrintWarning("--skip-tests set: landing without running the pre-merge test gate")
+	}
+
 	fmt.Printf("Landing swarm %s to main...\n", swarmID)
 
 	// Use swarm manager for the actual landing (git operations)
@@ -760,7 +1024,8 @@ func runSwarmLand(cmd *cobra.Command, args []string) error {
 
 	// Execute full landing protocol
 	config := swarm.LandingConfig{
-		TownRoot: townRoot,
+		TownRoot:  townRoot,
+		SkipTests: swarmSkipTests,
 	}
 	result, err := mgr.ExecuteLanding(swarmID, config)
 	if err != nil {
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestSelectReadyIssues_NoFilters(t *testing.T) {
+	ready := []*beads.Issue{
+		{ID: "gt-a", Priority: 2},
+		{ID: "gt-b", Priority: 0},
+		{ID: "gt-c", Priority: 3},
+	}
+
+	got := selectReadyIssues(ready, 5, -1, "")
+	if len(got) != 3 {
+		t.Fatalf("selectReadyIssues() len = %d, want 3", len(got))
+	}
+}
+
+func TestSelectReadyIssues_RespectsCount(t *testing.T) {
+	ready := []*beads.Issue{
+		{ID: "gt-a", Priority: 0},
+		{ID: "gt-b", Priority: 0},
+		{ID: "gt-c", Priority: 0},
+	}
+
+	got := selectReadyIssues(ready, 2, -1, "")
+	if len(got) != 2 {
+		t.Fatalf("selectReadyIssues() len = %d, want 2", len(got))
+	}
+	if got[0].ID != "gt-a" || got[1].ID != "gt-b" {
+		t.Errorf("selectReadyIssues() = %v, want the first two in ready-front order", got)
+	}
+}
+
+func TestSelectReadyIssues_FiltersByMaxPriority(t *testing.T) {
+	ready := []*beads.Issue{
+		{ID: "gt-p0", Priority: 0},
+		{ID: "gt-p1", Priority: 1},
+		{ID: "gt-p2", Priority: 2},
+		{ID: "gt-p3", Priority: 3},
+	}
+
+	got := selectReadyIssues(ready, 5, 1, "")
+
+	var gotIDs []string
+	for _, issue := range got {
+		gotIDs = append(gotIDs, issue.ID)
+	}
+	want := []string{"gt-p0", "gt-p1"}
+	if len(gotIDs) != len(want) || gotIDs[0] != want[0] || gotIDs[1] != want[1] {
+		t.Errorf("selectReadyIssues() IDs = %v, want %v", gotIDs, want)
+	}
+}
+
+func TestSelectReadyIssues_FiltersByLabel(t *testing.T) {
+	ready := []*beads.Issue{
+		{ID: "gt-a", Labels: []string{"backend"}},
+		{ID: "gt-b", Labels: []string{"frontend"}},
+		{ID: "gt-c", Labels: []string{"backend", "urgent"}},
+	}
+
+	got := selectReadyIssues(ready, 5, -1, "backend")
+	if len(got) != 2 {
+		t.Fatalf("selectReadyIssues() len = %d, want 2", len(got))
+	}
+	if got[0].ID != "gt-a" || got[1].ID != "gt-c" {
+		t.Errorf("selectReadyIssues() = %v, want gt-a and gt-c", got)
+	}
+}
+
+func TestSelectReadyIssues_NoMatches(t *testing.T) {
+	ready := []*beads.Issue{
+		{ID: "gt-a", Priority: 3},
+	}
+
+	got := selectReadyIssues(ready, 5, 1, "")
+	if len(got) != 0 {
+		t.Errorf("selectReadyIssues() len = %d, want 0", len(got))
+	}
+}
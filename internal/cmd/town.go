@@ -0,0 +1,99 @@
+// Package cmd provides CLI commands for the gt tool.
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// townCmd itself (Use "town") and its next/prev session-cycling
+// subcommands live in town_cycle.go; the registry subcommands below
+// (add/list/use) extend that same command group.
+
+var townAddCmd = &cobra.Command{
+	Use:   "add <name> <path>",
+	Short: "Register a town by name",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runTownAdd,
+}
+
+var townListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List registered towns",
+	RunE:  runTownList,
+}
+
+var townUseCmd = &cobra.Command{
+	Use:   "use <name>",
+	Short: "Mark a registered town as the current default",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runTownUse,
+}
+
+func runTownAdd(cmd *cobra.Command, args []string) error {
+	name, path := args[0], args[1]
+
+	reg, err := workspace.LoadTownRegistry()
+	if err != nil {
+		return fmt.Errorf("loading town registry: %w", err)
+	}
+	if err := reg.Register(name, path); err != nil {
+		return err
+	}
+	if err := workspace.SaveTownRegistry(reg); err != nil {
+		return fmt.Errorf("saving town registry: %w", err)
+	}
+
+	fmt.Printf("Registered town %q -> %s\n", name, reg.Towns[name])
+	return nil
+}
+
+func runTownList(cmd *cobra.Command, args []string) error {
+	reg, err := workspace.LoadTownRegistry()
+	if err != nil {
+		return fmt.Errorf("loading town registry: %w", err)
+	}
+
+	names := reg.Names()
+	if len(names) == 0 {
+		fmt.Println("No towns registered. Add one with: gt town add <name> <path>")
+		return nil
+	}
+
+	for _, name := range names {
+		marker := " "
+		if name == reg.Current {
+			marker = "*"
+		}
+		fmt.Printf("%s %-16s %s\n", marker, name, reg.Towns[name])
+	}
+	return nil
+}
+
+func runTownUse(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	reg, err := workspace.LoadTownRegistry()
+	if err != nil {
+		return fmt.Errorf("loading town registry: %w", err)
+	}
+	if _, ok := reg.Towns[name]; !ok {
+		return fmt.Errorf("town %q is not registered (see `gt town list`)", name)
+	}
+
+	reg.Current = name
+	if err := workspace.SaveTownRegistry(reg); err != nil {
+		return fmt.Errorf("saving town registry: %w", err)
+	}
+
+	fmt.Printf("Current town set to %q (%s)\n", name, reg.Towns[name])
+	return nil
+}
+
+func init() {
+	townCmd.AddCommand(townAddCmd)
+	townCmd.AddCommand(townListCmd)
+	townCmd.AddCommand(townUseCmd)
+}
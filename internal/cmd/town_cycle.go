@@ -43,7 +43,20 @@ func init() {
 var townCmd = &cobra.Command{
 	Use:   "town",
 	Short: "Town-level operations",
-	Long:  `Commands for town-level operations including session cycling.`,
+	Long: `Commands for town-level operations including session cycling and
+managing the registry of known towns for multi-town setups.
+
+Commands:
+  gt town next / prev          Cycle between Mayor/Deacon sessions
+  gt town add <name> <path>    Register a town
+  gt town list                 List registered towns
+  gt town use <name>           Mark a town as the current default
+
+Anyone running more than one town - say a personal town and a work
+town - can register each by name and address it with --town <name> or
+GT_TOWN=<name> instead of remembering paths. A --town flag or GT_TOWN
+value that is itself a valid workspace path works too, without needing
+to be registered first.`,
 }
 
 var townNextCmd = &cobra.Command{
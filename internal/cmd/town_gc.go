@@ -0,0 +1,114 @@
+// Package cmd provides CLI commands for the gt tool.
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var townGCDryRun bool
+
+var townGCCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Reclaim closed wisp mail, orphaned agent beads, and stale handoff content",
+	Long: `Sweep town-level beads for accumulated garbage:
+
+- Closed wisp messages older than the retention period are deleted
+- Closed polecat agent beads for polecats that no longer exist are pruned
+- Handoff bead content untouched past the retention period is cleared
+
+Retention periods come from settings/config.json (the "gc" section), with
+safe defaults if unset. Use --dry-run to preview without making changes.
+
+Examples:
+  gt town gc
+  gt town gc --dry-run`,
+	RunE: runTownGC,
+}
+
+func init() {
+	townCmd.AddCommand(townGCCmd)
+	townGCCmd.Flags().BoolVar(&townGCDryRun, "dry-run", false, "Show what would be reclaimed without making changes")
+}
+
+func runTownGC(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		settings = config.NewTownSettings()
+	}
+	gcSettings := settings.GC.WithDefaults()
+
+	activePolecats, err := listActivePolecats(townRoot)
+	if err != nil {
+		return fmt.Errorf("listing active polecats: %w", err)
+	}
+
+	bd := beads.New(townRoot)
+	result, err := bd.GC(beads.GCConfig{
+		WispRetention:    time.Duration(gcSettings.WispRetentionDays) * 24 * time.Hour,
+		HandoffRetention: time.Duration(gcSettings.HandoffRetentionDays) * 24 * time.Hour,
+		ActivePolecats:   activePolecats,
+		DryRun:           townGCDryRun,
+	})
+	if err != nil {
+		return fmt.Errorf("running gc: %w", err)
+	}
+
+	verb := "Reclaimed"
+	if townGCDryRun {
+		verb = "Would reclaim"
+	}
+	fmt.Printf("%s %s:\n", style.Success.Render("✓"), verb)
+	fmt.Printf("  Wisp messages:   %d\n", result.WispsDeleted)
+	fmt.Printf("  Agent beads:     %d\n", result.AgentBeadsPruned)
+	fmt.Printf("  Handoff content: %d\n", result.HandoffsCleared)
+
+	return nil
+}
+
+// listActivePolecats returns the "rig/name" address of every polecat
+// currently registered in any rig, for use as beads.GCConfig.ActivePolecats.
+func listActivePolecats(townRoot string) (map[string]bool, error) {
+	active := make(map[string]bool)
+
+	rigsPath := filepath.Join(townRoot, "mayor", "rigs.json")
+	rigsConfig, err := config.LoadRigsConfig(rigsPath)
+	if err != nil {
+		return active, nil // no rigs registered yet
+	}
+
+	g := git.NewGit(townRoot)
+	rigMgr := rig.NewManager(townRoot, rigsConfig, g)
+
+	for rigName := range rigsConfig.Rigs {
+		r, err := rigMgr.GetRig(rigName)
+		if err != nil {
+			continue
+		}
+		polecatMgr := polecat.NewManager(r, git.NewGit(r.Path), nil) // nil tmux: just listing
+		polecats, err := polecatMgr.List()
+		if err != nil {
+			continue
+		}
+		for _, p := range polecats {
+			active[rigName+"/"+p.Name] = true
+		}
+	}
+
+	return active, nil
+}
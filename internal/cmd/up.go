@@ -233,9 +233,9 @@ func printStatus(name string, ok bool, detail string) {
 		return
 	}
 	if ok {
-		fmt.Printf("%s %s: %s\n", style.SuccessPrefix, name, style.Dim.Render(detail))
+		fmt.Printf("%s %s: %s\n", style.SuccessPrefix(), name, style.Dim.Render(detail))
 	} else {
-		fmt.Printf("%s %s: %s\n", style.ErrorPrefix, name, detail)
+		fmt.Printf("%s %s: %s\n", style.ErrorPrefix(), name, detail)
 	}
 }
 
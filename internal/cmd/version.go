@@ -1,8 +1,11 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 	"os/exec"
+	"runtime"
 	"runtime/debug"
 	"strings"
 
@@ -18,8 +21,27 @@ var (
 	// Commit and Branch - the git revision the binary was built from (optional ldflag)
 	Commit = ""
 	Branch = ""
+	// BuildTime is the UTC build timestamp (RFC3339), set via ldflags.
+	BuildTime = ""
 )
 
+var versionJSON bool
+
+// versionInfo is the JSON shape for `gt version --json`.
+type versionInfo struct {
+	Version   string `json:"version"`
+	Build     string `json:"build"`
+	Commit    string `json:"commit,omitempty"`
+	Branch    string `json:"branch,omitempty"`
+	BuildTime string `json:"build_time,omitempty"`
+	GoVersion string `json:"go_version"`
+	BD        struct {
+		Version    string `json:"version,omitempty"`
+		Compatible bool   `json:"compatible"`
+		Warning    string `json:"warning,omitempty"`
+	} `json:"bd"`
+}
+
 var versionCmd = &cobra.Command{
 	Use:     "version",
 	GroupID: GroupDiag,
@@ -27,6 +49,26 @@ var versionCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		commit := resolveCommitHash()
 		branch := resolveBranch()
+		bd := version.CheckBDCompatibility()
+
+		if versionJSON {
+			info := versionInfo{
+				Version:   Version,
+				Build:     Build,
+				Commit:    commit,
+				Branch:    branch,
+				BuildTime: BuildTime,
+				GoVersion: runtime.Version(),
+			}
+			info.BD.Version = bd.Version
+			info.BD.Compatible = bd.Compatible
+			info.BD.Warning = bd.Warning
+
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			_ = enc.Encode(info)
+			return
+		}
 
 		if commit != "" && branch != "" {
 			fmt.Printf("gt version %s (%s: %s@%s)\n", Version, Build, branch, version.ShortCommit(commit))
@@ -35,10 +77,19 @@ var versionCmd = &cobra.Command{
 		} else {
 			fmt.Printf("gt version %s (%s)\n", Version, Build)
 		}
+		if BuildTime != "" {
+			fmt.Printf("built %s with %s\n", BuildTime, runtime.Version())
+		}
+		if bd.Warning != "" {
+			fmt.Printf("warning: %s\n", bd.Warning)
+		} else if bd.Version != "" {
+			fmt.Printf("bd version %s\n", bd.Version)
+		}
 	},
 }
 
 func init() {
+	versionCmd.Flags().BoolVar(&versionJSON, "json", false, "Output as JSON")
 	rootCmd.AddCommand(versionCmd)
 
 	// Pass the build-time commit to the version package for stale binary checks
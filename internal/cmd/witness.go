@@ -5,8 +5,14 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/refinery"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/witness"
@@ -19,6 +25,16 @@ var (
 	witnessStatusJSON    bool
 	witnessAgentOverride string
 	witnessEnvOverrides  []string
+	witnessPatrolJSON    bool
+	witnessPatrolNotify  bool
+	witnessPatrolRecover bool
+	witnessPatrolSyncMRs bool
+	witnessRecoverJSON   bool
+	witnessReviewApprove bool
+	witnessReviewReject  bool
+	witnessReviewReason  string
+	witnessSyncMRsJSON   bool
+	witnessSyncMRsDryRun bool
 )
 
 var witnessCmd = &cobra.Command{
@@ -78,9 +94,13 @@ Gracefully stops the witness monitoring agent.`,
 var witnessStatusCmd = &cobra.Command{
 	Use:   "status <rig>",
 	Short: "Show witness status",
-	Long: `Show the status of a rig's Witness.
+	Long: `Show a combined health report for a rig's Witness: tmux session state,
+monitored polecats, the witness agent bead's last heartbeat, and whether
+the Deacon's restart ledger has quarantined it.
 
-Displays running state, monitored polecats, and statistics.`,
+Exits non-zero if the report finds a problem (session not running, no
+recent heartbeat, or quarantined), so this is safe to wire into a cron
+health check.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runWitnessStatus,
 }
@@ -119,6 +139,95 @@ Examples:
 	RunE: runWitnessRestart,
 }
 
+var witnessPatrolCmd = &cobra.Command{
+	Use:   "patrol <rig>",
+	Short: "Run the mechanical patrol checks for a rig",
+	Long: `Run the witness's mechanical (non-LLM) patrol checks for a rig.
+
+Checks orphaned in_progress issues, dead sessions holding hooked work,
+idle polecats sitting on unread task mail, and a stopped refinery with a
+non-empty queue. This is the cheap Go-native subset of what the witness
+agent does on patrol; it does not replace judgment calls the witness
+makes as a Claude session.
+
+Pass --recover to also run session recovery (see 'gt witness recover') for
+any dead-session finding, restarting or escalating each one it touches.
+
+Pass --sync-mrs to also run 'gt witness sync-mrs' for this rig, reconciling
+pushed polecat branches with MR beads.
+
+Examples:
+  gt witness patrol greenplace
+  gt witness patrol greenplace --json
+  gt witness patrol greenplace --notify
+  gt witness patrol greenplace --recover
+  gt witness patrol greenplace --sync-mrs`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWitnessPatrol,
+}
+
+var witnessRecoverCmd = &cobra.Command{
+	Use:   "recover <rig>",
+	Short: "Restart polecats whose session died mid-molecule",
+	Long: `Find polecats whose agent bead still says working/running but whose
+tmux session is gone, and recover them.
+
+For each one found: if the polecat's worktree has no uncommitted work, the
+session is restarted so its SessionStart hook and hook wisp resume the
+interrupted molecule. Otherwise (or once recovery attempts are exhausted)
+the mayor is mailed the uncommitted-work details and the agent bead is
+marked needs_attention instead of restarting.
+
+This is the same mechanical check patrol's dead-session finding surfaces;
+recover is the routine that actually acts on it.
+
+Examples:
+  gt witness recover greenplace
+  gt witness recover greenplace --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWitnessRecover,
+}
+
+var witnessReviewCmd = &cobra.Command{
+	Use:   "review <mr-id>",
+	Short: "Approve or request changes on a merge request",
+	Long: `Record a witness review decision on a merge request bead.
+
+When a rig's merge_queue.require_review setting is on, the Refinery won't
+merge an MR until this records an approval. Approving sets reviewed_by on
+the bead; requesting changes clears it (blocking merge again) and mails the
+worker the reason.
+
+The rig is inferred from the current directory.
+
+Examples:
+  gt witness review gt-mr-abc123 --approve
+  gt witness review gt-mr-abc123 --reject -m "missing test evidence"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWitnessReview,
+}
+
+var witnessSyncMRsCmd = &cobra.Command{
+	Use:   "sync-mrs <rig>",
+	Short: "Reconcile pushed polecat branches with MR beads",
+	Long: `Reconcile pushed origin/polecat/* branches with merge-request beads.
+
+For each branch, the source issue is matched from the issue ID embedded in
+the branch name or, failing that, the branch's worker's hooked issue. A
+matched branch gets an MR bead created (or refreshed, if one already
+exists) with full MRFields - branch, target, source_issue, worker, rig -
+so the refinery never has to process an MR with no source issue attached.
+Branches that can't be matched are reported as orphans and mailed to the
+mayor.
+
+Examples:
+  gt witness sync-mrs greenplace
+  gt witness sync-mrs greenplace --dry-run
+  gt witness sync-mrs greenplace --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWitnessSyncMRs,
+}
+
 func init() {
 	// Start flags
 	witnessStartCmd.Flags().BoolVar(&witnessForeground, "foreground", false, "Run in foreground (default: background)")
@@ -132,16 +241,207 @@ func init() {
 	witnessRestartCmd.Flags().StringVar(&witnessAgentOverride, "agent", "", "Agent alias to run the Witness with (overrides town default)")
 	witnessRestartCmd.Flags().StringArrayVar(&witnessEnvOverrides, "env", nil, "Environment variable override (KEY=VALUE, can be repeated)")
 
+	// Patrol flags
+	witnessPatrolCmd.Flags().BoolVar(&witnessPatrolJSON, "json", false, "Output as JSON")
+	witnessPatrolCmd.Flags().BoolVar(&witnessPatrolNotify, "notify", false, "Mail the summary to witness/mayor if findings were produced")
+	witnessPatrolCmd.Flags().BoolVar(&witnessPatrolRecover, "recover", false, "Also run session recovery for dead-session findings")
+	witnessPatrolCmd.Flags().BoolVar(&witnessPatrolSyncMRs, "sync-mrs", false, "Also reconcile pushed polecat branches with MR beads")
+
+	// Recover flags
+	witnessRecoverCmd.Flags().BoolVar(&witnessRecoverJSON, "json", false, "Output as JSON")
+
+	// Review flags
+	witnessReviewCmd.Flags().BoolVar(&witnessReviewApprove, "approve", false, "Approve the MR (sets reviewed_by)")
+	witnessReviewCmd.Flags().BoolVar(&witnessReviewReject, "reject", false, "Request changes on the MR (clears reviewed_by, notifies worker)")
+	witnessReviewCmd.Flags().StringVarP(&witnessReviewReason, "message", "m", "", "Reason for requesting changes (required with --reject)")
+
+	// Sync-mrs flags
+	witnessSyncMRsCmd.Flags().BoolVar(&witnessSyncMRsJSON, "json", false, "Output as JSON")
+	witnessSyncMRsCmd.Flags().BoolVar(&witnessSyncMRsDryRun, "dry-run", false, "Report what would change without creating or updating beads")
+
 	// Add subcommands
 	witnessCmd.AddCommand(witnessStartCmd)
 	witnessCmd.AddCommand(witnessStopCmd)
 	witnessCmd.AddCommand(witnessRestartCmd)
 	witnessCmd.AddCommand(witnessStatusCmd)
 	witnessCmd.AddCommand(witnessAttachCmd)
+	witnessCmd.AddCommand(witnessPatrolCmd)
+	witnessCmd.AddCommand(witnessRecoverCmd)
+	witnessCmd.AddCommand(witnessReviewCmd)
+	witnessCmd.AddCommand(witnessSyncMRsCmd)
 
 	rootCmd.AddCommand(witnessCmd)
 }
 
+// refineryQueueSource adapts *refinery.Manager to witness.QueueSource.
+// witness can't import refinery directly (refinery -> protocol -> witness
+// would cycle), so the adapter lives here where both packages are visible.
+type refineryQueueSource struct {
+	mgr *refinery.Manager
+}
+
+func (s refineryQueueSource) IsRunning() (bool, error) { return s.mgr.IsRunning() }
+func (s refineryQueueSource) QueueLen() (int, error)   { return s.mgr.QueueLen() }
+
+func runWitnessPatrol(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
+
+	_, r, err := getRig(rigName)
+	if err != nil {
+		return err
+	}
+
+	var stallThreshold time.Duration
+	settingsPath := filepath.Join(r.Path, "settings", "config.json")
+	if settings, err := config.LoadRigSettings(settingsPath); err == nil {
+		stallThreshold = settings.GetStallThreshold()
+	}
+
+	report, err := witness.Patrol(r, witness.PatrolOptions{
+		Notify:         witnessPatrolNotify,
+		AutoRecover:    witnessPatrolRecover,
+		SyncMRs:        witnessPatrolSyncMRs,
+		Queue:          refineryQueueSource{mgr: refinery.NewManager(r)},
+		StallThreshold: stallThreshold,
+	})
+	if err != nil {
+		return fmt.Errorf("running patrol: %w", err)
+	}
+
+	if witnessPatrolJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+
+	fmt.Print(report.Summary())
+	if !report.Clean() && witnessPatrolNotify {
+		fmt.Printf("%s\n", style.Dim.Render("mailed summary to witness/mayor"))
+	}
+	return nil
+}
+
+func runWitnessSyncMRs(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
+
+	_, r, err := getRig(rigName)
+	if err != nil {
+		return err
+	}
+
+	report, err := witness.SyncMRs(r, witness.SyncMRsOptions{
+		DryRun: witnessSyncMRsDryRun,
+	})
+	if err != nil {
+		return fmt.Errorf("syncing MRs: %w", err)
+	}
+
+	if witnessSyncMRsJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+
+	fmt.Print(report.Summary())
+	if orphans := report.Orphans(); len(orphans) > 0 && !witnessSyncMRsDryRun {
+		fmt.Printf("%s\n", style.Dim.Render("mailed orphan branches to mayor"))
+	}
+	return nil
+}
+
+func runWitnessRecover(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
+
+	_, r, err := getRig(rigName)
+	if err != nil {
+		return err
+	}
+
+	report, err := witness.Recover(r, witness.RecoverOptions{})
+	if err != nil {
+		return fmt.Errorf("running recovery: %w", err)
+	}
+
+	if witnessRecoverJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+
+	fmt.Print(report.Summary())
+	return nil
+}
+
+func runWitnessReview(cmd *cobra.Command, args []string) error {
+	mrID := args[0]
+
+	if witnessReviewApprove == witnessReviewReject {
+		return fmt.Errorf("exactly one of --approve or --reject is required")
+	}
+	if witnessReviewReject && witnessReviewReason == "" {
+		return fmt.Errorf("--reject requires -m/--message with a reason")
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	rigName, err := inferRigFromCwd(townRoot)
+	if err != nil {
+		return fmt.Errorf("could not determine rig: %w", err)
+	}
+
+	_, r, err := getRig(rigName)
+	if err != nil {
+		return err
+	}
+
+	bd := beads.New(r.BeadsPath())
+	reviewer := fmt.Sprintf("%s/witness", rigName)
+
+	if witnessReviewApprove {
+		issue, err := bd.ApproveMRReview(mrID, reviewer)
+		if err != nil {
+			return fmt.Errorf("recording approval: %w", err)
+		}
+		fmt.Printf("%s Approved: %s\n", style.Bold.Render("✓"), issue.ID)
+		fmt.Printf("  %s\n", style.Dim.Render("Refinery may now merge this MR"))
+		return nil
+	}
+
+	issue, err := bd.RequestMRChanges(mrID, reviewer, witnessReviewReason)
+	if err != nil {
+		return fmt.Errorf("recording review rejection: %w", err)
+	}
+
+	fmt.Printf("%s Changes requested: %s\n", style.Bold.Render("✗"), issue.ID)
+	fmt.Printf("  Reason: %s\n", witnessReviewReason)
+
+	fields := beads.ParseMRFields(issue)
+	if fields != nil && fields.Worker != "" {
+		router := mail.NewRouter(r.Path)
+		msg := &mail.Message{
+			From:    reviewer,
+			To:      fmt.Sprintf("%s/%s", rigName, fields.Worker),
+			Subject: "Review: changes requested",
+			Body: fmt.Sprintf(`Your merge request %s (%s) needs changes before it can be merged.
+
+Reason: %s
+
+Push updates to the same branch and it will be picked up for re-review.`,
+				issue.ID, fields.Branch, witnessReviewReason),
+			Priority: mail.PriorityHigh,
+		}
+		if err := router.Send(msg); err != nil {
+			fmt.Printf("  %s\n", style.Dim.Render(fmt.Sprintf("warning: failed to notify worker: %v", err)))
+		} else {
+			fmt.Printf("  %s\n", style.Dim.Render("Worker notified via mail"))
+		}
+	}
+
+	return nil
+}
+
 // getWitnessManager creates a witness manager for a rig.
 func getWitnessManager(rigName string) (*witness.Manager, error) {
 	_, r, err := getRig(rigName)
@@ -221,15 +521,21 @@ func runWitnessStop(cmd *cobra.Command, args []string) error {
 
 // WitnessStatusOutput is the JSON output format for witness status.
 type WitnessStatusOutput struct {
-	Running           bool     `json:"running"`
-	RigName           string   `json:"rig_name"`
-	Session           string   `json:"session,omitempty"`
-	MonitoredPolecats []string `json:"monitored_polecats,omitempty"`
+	Running           bool                  `json:"running"`
+	RigName           string                `json:"rig_name"`
+	Session           string                `json:"session,omitempty"`
+	MonitoredPolecats []string              `json:"monitored_polecats,omitempty"`
+	Health            *witness.HealthReport `json:"health"`
 }
 
 func runWitnessStatus(cmd *cobra.Command, args []string) error {
 	rigName := args[0]
 
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
 	// Get rig for polecat info
 	_, r, err := getRig(rigName)
 	if err != nil {
@@ -245,19 +551,29 @@ func runWitnessStatus(cmd *cobra.Command, args []string) error {
 	// Polecats come from rig config, not state file
 	polecats := r.Polecats
 
+	health, err := witness.CheckHealth(r, townRoot)
+	if err != nil {
+		return fmt.Errorf("checking witness health: %w", err)
+	}
+
 	// JSON output
 	if witnessStatusJSON {
 		output := WitnessStatusOutput{
 			Running:           running,
 			RigName:           rigName,
 			MonitoredPolecats: polecats,
+			Health:            health,
 		}
 		if sessionInfo != nil {
 			output.Session = sessionInfo.Name
 		}
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(output)
+		if err := outputJSON(output); err != nil {
+			return err
+		}
+		if !health.Healthy() {
+			os.Exit(1)
+		}
+		return nil
 	}
 
 	// Human-readable output
@@ -282,6 +598,18 @@ func runWitnessStatus(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	fmt.Printf("\n  %s\n", style.Bold.Render("Health:"))
+	for _, line := range health.Lines {
+		marker := style.Bold.Render("✓ OK")
+		if !line.OK {
+			marker = style.Bold.Render("⚠ WARN")
+		}
+		fmt.Printf("    %s %s\n", marker, line.Message)
+	}
+
+	if !health.Healthy() {
+		os.Exit(1)
+	}
 	return nil
 }
 
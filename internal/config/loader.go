@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/util"
 )
 
 var (
@@ -26,6 +27,10 @@ var (
 
 	// ErrMissingField indicates a required field is missing.
 	ErrMissingField = errors.New("missing required field")
+
+	// ErrUnknownTunable indicates a `gt config get/set` key that isn't in
+	// townTunableRegistry.
+	ErrUnknownTunable = errors.New("unknown config key")
 )
 
 // LoadTownConfig loads and validates a town configuration file.
@@ -72,30 +77,53 @@ func SaveTownConfig(path string, config *TownConfig) error {
 	return nil
 }
 
-// LoadRigsConfig loads and validates a rigs registry file.
+// LoadRigsConfig loads and validates a rigs registry file. Non-fatal
+// Problems (e.g. a migrated legacy field) are discarded; use
+// LoadRigsConfigWithProblems to see them, as gt doctor does.
 func LoadRigsConfig(path string) (*RigsConfig, error) {
+	config, _, err := LoadRigsConfigWithProblems(path)
+	return config, err
+}
+
+// LoadRigsConfigWithProblems loads a rigs registry file with strict
+// decoding, migrating older schema shapes forward and returning any
+// non-fatal Problems found along the way (from decoding or from
+// Validate). Error-severity problems fail the load.
+func LoadRigsConfigWithProblems(path string) (*RigsConfig, []Problem, error) {
 	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally, not from user input
 	if err != nil {
 		if os.IsNotExist(err) {
-			return nil, fmt.Errorf("%w: %s", ErrNotFound, path)
+			return nil, nil, fmt.Errorf("%w: %s", ErrNotFound, path)
 		}
-		return nil, fmt.Errorf("reading config: %w", err)
+		return nil, nil, fmt.Errorf("reading config: %w", err)
 	}
 
-	var config RigsConfig
-	if err := json.Unmarshal(data, &config); err != nil {
-		return nil, fmt.Errorf("parsing config: %w", err)
+	config, problems, err := decodeRigsConfig(data)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	if err := validateRigsConfig(&config); err != nil {
-		return nil, err
+	if err := validateRigsConfig(config); err != nil {
+		return nil, problems, err
 	}
 
-	return &config, nil
+	// Validate's problems (even error-severity ones, e.g. a rig entry
+	// missing git_url) don't fail the load - they're the kind of thing a
+	// rig can be mid-setup with. gt doctor surfaces them; callers that
+	// just want a best-effort config (like GetRigPrefix) keep working.
+	problems = append(problems, Validate(config)...)
+
+	return config, problems, nil
 }
 
-// SaveRigsConfig saves a rigs registry to a file.
+// SaveRigsConfig saves a rigs registry to a file atomically (write-temp,
+// then rename), so a crash mid-write can't leave a truncated rigs.json
+// behind. The config is always written at CurrentRigsVersion; map keys
+// (rig names) come out in sorted order because encoding/json sorts map
+// keys, keeping diffs clean.
 func SaveRigsConfig(path string, config *RigsConfig) error {
+	config.Version = CurrentRigsVersion
+
 	if err := validateRigsConfig(config); err != nil {
 		return err
 	}
@@ -109,7 +137,7 @@ func SaveRigsConfig(path string, config *RigsConfig) error {
 		return fmt.Errorf("encoding config: %w", err)
 	}
 
-	if err := os.WriteFile(path, data, 0600); err != nil {
+	if err := util.AtomicWriteFile(path, data, 0600); err != nil {
 		return fmt.Errorf("writing config: %w", err)
 	}
 
@@ -212,6 +240,16 @@ func validateRigSettings(c *RigSettings) error {
 			return err
 		}
 	}
+	if c.IdlePolecatTimeout != "" {
+		if _, err := time.ParseDuration(c.IdlePolecatTimeout); err != nil {
+			return fmt.Errorf("invalid idle_polecat_timeout: %w", err)
+		}
+	}
+	if c.StallThreshold != "" {
+		if _, err := time.ParseDuration(c.StallThreshold); err != nil {
+			return fmt.Errorf("invalid stall_threshold: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -240,6 +278,9 @@ func validateMergeQueueConfig(c *MergeQueueConfig) error {
 	if c.MaxConcurrent < 0 {
 		return fmt.Errorf("%w: max_concurrent must be non-negative", ErrMissingField)
 	}
+	if c.RejectionExpiryDays < 0 {
+		return fmt.Errorf("%w: rejection_expiry_days must be non-negative", ErrMissingField)
+	}
 
 	return nil
 }
@@ -578,6 +619,85 @@ func ResolveAccountConfigDir(accountsPath, accountFlag string) (configDir, handl
 	return "", "", nil
 }
 
+// ResolveAccountForSpawn resolves the CLAUDE_CONFIG_DIR and account handle
+// for a new agent session, the same way ResolveAccountConfigDir does for
+// explicit GT_ACCOUNT/--account/default resolution, but when none of those
+// apply and more than one account is configured, it falls back to the
+// configured Strategy (round_robin or least_used) instead of always using
+// the default account - so one account doesn't absorb all spawn load while
+// others idle. sessionCounts is the current active-session count per
+// account handle (see beads.CountSessionsByAccount); pass nil if unknown,
+// which is equivalent to every account being idle.
+func ResolveAccountForSpawn(accountsPath, accountFlag string, sessionCounts map[string]int) (configDir, handle string, err error) {
+	cfg, loadErr := LoadAccountsConfig(accountsPath)
+	if loadErr != nil {
+		return "", "", nil
+	}
+
+	if envAccount := os.Getenv("GT_ACCOUNT"); envAccount != "" {
+		acct := cfg.GetAccount(envAccount)
+		if acct == nil {
+			return "", "", fmt.Errorf("GT_ACCOUNT '%s' not found in accounts config", envAccount)
+		}
+		return expandPath(acct.ConfigDir), envAccount, nil
+	}
+
+	if accountFlag != "" {
+		acct := cfg.GetAccount(accountFlag)
+		if acct == nil {
+			return "", "", fmt.Errorf("account '%s' not found in accounts config", accountFlag)
+		}
+		return expandPath(acct.ConfigDir), accountFlag, nil
+	}
+
+	if picked := cfg.pickAccountByStrategy(sessionCounts); picked != "" {
+		return expandPath(cfg.Accounts[picked].ConfigDir), picked, nil
+	}
+
+	if cfg.Default != "" {
+		acct := cfg.GetDefaultAccount()
+		if acct != nil {
+			return expandPath(acct.ConfigDir), cfg.Default, nil
+		}
+	}
+
+	return "", "", nil
+}
+
+// pickAccountByStrategy chooses an account handle per cfg.Strategy when more
+// than one account is configured. Returns "" when no strategy is set or
+// only one account exists, so callers fall back to the default account.
+func (c *AccountsConfig) pickAccountByStrategy(sessionCounts map[string]int) string {
+	if len(c.Accounts) < 2 {
+		return ""
+	}
+
+	handles := make([]string, 0, len(c.Accounts))
+	for h := range c.Accounts {
+		handles = append(handles, h)
+	}
+	sort.Strings(handles)
+
+	switch c.Strategy {
+	case AccountStrategyLeastUsed:
+		best := handles[0]
+		for _, h := range handles[1:] {
+			if sessionCounts[h] < sessionCounts[best] {
+				best = h
+			}
+		}
+		return best
+	case AccountStrategyRoundRobin:
+		total := 0
+		for _, h := range handles {
+			total += sessionCounts[h]
+		}
+		return handles[total%len(handles)]
+	default:
+		return ""
+	}
+}
+
 // expandPath expands ~ to home directory.
 func expandPath(path string) string {
 	if strings.HasPrefix(path, "~/") {
@@ -741,22 +861,44 @@ func RigSettingsPath(rigPath string) string {
 }
 
 // LoadOrCreateTownSettings loads town settings or creates defaults if missing.
+// Any tunable set via TownSettings.Defaults can be overridden at runtime with
+// a GT_SETTING_<KEY> environment variable (e.g. GT_SETTING_STALL_THRESHOLD),
+// without editing the settings file - see townTunableRegistry.
 func LoadOrCreateTownSettings(path string) (*TownSettings, error) {
 	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally
 	if err != nil {
-		if os.IsNotExist(err) {
-			return NewTownSettings(), nil
+		if !os.IsNotExist(err) {
+			return nil, err
 		}
-		return nil, err
+		settings := NewTownSettings()
+		applyTownSettingsEnvOverrides(settings)
+		return settings, nil
 	}
 
 	var settings TownSettings
 	if err := json.Unmarshal(data, &settings); err != nil {
 		return nil, err
 	}
+	applyTownSettingsEnvOverrides(&settings)
 	return &settings, nil
 }
 
+// applyTownSettingsEnvOverrides overrides settings.Defaults fields from
+// GT_SETTING_<KEY> environment variables, taking precedence over whatever
+// was loaded from disk.
+func applyTownSettingsEnvOverrides(settings *TownSettings) {
+	for _, t := range townTunableRegistry {
+		v, ok := os.LookupEnv("GT_SETTING_" + strings.ToUpper(t.key))
+		if !ok {
+			continue
+		}
+		if settings.Defaults == nil {
+			settings.Defaults = &TownTunables{}
+		}
+		t.set(settings.Defaults, v)
+	}
+}
+
 // SaveTownSettings saves town settings to a file.
 func SaveTownSettings(path string, settings *TownSettings) error {
 	if settings.Type != "town-settings" && settings.Type != "" {
@@ -1637,6 +1779,34 @@ func validateEscalationConfig(c *EscalationConfig) error {
 	return nil
 }
 
+// GetIdlePolecatTimeout returns the configured idle_polecat_timeout as a
+// time.Duration, or 0 if unset or invalid (callers should treat 0 as "no
+// default configured" rather than "never time out").
+func (c *RigSettings) GetIdlePolecatTimeout() time.Duration {
+	if c.IdlePolecatTimeout == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(c.IdlePolecatTimeout)
+	if err != nil {
+		return 0
+	}
+	return d
+}
+
+// GetStallThreshold returns the configured stall_threshold as a
+// time.Duration, or 0 if unset or invalid (callers should treat 0 as "no
+// override configured" and fall back to their own package default).
+func (c *RigSettings) GetStallThreshold() time.Duration {
+	if c.StallThreshold == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(c.StallThreshold)
+	if err != nil {
+		return 0
+	}
+	return d
+}
+
 // GetStaleThreshold returns the stale threshold as a time.Duration.
 // Returns 4 hours if not configured or invalid.
 func (c *EscalationConfig) GetStaleThreshold() time.Duration {
@@ -341,6 +341,24 @@ func TestRigSettingsValidation(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid idle_polecat_timeout",
+			settings: &RigSettings{
+				Type:               "rig-settings",
+				Version:            1,
+				IdlePolecatTimeout: "not-a-duration",
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid idle_polecat_timeout",
+			settings: &RigSettings{
+				Type:               "rig-settings",
+				Version:            1,
+				IdlePolecatTimeout: "2h",
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -353,6 +371,28 @@ func TestRigSettingsValidation(t *testing.T) {
 	}
 }
 
+func TestGetIdlePolecatTimeout(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		timeout string
+		want    time.Duration
+	}{
+		{"unset", "", 0},
+		{"valid", "2h", 2 * time.Hour},
+		{"invalid", "not-a-duration", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &RigSettings{IdlePolecatTimeout: tt.timeout}
+			if got := c.GetIdlePolecatTimeout(); got != tt.want {
+				t.Errorf("GetIdlePolecatTimeout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestDefaultMergeQueueConfig(t *testing.T) {
 	t.Parallel()
 	cfg := DefaultMergeQueueConfig()
@@ -499,6 +539,120 @@ func TestAccountsConfigRoundTrip(t *testing.T) {
 	}
 }
 
+func TestResolveAccountForSpawn_RoundRobinDistributesAcrossAccounts(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "mayor", "accounts.json")
+
+	cfg := NewAccountsConfig()
+	for _, handle := range []string{"a", "b", "c"} {
+		cfg.Accounts[handle] = Account{ConfigDir: "~/.claude-accounts/" + handle}
+	}
+	cfg.Default = "a"
+	cfg.Strategy = AccountStrategyRoundRobin
+	if err := SaveAccountsConfig(path, cfg); err != nil {
+		t.Fatalf("SaveAccountsConfig: %v", err)
+	}
+
+	counts := map[string]int{}
+	picks := make([]string, 5)
+	for i := 0; i < 5; i++ {
+		_, handle, err := ResolveAccountForSpawn(path, "", counts)
+		if err != nil {
+			t.Fatalf("ResolveAccountForSpawn() error = %v", err)
+		}
+		picks[i] = handle
+		counts[handle]++
+	}
+
+	// Every account should get at least one of the five spawns.
+	for _, handle := range []string{"a", "b", "c"} {
+		if counts[handle] == 0 {
+			t.Errorf("account %q got 0 of 5 spawns, want spread across all accounts: %v", handle, picks)
+		}
+	}
+	if max := 0; true {
+		for _, n := range counts {
+			if n > max {
+				max = n
+			}
+		}
+		if max > 2 {
+			t.Errorf("account received %d of 5 spawns, distribution too uneven: %v", max, counts)
+		}
+	}
+}
+
+func TestResolveAccountForSpawn_LeastUsedPicksLightestAccount(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "mayor", "accounts.json")
+
+	cfg := NewAccountsConfig()
+	for _, handle := range []string{"a", "b", "c"} {
+		cfg.Accounts[handle] = Account{ConfigDir: "~/.claude-accounts/" + handle}
+	}
+	cfg.Default = "a"
+	cfg.Strategy = AccountStrategyLeastUsed
+	if err := SaveAccountsConfig(path, cfg); err != nil {
+		t.Fatalf("SaveAccountsConfig: %v", err)
+	}
+
+	counts := map[string]int{"a": 5, "b": 1, "c": 3}
+	_, handle, err := ResolveAccountForSpawn(path, "", counts)
+	if err != nil {
+		t.Fatalf("ResolveAccountForSpawn() error = %v", err)
+	}
+	if handle != "b" {
+		t.Errorf("ResolveAccountForSpawn() handle = %q, want %q (fewest active sessions)", handle, "b")
+	}
+}
+
+func TestResolveAccountForSpawn_ExplicitAccountBypassesStrategy(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "mayor", "accounts.json")
+
+	cfg := NewAccountsConfig()
+	cfg.Accounts["a"] = Account{ConfigDir: "~/.claude-accounts/a"}
+	cfg.Accounts["b"] = Account{ConfigDir: "~/.claude-accounts/b"}
+	cfg.Default = "a"
+	cfg.Strategy = AccountStrategyLeastUsed
+	if err := SaveAccountsConfig(path, cfg); err != nil {
+		t.Fatalf("SaveAccountsConfig: %v", err)
+	}
+
+	_, handle, err := ResolveAccountForSpawn(path, "a", map[string]int{"a": 10, "b": 0})
+	if err != nil {
+		t.Fatalf("ResolveAccountForSpawn() error = %v", err)
+	}
+	if handle != "a" {
+		t.Errorf("ResolveAccountForSpawn() handle = %q, want %q (explicit flag wins over strategy)", handle, "a")
+	}
+}
+
+func TestResolveAccountForSpawn_NoStrategyUsesDefault(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "mayor", "accounts.json")
+
+	cfg := NewAccountsConfig()
+	cfg.Accounts["a"] = Account{ConfigDir: "~/.claude-accounts/a"}
+	cfg.Accounts["b"] = Account{ConfigDir: "~/.claude-accounts/b"}
+	cfg.Default = "a"
+	if err := SaveAccountsConfig(path, cfg); err != nil {
+		t.Fatalf("SaveAccountsConfig: %v", err)
+	}
+
+	_, handle, err := ResolveAccountForSpawn(path, "", map[string]int{"a": 10, "b": 0})
+	if err != nil {
+		t.Fatalf("ResolveAccountForSpawn() error = %v", err)
+	}
+	if handle != "a" {
+		t.Errorf("ResolveAccountForSpawn() handle = %q, want %q (no strategy set, falls back to default)", handle, "a")
+	}
+}
+
 func TestAccountsConfigValidation(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -2778,3 +2932,78 @@ func TestBuildStartupCommandWithAgentOverride_NoGTAgentWhenNoOverride(t *testing
 		t.Errorf("expected no GT_AGENT in command when no override, got: %q", cmd)
 	}
 }
+
+func TestTownSettings_TunableRoundTrip(t *testing.T) {
+	t.Parallel()
+	tmpDir := t.TempDir()
+	settingsPath := TownSettingsPath(tmpDir)
+
+	settings, err := LoadOrCreateTownSettings(settingsPath)
+	if err != nil {
+		t.Fatalf("LoadOrCreateTownSettings: %v", err)
+	}
+
+	if err := settings.SetTunable("stall_threshold", "45m"); err != nil {
+		t.Fatalf("SetTunable: %v", err)
+	}
+	if err := SaveTownSettings(settingsPath, settings); err != nil {
+		t.Fatalf("SaveTownSettings: %v", err)
+	}
+
+	reloaded, err := LoadOrCreateTownSettings(settingsPath)
+	if err != nil {
+		t.Fatalf("LoadOrCreateTownSettings (reload): %v", err)
+	}
+	got, err := reloaded.GetTunable("stall_threshold")
+	if err != nil {
+		t.Fatalf("GetTunable: %v", err)
+	}
+	if got != "45m" {
+		t.Errorf("GetTunable(stall_threshold) = %q, want %q", got, "45m")
+	}
+	if want := 45 * time.Minute; reloaded.GetStallThreshold() != want {
+		t.Errorf("GetStallThreshold() = %v, want %v", reloaded.GetStallThreshold(), want)
+	}
+}
+
+func TestTownSettings_SetTunable_UnknownKey(t *testing.T) {
+	t.Parallel()
+	settings := NewTownSettings()
+
+	if err := settings.SetTunable("bogus_key", "5m"); err == nil {
+		t.Fatal("SetTunable(bogus_key) expected error, got nil")
+	}
+	if _, err := settings.GetTunable("bogus_key"); err == nil {
+		t.Fatal("GetTunable(bogus_key) expected error, got nil")
+	}
+}
+
+func TestTownSettings_SetTunable_InvalidDuration(t *testing.T) {
+	t.Parallel()
+	settings := NewTownSettings()
+
+	if err := settings.SetTunable("stall_threshold", "not-a-duration"); err == nil {
+		t.Fatal("SetTunable(stall_threshold, not-a-duration) expected error, got nil")
+	}
+}
+
+func TestTownSettings_EnvOverrideTakesPrecedence(t *testing.T) {
+	tmpDir := t.TempDir()
+	settingsPath := TownSettingsPath(tmpDir)
+
+	settings := NewTownSettings()
+	settings.Defaults = &TownTunables{StallThreshold: "2h"}
+	if err := SaveTownSettings(settingsPath, settings); err != nil {
+		t.Fatalf("SaveTownSettings: %v", err)
+	}
+
+	t.Setenv("GT_SETTING_STALL_THRESHOLD", "10m")
+
+	reloaded, err := LoadOrCreateTownSettings(settingsPath)
+	if err != nil {
+		t.Fatalf("LoadOrCreateTownSettings: %v", err)
+	}
+	if want := 10 * time.Minute; reloaded.GetStallThreshold() != want {
+		t.Errorf("GetStallThreshold() = %v, want %v (env override)", reloaded.GetStallThreshold(), want)
+	}
+}
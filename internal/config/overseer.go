@@ -12,12 +12,25 @@ import (
 // OverseerConfig represents the human operator's identity (mayor/overseer.json).
 // The overseer is the human who controls Gas Town, distinct from AI agents.
 type OverseerConfig struct {
-	Type     string `json:"type"`               // "overseer"
-	Version  int    `json:"version"`            // schema version
-	Name     string `json:"name"`               // display name
-	Email    string `json:"email,omitempty"`    // email address
-	Username string `json:"username,omitempty"` // username/handle
-	Source   string `json:"source"`             // how identity was detected
+	Type     string               `json:"type"`               // "overseer"
+	Version  int                  `json:"version"`            // schema version
+	Name     string               `json:"name"`               // display name
+	Email    string               `json:"email,omitempty"`    // email address
+	Username string               `json:"username,omitempty"` // username/handle
+	Source   string               `json:"source"`             // how identity was detected
+	Delivery *HumanDeliveryConfig `json:"delivery,omitempty"` // external bridge for mail sent to --human
+}
+
+// HumanDeliveryConfig configures how mail sent to the overseer (--human) is
+// bridged to a channel outside Gas Town, in addition to the overseer's beads
+// mailbox. Exactly one of WebhookURL/SendmailCmd is expected to be set; if
+// WebhookURL is set it takes priority.
+type HumanDeliveryConfig struct {
+	// WebhookURL receives an HTTP POST with a JSON payload for every message.
+	WebhookURL string `json:"webhook_url,omitempty"`
+	// SendmailCmd is a shell command line (e.g. "sendmail -t") that the
+	// message is piped to as plaintext on stdin.
+	SendmailCmd string `json:"sendmail_cmd,omitempty"`
 }
 
 // CurrentOverseerVersion is the current schema version for OverseerConfig.
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gofrs/flock"
+)
+
+// WithRigsConfig performs a locked read-modify-write on the rigs registry
+// at path: it acquires an flock-based lock scoped to path+".lock", loads
+// the current config (starting fresh if the file doesn't exist yet),
+// calls fn to mutate it, and saves the result atomically before
+// releasing the lock. All rig registry mutators (rig add/remove/rename)
+// should go through this rather than calling LoadRigsConfig/
+// SaveRigsConfig directly, so a mayor session and a human running `gt
+// rig` at the same time can't silently clobber each other's writes.
+func WithRigsConfig(path string, fn func(*RigsConfig) error) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating directory: %w", err)
+	}
+
+	lock := flock.New(path + ".lock")
+	if err := lock.Lock(); err != nil {
+		return fmt.Errorf("acquiring rigs.json lock: %w", err)
+	}
+	defer func() { _ = lock.Unlock() }()
+
+	config, warning, err := loadOrRecoverRigsConfig(path)
+	if err != nil {
+		return err
+	}
+	if warning != "" {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", warning)
+	}
+
+	if err := fn(config); err != nil {
+		return err
+	}
+
+	return SaveRigsConfig(path, config)
+}
+
+// loadOrRecoverRigsConfig loads path, returning a fresh empty config if
+// it doesn't exist yet. A file that isn't valid JSON at all (ErrCorrupt -
+// e.g. a torn write from a crash mid-save) is quarantined to
+// <path>.corrupt-<unix-ts> and replaced with a fresh config, rather than
+// silently overwritten on the next save with no record of what was lost.
+// Any other load error (bad version, failed Validate) is returned as-is:
+// those are fixable in place and shouldn't cost the operator their file.
+func loadOrRecoverRigsConfig(path string) (config *RigsConfig, warning string, err error) {
+	config, err = LoadRigsConfig(path)
+	switch {
+	case err == nil:
+		return config, "", nil
+	case errors.Is(err, ErrNotFound):
+		return &RigsConfig{Rigs: make(map[string]RigEntry)}, "", nil
+	case !errors.Is(err, ErrCorrupt):
+		return nil, "", err
+	}
+
+	backupPath := fmt.Sprintf("%s.corrupt-%d", path, time.Now().Unix())
+	if renameErr := os.Rename(path, backupPath); renameErr != nil {
+		return nil, "", fmt.Errorf("loading %s: %w (backup also failed: %v)", path, err, renameErr)
+	}
+	return &RigsConfig{Rigs: make(map[string]RigEntry)}, fmt.Sprintf("%s was corrupt (%v); backed up to %s and starting fresh", path, err, backupPath), nil
+}
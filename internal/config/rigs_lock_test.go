@@ -0,0 +1,84 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestWithRigsConfig_ConcurrentAddsAllSurvive(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rigs.json")
+
+	const n = 10
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = WithRigsConfig(path, func(c *RigsConfig) error {
+				c.Rigs[fmt.Sprintf("rig-%d", i)] = RigEntry{
+					GitURL: fmt.Sprintf("git@example.com:rig-%d.git", i),
+				}
+				return nil
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("WithRigsConfig(%d): %v", i, err)
+		}
+	}
+
+	final, err := LoadRigsConfig(path)
+	if err != nil {
+		t.Fatalf("LoadRigsConfig: %v", err)
+	}
+	if len(final.Rigs) != n {
+		t.Fatalf("Rigs = %d entries, want %d: %+v", len(final.Rigs), n, final.Rigs)
+	}
+	for i := 0; i < n; i++ {
+		name := fmt.Sprintf("rig-%d", i)
+		if _, ok := final.Rigs[name]; !ok {
+			t.Errorf("missing %s", name)
+		}
+	}
+}
+
+func TestWithRigsConfig_FnErrorLeavesFileUntouched(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rigs.json")
+
+	if err := WithRigsConfig(path, func(c *RigsConfig) error {
+		c.Rigs["gastown"] = RigEntry{GitURL: "git@example.com:gastown.git"}
+		return nil
+	}); err != nil {
+		t.Fatalf("seeding WithRigsConfig: %v", err)
+	}
+
+	wantErr := fmt.Errorf("boom")
+	err := WithRigsConfig(path, func(c *RigsConfig) error {
+		c.Rigs["should-not-persist"] = RigEntry{GitURL: "git@example.com:nope.git"}
+		return wantErr
+	})
+	if err != wantErr {
+		t.Fatalf("err = %v, want %v", err, wantErr)
+	}
+
+	final, err := LoadRigsConfig(path)
+	if err != nil {
+		t.Fatalf("LoadRigsConfig: %v", err)
+	}
+	if _, ok := final.Rigs["should-not-persist"]; ok {
+		t.Error("fn's mutation persisted despite returning an error")
+	}
+	if _, ok := final.Rigs["gastown"]; !ok {
+		t.Error("pre-existing rig was lost")
+	}
+}
@@ -0,0 +1,237 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// townBeadsPrefix is the reserved prefix for town-level agent beads (mayor,
+// deacon). It mirrors beads.TownBeadsPrefix, duplicated here rather than
+// imported because internal/beads imports internal/config and importing
+// back would cycle.
+const townBeadsPrefix = "hq"
+
+// ErrCorrupt indicates a rigs.json file that isn't valid JSON at all, as
+// opposed to valid JSON that merely fails Validate. WithRigsConfig treats
+// only this case as corruption worth quarantining a backup for.
+var ErrCorrupt = errors.New("corrupt rigs.json")
+
+// Problem describes something wrong, or merely suspicious, about a loaded
+// RigsConfig. Unlike the hard errors LoadRigsConfig itself returns, a
+// Problem doesn't by itself fail a load - Severity lets callers (gt
+// doctor, LoadRigsConfig) decide what to do with it.
+type Problem struct {
+	Field    string `json:"field"`
+	Message  string `json:"message"`
+	Severity string `json:"severity"`
+}
+
+// Problem severities.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+func (p Problem) String() string {
+	return fmt.Sprintf("[%s] %s: %s", p.Severity, p.Field, p.Message)
+}
+
+// Validate checks a RigsConfig for problems beyond what decoding alone
+// catches: unsupported versions and rig entries missing the fields they
+// need to be usable. It does not mutate c, so it's safe to run against a
+// config that already failed a stricter check - gt doctor uses this to
+// report every problem in one pass instead of stopping at the first one.
+func Validate(c *RigsConfig) []Problem {
+	var problems []Problem
+
+	if c.Version > CurrentRigsVersion {
+		problems = append(problems, Problem{
+			Field:    "version",
+			Message:  fmt.Sprintf("unsupported version %d (max supported %d)", c.Version, CurrentRigsVersion),
+			Severity: SeverityError,
+		})
+	}
+
+	for name, entry := range c.Rigs {
+		field := fmt.Sprintf("rigs.%s", name)
+		if entry.GitURL == "" && entry.LocalRepo == "" {
+			problems = append(problems, Problem{
+				Field:    field,
+				Message:  "neither git_url nor local_repo is set",
+				Severity: SeverityError,
+			})
+		}
+		if entry.BeadsConfig != nil && entry.BeadsConfig.Prefix == "" {
+			problems = append(problems, Problem{
+				Field:    field + ".beads.prefix",
+				Message:  "beads config present but prefix is empty",
+				Severity: SeverityWarning,
+			})
+		}
+	}
+
+	problems = append(problems, validatePrefixCollisions(c)...)
+
+	return problems
+}
+
+// validatePrefixCollisions builds a prefix -> rig names map and reports two
+// kinds of problem: two rigs sharing a prefix (an error - issues created by
+// either route into the same beads database) and a rig sharing the
+// town-level "hq" prefix (a warning - town agent beads would route into the
+// rig's database too).
+func validatePrefixCollisions(c *RigsConfig) []Problem {
+	var problems []Problem
+
+	byPrefix := make(map[string][]string)
+	for name, entry := range c.Rigs {
+		if entry.BeadsConfig == nil || entry.BeadsConfig.Prefix == "" {
+			continue
+		}
+		prefix := strings.TrimSuffix(entry.BeadsConfig.Prefix, "-")
+		byPrefix[prefix] = append(byPrefix[prefix], name)
+	}
+
+	prefixes := make([]string, 0, len(byPrefix))
+	for prefix := range byPrefix {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+
+	for _, prefix := range prefixes {
+		names := byPrefix[prefix]
+		sort.Strings(names)
+
+		if len(names) > 1 {
+			problems = append(problems, Problem{
+				Field:    fmt.Sprintf("rigs.%s.beads.prefix", strings.Join(names, ",")),
+				Message:  fmt.Sprintf("prefix %q is shared by rigs %s; issues created in any of them route into the same beads database", prefix, strings.Join(names, ", ")),
+				Severity: SeverityError,
+			})
+		}
+
+		if prefix == townBeadsPrefix {
+			for _, name := range names {
+				problems = append(problems, Problem{
+					Field:    fmt.Sprintf("rigs.%s.beads.prefix", name),
+					Message:  fmt.Sprintf("prefix %q collides with the town-level beads prefix used by mayor/deacon", prefix),
+					Severity: SeverityWarning,
+				})
+			}
+		}
+	}
+
+	return problems
+}
+
+// CheckPrefixAvailable returns an error if prefix is already used by a rig
+// in c, or collides with the reserved town-level "hq" prefix. gt rig add
+// calls this before any filesystem work, so a colliding prefix fails fast
+// and names both rigs, rather than silently routing issues into the wrong
+// database - a bug that's otherwise only noticed hours later when issues
+// turn up somewhere unexpected.
+func CheckPrefixAvailable(c *RigsConfig, prefix string) error {
+	prefix = strings.TrimSuffix(prefix, "-")
+
+	if prefix == townBeadsPrefix {
+		return fmt.Errorf("prefix %q collides with the town-level beads prefix used by mayor/deacon; choose a different prefix", prefix)
+	}
+
+	for name, entry := range c.Rigs {
+		if entry.BeadsConfig == nil || entry.BeadsConfig.Prefix == "" {
+			continue
+		}
+		if strings.TrimSuffix(entry.BeadsConfig.Prefix, "-") == prefix {
+			return fmt.Errorf("prefix %q is already used by rig %q; choose a different prefix to avoid routing issues into the wrong database", prefix, name)
+		}
+	}
+
+	return nil
+}
+
+// rigEntryV1 mirrors the pre-v2 rig entry shape, from before the beads
+// issue prefix was always nested under "beads": {"prefix": ...}. Some
+// hand-edited rigs.json files set a flat "beads_prefix" field instead
+// (an easy typo to make vs. "beads.prefix") - migrateRigsConfigToV2
+// folds that into the nested field rather than dropping it.
+type rigEntryV1 struct {
+	GitURL        string       `json:"git_url"`
+	LocalRepo     string       `json:"local_repo,omitempty"`
+	AddedAt       time.Time    `json:"added_at"`
+	BeadsConfig   *BeadsConfig `json:"beads,omitempty"`
+	BeadsPrefixV1 string       `json:"beads_prefix,omitempty"`
+}
+
+// rigsConfigV1 is the lenient, pre-v2 shape used as a fallback when
+// strict decoding into RigsConfig rejects an unknown field.
+type rigsConfigV1 struct {
+	Version int                   `json:"version"`
+	Rigs    map[string]rigEntryV1 `json:"rigs"`
+}
+
+// migrateRigsConfigToV2 folds the legacy flat beads_prefix field into
+// beads.prefix and bumps the schema to CurrentRigsVersion.
+func migrateRigsConfigToV2(v1 *rigsConfigV1) (*RigsConfig, []Problem) {
+	var problems []Problem
+	out := &RigsConfig{Version: CurrentRigsVersion, Rigs: make(map[string]RigEntry, len(v1.Rigs))}
+
+	for name, e := range v1.Rigs {
+		entry := RigEntry{GitURL: e.GitURL, LocalRepo: e.LocalRepo, AddedAt: e.AddedAt, BeadsConfig: e.BeadsConfig}
+		if e.BeadsPrefixV1 != "" {
+			if entry.BeadsConfig == nil {
+				entry.BeadsConfig = &BeadsConfig{}
+			}
+			if entry.BeadsConfig.Prefix == "" {
+				entry.BeadsConfig.Prefix = e.BeadsPrefixV1
+			}
+			problems = append(problems, Problem{
+				Field:    fmt.Sprintf("rigs.%s.beads_prefix", name),
+				Message:  "migrated legacy beads_prefix field into beads.prefix",
+				Severity: SeverityWarning,
+			})
+		}
+		out.Rigs[name] = entry
+	}
+
+	return out, problems
+}
+
+// unknownFieldName extracts the offending field name from the error a
+// json.Decoder with DisallowUnknownFields returns, e.g.
+// `json: unknown field "beads_prefix"`.
+func unknownFieldName(err error) (string, bool) {
+	const prefix = "json: unknown field "
+	msg := err.Error()
+	if !strings.HasPrefix(msg, prefix) {
+		return "", false
+	}
+	return strings.Trim(strings.TrimPrefix(msg, prefix), `"`), true
+}
+
+// decodeRigsConfig decodes rigs.json, preferring strict decoding
+// (DisallowUnknownFields) so typos and stale fields are caught. If strict
+// decoding rejects an unknown field, it falls back to the tolerant v1
+// shape and migrates forward, returning the field as a warning Problem
+// rather than failing the whole load.
+func decodeRigsConfig(data []byte) (*RigsConfig, []Problem, error) {
+	var config RigsConfig
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&config); err == nil {
+		return &config, nil, nil
+	} else if _, ok := unknownFieldName(err); !ok {
+		return nil, nil, fmt.Errorf("%w: %v", ErrCorrupt, err)
+	}
+
+	var v1 rigsConfigV1
+	if err := json.Unmarshal(data, &v1); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrCorrupt, err)
+	}
+	migrated, problems := migrateRigsConfigToV2(&v1)
+	return migrated, problems, nil
+}
@@ -38,6 +38,22 @@ type RoleDefinition struct {
 
 	// PromptTemplate is the name of the role's prompt template file.
 	PromptTemplate string `toml:"prompt_template,omitempty"`
+
+	// HookReminder controls the stale-hook nudge in `gt mail check --inject`.
+	HookReminder HookReminderConfig `toml:"hook_reminder,omitempty"`
+}
+
+// HookReminderConfig controls the stale-hook reminder appended to
+// `gt mail check --inject` when a role has a hooked bead but no sign of
+// active work (status change or commit) recently.
+type HookReminderConfig struct {
+	// Disabled opts this role out of the reminder entirely.
+	Disabled bool `toml:"disabled"`
+
+	// StaleAfter is how long a hooked bead can go without a status update
+	// or commit before the reminder fires. Zero means use the caller's
+	// built-in default.
+	StaleAfter Duration `toml:"stale_after"`
 }
 
 // RoleSessionConfig contains session-related configuration.
@@ -254,6 +270,15 @@ func mergeRoleDefinition(base, override *RoleDefinition) {
 	if override.PromptTemplate != "" {
 		base.PromptTemplate = override.PromptTemplate
 	}
+
+	// HookReminder.Disabled can only be turned on via override, not off -
+	// same reasoning as Session.NeedsPreSync above: an opt-out should stick.
+	if override.HookReminder.Disabled {
+		base.HookReminder.Disabled = true
+	}
+	if override.HookReminder.StaleAfter.Duration != 0 {
+		base.HookReminder.StaleAfter = override.HookReminder.StaleAfter
+	}
 }
 
 // ExpandPattern expands placeholders in a pattern string.
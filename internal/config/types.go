@@ -2,6 +2,7 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -22,12 +23,29 @@ type TownConfig struct {
 // MayorConfig represents town-level behavioral configuration (mayor/config.json).
 // This is separate from TownConfig (identity) to keep configuration concerns distinct.
 type MayorConfig struct {
-	Type            string           `json:"type"`                        // "mayor-config"
-	Version         int              `json:"version"`                     // schema version
-	Theme           *TownThemeConfig `json:"theme,omitempty"`             // global theme settings
-	Daemon          *DaemonConfig    `json:"daemon,omitempty"`            // daemon settings
-	Deacon          *DeaconConfig    `json:"deacon,omitempty"`            // deacon settings
-	DefaultCrewName string           `json:"default_crew_name,omitempty"` // default crew name for new rigs
+	Type            string             `json:"type"`                        // "mayor-config"
+	Version         int                `json:"version"`                     // schema version
+	Theme           *TownThemeConfig   `json:"theme,omitempty"`             // global theme settings
+	Daemon          *DaemonConfig      `json:"daemon,omitempty"`            // daemon settings
+	Deacon          *DeaconConfig      `json:"deacon,omitempty"`            // deacon settings
+	DefaultCrewName string             `json:"default_crew_name,omitempty"` // default crew name for new rigs
+	Notifications   []NotificationRule `json:"notifications,omitempty"`     // external command hooks for events feed writes
+}
+
+// NotificationRule fires Command whenever an event whose type is listed in
+// Events is written to the events feed. The event's JSON encoding is piped
+// to the command's stdin. Rules can be defined town-wide (mayor/config.json)
+// or per-rig (a rig's settings/config.json); both apply.
+type NotificationRule struct {
+	// Events are the event type strings that trigger this rule (e.g.
+	// "merged", "merge_failed", "agent_quarantined"). See internal/events
+	// for the built-in Type* constants.
+	Events []string `json:"events"`
+
+	// Command is run via "sh -c" with the event JSON on stdin. It is
+	// rate-limited per command string and killed if it exceeds the
+	// notification timeout, so a hung webhook can't block the writer.
+	Command string `json:"command"`
 }
 
 // CurrentTownSettingsVersion is the current schema version for TownSettings.
@@ -62,6 +80,225 @@ type TownSettings struct {
 	// Agent addresses like "gastown/crew/jack" become "gastown.crew.jack@{domain}".
 	// Default: "gastown.local"
 	AgentEmailDomain string `json:"agent_email_domain,omitempty"`
+
+	// GC configures retention periods for `gt town gc`. Nil or zero fields
+	// fall back to DefaultGCSettings.
+	GC *GCSettings `json:"gc,omitempty"`
+
+	// Defaults configures town-wide tunables (mail dedupe windows, idle
+	// timeouts, wisp retention, stall thresholds) that previously had no
+	// single home - each feature that needed one invented its own place
+	// for it. Nil or empty fields fall back to DefaultTownTunables via
+	// WithDefaults. New tunables of this kind belong here, and can be
+	// viewed/changed with `gt config get/set`.
+	Defaults *TownTunables `json:"defaults,omitempty"`
+}
+
+// TownTunables holds the town-wide default thresholds exposed via
+// `gt config get/set`. All fields are duration strings (time.ParseDuration
+// syntax) so they round-trip the same way RigSettings.StallThreshold does.
+type TownTunables struct {
+	// MailDedupeWindow is how long a duplicate mail notification (e.g. the
+	// "done" summary) is suppressed after being sent once. Default: "10s".
+	MailDedupeWindow string `json:"mail_dedupe_window,omitempty"`
+
+	// IdlePolecatTimeout is the default --max-idle for `gt session
+	// watch-idle` when a rig doesn't set its own idle_polecat_timeout.
+	// Default: "30m".
+	IdlePolecatTimeout string `json:"idle_polecat_timeout,omitempty"`
+
+	// WispTTL is how long a wisp directory may sit unclaimed before `gt
+	// town gc` considers it abandoned. Default: "24h".
+	WispTTL string `json:"wisp_ttl,omitempty"`
+
+	// StallThreshold is how long an issue may sit in_progress before the
+	// witness patrol flags it as stalled, when a rig doesn't set its own
+	// stall_threshold. Default: "2h".
+	StallThreshold string `json:"stall_threshold,omitempty"`
+}
+
+// DefaultTownTunables returns the built-in defaults used for any field left
+// unset in a town's Defaults.
+func DefaultTownTunables() *TownTunables {
+	return &TownTunables{
+		MailDedupeWindow:   "10s",
+		IdlePolecatTimeout: "30m",
+		WispTTL:            "24h",
+		StallThreshold:     "2h",
+	}
+}
+
+// WithDefaults returns a copy of t with zero fields filled in from
+// DefaultTownTunables. Safe to call on a nil receiver.
+func (t *TownTunables) WithDefaults() *TownTunables {
+	defaults := DefaultTownTunables()
+	if t == nil {
+		return defaults
+	}
+	resolved := *t
+	if resolved.MailDedupeWindow == "" {
+		resolved.MailDedupeWindow = defaults.MailDedupeWindow
+	}
+	if resolved.IdlePolecatTimeout == "" {
+		resolved.IdlePolecatTimeout = defaults.IdlePolecatTimeout
+	}
+	if resolved.WispTTL == "" {
+		resolved.WispTTL = defaults.WispTTL
+	}
+	if resolved.StallThreshold == "" {
+		resolved.StallThreshold = defaults.StallThreshold
+	}
+	return &resolved
+}
+
+// GetMailDedupeWindow returns the effective mail dedupe window, applying
+// defaults for unset fields. Malformed values fall back to the default.
+func (s *TownSettings) GetMailDedupeWindow() time.Duration {
+	return parseTunableDuration(s.Defaults.WithDefaults().MailDedupeWindow, DefaultTownTunables().MailDedupeWindow)
+}
+
+// GetIdlePolecatTimeout returns the effective default idle polecat timeout,
+// applying defaults for unset fields. Malformed values fall back to the
+// default. Rig-level RigSettings.IdlePolecatTimeout takes precedence over
+// this when set.
+func (s *TownSettings) GetIdlePolecatTimeout() time.Duration {
+	return parseTunableDuration(s.Defaults.WithDefaults().IdlePolecatTimeout, DefaultTownTunables().IdlePolecatTimeout)
+}
+
+// GetWispTTL returns the effective wisp retention TTL, applying defaults
+// for unset fields. Malformed values fall back to the default.
+func (s *TownSettings) GetWispTTL() time.Duration {
+	return parseTunableDuration(s.Defaults.WithDefaults().WispTTL, DefaultTownTunables().WispTTL)
+}
+
+// GetStallThreshold returns the effective default stall threshold, applying
+// defaults for unset fields. Malformed values fall back to the default.
+// Rig-level RigSettings.StallThreshold takes precedence over this when set.
+func (s *TownSettings) GetStallThreshold() time.Duration {
+	return parseTunableDuration(s.Defaults.WithDefaults().StallThreshold, DefaultTownTunables().StallThreshold)
+}
+
+// parseTunableDuration parses value, falling back to fallback (assumed
+// always valid) if value is empty or malformed.
+func parseTunableDuration(value, fallback string) time.Duration {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		d, _ = time.ParseDuration(fallback)
+	}
+	return d
+}
+
+// townTunable describes one field of TownTunables for `gt config get/set`
+// and GT_SETTING_* env overrides. Kept as an explicit list rather than
+// reflection so unknown keys are rejected and errors mention valid ones.
+type townTunable struct {
+	key string
+	get func(*TownTunables) string
+	set func(*TownTunables, string)
+}
+
+// townTunableRegistry lists every key settable via `gt config get/set` and
+// GT_SETTING_<KEY>. Add new TownTunables fields here to expose them.
+var townTunableRegistry = []townTunable{
+	{
+		key: "mail_dedupe_window",
+		get: func(t *TownTunables) string { return t.MailDedupeWindow },
+		set: func(t *TownTunables, v string) { t.MailDedupeWindow = v },
+	},
+	{
+		key: "idle_polecat_timeout",
+		get: func(t *TownTunables) string { return t.IdlePolecatTimeout },
+		set: func(t *TownTunables, v string) { t.IdlePolecatTimeout = v },
+	},
+	{
+		key: "wisp_ttl",
+		get: func(t *TownTunables) string { return t.WispTTL },
+		set: func(t *TownTunables, v string) { t.WispTTL = v },
+	},
+	{
+		key: "stall_threshold",
+		get: func(t *TownTunables) string { return t.StallThreshold },
+		set: func(t *TownTunables, v string) { t.StallThreshold = v },
+	},
+}
+
+// GetTunable returns the effective (default-resolved) raw string value of a
+// town tunable by key, or an error if the key is unknown.
+func (s *TownSettings) GetTunable(key string) (string, error) {
+	for _, t := range townTunableRegistry {
+		if t.key == key {
+			return t.get(s.Defaults.WithDefaults()), nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnknownTunable, key)
+}
+
+// SetTunable validates and sets a town tunable by key. Returns an error if
+// the key is unknown or the value is not a valid duration.
+func (s *TownSettings) SetTunable(key, value string) error {
+	for _, t := range townTunableRegistry {
+		if t.key != key {
+			continue
+		}
+		if _, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid duration for %s: %w", key, err)
+		}
+		if s.Defaults == nil {
+			s.Defaults = &TownTunables{}
+		}
+		t.set(s.Defaults, value)
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrUnknownTunable, key)
+}
+
+// TunableKeys returns the sorted list of valid `gt config get/set` keys.
+func TunableKeys() []string {
+	keys := make([]string, len(townTunableRegistry))
+	for i, t := range townTunableRegistry {
+		keys[i] = t.key
+	}
+	return keys
+}
+
+// GCSettings configures retention periods for town-level garbage collection
+// (see `gt town gc` and beads.Beads.GC). A zero value for a field means
+// "use the default", not "collect immediately" - use GCSettings.WithDefaults
+// to resolve effective values.
+type GCSettings struct {
+	// WispRetentionDays is how long a closed wisp message is kept before
+	// being deleted. Default: 7.
+	WispRetentionDays int `json:"wisp_retention_days,omitempty"`
+
+	// HandoffRetentionDays is how long a handoff bead's content is kept
+	// before being cleared once its role has gone idle. Default: 30.
+	HandoffRetentionDays int `json:"handoff_retention_days,omitempty"`
+}
+
+// DefaultGCSettings returns the safe default retention periods used when
+// town settings don't specify GC configuration.
+func DefaultGCSettings() *GCSettings {
+	return &GCSettings{
+		WispRetentionDays:    7,
+		HandoffRetentionDays: 30,
+	}
+}
+
+// WithDefaults returns a copy of s with zero fields filled in from
+// DefaultGCSettings. Safe to call on a nil receiver.
+func (s *GCSettings) WithDefaults() *GCSettings {
+	defaults := DefaultGCSettings()
+	if s == nil {
+		return defaults
+	}
+	resolved := *s
+	if resolved.WispRetentionDays == 0 {
+		resolved.WispRetentionDays = defaults.WispRetentionDays
+	}
+	if resolved.HandoffRetentionDays == 0 {
+		resolved.HandoffRetentionDays = defaults.HandoffRetentionDays
+	}
+	return &resolved
 }
 
 // NewTownSettings creates a new TownSettings with defaults.
@@ -174,7 +411,9 @@ type BeadsConfig struct {
 const CurrentTownVersion = 2
 
 // CurrentRigsVersion is the current schema version for RigsConfig.
-const CurrentRigsVersion = 1
+// Version 2: legacy flat "beads_prefix" fields are migrated into the
+// nested "beads": {"prefix": ...} object on load (see rigs_schema.go).
+const CurrentRigsVersion = 2
 
 // CurrentRigConfigVersion is the current schema version for RigConfig.
 const CurrentRigConfigVersion = 1
@@ -199,6 +438,21 @@ type WorkflowConfig struct {
 	// DefaultFormula is the formula to use when `gt formula run` is called without arguments.
 	// If empty, no default is set and a formula name must be provided.
 	DefaultFormula string `json:"default_formula,omitempty"`
+
+	// DefaultWorkMolecule overrides the town-wide default formula ("mol-polecat-work")
+	// that gt sling auto-applies when handing a bare bead to a polecat in this rig.
+	// If empty, the town-wide default is used.
+	DefaultWorkMolecule string `json:"default_work_molecule,omitempty"`
+
+	// SwarmTaskMolecule overrides DefaultWorkMolecule specifically for tasks
+	// dispatched by `gt swarm dispatch`/`gt swarm start`. If empty,
+	// DefaultWorkMolecule (or the town-wide default) is used.
+	SwarmTaskMolecule string `json:"swarm_task_molecule,omitempty"`
+
+	// FreeformMolecule overrides DefaultWorkMolecule for slings that carry
+	// natural-language --args instead of a formula. If empty,
+	// DefaultWorkMolecule (or the town-wide default) is used.
+	FreeformMolecule string `json:"freeform_molecule,omitempty"`
 }
 
 // RigSettings represents per-rig behavioral configuration (settings/config.json).
@@ -230,6 +484,22 @@ type RigSettings struct {
 	// Overrides TownSettings.RoleAgents for this specific rig.
 	// Example: {"witness": "claude-haiku", "polecat": "claude-sonnet"}
 	RoleAgents map[string]string `json:"role_agents,omitempty"`
+
+	// Notifications are external command hooks for this rig, in addition
+	// to any town-wide rules in mayor/config.json. See NotificationRule.
+	Notifications []NotificationRule `json:"notifications,omitempty"`
+
+	// IdlePolecatTimeout is the default --max-idle for "gt session
+	// stop-idle" when the flag isn't passed. Format: Go duration string
+	// (e.g., "2h", "30m"). Empty means stop-idle requires an explicit
+	// --max-idle.
+	IdlePolecatTimeout string `json:"idle_polecat_timeout,omitempty"`
+
+	// StallThreshold overrides witness.DefaultStallThreshold for how long an
+	// issue may sit in_progress before the patrol and `gt swarm status` flag
+	// it as stalled. Format: Go duration string (e.g., "1h", "45m"). Empty
+	// means use the package default.
+	StallThreshold string `json:"stall_threshold,omitempty"`
 }
 
 // CrewConfig represents crew workspace settings for a rig.
@@ -710,6 +980,18 @@ type MergeQueueConfig struct {
 
 	// MaxConcurrent is the maximum number of concurrent merges.
 	MaxConcurrent int `json:"max_concurrent"`
+
+	// RejectionExpiryDays is how long a manually rejected branch stays
+	// blocked from resubmission before `gt done`/`gt mq submit` will create
+	// a fresh MR for it again. Use `gt mq unreject <branch>` to lift a
+	// rejection early once the worker addresses it.
+	RejectionExpiryDays int `json:"rejection_expiry_days"`
+
+	// RequireReview gates ProcessMR on a witness sign-off: an MR whose bead
+	// lacks a `reviewed_by:` field is skipped until `gt witness review
+	// --approve` records one. Set per-rig for teams that want every polecat
+	// MR eyeballed before the refinery may merge it.
+	RequireReview bool `json:"require_review,omitempty"`
 }
 
 // OnConflict strategy constants.
@@ -731,6 +1013,7 @@ func DefaultMergeQueueConfig() *MergeQueueConfig {
 		RetryFlakyTests:      1,
 		PollInterval:         "30s",
 		MaxConcurrent:        1,
+		RejectionExpiryDays:  7,
 	}
 }
 
@@ -760,11 +1043,18 @@ func DefaultNamepoolConfig() *NamepoolConfig {
 // AccountsConfig represents Claude Code account configuration (mayor/accounts.json).
 // This enables Gas Town to manage multiple Claude Code accounts with easy switching.
 type AccountsConfig struct {
-	Version  int                `json:"version"`  // schema version
-	Accounts map[string]Account `json:"accounts"` // handle -> account details
-	Default  string             `json:"default"`  // default account handle
+	Version  int                `json:"version"`            // schema version
+	Accounts map[string]Account `json:"accounts"`           // handle -> account details
+	Default  string             `json:"default"`            // default account handle
+	Strategy string             `json:"strategy,omitempty"` // allocation strategy when no account is explicitly requested: "round_robin", "least_used", or "" (always use Default)
 }
 
+// Account allocation strategies for AccountsConfig.Strategy.
+const (
+	AccountStrategyRoundRobin = "round_robin"
+	AccountStrategyLeastUsed  = "least_used"
+)
+
 // Account represents a single Claude Code account.
 type Account struct {
 	Email       string `json:"email"`                 // account email
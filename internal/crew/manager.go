@@ -18,6 +18,7 @@ import (
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/util"
+	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 // Common errors
@@ -114,6 +115,18 @@ func (m *Manager) exists(name string) bool {
 }
 
 // Add creates a new crew worker with a clone of the rig.
+//
+// This intentionally clones (via CloneWithReference, which shares objects
+// with the local repo much like polecat's worktrees share the bare repo)
+// rather than using git worktree add as polecat.Manager.Add does. Polecats
+// always mint a fresh, unique branch, which is exactly what a worktree
+// requires (a branch can only be checked out in one worktree at a time).
+// Crew members are optional-branch (createBranch may be false, leaving the
+// worker on the rig's default branch), and a single rig can have many crew
+// members - a worktree-based Add would fail the moment a second crew
+// member without --branch tried to check out the same default branch that
+// another worktree already has checked out. A full clone keeps every crew
+// member's checkout independent.
 func (m *Manager) Add(name string, createBranch bool) (*CrewWorker, error) {
 	if err := validateCrewName(name); err != nil {
 		return nil, err
@@ -173,6 +186,12 @@ func (m *Manager) Add(name string, createBranch bool) (*CrewWorker, error) {
 		fmt.Printf("Warning: could not set up shared beads: %v\n", err)
 	}
 
+	// Write .gastown marker so workspace/role detection still works if this
+	// clone ends up outside the town directory tree.
+	if err := m.writeWorkspaceMarker(crewPath, name); err != nil {
+		fmt.Printf("Warning: could not write workspace marker: %v\n", err)
+	}
+
 	// Provision PRIME.md with Gas Town context for this worker.
 	// This is the fallback if SessionStart hook fails - ensures crew workers
 	// always have GUPP and essential Gas Town context.
@@ -437,6 +456,19 @@ func (m *Manager) setupSharedBeads(crewPath string) error {
 	return beads.SetupRedirect(townRoot, crewPath)
 }
 
+// writeWorkspaceMarker writes a .gastown marker into the crew worker's
+// clone so workspace discovery still works if the clone was created
+// outside the town directory tree.
+func (m *Manager) writeWorkspaceMarker(crewPath, name string) error {
+	townRoot := filepath.Dir(m.rig.Path)
+	return workspace.WriteMarker(crewPath, workspace.Marker{
+		TownRoot: townRoot,
+		Rig:      m.rig.Name,
+		Role:     "crew",
+		Name:     name,
+	})
+}
+
 // SessionName returns the tmux session name for a crew member.
 func (m *Manager) SessionName(name string) string {
 	return fmt.Sprintf("gt-%s-crew-%s", m.rig.Name, name)
@@ -591,4 +623,3 @@ func (m *Manager) IsRunning(name string) (bool, error) {
 	sessionID := m.SessionName(name)
 	return t.HasSession(sessionID)
 }
-
@@ -0,0 +1,122 @@
+package deacon
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// HealthLine is a single OK/WARN observation in a HealthReport.
+type HealthLine struct {
+	OK      bool   `json:"ok"`
+	Message string `json:"message"`
+}
+
+// HealthReport is the combined liveness picture for the Deacon, gathered
+// for `gt deacon status`: tmux session existence, pause state, last-patrol
+// heartbeat age, and any sessions the restart ledger has quarantined.
+type HealthReport struct {
+	SessionName    string     `json:"session_name"`
+	SessionRunning bool       `json:"session_running"`
+	Paused         bool       `json:"paused"`
+	PauseReason    string     `json:"pause_reason,omitempty"`
+	PausedAt       *time.Time `json:"paused_at,omitempty"`
+	PausedBy       string     `json:"paused_by,omitempty"`
+	LastPatrol     *time.Time `json:"last_patrol,omitempty"`
+	Quarantined    []string   `json:"quarantined,omitempty"`
+
+	Lines []HealthLine `json:"lines"`
+}
+
+// Healthy reports whether every line in the report is OK. `gt deacon
+// status` uses this to decide its exit code for cron health checks.
+func (r *HealthReport) Healthy() bool {
+	for _, l := range r.Lines {
+		if !l.OK {
+			return false
+		}
+	}
+	return true
+}
+
+// healthSessionSource is the tmux surface CheckHealth needs, narrowed so
+// tests can stub session existence without a real tmux server.
+type healthSessionSource interface {
+	HasSession(name string) (bool, error)
+}
+
+// CheckHealth gathers the Deacon's combined liveness report.
+func CheckHealth(townRoot string) (*HealthReport, error) {
+	return checkHealth(townRoot, tmux.NewTmux())
+}
+
+func checkHealth(townRoot string, t healthSessionSource) (*HealthReport, error) {
+	sessionName := SessionName()
+	running, err := t.HasSession(sessionName)
+	if err != nil {
+		return nil, fmt.Errorf("checking session: %w", err)
+	}
+
+	report := &HealthReport{SessionName: sessionName, SessionRunning: running}
+	if running {
+		report.Lines = append(report.Lines, HealthLine{OK: true, Message: fmt.Sprintf("session %s is running", sessionName)})
+	} else {
+		report.Lines = append(report.Lines, HealthLine{OK: false, Message: fmt.Sprintf("session %s is not running", sessionName)})
+	}
+
+	if paused, state, err := IsPaused(townRoot); err == nil && paused {
+		report.Paused = true
+		report.PauseReason = state.Reason
+		report.PausedBy = state.PausedBy
+		if !state.PausedAt.IsZero() {
+			pausedAt := state.PausedAt
+			report.PausedAt = &pausedAt
+		}
+	}
+
+	// A paused Deacon isn't patrolling on purpose, so a stale heartbeat
+	// while paused isn't a health problem worth alarming a cron check over.
+	if report.Paused {
+		report.Lines = append(report.Lines, HealthLine{OK: true, Message: "patrol is paused" + reasonSuffix(report.PauseReason)})
+	} else {
+		hb := ReadHeartbeat(townRoot)
+		if hb != nil {
+			ts := hb.Timestamp
+			report.LastPatrol = &ts
+		}
+		switch {
+		case hb == nil:
+			report.Lines = append(report.Lines, HealthLine{OK: false, Message: "no patrol heartbeat recorded yet"})
+		case hb.IsVeryStale():
+			report.Lines = append(report.Lines, HealthLine{OK: false, Message: fmt.Sprintf("last patrol %s ago (stale)", hb.Age().Round(time.Second))})
+		default:
+			report.Lines = append(report.Lines, HealthLine{OK: true, Message: fmt.Sprintf("last patrol %s ago", hb.Age().Round(time.Second))})
+		}
+	}
+
+	ledger, err := LoadRestartLedger(townRoot)
+	if err != nil {
+		return nil, fmt.Errorf("loading restart ledger: %w", err)
+	}
+	for session, e := range ledger.Sessions {
+		if e.Quarantined {
+			report.Quarantined = append(report.Quarantined, session)
+		}
+	}
+	sort.Strings(report.Quarantined)
+	if len(report.Quarantined) > 0 {
+		report.Lines = append(report.Lines, HealthLine{OK: false, Message: fmt.Sprintf("%d session(s) quarantined: %s", len(report.Quarantined), strings.Join(report.Quarantined, ", "))})
+	}
+
+	return report, nil
+}
+
+func reasonSuffix(reason string) string {
+	if reason == "" {
+		return ""
+	}
+	return ": " + reason
+}
@@ -0,0 +1,106 @@
+package deacon
+
+import (
+	"testing"
+	"time"
+)
+
+type stubSessionSource struct {
+	running bool
+}
+
+func (s stubSessionSource) HasSession(name string) (bool, error) {
+	return s.running, nil
+}
+
+func TestCheckHealth_SessionNotRunning(t *testing.T) {
+	townRoot := t.TempDir()
+
+	report, err := checkHealth(townRoot, stubSessionSource{running: false})
+	if err != nil {
+		t.Fatalf("checkHealth: %v", err)
+	}
+	if report.SessionRunning {
+		t.Fatal("expected SessionRunning to be false")
+	}
+	if report.Healthy() {
+		t.Fatal("expected Healthy() to be false when the session isn't running")
+	}
+}
+
+func TestCheckHealth_FreshHeartbeatIsHealthy(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := Touch(townRoot); err != nil {
+		t.Fatalf("Touch: %v", err)
+	}
+
+	report, err := checkHealth(townRoot, stubSessionSource{running: true})
+	if err != nil {
+		t.Fatalf("checkHealth: %v", err)
+	}
+	if !report.Healthy() {
+		t.Fatalf("expected a fresh heartbeat with a running session to be healthy, got lines: %+v", report.Lines)
+	}
+	if report.LastPatrol == nil {
+		t.Fatal("expected LastPatrol to be set")
+	}
+}
+
+func TestCheckHealth_StaleHeartbeatIsUnhealthy(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := WriteHeartbeat(townRoot, &Heartbeat{Timestamp: time.Now().Add(-30 * time.Minute)}); err != nil {
+		t.Fatalf("WriteHeartbeat: %v", err)
+	}
+
+	report, err := checkHealth(townRoot, stubSessionSource{running: true})
+	if err != nil {
+		t.Fatalf("checkHealth: %v", err)
+	}
+	if report.Healthy() {
+		t.Fatal("expected a very stale heartbeat to make the report unhealthy")
+	}
+}
+
+func TestCheckHealth_PausedSuppressesStaleHeartbeatWarning(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := WriteHeartbeat(townRoot, &Heartbeat{Timestamp: time.Now().Add(-30 * time.Minute)}); err != nil {
+		t.Fatalf("WriteHeartbeat: %v", err)
+	}
+	if err := Pause(townRoot, "maintenance", "human"); err != nil {
+		t.Fatalf("Pause: %v", err)
+	}
+
+	report, err := checkHealth(townRoot, stubSessionSource{running: true})
+	if err != nil {
+		t.Fatalf("checkHealth: %v", err)
+	}
+	if !report.Paused {
+		t.Fatal("expected Paused to be true")
+	}
+	if !report.Healthy() {
+		t.Fatalf("expected pause to suppress the stale-heartbeat warning, got lines: %+v", report.Lines)
+	}
+}
+
+func TestCheckHealth_QuarantinedSessionIsUnhealthy(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := Touch(townRoot); err != nil {
+		t.Fatalf("Touch: %v", err)
+	}
+	for i := 0; i < DefaultMaxRestartsPerHour+1; i++ {
+		if _, err := RecordRestart(townRoot, "gt-greenplace-witness"); err != nil {
+			t.Fatalf("RecordRestart: %v", err)
+		}
+	}
+
+	report, err := checkHealth(townRoot, stubSessionSource{running: true})
+	if err != nil {
+		t.Fatalf("checkHealth: %v", err)
+	}
+	if report.Healthy() {
+		t.Fatal("expected a quarantined session to make the report unhealthy")
+	}
+	if len(report.Quarantined) != 1 || report.Quarantined[0] != "gt-greenplace-witness" {
+		t.Fatalf("Quarantined = %v, want [gt-greenplace-witness]", report.Quarantined)
+	}
+}
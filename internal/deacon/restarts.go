@@ -0,0 +1,191 @@
+package deacon
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// ErrSessionNotFound is returned by Unquarantine when the session has no
+// restart ledger entry.
+var ErrSessionNotFound = errors.New("session not found in restart ledger")
+
+// Default backoff parameters for the restart ledger.
+// Per ZFC: these are fallbacks; nothing here reads a role bead yet since
+// crash-loop protection is a town-wide safety net, not a per-agent policy.
+const (
+	// DefaultMaxRestartsPerHour is how many restarts a session may have in
+	// the trailing hour before it's quarantined.
+	DefaultMaxRestartsPerHour = 5
+
+	// DefaultRestartWindow is the trailing window restarts are counted over.
+	DefaultRestartWindow = time.Hour
+)
+
+// SessionRestarts tracks restart history for a single tmux session.
+type SessionRestarts struct {
+	// Session is the tmux session name (e.g. "gt-greenplace-witness").
+	Session string `json:"session"`
+
+	// Timestamps records when each restart happened, oldest first.
+	// Entries older than DefaultRestartWindow are pruned on each record.
+	Timestamps []time.Time `json:"timestamps"`
+
+	// Quarantined is true once the session has exceeded the backoff
+	// threshold. A quarantined session is not auto-restarted until a
+	// human runs `gt deacon unquarantine`.
+	Quarantined bool `json:"quarantined"`
+
+	// QuarantinedAt is when quarantine was applied.
+	QuarantinedAt time.Time `json:"quarantined_at,omitempty"`
+
+	// QuarantineReason explains why the session was quarantined.
+	QuarantineReason string `json:"quarantine_reason,omitempty"`
+}
+
+// RestartLedger is the on-disk record of restart history for all sessions.
+type RestartLedger struct {
+	Sessions map[string]*SessionRestarts `json:"sessions"`
+}
+
+// RestartLedgerFile returns the path to the restart ledger.
+func RestartLedgerFile(townRoot string) string {
+	return filepath.Join(townRoot, "deacon", ".runtime", "restarts.json")
+}
+
+// LoadRestartLedger loads the restart ledger from disk.
+// Returns an empty ledger if the file doesn't exist.
+func LoadRestartLedger(townRoot string) (*RestartLedger, error) {
+	path := RestartLedgerFile(townRoot)
+
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed from trusted townRoot
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &RestartLedger{Sessions: make(map[string]*SessionRestarts)}, nil
+		}
+		return nil, fmt.Errorf("reading restart ledger: %w", err)
+	}
+
+	var ledger RestartLedger
+	if err := json.Unmarshal(data, &ledger); err != nil {
+		return nil, fmt.Errorf("parsing restart ledger: %w", err)
+	}
+	if ledger.Sessions == nil {
+		ledger.Sessions = make(map[string]*SessionRestarts)
+	}
+	return &ledger, nil
+}
+
+// SaveRestartLedger writes the restart ledger to disk atomically.
+func SaveRestartLedger(townRoot string, ledger *RestartLedger) error {
+	path := RestartLedgerFile(townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating deacon runtime directory: %w", err)
+	}
+	return util.AtomicWriteJSON(path, ledger)
+}
+
+// entry returns the SessionRestarts for a session, creating it if needed.
+func (l *RestartLedger) entry(session string) *SessionRestarts {
+	if l.Sessions == nil {
+		l.Sessions = make(map[string]*SessionRestarts)
+	}
+	e, ok := l.Sessions[session]
+	if !ok {
+		e = &SessionRestarts{Session: session}
+		l.Sessions[session] = e
+	}
+	return e
+}
+
+// prune drops timestamps outside the restart window, returning the count
+// still within it.
+func (e *SessionRestarts) prune(now time.Time, window time.Duration) int {
+	kept := e.Timestamps[:0]
+	for _, ts := range e.Timestamps {
+		if now.Sub(ts) < window {
+			kept = append(kept, ts)
+		}
+	}
+	e.Timestamps = kept
+	return len(kept)
+}
+
+// RecordRestart records that the deacon restarted a session, applying
+// quarantine if this restart pushes the session over the backoff
+// threshold. Returns the updated ledger entry.
+func RecordRestart(townRoot, session string) (*SessionRestarts, error) {
+	ledger, err := LoadRestartLedger(townRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	e := ledger.entry(session)
+	e.prune(now, DefaultRestartWindow)
+	e.Timestamps = append(e.Timestamps, now)
+
+	if len(e.Timestamps) > DefaultMaxRestartsPerHour && !e.Quarantined {
+		e.Quarantined = true
+		e.QuarantinedAt = now
+		e.QuarantineReason = fmt.Sprintf("%d restarts within %s (limit %d)", len(e.Timestamps), DefaultRestartWindow, DefaultMaxRestartsPerHour)
+	}
+
+	if err := SaveRestartLedger(townRoot, ledger); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// ShouldRestart reports whether the deacon should restart a crashed
+// session, or false with a reason if the session is quarantined.
+// Sessions with no restart history are always eligible.
+func ShouldRestart(townRoot, session string) (bool, string) {
+	ledger, err := LoadRestartLedger(townRoot)
+	if err != nil {
+		// Ledger errors shouldn't block restarts - fail open.
+		return true, ""
+	}
+
+	e, ok := ledger.Sessions[session]
+	if !ok {
+		return true, ""
+	}
+
+	if e.Quarantined {
+		return false, e.QuarantineReason
+	}
+
+	if count := e.prune(time.Now().UTC(), DefaultRestartWindow); count > DefaultMaxRestartsPerHour {
+		return false, fmt.Sprintf("restart limit reached (%d per %s)", DefaultMaxRestartsPerHour, DefaultRestartWindow)
+	}
+
+	return true, ""
+}
+
+// Unquarantine clears quarantine and restart history for a session,
+// allowing it to be restarted again. Returns ErrSessionNotFound if the
+// session has no ledger entry.
+func Unquarantine(townRoot, session string) error {
+	ledger, err := LoadRestartLedger(townRoot)
+	if err != nil {
+		return err
+	}
+
+	e, ok := ledger.Sessions[session]
+	if !ok {
+		return ErrSessionNotFound
+	}
+
+	e.Quarantined = false
+	e.QuarantinedAt = time.Time{}
+	e.QuarantineReason = ""
+	e.Timestamps = nil
+
+	return SaveRestartLedger(townRoot, ledger)
+}
@@ -0,0 +1,102 @@
+package deacon
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecordRestart_AllowsRestartsUnderLimit(t *testing.T) {
+	townRoot := t.TempDir()
+
+	for i := 0; i < DefaultMaxRestartsPerHour; i++ {
+		e, err := RecordRestart(townRoot, "gt-greenplace-witness")
+		if err != nil {
+			t.Fatalf("RecordRestart: %v", err)
+		}
+		if e.Quarantined {
+			t.Fatalf("unexpected quarantine after %d restarts", i+1)
+		}
+	}
+
+	ok, reason := ShouldRestart(townRoot, "gt-greenplace-witness")
+	if !ok {
+		t.Fatalf("expected ShouldRestart to allow one more, got reason %q", reason)
+	}
+}
+
+func TestRecordRestart_QuarantinesAfterLimitExceeded(t *testing.T) {
+	townRoot := t.TempDir()
+	session := "gt-greenplace-witness"
+
+	var e *SessionRestarts
+	var err error
+	for i := 0; i < DefaultMaxRestartsPerHour+1; i++ {
+		e, err = RecordRestart(townRoot, session)
+		if err != nil {
+			t.Fatalf("RecordRestart: %v", err)
+		}
+	}
+
+	if !e.Quarantined {
+		t.Fatalf("expected quarantine after exceeding limit")
+	}
+
+	ok, reason := ShouldRestart(townRoot, session)
+	if ok {
+		t.Fatalf("expected ShouldRestart to refuse a quarantined session")
+	}
+	if reason == "" {
+		t.Fatalf("expected a quarantine reason")
+	}
+}
+
+func TestPrune_DropsRestartsOutsideWindow(t *testing.T) {
+	e := &SessionRestarts{
+		Timestamps: []time.Time{
+			time.Now().Add(-2 * time.Hour),
+			time.Now().Add(-1 * time.Minute),
+		},
+	}
+
+	kept := e.prune(time.Now(), DefaultRestartWindow)
+	if kept != 1 {
+		t.Fatalf("expected 1 restart within window, got %d", kept)
+	}
+}
+
+func TestShouldRestart_UnknownSessionIsAllowed(t *testing.T) {
+	townRoot := t.TempDir()
+
+	ok, reason := ShouldRestart(townRoot, "gt-greenplace-witness")
+	if !ok {
+		t.Fatalf("expected unknown session to be allowed, got reason %q", reason)
+	}
+}
+
+func TestUnquarantine_ClearsQuarantineAndHistory(t *testing.T) {
+	townRoot := t.TempDir()
+	session := "gt-greenplace-witness"
+
+	for i := 0; i < DefaultMaxRestartsPerHour+1; i++ {
+		if _, err := RecordRestart(townRoot, session); err != nil {
+			t.Fatalf("RecordRestart: %v", err)
+		}
+	}
+
+	if err := Unquarantine(townRoot, session); err != nil {
+		t.Fatalf("Unquarantine: %v", err)
+	}
+
+	ok, reason := ShouldRestart(townRoot, session)
+	if !ok {
+		t.Fatalf("expected session to be restartable after unquarantine, got reason %q", reason)
+	}
+}
+
+func TestUnquarantine_UnknownSessionErrors(t *testing.T) {
+	townRoot := t.TempDir()
+
+	if err := Unquarantine(townRoot, "gt-nonexistent-witness"); err != ErrSessionNotFound {
+		t.Fatalf("expected ErrSessionNotFound, got %v", err)
+	}
+}
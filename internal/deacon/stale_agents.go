@@ -0,0 +1,51 @@
+package deacon
+
+import (
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// StaleAgentConfig holds configurable parameters for stale heartbeat detection.
+type StaleAgentConfig struct {
+	// MaxAge is how long an agent bead's last_heartbeat can go stale before
+	// it's considered unresponsive.
+	MaxAge time.Duration `json:"max_age"`
+}
+
+// DefaultStaleAgentConfig returns the default stale agent config.
+func DefaultStaleAgentConfig() *StaleAgentConfig {
+	return &StaleAgentConfig{
+		MaxAge: 20 * time.Minute,
+	}
+}
+
+// StaleAgentScanResult contains the full results of a stale heartbeat scan.
+type StaleAgentScanResult struct {
+	ScannedAt time.Time      `json:"scanned_at"`
+	Stale     []*beads.Issue `json:"stale"`
+}
+
+// ScanStaleAgents finds agent beads that claim to be running but whose
+// last_heartbeat is older than cfg.MaxAge (or missing). Unlike
+// ScanStaleHooks, this doesn't take any action - it's a mechanical liveness
+// check meant to feed into the same escalation path as other patrol
+// findings, since "agent bead is stale" doesn't by itself tell you whether
+// restarting the session or nudging the mayor is the right response.
+func ScanStaleAgents(townRoot string, cfg *StaleAgentConfig) (*StaleAgentScanResult, error) {
+	if cfg == nil {
+		cfg = DefaultStaleAgentConfig()
+	}
+
+	now := time.Now().UTC()
+	b := beads.New(townRoot)
+	stale, err := b.StaleAgents(cfg.MaxAge, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StaleAgentScanResult{
+		ScannedAt: now,
+		Stale:     stale,
+	}, nil
+}
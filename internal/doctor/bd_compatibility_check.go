@@ -0,0 +1,44 @@
+package doctor
+
+import (
+	"github.com/steveyegge/gastown/internal/version"
+)
+
+// BDCompatibilityCheck warns when the installed bd binary's major version
+// falls outside the range gt has been tested against. This is separate from
+// the hard minimum version enforced at startup (CheckBeadsVersion) - it
+// exists to surface untested bd releases during triage rather than block on
+// them.
+type BDCompatibilityCheck struct {
+	BaseCheck
+}
+
+// NewBDCompatibilityCheck creates a new bd compatibility check.
+func NewBDCompatibilityCheck() *BDCompatibilityCheck {
+	return &BDCompatibilityCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "bd-compatibility",
+			CheckDescription: "Check the installed bd version against the range gt has been tested against",
+			CheckCategory:    CategoryInfrastructure,
+		},
+	}
+}
+
+// Run reports the cached result of version.CheckBDCompatibility().
+func (c *BDCompatibilityCheck) Run(ctx *CheckContext) *CheckResult {
+	compat := version.CheckBDCompatibility()
+
+	if compat.Warning != "" {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: compat.Warning,
+		}
+	}
+
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusOK,
+		Message: "bd " + compat.Version + " is within the tested version range",
+	}
+}
@@ -52,9 +52,36 @@ func (d *Doctor) Run(ctx *CheckContext) *Report {
 	return report
 }
 
+// FixConfirmFunc decides what to do about a single failing, fixable check.
+// apply reports whether the fix should be attempted; keepGoing reports
+// whether remaining checks should still be processed (false aborts the
+// run, leaving later checks out of the report entirely).
+type FixConfirmFunc func(check Check, result *CheckResult) (apply, keepGoing bool)
+
+// FixAttempt records what happened when a fixable check failed during Fix
+// or FixInteractive: whether a fix was attempted, whether it errored, and
+// the check's status after re-running it.
+type FixAttempt struct {
+	CheckName string
+	Applied   bool        // true if Fix() was called (false means the user skipped it)
+	Error     string      // Fix() error, if any
+	Recheck   CheckStatus // status from re-running the check after a successful fix
+}
+
 // Fix runs all checks with auto-fix enabled where possible.
 // It first runs the check, then if it fails and can be fixed, attempts the fix.
 func (d *Doctor) Fix(ctx *CheckContext) *Report {
+	return d.fix(ctx, nil)
+}
+
+// FixInteractive runs all checks, prompting confirm before attempting the
+// fix for each failing, fixable check. A nil confirm behaves like Fix
+// (every fix is applied without asking).
+func (d *Doctor) FixInteractive(ctx *CheckContext, confirm FixConfirmFunc) *Report {
+	return d.fix(ctx, confirm)
+}
+
+func (d *Doctor) fix(ctx *CheckContext, confirm FixConfirmFunc) *Report {
 	report := NewReport()
 
 	for _, check := range d.checks {
@@ -67,30 +94,43 @@ func (d *Doctor) Fix(ctx *CheckContext) *Report {
 			result.Category = cg.Category()
 		}
 
-		// Attempt fix if check failed and is fixable
-		if result.Status != StatusOK && check.CanFix() {
-			err := check.Fix(ctx)
-			if err == nil {
+		if result.Status == StatusOK || !check.CanFix() {
+			report.Add(result)
+			continue
+		}
+
+		apply, keepGoing := true, true
+		if confirm != nil {
+			apply, keepGoing = confirm(check, result)
+		}
+
+		attempt := &FixAttempt{CheckName: check.Name(), Applied: apply}
+		if apply {
+			if err := check.Fix(ctx); err != nil {
+				attempt.Error = err.Error()
+				result.Details = append(result.Details, "Fix failed: "+err.Error())
+			} else {
 				// Re-run check to verify fix worked
 				result = check.Run(ctx)
 				if result.Name == "" {
 					result.Name = check.Name()
 				}
-				// Set category again after re-run
 				if cg, ok := check.(categoryGetter); ok && result.Category == "" {
 					result.Category = cg.Category()
 				}
-				// Update message to indicate fix was applied
+				attempt.Recheck = result.Status
 				if result.Status == StatusOK {
 					result.Message = result.Message + " (fixed)"
 				}
-			} else {
-				// Fix failed, add error to details
-				result.Details = append(result.Details, "Fix failed: "+err.Error())
 			}
 		}
 
 		report.Add(result)
+		report.FixAttempts = append(report.FixAttempts, attempt)
+
+		if !keepGoing {
+			break
+		}
 	}
 
 	return report
@@ -330,6 +330,70 @@ func TestDoctor_Fix(t *testing.T) {
 	}
 }
 
+func TestDoctor_FixInteractive(t *testing.T) {
+	d := NewDoctor()
+
+	applyCheck := newMockCheck("apply-me", StatusError)
+	applyCheck.fixable = true
+	d.Register(applyCheck)
+
+	skipCheck := newMockCheck("skip-me", StatusError)
+	skipCheck.fixable = true
+	d.Register(skipCheck)
+
+	ctx := &CheckContext{TownRoot: "/test"}
+	report := d.FixInteractive(ctx, func(check Check, result *CheckResult) (bool, bool) {
+		return check.Name() == "apply-me", true
+	})
+
+	if applyCheck.fixCount != 1 {
+		t.Error("apply-me should have had Fix() called")
+	}
+	if skipCheck.fixCount != 0 {
+		t.Error("skip-me should not have had Fix() called")
+	}
+
+	if len(report.FixAttempts) != 2 {
+		t.Fatalf("FixAttempts = %d, want 2", len(report.FixAttempts))
+	}
+	if !report.FixAttempts[0].Applied || report.FixAttempts[0].Recheck != StatusOK {
+		t.Errorf("apply-me attempt = %+v, want Applied with Recheck OK", report.FixAttempts[0])
+	}
+	if report.FixAttempts[1].Applied {
+		t.Errorf("skip-me attempt = %+v, want not Applied", report.FixAttempts[1])
+	}
+
+	// The skipped check keeps its original failing status in the report.
+	if report.Checks[1].Status != StatusError {
+		t.Error("skip-me should remain Error since its fix was skipped")
+	}
+}
+
+func TestDoctor_FixInteractive_Quit(t *testing.T) {
+	d := NewDoctor()
+
+	first := newMockCheck("first", StatusError)
+	first.fixable = true
+	d.Register(first)
+
+	second := newMockCheck("second", StatusError)
+	second.fixable = true
+	d.Register(second)
+
+	ctx := &CheckContext{TownRoot: "/test"}
+	report := d.FixInteractive(ctx, func(check Check, result *CheckResult) (bool, bool) {
+		// Quit before even looking at the first check.
+		return false, false
+	})
+
+	if len(report.Checks) != 1 {
+		t.Fatalf("Checks = %d, want 1 (run stops after quit)", len(report.Checks))
+	}
+	if second.fixCount != 0 {
+		t.Error("second should never have been reached after quit")
+	}
+}
+
 func TestBaseCheck(t *testing.T) {
 	b := &BaseCheck{
 		CheckName:        "test",
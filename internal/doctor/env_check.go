@@ -15,6 +15,12 @@ type SessionEnvReader interface {
 	GetAllEnvironment(session string) (map[string]string, error)
 }
 
+// SessionEnvWriter abstracts writing tmux session environment variables, so
+// Fix() can correct drifted vars without touching a real tmux server in tests.
+type SessionEnvWriter interface {
+	SetEnvironment(session, key, value string) error
+}
+
 // tmuxEnvReader wraps real tmux operations.
 type tmuxEnvReader struct {
 	t *tmux.Tmux
@@ -28,27 +34,38 @@ func (r *tmuxEnvReader) GetAllEnvironment(session string) (map[string]string, er
 	return r.t.GetAllEnvironment(session)
 }
 
+func (r *tmuxEnvReader) SetEnvironment(session, key, value string) error {
+	return r.t.SetEnvironment(session, key, value)
+}
+
 // EnvVarsCheck verifies that tmux session environment variables match expected values.
 type EnvVarsCheck struct {
-	BaseCheck
+	FixableCheck
 	reader SessionEnvReader // nil means use real tmux
+	writer SessionEnvWriter // nil means use real tmux
 }
 
 // NewEnvVarsCheck creates a new env vars check.
 func NewEnvVarsCheck() *EnvVarsCheck {
 	return &EnvVarsCheck{
-		BaseCheck: BaseCheck{
-			CheckName:        "env-vars",
-			CheckDescription: "Verify tmux session environment variables match expected values",
-			CheckCategory:    CategoryConfig,
+		FixableCheck: FixableCheck{
+			BaseCheck: BaseCheck{
+				CheckName:        "env-vars",
+				CheckDescription: "Verify tmux session environment variables match expected values",
+				CheckCategory:    CategoryConfig,
+			},
 		},
 	}
 }
 
 // NewEnvVarsCheckWithReader creates a check with a custom reader (for testing).
+// If reader also implements SessionEnvWriter, it's used for Fix() too.
 func NewEnvVarsCheckWithReader(reader SessionEnvReader) *EnvVarsCheck {
 	c := NewEnvVarsCheck()
 	c.reader = reader
+	if writer, ok := reader.(SessionEnvWriter); ok {
+		c.writer = writer
+	}
 	return c
 }
 
@@ -172,3 +189,77 @@ func (c *EnvVarsCheck) Run(ctx *CheckContext) *CheckResult {
 		FixHint: "Run 'gt shutdown && gt up' to restart sessions with correct env vars",
 	}
 }
+
+// sessionDrift computes, per Gas Town session, the expected values of env
+// vars that are missing or wrong in the session's actual tmux environment.
+func (c *EnvVarsCheck) sessionDrift(ctx *CheckContext, reader SessionEnvReader) (map[string]map[string]string, error) {
+	sessions, err := reader.ListSessions()
+	if err != nil {
+		return nil, nil // No tmux server - nothing to drift-check
+	}
+
+	drift := make(map[string]map[string]string)
+	for _, sess := range sessions {
+		if !strings.HasPrefix(sess, "gt-") && !strings.HasPrefix(sess, "hq-") {
+			continue
+		}
+
+		identity, err := session.ParseSessionName(sess)
+		if err != nil {
+			continue
+		}
+
+		expected := config.AgentEnv(config.AgentEnvConfig{
+			Role:      string(identity.Role),
+			Rig:       identity.Rig,
+			AgentName: identity.Name,
+			TownRoot:  ctx.TownRoot,
+		})
+
+		actual, err := reader.GetAllEnvironment(sess)
+		if err != nil {
+			continue
+		}
+
+		for key, expectedVal := range expected {
+			if actualVal, exists := actual[key]; !exists || actualVal != expectedVal {
+				if drift[sess] == nil {
+					drift[sess] = make(map[string]string)
+				}
+				drift[sess][key] = expectedVal
+			}
+		}
+	}
+
+	return drift, nil
+}
+
+// Fix corrects drifted env vars in running sessions via SetEnvironment.
+// This only updates the session's stored environment - panes already
+// running Claude need a manual respawn (gt shutdown && gt up) to pick up
+// the corrected values, which Fix deliberately does not do automatically.
+func (c *EnvVarsCheck) Fix(ctx *CheckContext) error {
+	reader := c.reader
+	if reader == nil {
+		reader = &tmuxEnvReader{t: tmux.NewTmux()}
+	}
+	writer := c.writer
+	if writer == nil {
+		writer = &tmuxEnvReader{t: tmux.NewTmux()}
+	}
+
+	drift, err := c.sessionDrift(ctx, reader)
+	if err != nil {
+		return err
+	}
+
+	for sess, vars := range drift {
+		for key, expectedVal := range vars {
+			if err := writer.SetEnvironment(sess, key, expectedVal); err != nil {
+				return fmt.Errorf("setting %s=%q for session %s: %w", key, expectedVal, sess, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -8,12 +8,29 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 )
 
-// mockEnvReader implements SessionEnvReader for testing.
+// mockEnvReader implements SessionEnvReader and SessionEnvWriter for testing.
 type mockEnvReader struct {
 	sessions    []string
 	sessionEnvs map[string]map[string]string
 	listErr     error
 	envErrs     map[string]error
+	setErr      error
+}
+
+// SetEnvironment records the set into sessionEnvs so Fix() can be verified
+// by re-reading via GetAllEnvironment.
+func (m *mockEnvReader) SetEnvironment(session, key, value string) error {
+	if m.setErr != nil {
+		return m.setErr
+	}
+	if m.sessionEnvs == nil {
+		m.sessionEnvs = make(map[string]map[string]string)
+	}
+	if m.sessionEnvs[session] == nil {
+		m.sessionEnvs[session] = make(map[string]string)
+	}
+	m.sessionEnvs[session][key] = value
+	return nil
 }
 
 func (m *mockEnvReader) ListSessions() ([]string, error) {
@@ -438,3 +455,68 @@ func TestEnvVarsCheck_BeadsDirWithOtherMismatches(t *testing.T) {
 		t.Errorf("Details should mention other issues")
 	}
 }
+
+func TestEnvVarsCheck_CanFix(t *testing.T) {
+	check := NewEnvVarsCheck()
+	if !check.CanFix() {
+		t.Error("CanFix() = false, want true")
+	}
+}
+
+func TestEnvVarsCheck_Fix_CorrectsDriftedSession(t *testing.T) {
+	reader := &mockEnvReader{
+		sessions: []string{"gt-myrig-witness"},
+		sessionEnvs: map[string]map[string]string{
+			"gt-myrig-witness": {
+				"GT_ROLE": "witness",
+				"GT_RIG":  "wrongrig",
+			},
+		},
+	}
+	check := NewEnvVarsCheckWithReader(reader)
+
+	if err := check.Fix(testCtx()); err != nil {
+		t.Fatalf("Fix() error = %v", err)
+	}
+
+	expected := expectedEnv("witness", "myrig", "")
+	actual, err := reader.GetAllEnvironment("gt-myrig-witness")
+	if err != nil {
+		t.Fatalf("GetAllEnvironment: %v", err)
+	}
+	for key, val := range expected {
+		if actual[key] != val {
+			t.Errorf("after Fix, %s = %q, want %q", key, actual[key], val)
+		}
+	}
+
+	// Re-running Run() should now report the session as correct.
+	result := check.Run(testCtx())
+	if result.Status != StatusOK {
+		t.Errorf("Status after Fix = %v, want StatusOK, details: %v", result.Status, result.Details)
+	}
+}
+
+func TestEnvVarsCheck_Fix_NoSessionsIsNoop(t *testing.T) {
+	reader := &mockEnvReader{sessions: []string{}}
+	check := NewEnvVarsCheckWithReader(reader)
+
+	if err := check.Fix(testCtx()); err != nil {
+		t.Fatalf("Fix() error = %v", err)
+	}
+}
+
+func TestEnvVarsCheck_Fix_PropagatesSetEnvironmentError(t *testing.T) {
+	reader := &mockEnvReader{
+		sessions: []string{"gt-myrig-witness"},
+		sessionEnvs: map[string]map[string]string{
+			"gt-myrig-witness": {}, // fully drifted
+		},
+		setErr: errors.New("tmux: no such session"),
+	}
+	check := NewEnvVarsCheckWithReader(reader)
+
+	if err := check.Fix(testCtx()); err == nil {
+		t.Fatal("Fix() error = nil, want propagated SetEnvironment error")
+	}
+}
@@ -0,0 +1,180 @@
+package doctor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// remoteCheckTimeout bounds how long we wait on `git ls-remote` before
+// treating the remote as unreachable. This runs during every `gt doctor`
+// pass, so it needs to fail fast against a dead credential or network.
+const remoteCheckTimeout = 5 * time.Second
+
+// GitRemotesCheck verifies that a rig's clones have a working origin
+// remote and agree on the URL they point at. A missing or unauthenticated
+// origin surfaces as a hard-to-diagnose failure much later (a stuck
+// refinery merge, a polecat that can't push), so this check catches it
+// up front instead.
+type GitRemotesCheck struct {
+	BaseCheck
+}
+
+// NewGitRemotesCheck creates a new git remotes check.
+func NewGitRemotesCheck() *GitRemotesCheck {
+	return &GitRemotesCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "git-remotes",
+			CheckDescription: "Verify origin remote exists, is reachable, and is consistent across worktrees",
+			CheckCategory:    CategoryRig,
+		},
+	}
+}
+
+// Run checks the rig's canonical clone for a working origin remote, then
+// compares the origin URL against the mayor/refinery/crew worktrees.
+func (c *GitRemotesCheck) Run(ctx *CheckContext) *CheckResult {
+	if ctx.RigName == "" {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No rig specified, skipping git remotes check",
+		}
+	}
+
+	canonical := filepath.Join(ctx.RigPath(), "mayor", "rig")
+	if !c.isGitRepo(canonical) {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No canonical clone to check",
+		}
+	}
+
+	originURL, err := c.remoteURL(canonical)
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: "mayor/rig has no origin remote",
+			Details: []string{err.Error()},
+		}
+	}
+
+	var warnings []string
+
+	if err := c.checkReachable(canonical); err != nil {
+		warnings = append(warnings, fmt.Sprintf("origin %s is unreachable: %s", originURL, err.Error()))
+	}
+
+	for _, wt := range c.worktrees(ctx.RigPath()) {
+		wtURL, err := c.remoteURL(wt.path)
+		if err != nil {
+			continue // no origin at all is reported by the canonical clone above
+		}
+		if wtURL != originURL {
+			warnings = append(warnings, fmt.Sprintf("%s has origin %s, expected %s (from mayor/rig)", wt.label, wtURL, originURL))
+		}
+	}
+
+	if len(warnings) > 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("%d git remote issue(s) found", len(warnings)),
+			Details: warnings,
+		}
+	}
+
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusOK,
+		Message: fmt.Sprintf("origin %s reachable and consistent", originURL),
+	}
+}
+
+// worktree pairs a clone path with a human-readable label for Details output.
+type worktree struct {
+	path  string
+	label string
+}
+
+// worktrees returns the mayor/refinery/crew clones for a rig, labeled so
+// warnings can say exactly which one diverged.
+func (c *GitRemotesCheck) worktrees(rigPath string) []worktree {
+	var found []worktree
+
+	for _, wt := range []worktree{
+		{path: filepath.Join(rigPath, "mayor", "rig"), label: "mayor/rig"},
+		{path: filepath.Join(rigPath, "refinery", "rig"), label: "refinery/rig"},
+	} {
+		if c.isGitRepo(wt.path) {
+			found = append(found, wt)
+		}
+	}
+
+	crewPath := filepath.Join(rigPath, "crew")
+	entries, err := os.ReadDir(crewPath)
+	if err != nil {
+		return found
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(crewPath, entry.Name())
+		if c.isGitRepo(path) {
+			found = append(found, worktree{path: path, label: "crew/" + entry.Name()})
+		}
+	}
+
+	return found
+}
+
+// isGitRepo checks if a directory is a git repository.
+func (c *GitRemotesCheck) isGitRepo(path string) bool {
+	_, err := os.Stat(filepath.Join(path, ".git"))
+	return err == nil
+}
+
+// remoteURL returns the configured origin URL for a clone.
+func (c *GitRemotesCheck) remoteURL(path string) (string, error) {
+	cmd := exec.Command("git", "-C", path, "remote", "get-url", "origin")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%s", msg)
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// checkReachable confirms origin can actually be queried (auth + connectivity)
+// by running a lightweight `git ls-remote`, bounded so a dead credential
+// doesn't hang the whole doctor run.
+func (c *GitRemotesCheck) checkReachable(path string) error {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), remoteCheckTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(timeoutCtx, "git", "-C", path, "ls-remote", "--heads", "origin")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if timeoutCtx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("timed out after %s", remoteCheckTimeout)
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%s", msg)
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package doctor
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func addOrigin(t *testing.T, path, url string) {
+	t.Helper()
+	cmd := exec.Command("git", "remote", "add", "origin", url)
+	cmd.Dir = path
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git remote add failed: %v\n%s", err, out)
+	}
+}
+
+func TestNewGitRemotesCheck(t *testing.T) {
+	check := NewGitRemotesCheck()
+
+	if check.Name() != "git-remotes" {
+		t.Errorf("expected name 'git-remotes', got %q", check.Name())
+	}
+	if check.CanFix() {
+		t.Error("expected CanFix to return false; git-remotes has no auto-fix")
+	}
+}
+
+func TestGitRemotesCheck_NoRigSpecified(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	check := NewGitRemotesCheck()
+	ctx := &CheckContext{TownRoot: tmpDir, RigName: ""}
+
+	result := check.Run(ctx)
+
+	if result.Status != StatusOK {
+		t.Errorf("expected StatusOK when no rig specified, got %v", result.Status)
+	}
+}
+
+func TestGitRemotesCheck_NoCanonicalClone(t *testing.T) {
+	tmpDir := t.TempDir()
+	rigName := "testrig"
+	if err := os.MkdirAll(filepath.Join(tmpDir, rigName), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	check := NewGitRemotesCheck()
+	ctx := &CheckContext{TownRoot: tmpDir, RigName: rigName}
+
+	result := check.Run(ctx)
+
+	if result.Status != StatusOK {
+		t.Errorf("expected StatusOK when mayor/rig doesn't exist, got %v", result.Status)
+	}
+}
+
+func TestGitRemotesCheck_MissingOrigin(t *testing.T) {
+	tmpDir := t.TempDir()
+	rigName := "testrig"
+	rigDir := filepath.Join(tmpDir, rigName)
+
+	mayorRig := filepath.Join(rigDir, "mayor", "rig")
+	initGitRepo(t, mayorRig)
+
+	check := NewGitRemotesCheck()
+	ctx := &CheckContext{TownRoot: tmpDir, RigName: rigName}
+
+	result := check.Run(ctx)
+
+	if result.Status != StatusError {
+		t.Errorf("expected StatusError when origin is missing, got %v", result.Status)
+	}
+	if !strings.Contains(result.Message, "no origin remote") {
+		t.Errorf("expected message about missing origin, got %q", result.Message)
+	}
+}
+
+func TestGitRemotesCheck_UnreachableOrigin(t *testing.T) {
+	tmpDir := t.TempDir()
+	rigName := "testrig"
+	rigDir := filepath.Join(tmpDir, rigName)
+
+	mayorRig := filepath.Join(rigDir, "mayor", "rig")
+	initGitRepo(t, mayorRig)
+	badURL := filepath.Join(tmpDir, "does-not-exist.git")
+	addOrigin(t, mayorRig, badURL)
+
+	check := NewGitRemotesCheck()
+	ctx := &CheckContext{TownRoot: tmpDir, RigName: rigName}
+
+	result := check.Run(ctx)
+
+	if result.Status != StatusWarning {
+		t.Errorf("expected StatusWarning for unreachable origin, got %v", result.Status)
+	}
+	if len(result.Details) == 0 || !strings.Contains(result.Details[0], badURL) {
+		t.Errorf("expected details to include the failing remote URL %q, got %v", badURL, result.Details)
+	}
+}
+
+func TestGitRemotesCheck_DivergentWorktree(t *testing.T) {
+	tmpDir := t.TempDir()
+	rigName := "testrig"
+	rigDir := filepath.Join(tmpDir, rigName)
+
+	// Use a real bare repo so origin is reachable (isolates the divergence warning).
+	bareRepo := filepath.Join(tmpDir, "upstream.git")
+	if out, err := exec.Command("git", "init", "--bare", bareRepo).CombinedOutput(); err != nil {
+		t.Fatalf("git init --bare failed: %v\n%s", err, out)
+	}
+
+	mayorRig := filepath.Join(rigDir, "mayor", "rig")
+	initGitRepo(t, mayorRig)
+	addOrigin(t, mayorRig, bareRepo)
+
+	refineryRig := filepath.Join(rigDir, "refinery", "rig")
+	initGitRepo(t, refineryRig)
+	addOrigin(t, refineryRig, filepath.Join(tmpDir, "some-other-upstream.git"))
+
+	check := NewGitRemotesCheck()
+	ctx := &CheckContext{TownRoot: tmpDir, RigName: rigName}
+
+	result := check.Run(ctx)
+
+	if result.Status != StatusWarning {
+		t.Errorf("expected StatusWarning for divergent worktree, got %v", result.Status)
+	}
+	found := false
+	for _, d := range result.Details {
+		if strings.HasPrefix(d, "refinery/rig ") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected details to name refinery/rig as the divergent worktree, got %v", result.Details)
+	}
+}
+
+func TestGitRemotesCheck_ConsistentOrigin(t *testing.T) {
+	tmpDir := t.TempDir()
+	rigName := "testrig"
+	rigDir := filepath.Join(tmpDir, rigName)
+
+	bareRepo := filepath.Join(tmpDir, "upstream.git")
+	if out, err := exec.Command("git", "init", "--bare", bareRepo).CombinedOutput(); err != nil {
+		t.Fatalf("git init --bare failed: %v\n%s", err, out)
+	}
+
+	mayorRig := filepath.Join(rigDir, "mayor", "rig")
+	initGitRepo(t, mayorRig)
+	addOrigin(t, mayorRig, bareRepo)
+
+	refineryRig := filepath.Join(rigDir, "refinery", "rig")
+	initGitRepo(t, refineryRig)
+	addOrigin(t, refineryRig, bareRepo)
+
+	check := NewGitRemotesCheck()
+	ctx := &CheckContext{TownRoot: tmpDir, RigName: rigName}
+
+	result := check.Run(ctx)
+
+	if result.Status != StatusOK {
+		t.Errorf("expected StatusOK when origin is reachable and consistent, got %v (details: %v)", result.Status, result.Details)
+	}
+}
@@ -0,0 +1,20 @@
+//go:build !windows
+
+package doctor
+
+import "syscall"
+
+// isProcessRunning checks if a process with the given PID exists.
+func isProcessRunning(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+
+	err := syscall.Kill(pid, 0)
+	if err == nil {
+		return true
+	}
+
+	// EPERM means process exists but we don't have permission to signal it.
+	return err == syscall.EPERM
+}
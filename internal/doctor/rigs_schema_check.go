@@ -0,0 +1,75 @@
+package doctor
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// RigsSchemaCheck runs config.Validate over the rigs registry, surfacing
+// the same Problems LoadRigsConfig would soft-fail on (migrated legacy
+// fields, entries missing required identifiers) so they show up during a
+// routine `gt doctor` pass rather than only when something breaks.
+type RigsSchemaCheck struct {
+	BaseCheck
+}
+
+// NewRigsSchemaCheck creates a new rigs registry schema check.
+func NewRigsSchemaCheck() *RigsSchemaCheck {
+	return &RigsSchemaCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "rigs-registry-schema",
+			CheckDescription: "Check mayor/rigs.json against the rigs schema",
+			CheckCategory:    CategoryConfig,
+		},
+	}
+}
+
+// Run validates the rigs registry, if present.
+func (c *RigsSchemaCheck) Run(ctx *CheckContext) *CheckResult {
+	path := constants.MayorRigsPath(ctx.TownRoot)
+
+	rigsConfig, problems, err := config.LoadRigsConfigWithProblems(path)
+	if errors.Is(err, config.ErrNotFound) {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No rigs.json (covered by rigs-registry-exists)",
+		}
+	}
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: fmt.Sprintf("rigs.json failed to load: %v", err),
+		}
+	}
+	problems = append(problems, config.Validate(rigsConfig)...)
+
+	if len(problems) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: fmt.Sprintf("%d rig(s) match the current schema", len(rigsConfig.Rigs)),
+		}
+	}
+
+	var details []string
+	status := StatusWarning
+	for _, p := range problems {
+		details = append(details, p.String())
+		if p.Severity == config.SeverityError {
+			status = StatusError
+		}
+	}
+
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  status,
+		Message: fmt.Sprintf("%d problem(s) found in rigs.json", len(problems)),
+		Details: details,
+		FixHint: "Edit mayor/rigs.json, or re-save it with `gt rig` commands to migrate legacy fields",
+	}
+}
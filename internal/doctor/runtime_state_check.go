@@ -0,0 +1,153 @@
+package doctor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// legacyRuntimeStateFiles are state files written by pre-ZFC (Zero File
+// Config) versions of refinery/witness, before running state moved to being
+// derived entirely from tmux session existence (see refinery.Manager and
+// witness.Manager doc comments). Towns upgraded from that era can still have
+// these lying around in .runtime/, claiming "running" with a PID from a box
+// that's long gone - nothing reads them anymore, but they're confusing
+// leftovers worth cleaning up.
+var legacyRuntimeStateFiles = map[string]string{
+	"refinery.json": "gt-%s-refinery",
+	"witness.json":  "gt-%s-witness",
+}
+
+// staleRuntimeState is a stale legacy state file found during Run, cached for Fix.
+type staleRuntimeState struct {
+	rigName  string
+	path     string
+	fileName string
+	raw      map[string]any
+}
+
+// RuntimeStateCheck detects stale legacy refinery/witness state files that
+// claim StateRunning but whose PID and tmux session are both gone.
+type RuntimeStateCheck struct {
+	FixableCheck
+	stale []staleRuntimeState
+}
+
+// NewRuntimeStateCheck creates a new runtime state check.
+func NewRuntimeStateCheck() *RuntimeStateCheck {
+	return &RuntimeStateCheck{
+		FixableCheck: FixableCheck{
+			BaseCheck: BaseCheck{
+				CheckName:        "runtime-state",
+				CheckDescription: "Detect stale legacy refinery/witness state files",
+				CheckCategory:    CategoryCleanup,
+			},
+		},
+	}
+}
+
+// Run checks each rig's .runtime/ directory for legacy state files claiming
+// StateRunning that neither a live PID nor a live tmux session backs up.
+func (c *RuntimeStateCheck) Run(ctx *CheckContext) *CheckResult {
+	c.stale = nil
+
+	rigs, err := discoverRigs(ctx.TownRoot)
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: "Failed to discover rigs",
+			Details: []string{err.Error()},
+		}
+	}
+
+	t := tmux.NewTmux()
+	var details []string
+	for _, rigName := range rigs {
+		rigPath := filepath.Join(ctx.TownRoot, rigName)
+		for fileName, sessionPattern := range legacyRuntimeStateFiles {
+			statePath := filepath.Join(rigPath, ".runtime", fileName)
+			raw, ok := readLegacyState(statePath)
+			if !ok || raw["state"] != "running" {
+				continue
+			}
+
+			hasSession, _ := t.HasSession(fmt.Sprintf(sessionPattern, rigName))
+			if hasSession || pidAlive(raw["pid"]) {
+				continue
+			}
+
+			c.stale = append(c.stale, staleRuntimeState{rigName: rigName, path: statePath, fileName: fileName, raw: raw})
+			details = append(details, fmt.Sprintf("%s/%s claims running with no live PID or tmux session", rigName, fileName))
+		}
+	}
+
+	if len(c.stale) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No stale runtime state files found",
+		}
+	}
+
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("Found %d stale runtime state file(s)", len(c.stale)),
+		Details: details,
+		FixHint: "Run 'gt doctor --fix' to mark them stopped (backs up the original file)",
+	}
+}
+
+// Fix backs up each stale state file and rewrites its state to "stopped".
+func (c *RuntimeStateCheck) Fix(ctx *CheckContext) error {
+	for _, s := range c.stale {
+		data, err := os.ReadFile(s.path) //nolint:gosec // G304: path built from discovered rig names, not user input
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", s.path, err)
+		}
+		if err := os.WriteFile(s.path+".bak", data, 0644); err != nil { //nolint:gosec // G306: matches source file's own permissions intent
+			return fmt.Errorf("backing up %s: %w", s.path, err)
+		}
+
+		s.raw["state"] = "stopped"
+		delete(s.raw, "pid")
+		updated, err := json.MarshalIndent(s.raw, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding %s: %w", s.path, err)
+		}
+		if err := os.WriteFile(s.path, updated, 0644); err != nil { //nolint:gosec // G306: matches source file's own permissions intent
+			return fmt.Errorf("writing %s: %w", s.path, err)
+		}
+	}
+	return nil
+}
+
+// readLegacyState loads a legacy state file as a generic map so unrecognized
+// fields round-trip untouched through Fix. Returns ok=false if the file
+// doesn't exist or isn't valid JSON.
+func readLegacyState(path string) (map[string]any, bool) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path built from discovered rig names, not user input
+	if err != nil {
+		return nil, false
+	}
+
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, false
+	}
+	return raw, true
+}
+
+// pidAlive reports whether v (the "pid" field of a legacy state file) names a
+// currently running process.
+func pidAlive(v any) bool {
+	f, ok := v.(float64)
+	if !ok || f <= 0 {
+		return false
+	}
+	return isProcessRunning(int(f))
+}
@@ -0,0 +1,140 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func writeRigsJSON(t *testing.T, townRoot string, rigNames ...string) {
+	t.Helper()
+	mayorDir := filepath.Join(townRoot, "mayor")
+	if err := os.MkdirAll(mayorDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	rigs := `{"version": 1, "rigs": {`
+	for i, name := range rigNames {
+		if i > 0 {
+			rigs += ","
+		}
+		rigs += `"` + name + `": {"git_url": "https://example.com/repo.git", "added_at": "2026-01-01T00:00:00Z"}`
+	}
+	rigs += `}}`
+
+	if err := os.WriteFile(filepath.Join(mayorDir, "rigs.json"), []byte(rigs), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writeLegacyStateFile(t *testing.T, townRoot, rigName, fileName, contents string) string {
+	t.Helper()
+	runtimeDir := filepath.Join(townRoot, rigName, ".runtime")
+	if err := os.MkdirAll(runtimeDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(runtimeDir, fileName)
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestNewRuntimeStateCheck(t *testing.T) {
+	check := NewRuntimeStateCheck()
+
+	if check.Name() != "runtime-state" {
+		t.Errorf("Name() = %q, want %q", check.Name(), "runtime-state")
+	}
+	if !check.CanFix() {
+		t.Error("CanFix() = false, want true")
+	}
+	if check.Category() != CategoryCleanup {
+		t.Errorf("Category() = %q, want %q", check.Category(), CategoryCleanup)
+	}
+}
+
+func TestRuntimeStateCheck_Run_NoStateFiles(t *testing.T) {
+	townRoot := t.TempDir()
+	writeRigsJSON(t, townRoot, "myrig")
+
+	check := NewRuntimeStateCheck()
+	result := check.Run(&CheckContext{TownRoot: townRoot})
+
+	if result.Status != StatusOK {
+		t.Errorf("Status = %v, want StatusOK: %s", result.Status, result.Message)
+	}
+}
+
+func TestRuntimeStateCheck_Run_FlagsStaleRunningState(t *testing.T) {
+	townRoot := t.TempDir()
+	writeRigsJSON(t, townRoot, "myrig")
+	writeLegacyStateFile(t, townRoot, "myrig", "refinery.json", `{"state": "running", "pid": 999999999}`)
+
+	check := NewRuntimeStateCheck()
+	result := check.Run(&CheckContext{TownRoot: townRoot})
+
+	if result.Status != StatusWarning {
+		t.Fatalf("Status = %v, want StatusWarning: %s", result.Status, result.Message)
+	}
+	if len(check.stale) != 1 {
+		t.Fatalf("stale = %d entries, want 1", len(check.stale))
+	}
+}
+
+func TestRuntimeStateCheck_Run_IgnoresStoppedState(t *testing.T) {
+	townRoot := t.TempDir()
+	writeRigsJSON(t, townRoot, "myrig")
+	writeLegacyStateFile(t, townRoot, "myrig", "witness.json", `{"state": "stopped"}`)
+
+	check := NewRuntimeStateCheck()
+	result := check.Run(&CheckContext{TownRoot: townRoot})
+
+	if result.Status != StatusOK {
+		t.Errorf("Status = %v, want StatusOK: %s", result.Status, result.Message)
+	}
+}
+
+func TestRuntimeStateCheck_Run_IgnoresRunningWithLivePID(t *testing.T) {
+	townRoot := t.TempDir()
+	writeRigsJSON(t, townRoot, "myrig")
+	// os.Getpid() is definitely alive - this process.
+	writeLegacyStateFile(t, townRoot, "myrig", "refinery.json",
+		`{"state": "running", "pid": `+strconv.Itoa(os.Getpid())+`}`)
+
+	check := NewRuntimeStateCheck()
+	result := check.Run(&CheckContext{TownRoot: townRoot})
+
+	if result.Status != StatusOK {
+		t.Errorf("Status = %v, want StatusOK: %s", result.Status, result.Message)
+	}
+}
+
+func TestRuntimeStateCheck_Fix_BacksUpAndRewritesStopped(t *testing.T) {
+	townRoot := t.TempDir()
+	writeRigsJSON(t, townRoot, "myrig")
+	statePath := writeLegacyStateFile(t, townRoot, "myrig", "refinery.json", `{"state": "running", "pid": 999999999}`)
+
+	check := NewRuntimeStateCheck()
+	check.Run(&CheckContext{TownRoot: townRoot})
+
+	if err := check.Fix(&CheckContext{TownRoot: townRoot}); err != nil {
+		t.Fatalf("Fix() error = %v", err)
+	}
+
+	if _, err := os.Stat(statePath + ".bak"); err != nil {
+		t.Errorf("expected backup file to exist: %v", err)
+	}
+
+	raw, ok := readLegacyState(statePath)
+	if !ok {
+		t.Fatal("state file no longer valid JSON after Fix()")
+	}
+	if raw["state"] != "stopped" {
+		t.Errorf("state = %v, want %q", raw["state"], "stopped")
+	}
+	if _, hasPID := raw["pid"]; hasPID {
+		t.Error("expected stale pid field to be removed")
+	}
+}
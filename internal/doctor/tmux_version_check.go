@@ -0,0 +1,234 @@
+package doctor
+
+import (
+	"fmt"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// minTmuxMajor and minTmuxMinor are the oldest tmux release Gas Town
+// supports. Below this, respawn-pane, pipe-pane's %- format specifiers, and
+// set-environment for session-scoped vars behave differently or are missing
+// outright, producing confusing failures deep inside gt handoff or gt up
+// rather than a clear "upgrade tmux" message.
+const (
+	minTmuxMajor = 3
+	minTmuxMinor = 2
+)
+
+// quirkyTmuxVersions maps exact "major.minor[suffix]" strings to a note about
+// a known behavioral quirk in that specific release, for versions that meet
+// the minimum but are still worth flagging.
+var quirkyTmuxVersions = map[string]string{
+	"3.2": "3.2 (no letter suffix) has a documented control-mode / pipe-pane regression fixed in 3.2a - upgrade if you hit garbled pane output",
+}
+
+var tmuxVersionPattern = regexp.MustCompile(`tmux\s+(next-)?(\d+)\.(\d+)([a-z]*)`)
+
+// tmuxVersionInfo is the parsed result of `tmux -V`.
+type tmuxVersionInfo struct {
+	Major     int
+	Minor     int
+	Suffix    string // e.g. "a" in "3.2a"
+	IsNext    bool   // true for unstable "next-X.Y" builds
+	RawString string
+}
+
+// String reconstructs a display form of the version, e.g. "3.2a" or "next-3.4".
+func (v tmuxVersionInfo) String() string {
+	base := fmt.Sprintf("%d.%d%s", v.Major, v.Minor, v.Suffix)
+	if v.IsNext {
+		return "next-" + base
+	}
+	return base
+}
+
+// belowMinimum reports whether this version predates minTmuxMajor.minTmuxMinor.
+func (v tmuxVersionInfo) belowMinimum() bool {
+	if v.Major != minTmuxMajor {
+		return v.Major < minTmuxMajor
+	}
+	return v.Minor < minTmuxMinor
+}
+
+// parseTmuxVersion parses the output of `tmux -V` (e.g. "tmux 3.2a" or
+// "tmux next-3.4") into a tmuxVersionInfo.
+func parseTmuxVersion(output string) (tmuxVersionInfo, error) {
+	match := tmuxVersionPattern.FindStringSubmatch(strings.TrimSpace(output))
+	if match == nil {
+		return tmuxVersionInfo{}, fmt.Errorf("unrecognized tmux -V output: %q", output)
+	}
+
+	major, err := strconv.Atoi(match[2])
+	if err != nil {
+		return tmuxVersionInfo{}, fmt.Errorf("parsing major version from %q: %w", output, err)
+	}
+	minor, err := strconv.Atoi(match[3])
+	if err != nil {
+		return tmuxVersionInfo{}, fmt.Errorf("parsing minor version from %q: %w", output, err)
+	}
+
+	return tmuxVersionInfo{
+		Major:     major,
+		Minor:     minor,
+		Suffix:    match[4],
+		IsNext:    match[1] != "",
+		RawString: strings.TrimSpace(output),
+	}, nil
+}
+
+// tmuxVersionRunner abstracts running tmux for testing.
+type tmuxVersionRunner interface {
+	Version() (string, error)
+	ProbeServer() error
+}
+
+// realTmuxRunner shells out to the real tmux binary.
+type realTmuxRunner struct{}
+
+func (realTmuxRunner) Version() (string, error) {
+	out, err := exec.Command("tmux", "-V").Output()
+	return string(out), err
+}
+
+// ProbeServer verifies the tmux server is reachable by creating and
+// immediately killing a throwaway session. This is dry-run-safe: the
+// session name is unique per probe and is always cleaned up, so it leaves
+// no trace on success or failure.
+func (realTmuxRunner) ProbeServer() error {
+	t := tmux.NewTmux()
+	probeName := fmt.Sprintf("gt-doctor-tmux-probe-%d", time.Now().UnixNano())
+	if err := t.NewSession(probeName, ""); err != nil {
+		return err
+	}
+	return t.KillSession(probeName)
+}
+
+// TmuxVersionCheck verifies the installed tmux is new enough and its server
+// is reachable. Gas Town relies on respawn-pane, pipe-pane formats, and
+// set-environment behavior that changed across tmux releases; old versions
+// fail in confusing ways deep inside handoff or session start rather than
+// with a clear version error.
+type TmuxVersionCheck struct {
+	BaseCheck
+	runner tmuxVersionRunner // nil means use the real tmux binary
+}
+
+// NewTmuxVersionCheck creates a new tmux version/reachability check.
+func NewTmuxVersionCheck() *TmuxVersionCheck {
+	return &TmuxVersionCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "tmux-version",
+			CheckDescription: "Verify tmux meets the minimum version and its server is reachable",
+			CheckCategory:    CategoryInfrastructure,
+		},
+	}
+}
+
+// NewTmuxVersionCheckWithRunner creates a check with a custom runner (for testing).
+func NewTmuxVersionCheckWithRunner(runner tmuxVersionRunner) *TmuxVersionCheck {
+	c := NewTmuxVersionCheck()
+	c.runner = runner
+	return c
+}
+
+// Run parses `tmux -V`, checks it against the minimum supported version, and
+// verifies the server is reachable. There is no Fix() for this check -
+// upgrading tmux is outside what Gas Town can do for the user.
+func (c *TmuxVersionCheck) Run(ctx *CheckContext) *CheckResult {
+	runner := c.runner
+	if runner == nil {
+		runner = realTmuxRunner{}
+	}
+
+	raw, err := runner.Version()
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: "Could not run 'tmux -V'",
+			Details: append([]string{err.Error()}, upgradeGuidance()...),
+		}
+	}
+
+	version, err := parseTmuxVersion(raw)
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: "Could not parse tmux version, skipping compatibility check",
+			Details: []string{err.Error()},
+		}
+	}
+
+	if version.belowMinimum() {
+		return &CheckResult{
+			Name:   c.Name(),
+			Status: StatusError,
+			Message: fmt.Sprintf("tmux %s is older than the minimum supported %d.%d",
+				version, minTmuxMajor, minTmuxMinor),
+			Details: append([]string{
+				"respawn-pane, pipe-pane formats, and set-environment behave differently before " +
+					fmt.Sprintf("%d.%d", minTmuxMajor, minTmuxMinor) + " - handoff and session start may fail in confusing ways.",
+			}, upgradeGuidance()...),
+		}
+	}
+
+	var details []string
+	status := StatusOK
+	message := fmt.Sprintf("tmux %s (server reachable)", version)
+
+	key := fmt.Sprintf("%d.%d%s", version.Major, version.Minor, version.Suffix)
+	if note, quirky := quirkyTmuxVersions[key]; quirky {
+		status = StatusWarning
+		message = fmt.Sprintf("tmux %s is known to be quirky", version)
+		details = append(details, note)
+	}
+	if version.IsNext {
+		status = StatusWarning
+		message = fmt.Sprintf("tmux %s is an unstable development build", version)
+		details = append(details, "next-* builds track tmux master and can change behavior without notice - prefer a tagged release for daily use.")
+	}
+
+	if err := runner.ProbeServer(); err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: fmt.Sprintf("tmux %s found, but the server is not reachable", version),
+			Details: append([]string{err.Error()}, "gt up and gt handoff need a working tmux server."),
+		}
+	}
+
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  status,
+		Message: message,
+		Details: details,
+	}
+}
+
+// upgradeGuidance returns platform-specific tmux upgrade instructions.
+func upgradeGuidance() []string {
+	guidance := []string{
+		fmt.Sprintf("Gas Town requires tmux %d.%d or newer.", minTmuxMajor, minTmuxMinor),
+	}
+	switch runtime.GOOS {
+	case "darwin":
+		guidance = append(guidance, "macOS: brew install tmux (or brew upgrade tmux)")
+	case "linux":
+		guidance = append(guidance,
+			"Debian/Ubuntu: apt install tmux (Ubuntu <22.04 ships an old tmux - consider a backport or building from source)",
+			"Fedora/RHEL: dnf install tmux",
+			"Arch: pacman -S tmux",
+		)
+	default:
+		guidance = append(guidance, "See https://github.com/tmux/tmux/wiki/Installing for platform instructions.")
+	}
+	return guidance
+}
@@ -0,0 +1,131 @@
+package doctor
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseTmuxVersion(t *testing.T) {
+	tests := []struct {
+		input      string
+		wantMajor  int
+		wantMinor  int
+		wantSuffix string
+		wantNext   bool
+	}{
+		{"tmux 3.2\n", 3, 2, "", false},
+		{"tmux 3.2a", 3, 2, "a", false},
+		{"tmux 3.3a", 3, 3, "a", false},
+		{"tmux next-3.4", 3, 4, "", true},
+		{"tmux 1.8", 1, 8, "", false},
+	}
+
+	for _, tt := range tests {
+		got, err := parseTmuxVersion(tt.input)
+		if err != nil {
+			t.Errorf("parseTmuxVersion(%q) returned error: %v", tt.input, err)
+			continue
+		}
+		if got.Major != tt.wantMajor || got.Minor != tt.wantMinor || got.Suffix != tt.wantSuffix || got.IsNext != tt.wantNext {
+			t.Errorf("parseTmuxVersion(%q) = %+v, want major=%d minor=%d suffix=%q next=%v",
+				tt.input, got, tt.wantMajor, tt.wantMinor, tt.wantSuffix, tt.wantNext)
+		}
+	}
+}
+
+func TestParseTmuxVersion_Unrecognized(t *testing.T) {
+	if _, err := parseTmuxVersion("not a version string"); err == nil {
+		t.Fatal("expected error for unrecognized version string, got nil")
+	}
+}
+
+func TestTmuxVersionInfo_BelowMinimum(t *testing.T) {
+	tests := []struct {
+		version tmuxVersionInfo
+		want    bool
+	}{
+		{tmuxVersionInfo{Major: 1, Minor: 8}, true},
+		{tmuxVersionInfo{Major: 3, Minor: 1}, true},
+		{tmuxVersionInfo{Major: 3, Minor: 2}, false},
+		{tmuxVersionInfo{Major: 3, Minor: 3}, false},
+		{tmuxVersionInfo{Major: 4, Minor: 0}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.version.belowMinimum(); got != tt.want {
+			t.Errorf("%+v.belowMinimum() = %v, want %v", tt.version, got, tt.want)
+		}
+	}
+}
+
+// stubTmuxVersionRunner implements tmuxVersionRunner for testing.
+type stubTmuxVersionRunner struct {
+	version    string
+	versionErr error
+	probeErr   error
+}
+
+func (s *stubTmuxVersionRunner) Version() (string, error) {
+	return s.version, s.versionErr
+}
+
+func (s *stubTmuxVersionRunner) ProbeServer() error {
+	return s.probeErr
+}
+
+func TestTmuxVersionCheck_Run_OK(t *testing.T) {
+	c := NewTmuxVersionCheckWithRunner(&stubTmuxVersionRunner{version: "tmux 3.3a"})
+	result := c.Run(&CheckContext{})
+	if result.Status != StatusOK {
+		t.Errorf("Status = %v, want StatusOK; message: %s", result.Status, result.Message)
+	}
+}
+
+func TestTmuxVersionCheck_Run_TooOld(t *testing.T) {
+	c := NewTmuxVersionCheckWithRunner(&stubTmuxVersionRunner{version: "tmux 1.9a"})
+	result := c.Run(&CheckContext{})
+	if result.Status != StatusError {
+		t.Errorf("Status = %v, want StatusError", result.Status)
+	}
+}
+
+func TestTmuxVersionCheck_Run_QuirkyVersionWarns(t *testing.T) {
+	c := NewTmuxVersionCheckWithRunner(&stubTmuxVersionRunner{version: "tmux 3.2"})
+	result := c.Run(&CheckContext{})
+	if result.Status != StatusWarning {
+		t.Errorf("Status = %v, want StatusWarning", result.Status)
+	}
+}
+
+func TestTmuxVersionCheck_Run_NextBuildWarns(t *testing.T) {
+	c := NewTmuxVersionCheckWithRunner(&stubTmuxVersionRunner{version: "tmux next-3.4"})
+	result := c.Run(&CheckContext{})
+	if result.Status != StatusWarning {
+		t.Errorf("Status = %v, want StatusWarning", result.Status)
+	}
+}
+
+func TestTmuxVersionCheck_Run_VersionCommandFails(t *testing.T) {
+	c := NewTmuxVersionCheckWithRunner(&stubTmuxVersionRunner{versionErr: errors.New("tmux: command not found")})
+	result := c.Run(&CheckContext{})
+	if result.Status != StatusError {
+		t.Errorf("Status = %v, want StatusError", result.Status)
+	}
+}
+
+func TestTmuxVersionCheck_Run_ServerUnreachable(t *testing.T) {
+	c := NewTmuxVersionCheckWithRunner(&stubTmuxVersionRunner{
+		version:  "tmux 3.3a",
+		probeErr: errors.New("no server running"),
+	})
+	result := c.Run(&CheckContext{})
+	if result.Status != StatusError {
+		t.Errorf("Status = %v, want StatusError", result.Status)
+	}
+}
+
+func TestTmuxVersionCheck_CanFix(t *testing.T) {
+	c := NewTmuxVersionCheck()
+	if c.CanFix() {
+		t.Error("TmuxVersionCheck should not be fixable")
+	}
+}
@@ -114,9 +114,10 @@ type ReportSummary struct {
 
 // Report contains all check results and a summary.
 type Report struct {
-	Timestamp time.Time
-	Checks    []*CheckResult
-	Summary   ReportSummary
+	Timestamp   time.Time
+	Checks      []*CheckResult
+	Summary     ReportSummary
+	FixAttempts []*FixAttempt // populated by Fix/FixInteractive; empty for Run
 }
 
 // NewReport creates an empty report with the current timestamp.
@@ -216,6 +217,31 @@ func (r *Report) Print(w io.Writer, verbose bool) {
 	r.printWarningsSection(w, warnings)
 }
 
+// PrintFixSummary outputs a report of what --fix did: which checks were
+// fixed, which were skipped by the user, and the post-fix re-check status
+// for each one that was applied. It is a no-op if no fixes were attempted.
+func (r *Report) PrintFixSummary(w io.Writer) {
+	if len(r.FixAttempts) == 0 {
+		return
+	}
+
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintln(w, ui.RenderCategory("Fix report"))
+
+	for _, attempt := range r.FixAttempts {
+		switch {
+		case !attempt.Applied:
+			_, _ = fmt.Fprintf(w, "  %s  %s%s\n", ui.RenderMuted("-"), attempt.CheckName, ui.RenderMuted(" skipped"))
+		case attempt.Error != "":
+			_, _ = fmt.Fprintf(w, "  %s  %s%s\n", ui.RenderFailIcon(), attempt.CheckName, ui.RenderMuted(" fix failed: "+attempt.Error))
+		case attempt.Recheck == StatusOK:
+			_, _ = fmt.Fprintf(w, "  %s  %s%s\n", ui.RenderPassIcon(), attempt.CheckName, ui.RenderMuted(" fixed"))
+		default:
+			_, _ = fmt.Fprintf(w, "  %s  %s%s\n", ui.RenderWarnIcon(), attempt.CheckName, ui.RenderMuted(" applied fix, still "+attempt.Recheck.String()))
+		}
+	}
+}
+
 // printCheck outputs a single check result with semantic styling.
 func (r *Report) printCheck(w io.Writer, check *CheckResult, verbose bool) {
 	var statusIcon string
@@ -257,12 +283,12 @@ func (r *Report) printSummary(w io.Writer) {
 func (r *Report) printWarningsSection(w io.Writer, warnings []*CheckResult) {
 	if len(warnings) == 0 {
 		_, _ = fmt.Fprintln(w)
-		_, _ = fmt.Fprintln(w, ui.RenderPass(ui.IconPass+" All checks passed"))
+		_, _ = fmt.Fprintln(w, ui.RenderPass(ui.IconPass()+" All checks passed"))
 		return
 	}
 
 	_, _ = fmt.Fprintln(w)
-	_, _ = fmt.Fprintln(w, ui.RenderWarn(ui.IconWarn+"  WARNINGS"))
+	_, _ = fmt.Fprintln(w, ui.RenderWarn(ui.IconWarn()+"  WARNINGS"))
 
 	// Sort by severity: errors first, then warnings
 	slices.SortStableFunc(warnings, func(a, b *CheckResult) int {
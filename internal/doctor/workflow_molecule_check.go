@@ -0,0 +1,117 @@
+package doctor
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// WorkflowMoleculeCheck verifies that any per-rig workflow molecule
+// overrides (settings/config.json's workflow.default_work_molecule,
+// workflow.swarm_task_molecule, workflow.freeform_molecule) name formulas
+// that actually exist, so gt sling doesn't fail mid-dispatch on a typo'd
+// or since-deleted formula name.
+type WorkflowMoleculeCheck struct {
+	BaseCheck
+}
+
+// NewWorkflowMoleculeCheck creates a new workflow molecule override check.
+func NewWorkflowMoleculeCheck() *WorkflowMoleculeCheck {
+	return &WorkflowMoleculeCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "workflow-molecule-overrides",
+			CheckDescription: "Check that rig workflow molecule overrides reference existing formulas",
+			CheckCategory:    CategoryConfig,
+		},
+	}
+}
+
+// Run checks each rig's workflow overrides against its accessible formulas.
+func (c *WorkflowMoleculeCheck) Run(ctx *CheckContext) *CheckResult {
+	rigs, err := discoverRigs(ctx.TownRoot)
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: "Failed to discover rigs",
+			Details: []string{err.Error()},
+		}
+	}
+
+	if len(rigs) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No rigs configured",
+		}
+	}
+
+	var details []string
+	checked := 0
+	for _, rigName := range rigs {
+		rigPath := filepath.Join(ctx.TownRoot, rigName)
+		settings, err := config.LoadRigSettings(config.RigSettingsPath(rigPath))
+		if err != nil || settings.Workflow == nil {
+			continue
+		}
+
+		for _, override := range c.overrides(settings.Workflow) {
+			checked++
+			if !c.formulaExists(rigPath, override.molecule) {
+				details = append(details, fmt.Sprintf("%s: %s references missing formula %q", rigName, override.field, override.molecule))
+			}
+		}
+	}
+
+	if len(details) > 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("%d workflow override(s) reference missing formulas", len(details)),
+			Details: details,
+			FixHint: "Fix the formula name in settings/config.json's workflow block, or run 'bd formula list' to see what's available",
+		}
+	}
+
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusOK,
+		Message: fmt.Sprintf("%d rig(s), %d workflow override(s) all reference existing formulas", len(rigs), checked),
+	}
+}
+
+type workflowOverride struct {
+	field    string
+	molecule string
+}
+
+// overrides lists the configured (non-empty) molecule overrides on wf.
+func (c *WorkflowMoleculeCheck) overrides(wf *config.WorkflowConfig) []workflowOverride {
+	var out []workflowOverride
+	if wf.DefaultWorkMolecule != "" {
+		out = append(out, workflowOverride{"default_work_molecule", wf.DefaultWorkMolecule})
+	}
+	if wf.SwarmTaskMolecule != "" {
+		out = append(out, workflowOverride{"swarm_task_molecule", wf.SwarmTaskMolecule})
+	}
+	if wf.FreeformMolecule != "" {
+		out = append(out, workflowOverride{"freeform_molecule", wf.FreeformMolecule})
+	}
+	return out
+}
+
+// formulaExists checks whether a formula is accessible from rigPath via
+// bd formula list, the same mechanism PatrolMoleculesExistCheck uses.
+func (c *WorkflowMoleculeCheck) formulaExists(rigPath, formulaName string) bool {
+	cmd := exec.Command("bd", "formula", "list")
+	cmd.Dir = rigPath
+	output, err := cmd.Output()
+	if err != nil {
+		// Can't check formulas - don't report a false positive.
+		return true
+	}
+	return strings.Contains(string(output), formulaName)
+}
@@ -0,0 +1,104 @@
+package doctor
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestNewWorkflowMoleculeCheck(t *testing.T) {
+	check := NewWorkflowMoleculeCheck()
+	if check == nil {
+		t.Fatal("NewWorkflowMoleculeCheck() returned nil")
+	}
+	if check.Name() != "workflow-molecule-overrides" {
+		t.Errorf("Name() = %q, want %q", check.Name(), "workflow-molecule-overrides")
+	}
+	if check.CanFix() {
+		t.Error("CanFix() should return false - this check has no automatic fix")
+	}
+}
+
+func TestWorkflowMoleculeCheck_Overrides(t *testing.T) {
+	check := NewWorkflowMoleculeCheck()
+
+	if got := check.overrides(&config.WorkflowConfig{}); len(got) != 0 {
+		t.Errorf("expected no overrides for an empty config, got %v", got)
+	}
+
+	wf := &config.WorkflowConfig{
+		DefaultWorkMolecule: "mol-default-work",
+		SwarmTaskMolecule:   "mol-swarm-work",
+		FreeformMolecule:    "mol-freeform-work",
+	}
+	got := check.overrides(wf)
+	if len(got) != 3 {
+		t.Fatalf("expected 3 overrides, got %d: %v", len(got), got)
+	}
+}
+
+func TestWorkflowMoleculeCheck_NoRigs(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	check := NewWorkflowMoleculeCheck()
+	ctx := &CheckContext{TownRoot: tmpDir}
+	result := check.Run(ctx)
+
+	if result.Status != StatusOK {
+		t.Errorf("Status = %v, want StatusOK", result.Status)
+	}
+	if result.Message != "No rigs configured" {
+		t.Errorf("Message = %q, want %q", result.Message, "No rigs configured")
+	}
+}
+
+func TestWorkflowMoleculeCheck_NoWorkflowOverridesConfigured(t *testing.T) {
+	tmpDir := t.TempDir()
+	setupRigConfig(t, tmpDir, []string{"greenplace"})
+
+	check := NewWorkflowMoleculeCheck()
+	ctx := &CheckContext{TownRoot: tmpDir}
+	result := check.Run(ctx)
+
+	if result.Status != StatusOK {
+		t.Errorf("Status = %v, want StatusOK, got details: %v", result.Status, result.Details)
+	}
+}
+
+func TestWorkflowMoleculeCheck_SkipsRigsWithoutSettings(t *testing.T) {
+	tmpDir := t.TempDir()
+	setupRigConfig(t, tmpDir, []string{"greenplace"})
+
+	settingsDir := filepath.Join(tmpDir, "greenplace", "settings")
+	if err := os.MkdirAll(settingsDir, 0755); err != nil {
+		t.Fatalf("mkdir settings: %v", err)
+	}
+	settings := config.RigSettings{
+		Type:    "rig-settings",
+		Version: 1,
+		Workflow: &config.WorkflowConfig{
+			DefaultWorkMolecule: "mol-custom-work",
+		},
+	}
+	data, err := json.Marshal(settings)
+	if err != nil {
+		t.Fatalf("marshal settings: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(settingsDir, "config.json"), data, 0644); err != nil {
+		t.Fatalf("write settings: %v", err)
+	}
+
+	check := NewWorkflowMoleculeCheck()
+	ctx := &CheckContext{TownRoot: tmpDir}
+	result := check.Run(ctx)
+
+	// bd isn't necessarily available in the test environment; formulaExists
+	// treats that as "can't check" rather than a false positive, so this
+	// exercises the settings-loading path without asserting on bd's output.
+	if result.Status != StatusOK && result.Status != StatusWarning {
+		t.Errorf("unexpected Status = %v", result.Status)
+	}
+}
@@ -1,7 +1,10 @@
 // Package events provides event logging for the gt activity feed.
 //
 // Events are written to ~/gt/.events.jsonl (raw audit log) and later
-// curated by the feed daemon into ~/.feed.jsonl (user-facing).
+// curated by the feed daemon into ~/.feed.jsonl (user-facing). The raw
+// log rotates to a timestamped segment once it grows past
+// maxSegmentBytes; Query reads across all segments transparently, and
+// PruneOldSegments reclaims rotated ones past a retention age.
 package events
 
 import (
@@ -55,9 +58,9 @@ const (
 	TypeMassDeath    = "mass_death"    // Multiple sessions died in short window
 
 	// Witness patrol events
-	TypePatrolStarted   = "patrol_started"
-	TypePolecatChecked  = "polecat_checked"
-	TypePolecatNudged   = "polecat_nudged"
+	TypePatrolStarted    = "patrol_started"
+	TypePolecatChecked   = "polecat_checked"
+	TypePolecatNudged    = "polecat_nudged"
 	TypeEscalationSent   = "escalation_sent"
 	TypeEscalationAcked  = "escalation_acked"
 	TypeEscalationClosed = "escalation_closed"
@@ -68,6 +71,17 @@ const (
 	TypeMerged       = "merged"
 	TypeMergeFailed  = "merge_failed"
 	TypeMergeSkipped = "merge_skipped"
+
+	// Agent and work lifecycle events, for reconstructing "what happened
+	// overnight" from the feed. These are deliberately a consistent shape
+	// across the whole agent/work lifecycle, unlike the older
+	// type-specific events above (TypeSpawn, TypeKill, TypeHandoff,
+	// TypeDone) which each grew their own payload for their own command.
+	TypeAgentSpawned = "agent_spawned"
+	TypeAgentStopped = "agent_stopped"
+	TypeAgentHandoff = "agent_handoff"
+	TypeWorkAssigned = "work_assigned"
+	TypeWorkDone     = "work_done"
 )
 
 // EventsFile is the name of the raw events log.
@@ -76,6 +90,14 @@ const EventsFile = ".events.jsonl"
 // mutex protects concurrent writes to the events file.
 var mutex sync.Mutex
 
+// Notify, if set, is called with the town root and every event that gets
+// written to the events feed. It's the seam the notifications subsystem
+// (internal/notify) hooks into, wired up by cmd/notify.go's init(), so
+// this package doesn't need to depend on notify's config/exec machinery.
+// Left nil, events are logged exactly as before. Notify is expected to
+// dispatch asynchronously - it must never block or slow down Log().
+var Notify func(townRoot string, event Event)
+
 // Log writes an event to the events log.
 // The event is appended to ~/gt/.events.jsonl.
 // Returns nil if logging fails (events are best-effort).
@@ -123,6 +145,10 @@ func write(event Event) error {
 	mutex.Lock()
 	defer mutex.Unlock()
 
+	if err := rotateIfNeeded(eventsPath); err != nil {
+		return fmt.Errorf("rotating events file: %w", err)
+	}
+
 	f, err := os.OpenFile(eventsPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint:gosec // G302: events file is non-sensitive operational data
 	if err != nil {
 		return fmt.Errorf("opening events file: %w", err)
@@ -133,6 +159,10 @@ func write(event Event) error {
 		return fmt.Errorf("writing event: %w", err)
 	}
 
+	if Notify != nil {
+		Notify(townRoot, event)
+	}
+
 	return nil
 }
 
@@ -200,16 +230,28 @@ func BootPayload(rig string, agents []string) map[string]interface{} {
 // mrID: merge request ID
 // worker: polecat name that submitted the work
 // branch: source branch being merged
+// issueID: the source issue the MR closes, if known
 // reason: failure reason (for merge_failed/merge_skipped events)
-func MergePayload(mrID, worker, branch, reason string) map[string]interface{} {
+// queueWait: time between the MR being registered and processing starting, if known
+// processing: time spent actually processing the MR (merge + tests), if known
+func MergePayload(mrID, worker, branch, issueID, reason string, queueWait, processing time.Duration) map[string]interface{} {
 	p := map[string]interface{}{
 		"mr":     mrID,
 		"worker": worker,
 		"branch": branch,
 	}
+	if issueID != "" {
+		p["issue_id"] = issueID
+	}
 	if reason != "" {
 		p["reason"] = reason
 	}
+	if queueWait > 0 {
+		p["queue_wait_seconds"] = queueWait.Seconds()
+	}
+	if processing > 0 {
+		p["processing_seconds"] = processing.Seconds()
+	}
 	return p
 }
 
@@ -311,6 +353,58 @@ func MassDeathPayload(count int, window string, sessions []string, possibleCause
 	return p
 }
 
+// AgentSpawnedPayload creates a payload for agent_spawned events.
+func AgentSpawnedPayload(rig, polecat string) map[string]interface{} {
+	return map[string]interface{}{
+		"rig":     rig,
+		"polecat": polecat,
+	}
+}
+
+// AgentStoppedPayload creates a payload for agent_stopped events.
+// reason: why the session was stopped (e.g. "shutdown", "force"), empty if unknown.
+func AgentStoppedPayload(rig, polecat, reason string) map[string]interface{} {
+	p := map[string]interface{}{
+		"rig":     rig,
+		"polecat": polecat,
+	}
+	if reason != "" {
+		p["reason"] = reason
+	}
+	return p
+}
+
+// AgentHandoffPayload creates a payload for agent_handoff events.
+func AgentHandoffPayload(agent, subject string, toSession bool) map[string]interface{} {
+	p := map[string]interface{}{
+		"agent":      agent,
+		"to_session": toSession,
+	}
+	if subject != "" {
+		p["subject"] = subject
+	}
+	return p
+}
+
+// WorkAssignedPayload creates a payload for work_assigned events.
+func WorkAssignedPayload(rig, polecat, issue string) map[string]interface{} {
+	return map[string]interface{}{
+		"rig":     rig,
+		"polecat": polecat,
+		"issue":   issue,
+	}
+}
+
+// WorkDonePayload creates a payload for work_done events.
+func WorkDonePayload(rig, polecat, issue, branch string) map[string]interface{} {
+	return map[string]interface{}{
+		"rig":     rig,
+		"polecat": polecat,
+		"issue":   issue,
+		"branch":  branch,
+	}
+}
+
 // SessionPayload creates a payload for session start/end events.
 // sessionID: Claude Code session UUID
 // role: Gas Town role (e.g., "gastown/crew/joe", "deacon")
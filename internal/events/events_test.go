@@ -0,0 +1,62 @@
+package events
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestMergePayload_OmitsUnknownFields(t *testing.T) {
+	p := MergePayload("mr-1", "nux", "polecat/nux/gt-abc", "", "", 0, 0)
+
+	for _, key := range []string{"issue_id", "reason", "queue_wait_seconds", "processing_seconds"} {
+		if _, ok := p[key]; ok {
+			t.Errorf("expected %q to be omitted when unknown, got %v", key, p[key])
+		}
+	}
+	if p["mr"] != "mr-1" || p["worker"] != "nux" || p["branch"] != "polecat/nux/gt-abc" {
+		t.Errorf("unexpected core fields: %+v", p)
+	}
+}
+
+func TestMergePayload_IncludesIssueIDAndDurations(t *testing.T) {
+	p := MergePayload("mr-1", "nux", "polecat/nux/gt-abc", "gt-abc", "", 90*time.Second, 42*time.Second)
+
+	if p["issue_id"] != "gt-abc" {
+		t.Errorf("issue_id = %v, want %q", p["issue_id"], "gt-abc")
+	}
+	if p["queue_wait_seconds"] != float64(90) {
+		t.Errorf("queue_wait_seconds = %v, want 90", p["queue_wait_seconds"])
+	}
+	if p["processing_seconds"] != float64(42) {
+		t.Errorf("processing_seconds = %v, want 42", p["processing_seconds"])
+	}
+	if _, ok := p["reason"]; ok {
+		t.Error("expected reason to be omitted when empty")
+	}
+}
+
+func TestMergePayload_FailureReasonRoundTripsThroughJSON(t *testing.T) {
+	p := MergePayload("mr-1", "nux", "polecat/nux/gt-abc", "gt-abc", "tests failed", 5*time.Second, 10*time.Second)
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if decoded["reason"] != "tests failed" {
+		t.Errorf("reason = %v, want %q", decoded["reason"], "tests failed")
+	}
+	if decoded["issue_id"] != "gt-abc" {
+		t.Errorf("issue_id = %v, want %q", decoded["issue_id"], "gt-abc")
+	}
+	if decoded["queue_wait_seconds"] != float64(5) {
+		t.Errorf("queue_wait_seconds = %v, want 5", decoded["queue_wait_seconds"])
+	}
+	if decoded["processing_seconds"] != float64(10) {
+		t.Errorf("processing_seconds = %v, want 10", decoded["processing_seconds"])
+	}
+}
@@ -0,0 +1,61 @@
+package events
+
+// TestAgentLifecycleEvents_OrderedInFeed simulates the emission points a
+// real polecat lifecycle goes through - spawn, work assignment, a
+// mid-session handoff, work completion, and finally the session being
+// stopped - using stubbed rig/polecat/issue identifiers instead of real
+// tmux/git/beads dependencies, and asserts the feed preserves that order
+// with the expected agent/work lifecycle types.
+
+import (
+	"testing"
+)
+
+func TestAgentLifecycleEvents_OrderedInFeed(t *testing.T) {
+	townRoot := withWorkspace(t)
+
+	const rig, polecat, issue, branch = "gastown", "Toast", "gt-100", "polecat/Toast/gt-100@abc"
+
+	// Emitted in the same order SpawnPolecatForSling, AssignIssue,
+	// runHandoff, the done flow, and SessionManager.Stop would produce it.
+	steps := []struct {
+		eventType string
+		payload   map[string]interface{}
+	}{
+		{TypeAgentSpawned, AgentSpawnedPayload(rig, polecat)},
+		{TypeWorkAssigned, WorkAssignedPayload(rig, polecat, issue)},
+		{TypeAgentHandoff, AgentHandoffPayload(rig+"/polecats/"+polecat, "context low", true)},
+		{TypeWorkDone, WorkDonePayload(rig, polecat, issue, branch)},
+		{TypeAgentStopped, AgentStoppedPayload(rig, polecat, "")},
+	}
+
+	for _, step := range steps {
+		if err := LogFeed(step.eventType, "gt", step.payload); err != nil {
+			t.Fatalf("LogFeed(%s): %v", step.eventType, err)
+		}
+	}
+
+	got, err := Query(townRoot, QueryOptions{})
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(got) != len(steps) {
+		t.Fatalf("len(got) = %d, want %d", len(got), len(steps))
+	}
+
+	// Query returns most-recent-first; reverse to check emission order.
+	wantTypes := make([]string, len(steps))
+	for i, step := range steps {
+		wantTypes[i] = step.eventType
+	}
+	gotTypes := make([]string, len(got))
+	for i, e := range got {
+		gotTypes[len(got)-1-i] = e.Type
+	}
+
+	for i := range wantTypes {
+		if gotTypes[i] != wantTypes[i] {
+			t.Errorf("event[%d] = %q, want %q (full order: %v)", i, gotTypes[i], wantTypes[i], gotTypes)
+		}
+	}
+}
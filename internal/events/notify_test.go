@@ -0,0 +1,64 @@
+package events
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// withWorkspace points GT_TOWN at a freshly created workspace directory so
+// Log/write can resolve a real townRoot, restoring the previous env value
+// after the test. Returns the workspace path (== townRoot).
+func withWorkspace(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "mayor"), 0755); err != nil {
+		t.Fatalf("mkdir mayor: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "mayor", "town.json"), []byte("{}"), 0644); err != nil {
+		t.Fatalf("write town.json: %v", err)
+	}
+	t.Setenv("GT_TOWN", dir)
+	return dir
+}
+
+func TestLog_CallsNotifyHook(t *testing.T) {
+	townRoot := withWorkspace(t)
+
+	var gotRoot string
+	var gotEvent Event
+	called := false
+	orig := Notify
+	Notify = func(townRoot string, event Event) {
+		called = true
+		gotRoot = townRoot
+		gotEvent = event
+	}
+	t.Cleanup(func() { Notify = orig })
+
+	if err := LogAudit(TypeMerged, "refinery", MergePayload("mr-1", "polecat-1", "feature", "", "", 0, 0)); err != nil {
+		t.Fatalf("LogAudit: %v", err)
+	}
+
+	if !called {
+		t.Fatal("Notify hook was not called")
+	}
+	if gotRoot != townRoot {
+		t.Errorf("townRoot = %q, want %q", gotRoot, townRoot)
+	}
+	if gotEvent.Type != TypeMerged {
+		t.Errorf("event.Type = %q, want %q", gotEvent.Type, TypeMerged)
+	}
+}
+
+func TestLog_NilNotifyHookIsFine(t *testing.T) {
+	withWorkspace(t)
+
+	orig := Notify
+	Notify = nil
+	t.Cleanup(func() { Notify = orig })
+
+	if err := LogAudit(TypeMerged, "refinery", nil); err != nil {
+		t.Fatalf("LogAudit: %v", err)
+	}
+}
@@ -0,0 +1,186 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// QueryOptions filters a Query over the events log. A zero value matches
+// every event (subject to Limit).
+type QueryOptions struct {
+	// Type filters to events with this exact Type, if non-empty.
+	Type string
+	// Actor filters to events whose Actor equals this, or is scoped under
+	// it as "<actor>/..." (e.g. Actor "gastown" matches actor
+	// "gastown/refinery"), if non-empty.
+	Actor string
+	// Rig filters to events for this rig, matched against the actor's
+	// leading path segment (e.g. "gastown/refinery" -> rig "gastown")
+	// and, failing that, a top-level "rig" key in the payload.
+	Rig string
+	// Since, if non-zero, excludes events at or before this time.
+	Since time.Time
+	// Until, if non-zero, excludes events after this time.
+	Until time.Time
+	// Limit caps the number of events returned. Zero means unlimited.
+	Limit int
+}
+
+// Matches reports whether e satisfies every filter in opts except Since
+// (which Query uses to stop scanning early rather than to filter a
+// single event) and Limit (which only makes sense across a whole
+// result set). It's exported so gt events --follow can apply the same
+// Type/Actor/Rig/Until filters to newly-tailed events.
+func (opts QueryOptions) Matches(e *Event) bool {
+	if opts.Type != "" && e.Type != opts.Type {
+		return false
+	}
+	if opts.Actor != "" && e.Actor != opts.Actor && !strings.HasPrefix(e.Actor, opts.Actor+"/") {
+		return false
+	}
+	if opts.Rig != "" {
+		rig, _, _ := strings.Cut(e.Actor, "/")
+		if rig != opts.Rig {
+			payloadRig, _ := e.Payload["rig"].(string)
+			if payloadRig != opts.Rig {
+				return false
+			}
+		}
+	}
+	if !opts.Until.IsZero() {
+		ts, err := time.Parse(time.RFC3339, e.Timestamp)
+		if err == nil && ts.After(opts.Until) {
+			return false
+		}
+	}
+	return true
+}
+
+// Query returns events from townRoot's raw events log, most-recent-first,
+// matching opts. The log may be spread across the active segment and any
+// number of rotated ones (see rotate.go); Query walks them newest-first
+// and reads each backward in fixed-size chunks rather than loading whole
+// files, so a small Limit against a large log stays cheap: it stops as
+// soon as Limit is satisfied or a line's timestamp falls at or before
+// opts.Since, without opening older segments.
+func Query(townRoot string, opts QueryOptions) ([]Event, error) {
+	paths, err := segments(townRoot)
+	if err != nil {
+		return nil, fmt.Errorf("listing event segments: %w", err)
+	}
+
+	var result []Event
+	for _, path := range paths {
+		done, err := queryFile(path, opts, &result)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", filepath.Base(path), err)
+		}
+		if done {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// queryFile scans one segment backward, appending opts-matching events to
+// *result. It reports done=true once opts.Limit is satisfied or a line at
+// or before opts.Since is reached, telling Query to stop without opening
+// older segments.
+func queryFile(path string, opts QueryOptions, result *[]Event) (done bool, err error) {
+	f, ferr := os.Open(path)
+	if ferr != nil {
+		if os.IsNotExist(ferr) {
+			return false, nil
+		}
+		return false, ferr
+	}
+	defer f.Close()
+
+	err = reverseLines(f, func(line string) bool {
+		var e Event
+		if jsonErr := json.Unmarshal([]byte(line), &e); jsonErr != nil {
+			return true // skip malformed lines, keep scanning
+		}
+
+		if !opts.Since.IsZero() {
+			ts, tsErr := time.Parse(time.RFC3339, e.Timestamp)
+			if tsErr == nil && !ts.After(opts.Since) {
+				done = true
+				return false // events only get older from here; stop
+			}
+		}
+
+		if opts.Matches(&e) {
+			*result = append(*result, e)
+		}
+
+		if opts.Limit > 0 && len(*result) >= opts.Limit {
+			done = true
+			return false
+		}
+		return true
+	})
+
+	return done, err
+}
+
+// reverseChunkSize is the read granularity for reverseLines. Large enough
+// that a typical query needs only one or two reads even against a
+// multi-megabyte log, small enough to keep memory bounded.
+const reverseChunkSize = 64 * 1024
+
+// reverseLines reads f from the end backward, calling yield once per
+// non-empty line in last-to-first order. It stops as soon as yield
+// returns false, without reading the rest of the file.
+func reverseLines(f *os.File, yield func(line string) (keepGoing bool)) error {
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	var trailing []byte // unprocessed prefix of the chunk read one step later (i.e. immediately following the next chunk)
+	pos := size
+	buf := make([]byte, reverseChunkSize)
+
+	for pos > 0 {
+		n := int64(reverseChunkSize)
+		if n > pos {
+			n = pos
+		}
+		pos -= n
+
+		if _, err := f.ReadAt(buf[:n], pos); err != nil {
+			return err
+		}
+
+		data := make([]byte, 0, n+int64(len(trailing)))
+		data = append(data, buf[:n]...)
+		data = append(data, trailing...)
+
+		end := len(data)
+		for i := len(data) - 1; i >= 0; i-- {
+			if data[i] != '\n' {
+				continue
+			}
+			if line := strings.TrimSpace(string(data[i+1 : end])); line != "" {
+				if !yield(line) {
+					return nil
+				}
+			}
+			end = i
+		}
+		trailing = append([]byte(nil), data[:end]...)
+	}
+
+	if line := strings.TrimSpace(string(trailing)); line != "" {
+		yield(line)
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package events
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeEventsFile(t *testing.T, dir string, evs []Event) {
+	t.Helper()
+	f, err := os.Create(filepath.Join(dir, EventsFile))
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	defer f.Close()
+	for _, e := range evs {
+		data, err := json.Marshal(e)
+		if err != nil {
+			t.Fatalf("marshal: %v", err)
+		}
+		if _, err := f.Write(append(data, '\n')); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+}
+
+func ev(offset time.Duration, eventType, actor string, payload map[string]interface{}) Event {
+	return Event{
+		Timestamp:  time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Add(offset).Format(time.RFC3339),
+		Source:     "gt",
+		Type:       eventType,
+		Actor:      actor,
+		Payload:    payload,
+		Visibility: VisibilityFeed,
+	}
+}
+
+func TestQuery_NoFilter_MostRecentFirst(t *testing.T) {
+	dir := t.TempDir()
+	writeEventsFile(t, dir, []Event{
+		ev(0*time.Minute, TypeSling, "gastown/mayor", nil),
+		ev(1*time.Minute, TypeDone, "gastown/refinery", nil),
+		ev(2*time.Minute, TypeMerged, "gastown/refinery", nil),
+	})
+
+	got, err := Query(dir, QueryOptions{})
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("len(got) = %d, want 3", len(got))
+	}
+	if got[0].Type != TypeMerged || got[2].Type != TypeSling {
+		t.Errorf("order = %v, want most-recent-first", []string{got[0].Type, got[1].Type, got[2].Type})
+	}
+}
+
+func TestQuery_FiltersByTypeActorRig(t *testing.T) {
+	dir := t.TempDir()
+	writeEventsFile(t, dir, []Event{
+		ev(0*time.Minute, TypeSling, "gastown/mayor", nil),
+		ev(1*time.Minute, TypeMerged, "greenplace/refinery", nil),
+		ev(2*time.Minute, TypeMerged, "gastown/refinery", nil),
+		ev(3*time.Minute, TypeMergeFailed, "gastown/refinery", nil),
+	})
+
+	got, err := Query(dir, QueryOptions{Type: TypeMerged, Actor: "gastown/refinery"})
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(got) != 1 || got[0].Type != TypeMerged || got[0].Actor != "gastown/refinery" {
+		t.Fatalf("got %+v, want single gastown/refinery merged event", got)
+	}
+
+	got, err = Query(dir, QueryOptions{Rig: "greenplace"})
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(got) != 1 || got[0].Actor != "greenplace/refinery" {
+		t.Fatalf("got %+v, want single greenplace event", got)
+	}
+}
+
+func TestQuery_RigFallsBackToPayload(t *testing.T) {
+	dir := t.TempDir()
+	writeEventsFile(t, dir, []Event{
+		ev(0*time.Minute, TypeBoot, "mayor", map[string]interface{}{"rig": "greenplace"}),
+	})
+
+	got, err := Query(dir, QueryOptions{Rig: "greenplace"})
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("got %d events, want 1", len(got))
+	}
+}
+
+func TestQuery_SinceStopsEarly(t *testing.T) {
+	dir := t.TempDir()
+	writeEventsFile(t, dir, []Event{
+		ev(0*time.Minute, TypeSling, "gastown/mayor", nil),
+		ev(10*time.Minute, TypeDone, "gastown/mayor", nil),
+		ev(20*time.Minute, TypeMerged, "gastown/refinery", nil),
+	})
+
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Add(9 * time.Minute)
+	got, err := Query(dir, QueryOptions{Since: since})
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("len(got) = %d, want 2 (events after 'since')", len(got))
+	}
+}
+
+func TestQuery_UntilExcludesLaterEvents(t *testing.T) {
+	dir := t.TempDir()
+	writeEventsFile(t, dir, []Event{
+		ev(0*time.Minute, TypeSling, "gastown/mayor", nil),
+		ev(10*time.Minute, TypeDone, "gastown/mayor", nil),
+	})
+
+	until := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Add(5 * time.Minute)
+	got, err := Query(dir, QueryOptions{Until: until})
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(got) != 1 || got[0].Type != TypeSling {
+		t.Fatalf("got %+v, want only the event before 'until'", got)
+	}
+}
+
+func TestQuery_Limit(t *testing.T) {
+	dir := t.TempDir()
+	var evs []Event
+	for i := 0; i < 10; i++ {
+		evs = append(evs, ev(time.Duration(i)*time.Minute, TypeSling, "gastown/mayor", nil))
+	}
+	writeEventsFile(t, dir, evs)
+
+	got, err := Query(dir, QueryOptions{Limit: 3})
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("len(got) = %d, want 3", len(got))
+	}
+	// Most recent 3, in most-recent-first order.
+	if got[0].Timestamp != evs[9].Timestamp || got[2].Timestamp != evs[7].Timestamp {
+		t.Errorf("got %v, want the 3 most recent events", got)
+	}
+}
+
+func TestQuery_MissingFileReturnsEmpty(t *testing.T) {
+	got, err := Query(t.TempDir(), QueryOptions{})
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if got != nil {
+		t.Errorf("got %v, want nil for missing events file", got)
+	}
+}
+
+func TestQuery_SkipsMalformedLines(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, EventsFile)
+	content := `not json at all
+{"ts":"2026-01-01T00:01:00Z","source":"gt","type":"sling","actor":"gastown/mayor","visibility":"feed"}
+{"broken`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	got, err := Query(dir, QueryOptions{})
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(got) != 1 || got[0].Type != TypeSling {
+		t.Fatalf("got %+v, want the one valid event", got)
+	}
+}
+
+// BenchmarkQuery_LargeFileSmallLimit verifies a Limit-bounded query against
+// a large log stays cheap: it should only need to read the file's tail,
+// not the whole 100k-line log, for a Limit of 50.
+func BenchmarkQuery_LargeFileSmallLimit(b *testing.B) {
+	dir := b.TempDir()
+	f, err := os.Create(filepath.Join(dir, EventsFile))
+	if err != nil {
+		b.Fatalf("Create: %v", err)
+	}
+	for i := 0; i < 100_000; i++ {
+		e := ev(time.Duration(i)*time.Second, TypeSling, "gastown/mayor", nil)
+		data, _ := json.Marshal(e)
+		if _, err := f.Write(append(data, '\n')); err != nil {
+			b.Fatalf("Write: %v", err)
+		}
+	}
+	f.Close()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		got, err := Query(dir, QueryOptions{Limit: 50})
+		if err != nil {
+			b.Fatalf("Query: %v", err)
+		}
+		if len(got) != 50 {
+			b.Fatalf("len(got) = %d, want 50", len(got))
+		}
+	}
+}
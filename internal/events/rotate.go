@@ -0,0 +1,121 @@
+package events
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// maxSegmentBytes is the size threshold at which the active segment
+// (EventsFile) is rotated out to a timestamped segment. Tests in this
+// package override it directly to force rotation without writing 50MB of
+// fixtures.
+var maxSegmentBytes int64 = 50 * 1024 * 1024
+
+// segmentPattern matches a rotated segment's filename, e.g.
+// ".events-1735689600123456789.jsonl". The captured group is the
+// UnixNano instant it was rotated at.
+var segmentPattern = regexp.MustCompile(`^\.events-(\d+)\.jsonl$`)
+
+// rotateIfNeeded renames the active segment at path to a timestamped
+// segment if it's at or above maxSegmentBytes, so the caller's next
+// append (via os.O_CREATE) starts a fresh active file. It's a no-op if
+// path doesn't exist yet or is under the limit.
+//
+// Segments are keyed by rotation instant rather than calendar date: a
+// busy town (or a test with a tiny maxSegmentBytes) can rotate more than
+// once a day, and a UnixNano key both guarantees uniqueness and sorts
+// lexically in chronological order, so no date-collision handling is
+// needed.
+func rotateIfNeeded(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("stat events file: %w", err)
+	}
+	if info.Size() < maxSegmentBytes {
+		return nil
+	}
+
+	rotated := filepath.Join(filepath.Dir(path), fmt.Sprintf(".events-%d.jsonl", time.Now().UnixNano()))
+	if err := os.Rename(path, rotated); err != nil {
+		return fmt.Errorf("rotating events file: %w", err)
+	}
+	return nil
+}
+
+// segments returns every events segment under townRoot, newest-first: the
+// active EventsFile (if it exists) followed by rotated segments in
+// reverse-chronological order. Query walks them in this order so a
+// bounded Limit or Since can stop before opening older segments.
+func segments(townRoot string) ([]string, error) {
+	entries, err := os.ReadDir(townRoot)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading town root: %w", err)
+	}
+
+	var rotated []string
+	for _, entry := range entries {
+		if !entry.IsDir() && segmentPattern.MatchString(entry.Name()) {
+			rotated = append(rotated, entry.Name())
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(rotated)))
+
+	var result []string
+	activePath := filepath.Join(townRoot, EventsFile)
+	if _, err := os.Stat(activePath); err == nil {
+		result = append(result, activePath)
+	}
+	for _, name := range rotated {
+		result = append(result, filepath.Join(townRoot, name))
+	}
+	return result, nil
+}
+
+// PruneOldSegments deletes rotated segments (never the active one) whose
+// rotation instant is older than maxAge, returning the number removed.
+// There's no daemon loop in this codebase that calls this automatically
+// yet - it's exposed the same way beads.PruneAllChannels is, for a
+// patrol or cron-style caller to invoke periodically.
+func PruneOldSegments(townRoot string, maxAge time.Duration) (int, error) {
+	entries, err := os.ReadDir(townRoot)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("reading town root: %w", err)
+	}
+
+	cutoff := time.Now().Add(-maxAge)
+	deleted := 0
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		m := segmentPattern.FindStringSubmatch(entry.Name())
+		if m == nil {
+			continue
+		}
+		nanos, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		if time.Unix(0, nanos).Before(cutoff) {
+			if err := os.Remove(filepath.Join(townRoot, entry.Name())); err != nil && !os.IsNotExist(err) {
+				return deleted, fmt.Errorf("removing rotated segment %s: %w", entry.Name(), err)
+			}
+			deleted++
+		}
+	}
+	return deleted, nil
+}
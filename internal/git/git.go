@@ -8,7 +8,9 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // GitError contains raw output from a git command for agent observation.
@@ -162,6 +164,31 @@ func (g *Git) run(args ...string) (string, error) {
 	return strings.TrimSpace(stdout.String()), nil
 }
 
+// runRightTrimmed is like run, but only trims trailing whitespace. Porcelain
+// formats like `git status --porcelain` use a leading space as a meaningful
+// status-code column on the first line; run's full TrimSpace would eat it.
+func (g *Git) runRightTrimmed(args ...string) (string, error) {
+	if g.gitDir != "" {
+		args = append([]string{"--git-dir=" + g.gitDir}, args...)
+	}
+
+	cmd := exec.Command("git", args...)
+	if g.workDir != "" {
+		cmd.Dir = g.workDir
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		return "", g.wrapError(err, stdout.String(), stderr.String(), args)
+	}
+
+	return strings.TrimRight(stdout.String(), "\r\n"), nil
+}
+
 // wrapError wraps git errors with context.
 // ZFC: Returns GitError with raw output for agent observation.
 // Does not detect or interpret error types - agents should observe and decide.
@@ -442,16 +469,16 @@ func (g *Git) CommitAll(message string) error {
 
 // GitStatus represents the status of the working directory.
 type GitStatus struct {
-	Clean    bool
-	Modified []string
-	Added    []string
-	Deleted  []string
+	Clean     bool
+	Modified  []string
+	Added     []string
+	Deleted   []string
 	Untracked []string
 }
 
 // Status returns the current git status.
 func (g *Git) Status() (*GitStatus, error) {
-	out, err := g.run("status", "--porcelain")
+	out, err := g.runRightTrimmed("status", "--porcelain")
 	if err != nil {
 		return nil, err
 	}
@@ -546,6 +573,12 @@ func (g *Git) RemoteURL(remote string) (string, error) {
 	return g.run("remote", "get-url", remote)
 }
 
+// SetRemoteURL repoints an existing remote at a new URL.
+func (g *Git) SetRemoteURL(remote, url string) error {
+	_, err := g.run("remote", "set-url", remote, url)
+	return err
+}
+
 // Remotes returns the list of configured remote names.
 func (g *Git) Remotes() ([]string, error) {
 	out, err := g.run("remote")
@@ -707,6 +740,28 @@ func (g *Git) AbortRebase() error {
 	return err
 }
 
+// RebaseContinue continues a rebase after conflicts have been staged.
+func (g *Git) RebaseContinue() error {
+	_, err := g.run("rebase", "--continue")
+	return err
+}
+
+// CheckoutOursTheirs resolves a conflicted path during a rebase by taking
+// one side and staging it. During a rebase, "theirs" is the commit being
+// replayed (the branch's own changes) and "ours" is the branch being
+// rebased onto - the opposite of a merge.
+func (g *Git) CheckoutOursTheirs(path string, theirs bool) error {
+	side := "--ours"
+	if theirs {
+		side = "--theirs"
+	}
+	if _, err := g.run("checkout", side, "--", path); err != nil {
+		return err
+	}
+	_, err := g.run("add", "--", path)
+	return err
+}
+
 // CreateBranch creates a new branch.
 func (g *Git) CreateBranch(name string) error {
 	_, err := g.run("branch", name)
@@ -756,6 +811,14 @@ func (g *Git) DeleteBranch(name string, force bool) error {
 	return err
 }
 
+// RenameBranch renames a local branch. If oldName is the current branch,
+// this also updates the checked-out worktree's HEAD - no separate checkout
+// or worktree move is needed.
+func (g *Git) RenameBranch(oldName, newName string) error {
+	_, err := g.run("branch", "-m", oldName, newName)
+	return err
+}
+
 // ListBranches returns all local branches matching a pattern.
 // Pattern uses git's pattern matching (e.g., "polecat/*" matches all polecat branches).
 // Returns branch names without the refs/heads/ prefix.
@@ -774,6 +837,29 @@ func (g *Git) ListBranches(pattern string) ([]string, error) {
 	return strings.Split(out, "\n"), nil
 }
 
+// ListRemoteBranches returns remote-tracking branches matching a pattern,
+// e.g. ListRemoteBranches("origin", "polecat/*") matches refs/remotes/origin/polecat/*.
+// Reads from locally-fetched refs/remotes, not the actual remote, so callers
+// should fetch first if they need up-to-date results. Returned names have
+// the "<remote>/" prefix stripped (e.g. "polecat/nux", not "origin/polecat/nux").
+func (g *Git) ListRemoteBranches(remote, pattern string) ([]string, error) {
+	ref := "refs/remotes/" + remote + "/" + pattern
+	out, err := g.run("for-each-ref", "--format=%(refname:short)", ref)
+	if err != nil {
+		return nil, err
+	}
+	if out == "" {
+		return nil, nil
+	}
+	prefix := remote + "/"
+	lines := strings.Split(out, "\n")
+	branches := make([]string, len(lines))
+	for i, line := range lines {
+		branches[i] = strings.TrimPrefix(line, prefix)
+	}
+	return branches, nil
+}
+
 // ResetBranch force-updates a branch to point to a ref.
 // This is useful for resetting stale polecat branches to main.
 func (g *Git) ResetBranch(name, ref string) error {
@@ -1075,6 +1161,24 @@ func (g *Git) BranchCreatedDate(branch string) (string, error) {
 	return out, nil
 }
 
+// LastCommitTime returns the committer timestamp of HEAD.
+func (g *Git) LastCommitTime() (time.Time, error) {
+	return g.CommitTime("HEAD")
+}
+
+// CommitTime returns the committer timestamp of ref's tip commit.
+func (g *Git) CommitTime(ref string) (time.Time, error) {
+	out, err := g.run("log", "-1", "--format=%cI", ref)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := time.Parse(time.RFC3339, out)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing commit time: %w", err)
+	}
+	return t, nil
+}
+
 // CommitsAhead returns the number of commits that branch has ahead of base.
 // For example, CommitsAhead("main", "feature") returns how many commits
 // are on feature that are not on main.
@@ -1161,14 +1265,27 @@ func (g *Git) UnpushedCommits() (int, error) {
 	return count, nil
 }
 
+// FileChange describes one file's line-level diff stats, for UncommittedWorkStatus.FileChanges.
+type FileChange struct {
+	Path    string
+	Added   int
+	Deleted int
+}
+
+// maxDetailFiles caps how many files UncommittedWorkStatus.Detail() lists
+// individually before collapsing the rest into a "+N more" line.
+const maxDetailFiles = 20
+
 // UncommittedWorkStatus contains information about uncommitted work in a repo.
 type UncommittedWorkStatus struct {
 	HasUncommittedChanges bool
 	StashCount            int
 	UnpushedCommits       int
 	// Details for error messages
-	ModifiedFiles   []string
-	UntrackedFiles  []string
+	ModifiedFiles  []string
+	UntrackedFiles []string
+	FileChanges    []FileChange // per-file added/deleted line counts, tracked + untracked
+	StashSubjects  []string     // one subject per stash, in `git stash list` order
 }
 
 // Clean returns true if there is no uncommitted work.
@@ -1225,6 +1342,35 @@ func (s *UncommittedWorkStatus) String() string {
 	return strings.Join(issues, ", ")
 }
 
+// Detail returns a multi-line breakdown of uncommitted work: per-file
+// added/deleted line counts (capped at maxDetailFiles, with a "+N more"
+// summary for the rest) followed by stash subjects. Unlike String()'s
+// one-line count, Detail() is meant for a human deciding whether losing
+// the work actually matters.
+func (s *UncommittedWorkStatus) Detail() string {
+	var b strings.Builder
+
+	shown := s.FileChanges
+	if len(shown) > maxDetailFiles {
+		shown = shown[:maxDetailFiles]
+	}
+	for _, f := range shown {
+		fmt.Fprintf(&b, "  %s (+%d -%d)\n", f.Path, f.Added, f.Deleted)
+	}
+	if extra := len(s.FileChanges) - len(shown); extra > 0 {
+		fmt.Fprintf(&b, "  +%d more\n", extra)
+	}
+
+	for _, subject := range s.StashSubjects {
+		fmt.Fprintf(&b, "  stash: %s\n", subject)
+	}
+
+	if b.Len() == 0 {
+		return "clean"
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
 // CheckUncommittedWork performs a comprehensive check for uncommitted work.
 func (g *Git) CheckUncommittedWork() (*UncommittedWorkStatus, error) {
 	status := &UncommittedWorkStatus{}
@@ -1239,12 +1385,19 @@ func (g *Git) CheckUncommittedWork() (*UncommittedWorkStatus, error) {
 	status.ModifiedFiles = append(status.ModifiedFiles, gitStatus.Deleted...)
 	status.UntrackedFiles = gitStatus.Untracked
 
+	fileChanges, err := g.fileChanges(status.ModifiedFiles, status.UntrackedFiles)
+	if err != nil {
+		return nil, fmt.Errorf("checking file changes: %w", err)
+	}
+	status.FileChanges = fileChanges
+
 	// Check stashes
-	stashCount, err := g.StashCount()
+	stashSubjects, err := g.StashSubjects()
 	if err != nil {
 		return nil, fmt.Errorf("checking stashes: %w", err)
 	}
-	status.StashCount = stashCount
+	status.StashSubjects = stashSubjects
+	status.StashCount = len(stashSubjects)
 
 	// Check unpushed commits
 	unpushed, err := g.UnpushedCommits()
@@ -1256,6 +1409,88 @@ func (g *Git) CheckUncommittedWork() (*UncommittedWorkStatus, error) {
 	return status, nil
 }
 
+// fileChanges returns per-file added/deleted line counts for modified
+// (tracked) and untracked files, in that order. Tracked counts come from
+// `git diff --numstat HEAD`; untracked files have no diff to measure
+// against, so their whole line count is reported as added.
+func (g *Git) fileChanges(modified, untracked []string) ([]FileChange, error) {
+	var changes []FileChange
+
+	if len(modified) > 0 {
+		out, err := g.run("diff", "--numstat", "HEAD")
+		if err != nil {
+			// No HEAD yet (empty repo) - fall back to zero counts rather than failing.
+			for _, path := range modified {
+				changes = append(changes, FileChange{Path: path})
+			}
+		} else {
+			byPath := make(map[string]FileChange, len(modified))
+			for _, line := range strings.Split(out, "\n") {
+				fields := strings.SplitN(line, "\t", 3)
+				if len(fields) != 3 {
+					continue
+				}
+				added, _ := strconv.Atoi(fields[0])   // "-" for binary files parses to 0
+				deleted, _ := strconv.Atoi(fields[1]) // same
+				byPath[fields[2]] = FileChange{Path: fields[2], Added: added, Deleted: deleted}
+			}
+			for _, path := range modified {
+				if fc, ok := byPath[path]; ok {
+					changes = append(changes, fc)
+				} else {
+					changes = append(changes, FileChange{Path: path})
+				}
+			}
+		}
+	}
+
+	for _, path := range untracked {
+		lines, err := g.countLines(path)
+		if err != nil {
+			changes = append(changes, FileChange{Path: path})
+			continue
+		}
+		changes = append(changes, FileChange{Path: path, Added: lines})
+	}
+
+	return changes, nil
+}
+
+// countLines returns the number of lines in a file relative to the repo root.
+func (g *Git) countLines(relPath string) (int, error) {
+	data, err := os.ReadFile(filepath.Join(g.workDir, relPath))
+	if err != nil {
+		return 0, err
+	}
+	if len(data) == 0 {
+		return 0, nil
+	}
+	lines := bytes.Count(data, []byte("\n"))
+	if !bytes.HasSuffix(data, []byte("\n")) {
+		lines++ // trailing partial line with no newline still counts
+	}
+	return lines, nil
+}
+
+// StashSubjects returns the subject line of each stash, in `git stash list` order.
+func (g *Git) StashSubjects() ([]string, error) {
+	out, err := g.run("stash", "list", "--format=%s")
+	if err != nil {
+		return nil, err
+	}
+	if out == "" {
+		return nil, nil
+	}
+
+	var subjects []string
+	for _, line := range strings.Split(out, "\n") {
+		if line != "" {
+			subjects = append(subjects, line)
+		}
+	}
+	return subjects, nil
+}
+
 // BranchPushedToRemote checks if a branch has been pushed to the remote.
 // Returns (pushed bool, unpushedCount int, err).
 // This handles polecat branches that don't have upstream tracking configured.
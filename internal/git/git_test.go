@@ -1,11 +1,13 @@
 package git
 
 import (
+	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 func initTestRepo(t *testing.T) string {
@@ -163,6 +165,31 @@ func TestAddAndCommit(t *testing.T) {
 	}
 }
 
+func TestLastCommitTime(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	before := time.Now().Add(-time.Minute)
+	testFile := filepath.Join(dir, "new.txt")
+	if err := os.WriteFile(testFile, []byte("new content"), 0644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	if err := g.Add("new.txt"); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := g.Commit("add new file"); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+
+	got, err := g.LastCommitTime()
+	if err != nil {
+		t.Fatalf("LastCommitTime: %v", err)
+	}
+	if got.Before(before) {
+		t.Errorf("LastCommitTime = %v, want at or after %v", got, before)
+	}
+}
+
 func TestHasUncommittedChanges(t *testing.T) {
 	dir := initTestRepo(t)
 	g := NewGit(dir)
@@ -488,3 +515,176 @@ func stringContains(s, substr string) bool {
 	}
 	return false
 }
+
+func TestCheckUncommittedWork_FileChanges(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	// Unstaged modification to a tracked file.
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("# Test\nmore\n"), 0644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	// Staged new file.
+	staged := filepath.Join(dir, "staged.txt")
+	if err := os.WriteFile(staged, []byte("line1\nline2\nline3\n"), 0644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	if err := g.Add("staged.txt"); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	// Untracked file.
+	if err := os.WriteFile(filepath.Join(dir, "scratch.txt"), []byte("a\nb\n"), 0644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	status, err := g.CheckUncommittedWork()
+	if err != nil {
+		t.Fatalf("CheckUncommittedWork: %v", err)
+	}
+	if status.Clean() {
+		t.Fatal("expected dirty status")
+	}
+
+	byPath := make(map[string]FileChange)
+	for _, fc := range status.FileChanges {
+		byPath[fc.Path] = fc
+	}
+
+	if fc, ok := byPath["README.md"]; !ok || fc.Added == 0 {
+		t.Errorf("README.md change = %+v, want added > 0", fc)
+	}
+	if fc, ok := byPath["staged.txt"]; !ok || fc.Added != 3 {
+		t.Errorf("staged.txt change = %+v, want added=3", fc)
+	}
+	if fc, ok := byPath["scratch.txt"]; !ok || fc.Added != 2 {
+		t.Errorf("scratch.txt change = %+v, want added=2", fc)
+	}
+
+	detail := status.Detail()
+	if !stringContains(detail, "README.md") || !stringContains(detail, "staged.txt") || !stringContains(detail, "scratch.txt") {
+		t.Errorf("Detail() missing expected files: %s", detail)
+	}
+}
+
+func TestCheckUncommittedWork_StashSubjects(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("stash me"), 0644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	if _, err := runGit(dir, "stash", "push", "-m", "wip: scratch notes"); err != nil {
+		t.Fatalf("git stash: %v", err)
+	}
+
+	status, err := g.CheckUncommittedWork()
+	if err != nil {
+		t.Fatalf("CheckUncommittedWork: %v", err)
+	}
+	if status.StashCount != 1 {
+		t.Errorf("StashCount = %d, want 1", status.StashCount)
+	}
+	if len(status.StashSubjects) != 1 || !stringContains(status.StashSubjects[0], "wip: scratch notes") {
+		t.Errorf("StashSubjects = %v, want a subject containing %q", status.StashSubjects, "wip: scratch notes")
+	}
+	if !stringContains(status.Detail(), "wip: scratch notes") {
+		t.Errorf("Detail() missing stash subject: %s", status.Detail())
+	}
+}
+
+func TestUncommittedWorkStatus_Detail_CapsFileList(t *testing.T) {
+	status := &UncommittedWorkStatus{}
+	for i := 0; i < 25; i++ {
+		status.FileChanges = append(status.FileChanges, FileChange{Path: fmt.Sprintf("file%d.txt", i), Added: 1})
+	}
+
+	detail := status.Detail()
+	if !stringContains(detail, "+5 more") {
+		t.Errorf("Detail() = %q, want a \"+5 more\" summary for the remaining files", detail)
+	}
+	if stringContains(detail, "file24.txt") {
+		t.Error("Detail() should not list files past the cap")
+	}
+}
+
+// runGit runs a git command in dir and returns combined output, for test
+// setup steps (like stash) that Git doesn't wrap.
+func runGit(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	return string(out), err
+}
+
+func TestCheckoutOursTheirsAndRebaseContinue(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+	mainBranch, _ := g.CurrentBranch()
+
+	if err := g.CreateBranch("feature"); err != nil {
+		t.Fatalf("CreateBranch: %v", err)
+	}
+	if err := g.Checkout("feature"); err != nil {
+		t.Fatalf("Checkout feature: %v", err)
+	}
+	readmeFile := filepath.Join(dir, "README.md")
+	if err := os.WriteFile(readmeFile, []byte("# Feature changes\n"), 0644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	if err := g.Add("README.md"); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := g.Commit("modify readme on feature"); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+
+	if err := g.Checkout(mainBranch); err != nil {
+		t.Fatalf("Checkout main: %v", err)
+	}
+	if err := os.WriteFile(readmeFile, []byte("# Main changes\n"), 0644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	if err := g.Add("README.md"); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := g.Commit("modify readme on main"); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+
+	if err := g.Checkout("feature"); err != nil {
+		t.Fatalf("Checkout feature: %v", err)
+	}
+	if err := g.Rebase(mainBranch); err == nil {
+		t.Fatal("expected Rebase to fail with a conflict")
+	}
+
+	conflicts, err := g.GetConflictingFiles()
+	if err != nil {
+		t.Fatalf("GetConflictingFiles: %v", err)
+	}
+	if len(conflicts) != 1 || conflicts[0] != "README.md" {
+		t.Fatalf("conflicts = %v, want [README.md]", conflicts)
+	}
+
+	// Take "theirs" - the commit being replayed, i.e. feature's own change.
+	if err := g.CheckoutOursTheirs("README.md", true); err != nil {
+		t.Fatalf("CheckoutOursTheirs: %v", err)
+	}
+	if err := g.RebaseContinue(); err != nil {
+		t.Fatalf("RebaseContinue: %v", err)
+	}
+
+	content, err := os.ReadFile(readmeFile)
+	if err != nil {
+		t.Fatalf("read README.md: %v", err)
+	}
+	if string(content) != "# Feature changes\n" {
+		t.Errorf("README.md = %q, want feature's version to have won", string(content))
+	}
+	status, _ := g.Status()
+	if !status.Clean {
+		t.Error("expected clean working directory after RebaseContinue")
+	}
+}
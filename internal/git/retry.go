@@ -0,0 +1,118 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// transientErrorSubstrings are lowercase substrings of git stderr/stdout
+// that indicate a network hiccup worth retrying, as opposed to a permanent
+// failure (bad credentials, missing repo) that a retry can't fix.
+var transientErrorSubstrings = []string{
+	"could not resolve host",
+	"connection reset",
+	"connection refused",
+	"connection timed out",
+	"early eof",
+	"unexpected eof",
+	"the remote end hung up unexpectedly",
+	"failed to connect",
+	"operation timed out",
+	"tls handshake timeout",
+	"temporary failure in name resolution",
+}
+
+// IsTransientError reports whether err looks like a network blip worth
+// retrying (DNS failure, reset connection, truncated transfer) rather than
+// a permanent failure (bad auth, missing repo) that retrying can't fix.
+func IsTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var gitErr *GitError
+	text := err.Error()
+	if errors.As(err, &gitErr) {
+		text = gitErr.Stdout + "\n" + gitErr.Stderr
+	}
+	text = strings.ToLower(text)
+
+	for _, substr := range transientErrorSubstrings {
+		if strings.Contains(text, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+// RetryError reports that an operation was retried and still failed.
+type RetryError struct {
+	Op       string
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("%s failed after %d attempts: %v", e.Op, e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// gitRetryBaseDelay is the delay before the first retry, doubling each
+// attempt after. A var (not a const) so tests can shrink it.
+var gitRetryBaseDelay = time.Second
+
+// gitRetry runs fn up to attempts times, retrying with exponential backoff
+// (starting at 1s, doubling each attempt) only when fn's error is
+// transient per IsTransientError. A permanent error (auth failed,
+// repository not found) returns immediately without wasting the remaining
+// attempts. op identifies the operation in the error message if every
+// attempt fails.
+func gitRetry(op string, attempts int, fn func() error) error {
+	var lastErr error
+	delay := gitRetryBaseDelay
+
+	for attempt := 1; attempt <= attempts; attempt++ {
+		lastErr = fn()
+		if lastErr == nil {
+			return nil
+		}
+		if !IsTransientError(lastErr) {
+			return lastErr
+		}
+		if attempt < attempts {
+			time.Sleep(delay)
+			delay *= 2
+		}
+	}
+
+	return &RetryError{Op: op, Attempts: attempts, Err: lastErr}
+}
+
+// FetchRetry fetches from remote, retrying transient network failures with
+// exponential backoff up to attempts times.
+func (g *Git) FetchRetry(remote string, attempts int) error {
+	return gitRetry("fetch", attempts, func() error {
+		return g.Fetch(remote)
+	})
+}
+
+// PullRetry pulls remote/branch, retrying transient network failures with
+// exponential backoff up to attempts times.
+func (g *Git) PullRetry(remote, branch string, attempts int) error {
+	return gitRetry("pull", attempts, func() error {
+		return g.Pull(remote, branch)
+	})
+}
+
+// PushRetry pushes to remote/branch, retrying transient network failures
+// with exponential backoff up to attempts times.
+func (g *Git) PushRetry(remote, branch string, force bool, attempts int) error {
+	return gitRetry("push", attempts, func() error {
+		return g.Push(remote, branch, force)
+	})
+}
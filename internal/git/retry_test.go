@@ -0,0 +1,96 @@
+package git
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"could not resolve host", &GitError{Stderr: "fatal: unable to access 'x': Could not resolve host: github.com"}, true},
+		{"connection reset", &GitError{Stderr: "fatal: the remote end hung up unexpectedly\nerrno=Connection reset by peer"}, true},
+		{"early eof", &GitError{Stderr: "error: RPC failed; curl 56 GnuTLS recv error, early EOF"}, true},
+		{"plain error, no GitError", errors.New("temporary failure in name resolution"), true},
+		{"auth failed", &GitError{Stderr: "fatal: Authentication failed for 'https://github.com/x/y.git'"}, false},
+		{"repository not found", &GitError{Stderr: "remote: Repository not found."}, false},
+		{"unrelated error", errors.New("something else entirely"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTransientError(tt.err); got != tt.want {
+				t.Errorf("IsTransientError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGitRetry_SucceedsAfterTransientFailures(t *testing.T) {
+	orig := gitRetryBaseDelay
+	gitRetryBaseDelay = time.Millisecond
+	defer func() { gitRetryBaseDelay = orig }()
+
+	calls := 0
+	err := gitRetry("fetch", 3, func() error {
+		calls++
+		if calls < 3 {
+			return &GitError{Stderr: "Could not resolve host: github.com"}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("gitRetry() = %v, want nil", err)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+}
+
+func TestGitRetry_GivesUpAfterAttemptsExhausted(t *testing.T) {
+	orig := gitRetryBaseDelay
+	gitRetryBaseDelay = time.Millisecond
+	defer func() { gitRetryBaseDelay = orig }()
+
+	calls := 0
+	err := gitRetry("fetch", 2, func() error {
+		calls++
+		return &GitError{Stderr: "connection reset by peer"}
+	})
+	if err == nil {
+		t.Fatal("gitRetry() = nil, want error")
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) {
+		t.Fatalf("error is not a *RetryError: %v", err)
+	}
+	if retryErr.Attempts != 2 {
+		t.Errorf("retryErr.Attempts = %d, want 2", retryErr.Attempts)
+	}
+}
+
+func TestGitRetry_StopsImmediatelyOnPermanentError(t *testing.T) {
+	calls := 0
+	err := gitRetry("push", 5, func() error {
+		calls++
+		return &GitError{Stderr: "fatal: Authentication failed"}
+	})
+	if err == nil {
+		t.Fatal("gitRetry() = nil, want error")
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1 (should not retry a permanent error)", calls)
+	}
+	var retryErr *RetryError
+	if errors.As(err, &retryErr) {
+		t.Error("permanent error should be returned as-is, not wrapped in *RetryError")
+	}
+}
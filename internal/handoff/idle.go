@@ -0,0 +1,49 @@
+package handoff
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// PaneCapturer captures the current contents of a tmux pane. It is
+// satisfied by *tmux.Tmux (via CapturePaneAll) and by fakes in tests, so
+// the idle-detection gate can be exercised without a real tmux session.
+type PaneCapturer interface {
+	CapturePaneAll(session string) (string, error)
+}
+
+// DefaultIdleThreshold is how long a pane must show unchanged content
+// before a due scheduled handoff is allowed to fire.
+const DefaultIdleThreshold = 30 * time.Second
+
+// CheckIdle captures the current pane content, compares it against the
+// schedule's last-seen hash, and reports whether the pane has been idle
+// (unchanged) for at least threshold. It mutates s in place to record the
+// new hash/change time, so callers should persist s via Write afterward
+// regardless of the returned idle verdict.
+func CheckIdle(capturer PaneCapturer, session string, s *Schedule, threshold time.Duration, now time.Time) (idle bool, err error) {
+	content, err := capturer.CapturePaneAll(session)
+	if err != nil {
+		return false, err
+	}
+	hash := hashPane(content)
+
+	if s.LastPaneHash != hash {
+		s.LastPaneHash = hash
+		s.LastPaneChangeAt = now
+		return false, nil
+	}
+
+	if s.LastPaneChangeAt.IsZero() {
+		s.LastPaneChangeAt = now
+		return false, nil
+	}
+
+	return now.Sub(s.LastPaneChangeAt) >= threshold, nil
+}
+
+func hashPane(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,91 @@
+package handoff
+
+import (
+	"testing"
+	"time"
+)
+
+// fakePaneCapturer stubs tmux pane capture for the idle-detection gate.
+type fakePaneCapturer struct {
+	content string
+}
+
+func (f *fakePaneCapturer) CapturePaneAll(session string) (string, error) {
+	return f.content, nil
+}
+
+func TestCheckIdle_FirstCallIsNeverIdle(t *testing.T) {
+	capturer := &fakePaneCapturer{content: "$ running a tool"}
+	s := &Schedule{}
+	now := time.Date(2026, 8, 8, 12, 0, 0, 0, time.UTC)
+
+	idle, err := CheckIdle(capturer, "gt-gastown-crew-max", s, 30*time.Second, now)
+	if err != nil {
+		t.Fatalf("CheckIdle() error = %v", err)
+	}
+	if idle {
+		t.Error("CheckIdle() = idle on first observation, want not idle")
+	}
+	if s.LastPaneHash == "" {
+		t.Error("CheckIdle() did not record LastPaneHash")
+	}
+	if !s.LastPaneChangeAt.Equal(now) {
+		t.Errorf("LastPaneChangeAt = %v, want %v", s.LastPaneChangeAt, now)
+	}
+}
+
+func TestCheckIdle_ChangedContentResetsTimer(t *testing.T) {
+	capturer := &fakePaneCapturer{content: "first"}
+	base := time.Date(2026, 8, 8, 12, 0, 0, 0, time.UTC)
+	s := &Schedule{}
+
+	if _, err := CheckIdle(capturer, "sess", s, 30*time.Second, base); err != nil {
+		t.Fatalf("CheckIdle() error = %v", err)
+	}
+
+	// Content changes on the next tick, well past the threshold - should
+	// NOT be idle, because the change resets the clock.
+	capturer.content = "second"
+	later := base.Add(time.Minute)
+	idle, err := CheckIdle(capturer, "sess", s, 30*time.Second, later)
+	if err != nil {
+		t.Fatalf("CheckIdle() error = %v", err)
+	}
+	if idle {
+		t.Error("CheckIdle() = idle after content changed, want not idle")
+	}
+	if !s.LastPaneChangeAt.Equal(later) {
+		t.Errorf("LastPaneChangeAt = %v, want %v (reset on change)", s.LastPaneChangeAt, later)
+	}
+}
+
+func TestCheckIdle_UnchangedContentPastThresholdIsIdle(t *testing.T) {
+	capturer := &fakePaneCapturer{content: "quiet prompt"}
+	base := time.Date(2026, 8, 8, 12, 0, 0, 0, time.UTC)
+	s := &Schedule{}
+	threshold := 30 * time.Second
+
+	if _, err := CheckIdle(capturer, "sess", s, threshold, base); err != nil {
+		t.Fatalf("CheckIdle() error = %v", err)
+	}
+
+	// Same content, not yet past threshold.
+	tooSoon := base.Add(10 * time.Second)
+	idle, err := CheckIdle(capturer, "sess", s, threshold, tooSoon)
+	if err != nil {
+		t.Fatalf("CheckIdle() error = %v", err)
+	}
+	if idle {
+		t.Error("CheckIdle() = idle before threshold elapsed, want not idle")
+	}
+
+	// Same content, past threshold now.
+	afterThreshold := base.Add(45 * time.Second)
+	idle, err = CheckIdle(capturer, "sess", s, threshold, afterThreshold)
+	if err != nil {
+		t.Fatalf("CheckIdle() error = %v", err)
+	}
+	if !idle {
+		t.Error("CheckIdle() = not idle after threshold elapsed with unchanged content, want idle")
+	}
+}
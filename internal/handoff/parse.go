@@ -0,0 +1,36 @@
+package handoff
+
+import (
+	"fmt"
+	"time"
+)
+
+// clockLayouts are the clock-time formats accepted by ParseAt, tried in
+// order.
+var clockLayouts = []string{"15:04", "15:04:05"}
+
+// ParseAt resolves a --at value into an absolute time relative to now. It
+// accepts a Go duration ("30m", "1h15m") or a clock time ("15:04",
+// "15:04:05"). A clock time in the past relative to now rolls over to the
+// same time tomorrow, since "hand me off at 09:00" said at 21:00 means
+// tomorrow morning, not nine hours ago.
+func ParseAt(value string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		if d <= 0 {
+			return time.Time{}, fmt.Errorf("duration must be positive: %s", value)
+		}
+		return now.Add(d), nil
+	}
+
+	for _, layout := range clockLayouts {
+		if t, err := time.ParseInLocation(layout, value, now.Location()); err == nil {
+			target := time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), t.Second(), 0, now.Location())
+			if !target.After(now) {
+				target = target.AddDate(0, 0, 1)
+			}
+			return target, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("cannot parse %q as a duration (e.g. 30m) or clock time (e.g. 15:04)", value)
+}
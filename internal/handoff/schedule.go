@@ -0,0 +1,99 @@
+// Package handoff implements deferred handoff scheduling: an agent can ask
+// to be handed off later (at a duration or clock time) instead of
+// immediately, and have the handoff fire once the time has passed and the
+// pane looks idle. The schedule is persisted to disk so it survives across
+// whatever process ends up checking it (deacon patrol, a SessionStart-
+// adjacent hook, or the agent itself).
+package handoff
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// FileScheduled is the runtime-dir file name for a pending scheduled handoff.
+const FileScheduled = "handoff_scheduled"
+
+// Schedule is a deferred handoff request, persisted under an agent's
+// .runtime directory until it fires or is cancelled.
+type Schedule struct {
+	// At is when the handoff becomes due.
+	At time.Time `json:"at"`
+	// Subject and Message are passed through to the handoff mail, same as
+	// gt handoff -s/-m.
+	Subject string `json:"subject,omitempty"`
+	Message string `json:"message,omitempty"`
+	// CreatedAt records when the schedule was armed, for diagnostics.
+	CreatedAt time.Time `json:"created_at"`
+
+	// LastPaneHash and LastPaneChangeAt track the idle-detection gate: the
+	// hash of the pane content last time it was checked, and when that
+	// content last changed. Updated in place by the idle check so repeated
+	// polls (e.g. from deacon patrol) can tell whether the pane has been
+	// quiet for long enough.
+	LastPaneHash     string    `json:"last_pane_hash,omitempty"`
+	LastPaneChangeAt time.Time `json:"last_pane_change_at,omitempty"`
+}
+
+// SchedulePath returns the path to the scheduled-handoff file within a
+// runtime directory (an agent's .runtime dir).
+func SchedulePath(runtimeDir string) string {
+	return filepath.Join(runtimeDir, FileScheduled)
+}
+
+// Write persists a schedule to the given runtime directory, creating it if
+// needed.
+func Write(runtimeDir string, s *Schedule) error {
+	if err := os.MkdirAll(runtimeDir, 0755); err != nil {
+		return fmt.Errorf("creating runtime dir: %w", err)
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling scheduled handoff: %w", err)
+	}
+	return os.WriteFile(SchedulePath(runtimeDir), data, 0644)
+}
+
+// Load reads a pending schedule from a runtime directory. It returns
+// (nil, nil) if no schedule is armed.
+func Load(runtimeDir string) (*Schedule, error) {
+	data, err := os.ReadFile(SchedulePath(runtimeDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading scheduled handoff: %w", err)
+	}
+	var s Schedule
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("parsing scheduled handoff: %w", err)
+	}
+	return &s, nil
+}
+
+// Clear removes a pending schedule, if any. Clearing a non-existent
+// schedule is not an error.
+func Clear(runtimeDir string) error {
+	err := os.Remove(SchedulePath(runtimeDir))
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("removing scheduled handoff: %w", err)
+	}
+	return nil
+}
+
+// Due reports whether a schedule's time has passed.
+func (s *Schedule) Due(now time.Time) bool {
+	return !s.At.After(now)
+}
+
+// RuntimeDir returns the .runtime directory for an agent's working
+// directory, following the same DirRuntime convention as the handoff
+// marker file.
+func RuntimeDir(workDir string) string {
+	return filepath.Join(workDir, constants.DirRuntime)
+}
@@ -0,0 +1,144 @@
+package handoff
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWriteLoadSchedule(t *testing.T) {
+	runtimeDir := filepath.Join(t.TempDir(), ".runtime")
+	now := time.Date(2026, 8, 8, 12, 0, 0, 0, time.UTC)
+	s := &Schedule{
+		At:        now.Add(30 * time.Minute),
+		Subject:   "Context cycling",
+		Message:   "finish this tool call first",
+		CreatedAt: now,
+	}
+
+	if err := Write(runtimeDir, s); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	loaded, err := Load(runtimeDir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if loaded == nil {
+		t.Fatal("Load() = nil, want a schedule")
+	}
+	if !loaded.At.Equal(s.At) {
+		t.Errorf("At = %v, want %v", loaded.At, s.At)
+	}
+	if loaded.Subject != s.Subject || loaded.Message != s.Message {
+		t.Errorf("Subject/Message = %q/%q, want %q/%q", loaded.Subject, loaded.Message, s.Subject, s.Message)
+	}
+}
+
+func TestLoadSchedule_NoneArmed(t *testing.T) {
+	runtimeDir := filepath.Join(t.TempDir(), ".runtime")
+
+	loaded, err := Load(runtimeDir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if loaded != nil {
+		t.Errorf("Load() = %+v, want nil", loaded)
+	}
+}
+
+func TestClearSchedule(t *testing.T) {
+	runtimeDir := filepath.Join(t.TempDir(), ".runtime")
+	s := &Schedule{At: time.Now().Add(time.Hour)}
+	if err := Write(runtimeDir, s); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	if err := Clear(runtimeDir); err != nil {
+		t.Fatalf("Clear() error = %v", err)
+	}
+
+	loaded, err := Load(runtimeDir)
+	if err != nil {
+		t.Fatalf("Load() after Clear() error = %v", err)
+	}
+	if loaded != nil {
+		t.Errorf("Load() after Clear() = %+v, want nil", loaded)
+	}
+}
+
+func TestClearSchedule_NoneArmedIsNotAnError(t *testing.T) {
+	runtimeDir := filepath.Join(t.TempDir(), ".runtime")
+	if err := Clear(runtimeDir); err != nil {
+		t.Errorf("Clear() on empty runtime dir error = %v, want nil", err)
+	}
+}
+
+func TestScheduleDue(t *testing.T) {
+	now := time.Date(2026, 8, 8, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"in the future", now.Add(time.Minute), false},
+		{"exactly now", now, true},
+		{"in the past", now.Add(-time.Minute), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Schedule{At: tt.at}
+			if got := s.Due(now); got != tt.want {
+				t.Errorf("Due() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAt_Duration(t *testing.T) {
+	now := time.Date(2026, 8, 8, 12, 0, 0, 0, time.UTC)
+	got, err := ParseAt("30m", now)
+	if err != nil {
+		t.Fatalf("ParseAt() error = %v", err)
+	}
+	want := now.Add(30 * time.Minute)
+	if !got.Equal(want) {
+		t.Errorf("ParseAt() = %v, want %v", got, want)
+	}
+}
+
+func TestParseAt_ClockTimeLaterToday(t *testing.T) {
+	now := time.Date(2026, 8, 8, 12, 0, 0, 0, time.UTC)
+	got, err := ParseAt("15:04", now)
+	if err != nil {
+		t.Fatalf("ParseAt() error = %v", err)
+	}
+	want := time.Date(2026, 8, 8, 15, 4, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("ParseAt() = %v, want %v", got, want)
+	}
+}
+
+func TestParseAt_ClockTimeAlreadyPassedRollsOverToTomorrow(t *testing.T) {
+	now := time.Date(2026, 8, 8, 21, 0, 0, 0, time.UTC)
+	got, err := ParseAt("09:00", now)
+	if err != nil {
+		t.Fatalf("ParseAt() error = %v", err)
+	}
+	want := time.Date(2026, 8, 9, 9, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("ParseAt() = %v, want %v", got, want)
+	}
+}
+
+func TestParseAt_Invalid(t *testing.T) {
+	if _, err := ParseAt("not-a-time", time.Now()); err == nil {
+		t.Error("ParseAt() error = nil, want error for unparseable value")
+	}
+}
+
+func TestParseAt_NonPositiveDuration(t *testing.T) {
+	if _, err := ParseAt("-5m", time.Now()); err == nil {
+		t.Error("ParseAt() error = nil, want error for non-positive duration")
+	}
+}
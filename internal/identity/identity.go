@@ -0,0 +1,247 @@
+// Package identity consolidates Gas Town agent identity parsing.
+//
+// Before this package existed, resolving "who am I" or "who is this
+// address" was reimplemented at each call site - detectSender in
+// internal/cmd/mail_identity.go, GetRoleWithContext in
+// internal/cmd/role.go, sessionToGTRole in internal/cmd/handoff.go, and
+// assigneeToSessionName in internal/cmd/rig.go - and they disagreed on
+// edge cases (most notably whether a polecat address is written as
+// "<rig>/<name>" or "<rig>/polecats/<name>"). Identity is the single
+// Role/Rig/Name triple all of them now produce and consume.
+package identity
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+// Role is the type of Gas Town agent an Identity refers to. Values match
+// session.Role's string values so the two interoperate without a
+// translation table.
+type Role string
+
+const (
+	RoleMayor    Role = Role(session.RoleMayor)
+	RoleDeacon   Role = Role(session.RoleDeacon)
+	RoleWitness  Role = Role(session.RoleWitness)
+	RoleRefinery Role = Role(session.RoleRefinery)
+	RoleCrew     Role = Role(session.RoleCrew)
+	RolePolecat  Role = Role(session.RolePolecat)
+)
+
+// Identity is a resolved Gas Town agent identity.
+type Identity struct {
+	Role Role
+	Rig  string // empty for mayor/deacon
+	Name string // polecat/crew member name; empty otherwise
+}
+
+// String returns the role name, e.g. "mayor" or "polecat".
+func (id Identity) String() string {
+	return string(id.Role)
+}
+
+func (id Identity) toSession() *session.AgentIdentity {
+	return &session.AgentIdentity{Role: session.Role(id.Role), Rig: id.Rig, Name: id.Name}
+}
+
+// SessionName returns the tmux session name for this identity, or "" if
+// Role is unset.
+func (id Identity) SessionName() string {
+	if id.Role == "" {
+		return ""
+	}
+	return id.toSession().SessionName()
+}
+
+// Address returns the mail-style address for this identity:
+//
+//	mayor    -> "mayor/"
+//	deacon   -> "deacon/"
+//	witness  -> "<rig>/witness"
+//	refinery -> "<rig>/refinery"
+//	crew     -> "<rig>/crew/<name>"
+//	polecat  -> "<rig>/<name>"
+//
+// Polecat addresses use the short "<rig>/<name>" form mail routing has
+// always used, not session.AgentIdentity.Address's "<rig>/polecats/<name>" -
+// FromAddress accepts both, but this is the canonical output form.
+func (id Identity) Address() string {
+	switch id.Role {
+	case RoleMayor:
+		return "mayor/"
+	case RoleDeacon:
+		return "deacon/"
+	case RoleWitness:
+		return fmt.Sprintf("%s/witness", id.Rig)
+	case RoleRefinery:
+		return fmt.Sprintf("%s/refinery", id.Rig)
+	case RoleCrew:
+		return fmt.Sprintf("%s/crew/%s", id.Rig, id.Name)
+	case RolePolecat:
+		return fmt.Sprintf("%s/%s", id.Rig, id.Name)
+	default:
+		return ""
+	}
+}
+
+// GTRole returns the value to assign to the GT_ROLE environment variable
+// for this identity. Currently identical to Address.
+func (id Identity) GTRole() string {
+	return id.Address()
+}
+
+// FromEnv resolves an Identity from the GT_ROLE environment variable and
+// its companions (GT_RIG, GT_POLECAT, GT_CREW). ok is false if GT_ROLE
+// isn't set, or is set to a role that needs a rig/name that isn't
+// available from its companion env vars.
+//
+// GT_ROLE may be a simple role name ("mayor", "polecat") or a full
+// address ("gastown/crew/joe"), in which case it's parsed by FromAddress.
+func FromEnv() (id Identity, ok bool) {
+	role := os.Getenv("GT_ROLE")
+	if role == "" {
+		return Identity{}, false
+	}
+
+	if strings.Contains(role, "/") {
+		parsed, err := FromAddress(role)
+		if err != nil {
+			return Identity{}, false
+		}
+		return parsed, true
+	}
+
+	rig := os.Getenv("GT_RIG")
+	switch role {
+	case "mayor":
+		return Identity{Role: RoleMayor}, true
+	case "deacon":
+		return Identity{Role: RoleDeacon}, true
+	case "witness":
+		if rig == "" {
+			return Identity{}, false
+		}
+		return Identity{Role: RoleWitness, Rig: rig}, true
+	case "refinery":
+		if rig == "" {
+			return Identity{}, false
+		}
+		return Identity{Role: RoleRefinery, Rig: rig}, true
+	case "polecat":
+		polecat := os.Getenv("GT_POLECAT")
+		if rig == "" || polecat == "" {
+			return Identity{}, false
+		}
+		return Identity{Role: RolePolecat, Rig: rig, Name: polecat}, true
+	case "crew":
+		crew := os.Getenv("GT_CREW")
+		if rig == "" || crew == "" {
+			return Identity{}, false
+		}
+		return Identity{Role: RoleCrew, Rig: rig, Name: crew}, true
+	default:
+		return Identity{}, false
+	}
+}
+
+// FromPath resolves an Identity by looking for a "/polecats/<name>",
+// "/crew/<name>", "/witness", or "/refinery" segment anywhere in path. ok
+// is false if none is found.
+//
+// This is a substring heuristic, not a townRoot-relative walk - it works
+// for cwd-based detection wherever the path lives (e.g. a polecat clone
+// checked out outside the town directory tree), which is what mail's
+// sender detection has always relied on. cmd.detectRole solves a related
+// but distinct problem (classifying a path known to be under townRoot,
+// including roles like RoleBoot that have no address/session-name form)
+// and is intentionally not folded into this heuristic.
+func FromPath(path string) (id Identity, ok bool) {
+	if strings.Contains(path, "/polecats/") {
+		parts := strings.SplitN(path, "/polecats/", 2)
+		name := strings.Split(parts[1], "/")[0]
+		if rig := lastPathSegment(parts[0]); rig != "" && name != "" {
+			return Identity{Role: RolePolecat, Rig: rig, Name: name}, true
+		}
+	}
+	if strings.Contains(path, "/crew/") {
+		parts := strings.SplitN(path, "/crew/", 2)
+		name := strings.Split(parts[1], "/")[0]
+		if rig := lastPathSegment(parts[0]); rig != "" && name != "" {
+			return Identity{Role: RoleCrew, Rig: rig, Name: name}, true
+		}
+	}
+	if strings.Contains(path, "/refinery") {
+		if rig := lastPathSegment(strings.SplitN(path, "/refinery", 2)[0]); rig != "" {
+			return Identity{Role: RoleRefinery, Rig: rig}, true
+		}
+	}
+	if strings.Contains(path, "/witness") {
+		if rig := lastPathSegment(strings.SplitN(path, "/witness", 2)[0]); rig != "" {
+			return Identity{Role: RoleWitness, Rig: rig}, true
+		}
+	}
+	return Identity{}, false
+}
+
+func lastPathSegment(p string) string {
+	p = strings.TrimSuffix(p, "/")
+	if i := strings.LastIndex(p, "/"); i >= 0 {
+		return p[i+1:]
+	}
+	return p
+}
+
+// FromSessionName parses a tmux session name (hq-mayor, gt-<rig>-witness,
+// gt-<rig>-refinery, gt-<rig>-crew-<name>, gt-<rig>-<name>) into an
+// Identity.
+func FromSessionName(name string) (Identity, error) {
+	parsed, err := session.ParseSessionName(name)
+	if err != nil {
+		return Identity{}, err
+	}
+	return Identity{Role: Role(parsed.Role), Rig: parsed.Rig, Name: parsed.Name}, nil
+}
+
+// FromAddress parses a mail-style address into an Identity. It accepts
+// both the short polecat form mail routing uses ("<rig>/<name>") and the
+// longer "<rig>/polecats/<name>" form session.AgentIdentity.Address
+// produces - both resolve to the same Identity.
+func FromAddress(address string) (Identity, error) {
+	trimmed := strings.TrimSuffix(strings.TrimSpace(address), "/")
+
+	switch trimmed {
+	case "mayor":
+		return Identity{Role: RoleMayor}, nil
+	case "deacon":
+		return Identity{Role: RoleDeacon}, nil
+	}
+
+	parts := strings.Split(trimmed, "/")
+	switch len(parts) {
+	case 2:
+		rig, second := parts[0], parts[1]
+		switch second {
+		case "witness":
+			return Identity{Role: RoleWitness, Rig: rig}, nil
+		case "refinery":
+			return Identity{Role: RoleRefinery, Rig: rig}, nil
+		default:
+			// Short form: <rig>/<polecatName>
+			return Identity{Role: RolePolecat, Rig: rig, Name: second}, nil
+		}
+	case 3:
+		rig, kind, name := parts[0], parts[1], parts[2]
+		switch kind {
+		case "crew":
+			return Identity{Role: RoleCrew, Rig: rig, Name: name}, nil
+		case "polecats":
+			return Identity{Role: RolePolecat, Rig: rig, Name: name}, nil
+		}
+	}
+
+	return Identity{}, fmt.Errorf("unrecognized address %q", address)
+}
@@ -0,0 +1,276 @@
+package identity
+
+import (
+	"os"
+	"testing"
+)
+
+func TestFromAddress(t *testing.T) {
+	tests := []struct {
+		name     string
+		address  string
+		wantRole Role
+		wantRig  string
+		wantName string
+		wantErr  bool
+	}{
+		{name: "mayor", address: "mayor", wantRole: RoleMayor},
+		{name: "mayor with trailing slash", address: "mayor/", wantRole: RoleMayor},
+		{name: "deacon", address: "deacon", wantRole: RoleDeacon},
+		{name: "deacon with trailing slash", address: "deacon/", wantRole: RoleDeacon},
+		{name: "witness", address: "gastown/witness", wantRole: RoleWitness, wantRig: "gastown"},
+		{name: "refinery", address: "gastown/refinery", wantRole: RoleRefinery, wantRig: "gastown"},
+		{name: "crew", address: "gastown/crew/max", wantRole: RoleCrew, wantRig: "gastown", wantName: "max"},
+		{
+			name:     "polecat short form (mail addressing)",
+			address:  "gastown/Toast",
+			wantRole: RolePolecat,
+			wantRig:  "gastown",
+			wantName: "Toast",
+		},
+		{
+			name:     "polecat long form (session.AgentIdentity.Address form)",
+			address:  "gastown/polecats/Toast",
+			wantRole: RolePolecat,
+			wantRig:  "gastown",
+			wantName: "Toast",
+		},
+		{name: "empty", address: "", wantErr: true},
+		{name: "too many segments", address: "gastown/foo/bar/baz", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromAddress(tt.address)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromAddress(%q) error = %v, wantErr %v", tt.address, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Role != tt.wantRole || got.Rig != tt.wantRig || got.Name != tt.wantName {
+				t.Errorf("FromAddress(%q) = %+v, want {%v %v %v}", tt.address, got, tt.wantRole, tt.wantRig, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestFromAddress_ShortAndLongPolecatFormsAgree(t *testing.T) {
+	short, err := FromAddress("gastown/Toast")
+	if err != nil {
+		t.Fatalf("FromAddress(short) error = %v", err)
+	}
+	long, err := FromAddress("gastown/polecats/Toast")
+	if err != nil {
+		t.Fatalf("FromAddress(long) error = %v", err)
+	}
+	if short != long {
+		t.Errorf("short form %+v and long form %+v should resolve to the same Identity", short, long)
+	}
+}
+
+func TestFromSessionName(t *testing.T) {
+	tests := []struct {
+		name     string
+		session  string
+		wantRole Role
+		wantRig  string
+		wantName string
+		wantErr  bool
+	}{
+		{name: "mayor", session: "hq-mayor", wantRole: RoleMayor},
+		{name: "deacon", session: "hq-deacon", wantRole: RoleDeacon},
+		{name: "witness", session: "gt-gastown-witness", wantRole: RoleWitness, wantRig: "gastown"},
+		{name: "refinery hyphenated rig", session: "gt-my-project-refinery", wantRole: RoleRefinery, wantRig: "my-project"},
+		{name: "crew", session: "gt-gastown-crew-max", wantRole: RoleCrew, wantRig: "gastown", wantName: "max"},
+		{name: "polecat", session: "gt-gastown-morsov", wantRole: RolePolecat, wantRig: "gastown", wantName: "morsov"},
+		{name: "invalid", session: "not-a-session", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromSessionName(tt.session)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromSessionName(%q) error = %v, wantErr %v", tt.session, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Role != tt.wantRole || got.Rig != tt.wantRig || got.Name != tt.wantName {
+				t.Errorf("FromSessionName(%q) = %+v, want {%v %v %v}", tt.session, got, tt.wantRole, tt.wantRig, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestFromPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		wantRole Role
+		wantRig  string
+		wantName string
+		wantOk   bool
+	}{
+		{
+			name:     "polecat path",
+			path:     "/home/user/gt/gastown/polecats/Toast/rig",
+			wantRole: RolePolecat,
+			wantRig:  "gastown",
+			wantName: "Toast",
+			wantOk:   true,
+		},
+		{
+			name:     "crew path",
+			path:     "/home/user/gt/gastown/crew/max/rig",
+			wantRole: RoleCrew,
+			wantRig:  "gastown",
+			wantName: "max",
+			wantOk:   true,
+		},
+		{
+			name:     "witness path",
+			path:     "/home/user/gt/gastown/witness/rig",
+			wantRole: RoleWitness,
+			wantRig:  "gastown",
+			wantOk:   true,
+		},
+		{
+			name:     "refinery path",
+			path:     "/home/user/gt/gastown/refinery/rig",
+			wantRole: RoleRefinery,
+			wantRig:  "gastown",
+			wantOk:   true,
+		},
+		{
+			name:   "unrelated path",
+			path:   "/home/user/gt/mayor",
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := FromPath(tt.path)
+			if ok != tt.wantOk {
+				t.Fatalf("FromPath(%q) ok = %v, want %v", tt.path, ok, tt.wantOk)
+			}
+			if !ok {
+				return
+			}
+			if got.Role != tt.wantRole || got.Rig != tt.wantRig || got.Name != tt.wantName {
+				t.Errorf("FromPath(%q) = %+v, want {%v %v %v}", tt.path, got, tt.wantRole, tt.wantRig, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	for _, v := range []string{"GT_ROLE", "GT_RIG", "GT_POLECAT", "GT_CREW"} {
+		t.Setenv(v, "")
+	}
+
+	tests := []struct {
+		name     string
+		env      map[string]string
+		wantRole Role
+		wantRig  string
+		wantName string
+		wantOk   bool
+	}{
+		{name: "unset", env: map[string]string{}, wantOk: false},
+		{name: "mayor", env: map[string]string{"GT_ROLE": "mayor"}, wantRole: RoleMayor, wantOk: true},
+		{name: "deacon", env: map[string]string{"GT_ROLE": "deacon"}, wantRole: RoleDeacon, wantOk: true},
+		{
+			name:     "polecat with companions",
+			env:      map[string]string{"GT_ROLE": "polecat", "GT_RIG": "gastown", "GT_POLECAT": "Toast"},
+			wantRole: RolePolecat, wantRig: "gastown", wantName: "Toast", wantOk: true,
+		},
+		{
+			name:   "polecat missing companions",
+			env:    map[string]string{"GT_ROLE": "polecat"},
+			wantOk: false,
+		},
+		{
+			name:     "crew with companions",
+			env:      map[string]string{"GT_ROLE": "crew", "GT_RIG": "gastown", "GT_CREW": "max"},
+			wantRole: RoleCrew, wantRig: "gastown", wantName: "max", wantOk: true,
+		},
+		{
+			name:     "witness with rig",
+			env:      map[string]string{"GT_ROLE": "witness", "GT_RIG": "gastown"},
+			wantRole: RoleWitness, wantRig: "gastown", wantOk: true,
+		},
+		{
+			name:     "full address in GT_ROLE",
+			env:      map[string]string{"GT_ROLE": "gastown/crew/max"},
+			wantRole: RoleCrew, wantRig: "gastown", wantName: "max", wantOk: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, v := range []string{"GT_ROLE", "GT_RIG", "GT_POLECAT", "GT_CREW"} {
+				os.Unsetenv(v)
+			}
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			got, ok := FromEnv()
+			if ok != tt.wantOk {
+				t.Fatalf("FromEnv() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !ok {
+				return
+			}
+			if got.Role != tt.wantRole || got.Rig != tt.wantRig || got.Name != tt.wantName {
+				t.Errorf("FromEnv() = %+v, want {%v %v %v}", got, tt.wantRole, tt.wantRig, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestIdentityAddress(t *testing.T) {
+	tests := []struct {
+		name     string
+		identity Identity
+		want     string
+	}{
+		{name: "mayor", identity: Identity{Role: RoleMayor}, want: "mayor/"},
+		{name: "deacon", identity: Identity{Role: RoleDeacon}, want: "deacon/"},
+		{name: "witness", identity: Identity{Role: RoleWitness, Rig: "gastown"}, want: "gastown/witness"},
+		{name: "refinery", identity: Identity{Role: RoleRefinery, Rig: "gastown"}, want: "gastown/refinery"},
+		{name: "crew", identity: Identity{Role: RoleCrew, Rig: "gastown", Name: "max"}, want: "gastown/crew/max"},
+		{name: "polecat", identity: Identity{Role: RolePolecat, Rig: "gastown", Name: "Toast"}, want: "gastown/Toast"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.identity.Address(); got != tt.want {
+				t.Errorf("Address() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIdentitySessionName(t *testing.T) {
+	tests := []struct {
+		name     string
+		identity Identity
+		want     string
+	}{
+		{name: "mayor", identity: Identity{Role: RoleMayor}, want: "hq-mayor"},
+		{name: "witness", identity: Identity{Role: RoleWitness, Rig: "gastown"}, want: "gt-gastown-witness"},
+		{name: "crew", identity: Identity{Role: RoleCrew, Rig: "gastown", Name: "max"}, want: "gt-gastown-crew-max"},
+		{name: "polecat", identity: Identity{Role: RolePolecat, Rig: "gastown", Name: "Toast"}, want: "gt-gastown-Toast"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.identity.SessionName(); got != tt.want {
+				t.Errorf("SessionName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package mail
+
+import "testing"
+
+func TestParsePriorityFilter(t *testing.T) {
+	tests := []struct {
+		s       string
+		cmp     string
+		value   int
+		wantErr bool
+	}{
+		{"1", "=", 1, false},
+		{"=1", "=", 1, false},
+		{"<=1", "<=", 1, false},
+		{">=2", ">=", 2, false},
+		{"<3", "<", 3, false},
+		{">0", ">", 0, false},
+		{" <= 1 ", "<=", 1, false},
+		{"", "", 0, true},
+		{"nope", "", 0, true},
+		{"<=nope", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			cmp, value, err := ParsePriorityFilter(tt.s)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParsePriorityFilter(%q) expected error, got nil", tt.s)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParsePriorityFilter(%q) unexpected error: %v", tt.s, err)
+			}
+			if cmp != tt.cmp || value != tt.value {
+				t.Errorf("ParsePriorityFilter(%q) = (%q, %d), want (%q, %d)", tt.s, cmp, value, tt.cmp, tt.value)
+			}
+		})
+	}
+}
+
+func TestListFilterMatches(t *testing.T) {
+	msg := func(read bool, msgType MessageType, priority Priority) *Message {
+		return &Message{Read: read, Type: msgType, Priority: priority}
+	}
+
+	tests := []struct {
+		name   string
+		filter ListFilter
+		msg    *Message
+		want   bool
+	}{
+		{"no filter matches anything", ListFilter{}, msg(true, TypeTask, PriorityNormal), true},
+		{"unread excludes read", ListFilter{Unread: true}, msg(true, TypeTask, PriorityNormal), false},
+		{"unread includes unread", ListFilter{Unread: true}, msg(false, TypeTask, PriorityNormal), true},
+		{"type match", ListFilter{Types: []MessageType{TypeTask}}, msg(false, TypeTask, PriorityNormal), true},
+		{"type mismatch", ListFilter{Types: []MessageType{TypeScavenge}}, msg(false, TypeTask, PriorityNormal), false},
+		{"type list matches one of many", ListFilter{Types: []MessageType{TypeReply, TypeTask}}, msg(false, TypeTask, PriorityNormal), true},
+		{"priority <= matches equal", ListFilter{PriorityCmp: "<=", PriorityValue: 1}, msg(false, TypeTask, PriorityHigh), true},
+		{"priority <= excludes lower urgency number", ListFilter{PriorityCmp: "<=", PriorityValue: 1}, msg(false, TypeTask, PriorityNormal), false},
+		{"priority = matches", ListFilter{PriorityCmp: "=", PriorityValue: 0}, msg(false, TypeTask, PriorityUrgent), true},
+		{"combined filter all match", ListFilter{Unread: true, Types: []MessageType{TypeTask}, PriorityCmp: "<=", PriorityValue: 1}, msg(false, TypeTask, PriorityHigh), true},
+		{"combined filter fails on unread", ListFilter{Unread: true, Types: []MessageType{TypeTask}, PriorityCmp: "<=", PriorityValue: 1}, msg(true, TypeTask, PriorityHigh), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.matches(tt.msg); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterMessagesEmptyResult(t *testing.T) {
+	messages := []*Message{
+		{Type: TypeTask, Priority: PriorityNormal},
+		{Type: TypeNotification, Priority: PriorityLow},
+	}
+
+	got := filterMessages(messages, ListFilter{Types: []MessageType{TypeReply}})
+	if len(got) != 0 {
+		t.Errorf("filterMessages() = %d messages, want 0", len(got))
+	}
+}
@@ -0,0 +1,123 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// humanBridgeRetries is how many delivery attempts deliverToHuman makes
+// before giving up and leaving the failure to RetryHumanDeliveries.
+const humanBridgeRetries = 3
+
+// humanBridgeRetryDelay is the initial backoff between attempts, doubling
+// each time. A var so tests can shrink it.
+var humanBridgeRetryDelay = time.Second
+
+// humanBridgeTimeout bounds a single webhook POST or sendmail invocation.
+const humanBridgeTimeout = 10 * time.Second
+
+// HumanDeliveryPayload is the JSON body posted to a webhook (and the source
+// data for the plaintext piped to a sendmail command) when a message is
+// sent to the overseer.
+type HumanDeliveryPayload struct {
+	From     string   `json:"from"`
+	Subject  string   `json:"subject"`
+	Body     string   `json:"body"`
+	Priority Priority `json:"priority"`
+	SentAt   string   `json:"sent_at"`
+}
+
+func newHumanDeliveryPayload(msg *Message, now time.Time) HumanDeliveryPayload {
+	return HumanDeliveryPayload{
+		From:     msg.From,
+		Subject:  msg.Subject,
+		Body:     msg.Body,
+		Priority: msg.Priority,
+		SentAt:   now.UTC().Format(time.RFC3339),
+	}
+}
+
+// deliverToHuman bridges msg to the overseer's configured external channel,
+// retrying transient failures with exponential backoff. A nil cfg, or one
+// with neither delivery method set, is not an error - there's simply
+// nothing configured to bridge to, and the beads mailbox copy is the only
+// record.
+func deliverToHuman(cfg *config.HumanDeliveryConfig, msg *Message) error {
+	if cfg == nil || (cfg.WebhookURL == "" && cfg.SendmailCmd == "") {
+		return nil
+	}
+
+	payload := newHumanDeliveryPayload(msg, time.Now())
+
+	var lastErr error
+	delay := humanBridgeRetryDelay
+	for attempt := 1; attempt <= humanBridgeRetries; attempt++ {
+		if cfg.WebhookURL != "" {
+			lastErr = deliverViaWebhook(cfg.WebhookURL, payload)
+		} else {
+			lastErr = deliverViaSendmail(cfg.SendmailCmd, payload)
+		}
+		if lastErr == nil {
+			return nil
+		}
+		if attempt < humanBridgeRetries {
+			time.Sleep(delay)
+			delay *= 2
+		}
+	}
+	return fmt.Errorf("human bridge delivery failed after %d attempts: %w", humanBridgeRetries, lastErr)
+}
+
+// deliverViaWebhook POSTs payload as JSON to url.
+func deliverViaWebhook(url string, payload HumanDeliveryPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("encoding webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("building webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: humanBridgeTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("posting to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// deliverViaSendmail pipes payload, formatted as plaintext, to cmdline's
+// stdin. cmdline is split on whitespace, e.g. "sendmail -t overseer@example.com".
+func deliverViaSendmail(cmdline string, payload HumanDeliveryPayload) error {
+	fields := strings.Fields(cmdline)
+	if len(fields) == 0 {
+		return fmt.Errorf("empty sendmail command")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), humanBridgeTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, fields[0], fields[1:]...) //nolint:gosec // G204: sendmail_cmd is town-operator configuration, not remote input
+	cmd.Stdin = strings.NewReader(fmt.Sprintf("From: %s\nSubject: %s\n\n%s\n", payload.From, payload.Subject, payload.Body))
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("sendmail command failed: %w (%s)", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+package mail
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestDeliverToHuman_NilConfig_NoOp(t *testing.T) {
+	if err := deliverToHuman(nil, &Message{Subject: "hi"}); err != nil {
+		t.Errorf("deliverToHuman(nil, ...) = %v, want nil", err)
+	}
+}
+
+func TestDeliverToHuman_Webhook_PayloadShape(t *testing.T) {
+	var gotPayload HumanDeliveryPayload
+	var gotContentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		if err := json.NewDecoder(r.Body).Decode(&gotPayload); err != nil {
+			t.Errorf("decoding webhook payload: %v", err)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	msg := &Message{From: "gastown/Toast", Subject: "Need input", Body: "Which approach?", Priority: PriorityHigh}
+	if err := deliverToHuman(&config.HumanDeliveryConfig{WebhookURL: server.URL}, msg); err != nil {
+		t.Fatalf("deliverToHuman() error = %v", err)
+	}
+
+	if gotContentType != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", gotContentType)
+	}
+	if gotPayload.From != msg.From || gotPayload.Subject != msg.Subject || gotPayload.Body != msg.Body || gotPayload.Priority != msg.Priority {
+		t.Errorf("payload = %+v, want fields to match message %+v", gotPayload, msg)
+	}
+	if gotPayload.SentAt == "" {
+		t.Error("payload.SentAt is empty")
+	}
+}
+
+func TestDeliverToHuman_Webhook_RetriesOnFailureThenSucceeds(t *testing.T) {
+	orig := humanBridgeRetryDelay
+	humanBridgeRetryDelay = time.Millisecond
+	defer func() { humanBridgeRetryDelay = orig }()
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		if n < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	msg := &Message{From: "mayor/", Subject: "test", Body: "body"}
+	if err := deliverToHuman(&config.HumanDeliveryConfig{WebhookURL: server.URL}, msg); err != nil {
+		t.Fatalf("deliverToHuman() error = %v", err)
+	}
+	if atomic.LoadInt32(&calls) != 3 {
+		t.Errorf("webhook called %d times, want 3", calls)
+	}
+}
+
+func TestDeliverToHuman_Webhook_GivesUpAfterRetriesExhausted(t *testing.T) {
+	orig := humanBridgeRetryDelay
+	humanBridgeRetryDelay = time.Millisecond
+	defer func() { humanBridgeRetryDelay = orig }()
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	msg := &Message{From: "mayor/", Subject: "test", Body: "body"}
+	err := deliverToHuman(&config.HumanDeliveryConfig{WebhookURL: server.URL}, msg)
+	if err == nil {
+		t.Fatal("deliverToHuman() = nil, want error")
+	}
+	if atomic.LoadInt32(&calls) != humanBridgeRetries {
+		t.Errorf("webhook called %d times, want %d", calls, humanBridgeRetries)
+	}
+}
+
+func TestDeliverToHuman_Sendmail_PipesPlaintext(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("sendmail_cmd stub is a shell script")
+	}
+
+	binDir := t.TempDir()
+	outFile := filepath.Join(binDir, "captured.txt")
+	stub := filepath.Join(binDir, "fake-sendmail")
+	script := "#!/bin/sh\ncat > " + outFile + "\n"
+	if err := os.WriteFile(stub, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	msg := &Message{From: "mayor/", Subject: "Escalation", Body: "The build is red."}
+	if err := deliverToHuman(&config.HumanDeliveryConfig{SendmailCmd: stub}, msg); err != nil {
+		t.Fatalf("deliverToHuman() error = %v", err)
+	}
+
+	captured, err := os.ReadFile(outFile)
+	if err != nil {
+		t.Fatalf("sendmail stub did not receive stdin: %v", err)
+	}
+	if !strings.Contains(string(captured), "Escalation") || !strings.Contains(string(captured), "The build is red.") {
+		t.Errorf("captured sendmail input = %q, want it to contain the subject and body", captured)
+	}
+}
+
+// ============ Router integration: overseer bridge ============
+
+func writeOverseerBridgeBDStub(t *testing.T, binDir, callLog, createdID string) {
+	t.Helper()
+	script := `#!/bin/sh
+echo "$@" >> ` + callLog + `
+if [ "$1" = "create" ]; then
+  echo '{"id":"` + createdID + `"}'
+elif [ "$1" = "list" ]; then
+  cat <<EOF
+[
+  {"id":"` + createdID + `","title":"hi","description":"body","assignee":"overseer","priority":2,"status":"open","created_at":"2026-01-01T00:00:00Z","labels":["from:mayor/","human-bridge-pending"]}
+]
+EOF
+fi
+`
+	writeBDStub(t, binDir, script)
+}
+
+func TestRouter_SendToOverseer_FlagsPendingOnBridgeFailure(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub is a shell script")
+	}
+
+	binDir := t.TempDir()
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(townRoot, "mayor"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	overseerCfg := &config.OverseerConfig{
+		Type: "overseer", Version: 1, Name: "Steve",
+		Delivery: &config.HumanDeliveryConfig{WebhookURL: "http://127.0.0.1:1/does-not-exist"},
+	}
+	if err := config.SaveOverseerConfig(config.OverseerConfigPath(townRoot), overseerCfg); err != nil {
+		t.Fatal(err)
+	}
+
+	origDelay := humanBridgeRetryDelay
+	humanBridgeRetryDelay = time.Millisecond
+	defer func() { humanBridgeRetryDelay = origDelay }()
+
+	callLog := filepath.Join(binDir, "calls.log")
+	if err := os.WriteFile(callLog, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	writeOverseerBridgeBDStub(t, binDir, callLog, "hq-human1")
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	r := NewRouterWithTownRoot(townRoot, townRoot)
+	msg := &Message{From: "mayor/", To: "overseer", Subject: "Need a decision", Body: "Pick A or B"}
+	if err := r.Send(msg); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	log, err := os.ReadFile(callLog)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(log), "label add hq-human1 human-bridge-pending") {
+		t.Errorf("expected the mailbox bead to be flagged pending after a bridge failure, calls:\n%s", log)
+	}
+}
+
+func TestRouter_RetryHumanDeliveries_ClearsLabelOnSuccess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub is a shell script")
+	}
+
+	binDir := t.TempDir()
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(townRoot, "mayor"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	overseerCfg := &config.OverseerConfig{
+		Type: "overseer", Version: 1, Name: "Steve",
+		Delivery: &config.HumanDeliveryConfig{WebhookURL: server.URL},
+	}
+	if err := config.SaveOverseerConfig(config.OverseerConfigPath(townRoot), overseerCfg); err != nil {
+		t.Fatal(err)
+	}
+
+	callLog := filepath.Join(binDir, "calls.log")
+	if err := os.WriteFile(callLog, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	writeOverseerBridgeBDStub(t, binDir, callLog, "hq-human2")
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	r := NewRouterWithTownRoot(townRoot, townRoot)
+	delivered, err := r.RetryHumanDeliveries()
+	if err != nil {
+		t.Fatalf("RetryHumanDeliveries() error = %v", err)
+	}
+	if delivered != 1 {
+		t.Errorf("delivered = %d, want 1", delivered)
+	}
+
+	log, err := os.ReadFile(callLog)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(log), "label remove hq-human2 human-bridge-pending") {
+		t.Errorf("expected the pending label to be cleared, calls:\n%s", log)
+	}
+}
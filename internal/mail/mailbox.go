@@ -13,7 +13,6 @@ import (
 	"time"
 
 	"github.com/steveyegge/gastown/internal/beads"
-	"github.com/steveyegge/gastown/internal/runtime"
 )
 
 // timeNow is a function that returns the current time. It can be overridden in tests.
@@ -234,10 +233,16 @@ func (m *Mailbox) queryMessages(beadsDir, filterFlag, filterValue, status string
 		return nil, err
 	}
 
-	// Convert to GGT messages - wisp status comes from beads issue.wisp field
+	// Convert to GGT messages - wisp status comes from beads issue.wisp field.
+	// Pending (scheduled but not yet due) messages are excluded here so they
+	// stay invisible until Router.ReleaseDue delivers them.
 	var messages []*Message
 	for _, bm := range beadsMsgs {
-		messages = append(messages, bm.ToMessage())
+		msg := bm.ToMessage()
+		if msg.Pending {
+			continue
+		}
+		messages = append(messages, msg)
 	}
 
 	return messages, nil
@@ -299,12 +304,119 @@ func (m *Mailbox) ListUnread() ([]*Message, error) {
 	return m.List()
 }
 
-// Get returns a message by ID.
+// ListUnreadVisible returns unread messages that aren't currently snoozed,
+// plus a count of unread messages an active snooze is hiding. peek/check use
+// this instead of ListUnread so a low-value notification the recipient
+// snoozed can't keep a more important one buried behind it.
+func (m *Mailbox) ListUnreadVisible(now time.Time) (visible []*Message, snoozed int, err error) {
+	messages, err := m.ListUnread()
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, msg := range messages {
+		if msg.IsSnoozed(now) {
+			snoozed++
+			continue
+		}
+		visible = append(visible, msg)
+	}
+	return visible, snoozed, nil
+}
+
+// Snooze hides a message from peek/check until the given time by recording a
+// snoozed-until label (or, in legacy mode, the SnoozedUntil field). The
+// message stays in the inbox and still counts as unread.
+func (m *Mailbox) Snooze(id string, until time.Time) error {
+	if m.legacy {
+		return m.snoozeLegacy(id, until)
+	}
+	return m.snoozeBeads(id, until)
+}
+
+func (m *Mailbox) snoozeBeads(id string, until time.Time) error {
+	// Re-snoozing needs the old label removed first - labels are
+	// exact-value, so a stale snoozed-until:<time> would otherwise linger
+	// alongside the new one.
+	if existing, err := m.Get(id); err == nil && existing.SnoozedUntil != nil {
+		old := "snoozed-until:" + existing.SnoozedUntil.UTC().Format(time.RFC3339)
+		_, _ = runBdCommand([]string{"label", "remove", id, old}, m.workDir, m.beadsDir)
+	}
+
+	args := []string{"label", "add", id, "snoozed-until:" + until.UTC().Format(time.RFC3339)}
+	_, err := runBdCommand(args, m.workDir, m.beadsDir)
+	if err != nil {
+		if bdErr, ok := err.(*bdError); ok && bdErr.ContainsError("not found") {
+			return ErrMessageNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *Mailbox) snoozeLegacy(id string, until time.Time) error {
+	messages, err := m.List()
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, msg := range messages {
+		if msg.ID == id {
+			u := until
+			msg.SnoozedUntil = &u
+			found = true
+		}
+	}
+
+	if !found {
+		return ErrMessageNotFound
+	}
+
+	return m.rewriteLegacy(messages)
+}
+
+// ListFiltered returns messages matching filter. Unread, Types and
+// PriorityCmp/PriorityValue narrow the base List() results; a zero-value
+// ListFilter behaves like List().
+func (m *Mailbox) ListFiltered(filter ListFilter) ([]*Message, error) {
+	messages, err := m.List()
+	if err != nil {
+		return nil, err
+	}
+	return filterMessages(messages, filter), nil
+}
+
+// Get returns a message by ID. If the body was spilled to a blob file
+// (BodyRef set), the full body is transparently reloaded from disk; if the
+// blob has since been deleted, the truncated preview already in Body is
+// left as-is so callers can still show something.
 func (m *Mailbox) Get(id string) (*Message, error) {
+	var msg *Message
+	var err error
 	if m.legacy {
-		return m.getLegacy(id)
+		msg, err = m.getLegacy(id)
+	} else {
+		msg, err = m.getBeads(id)
+	}
+	if err != nil {
+		return nil, err
 	}
-	return m.getBeads(id)
+	reloadBodyRef(msg)
+	return msg, nil
+}
+
+// reloadBodyRef replaces msg.Body with the full contents of its blob file
+// when BodyRef is set and the blob still exists.
+func reloadBodyRef(msg *Message) {
+	if msg.BodyRef == "" {
+		return
+	}
+	data, err := os.ReadFile(msg.BodyRef)
+	if err != nil {
+		return
+	}
+	msg.Body = string(data)
 }
 
 func (m *Mailbox) getBeads(id string) (*Message, error) {
@@ -365,15 +477,9 @@ func (m *Mailbox) markReadBeads(id string) error {
 
 // closeInDir closes a message in a specific beads directory.
 func (m *Mailbox) closeInDir(id, beadsDir string) error {
-	args := []string{"close", id}
-	// Pass session ID for work attribution if available
-	if sessionID := runtime.SessionIDFromEnv(); sessionID != "" {
-		args = append(args, "--session="+sessionID)
-	}
-
-	_, err := runBdCommand(args, m.workDir, beadsDir)
+	err := beads.NewWithBeadsDir(m.workDir, beadsDir).Close(id, "")
 	if err != nil {
-		if bdErr, ok := err.(*bdError); ok && bdErr.ContainsError("not found") {
+		if errors.Is(err, beads.ErrNotFound) {
 			return ErrMessageNotFound
 		}
 		return err
@@ -467,11 +573,9 @@ func (m *Mailbox) MarkUnread(id string) error {
 }
 
 func (m *Mailbox) markUnreadBeads(id string) error {
-	args := []string{"reopen", id}
-
-	_, err := runBdCommand(args, m.workDir, m.beadsDir)
+	err := beads.NewWithBeadsDir(m.workDir, m.beadsDir).Reopen(id, "")
 	if err != nil {
-		if bdErr, ok := err.(*bdError); ok && bdErr.ContainsError("not found") {
+		if errors.Is(err, beads.ErrNotFound) {
 			return ErrMessageNotFound
 		}
 		return err
@@ -509,6 +613,48 @@ func (m *Mailbox) Delete(id string) error {
 	return m.MarkRead(id) // beads: just acknowledge/close
 }
 
+// DeleteWhere deletes every message matching filter and returns how many
+// were deleted. It follows the same legacy-vs-beads split as Delete: legacy
+// mailboxes physically remove the matching messages in one rewrite, beads
+// mailboxes acknowledge/close them one at a time. Pinned messages are
+// skipped unless filter.IncludePinned is set.
+func (m *Mailbox) DeleteWhere(filter ListFilter) (int, error) {
+	messages, err := m.List()
+	if err != nil {
+		return 0, err
+	}
+	matched := filterMessages(messages, filter)
+	if len(matched) == 0 {
+		return 0, nil
+	}
+
+	if m.legacy {
+		toDelete := make(map[string]bool, len(matched))
+		for _, msg := range matched {
+			toDelete[msg.ID] = true
+		}
+		var kept []*Message
+		for _, msg := range messages {
+			if !toDelete[msg.ID] {
+				kept = append(kept, msg)
+			}
+		}
+		if err := m.rewriteLegacy(kept); err != nil {
+			return 0, err
+		}
+		return len(matched), nil
+	}
+
+	deleted := 0
+	for _, msg := range matched {
+		if err := m.MarkRead(msg.ID); err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
 func (m *Mailbox) deleteLegacy(id string) error {
 	messages, err := m.List()
 	if err != nil {
@@ -783,6 +929,135 @@ func (m *Mailbox) Count() (total, unread int, err error) {
 	return total, unread, nil
 }
 
+// CountUnread returns the number of unread messages without deserializing
+// full message bodies. Callers that only need to decide whether mail exists
+// (mail check, mail peek) should use this instead of Count/List so a busy
+// inbox doesn't pay for parsing subjects, bodies and timestamps on every
+// agent turn.
+func (m *Mailbox) CountUnread() (int, error) {
+	if m.legacy {
+		return m.countUnreadLegacy()
+	}
+	return m.countUnreadBeads()
+}
+
+// countRecord is the subset of a message needed to tell whether it's
+// unread, whether decoded from a beads JSON row or a legacy JSONL line.
+type countRecord struct {
+	Status string   `json:"status"`
+	Read   bool     `json:"read"`
+	Labels []string `json:"labels"`
+}
+
+func (r countRecord) isUnread() bool {
+	if r.Read || r.Status == "closed" {
+		return false
+	}
+	for _, l := range r.Labels {
+		if l == "read" || l == "pending" {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Mailbox) countUnreadLegacy() (int, error) {
+	file, err := os.Open(m.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	defer func() { _ = file.Close() }() // non-fatal: OS will close on exit
+
+	unread := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		var rec countRecord
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			continue // Skip malformed lines
+		}
+		if rec.isUnread() {
+			unread++
+		}
+	}
+
+	return unread, scanner.Err()
+}
+
+// countUnreadBeads runs the same assignee/CC queries as listBeads but
+// unmarshals into countRecord instead of BeadsMessage, so bd's response is
+// parsed for status/labels only - no subject, body, or timestamp fields.
+func (m *Mailbox) countUnreadBeads() (int, error) {
+	identities := m.identityVariants()
+
+	seen := make(map[string]bool)
+	unread := 0
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var lastErr error
+	anySucceeded := false
+
+	count := func(filterFlag, filterValue, status string) {
+		defer wg.Done()
+		args := []string{"list",
+			"--type", "message",
+			filterFlag, filterValue,
+			"--status", status,
+			"--json",
+		}
+		stdout, err := runBdCommand(args, m.workDir, m.beadsDir)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			lastErr = err
+			return
+		}
+		anySucceeded = true
+
+		var records []struct {
+			ID string `json:"id"`
+			countRecord
+		}
+		if unmarshalErr := json.Unmarshal(stdout, &records); unmarshalErr != nil {
+			return // empty inbox returns empty array or nothing
+		}
+		for _, rec := range records {
+			if seen[rec.ID] {
+				continue
+			}
+			seen[rec.ID] = true
+			if rec.isUnread() {
+				unread++
+			}
+		}
+	}
+
+	for _, identity := range identities {
+		for _, status := range []string{"open", "hooked"} {
+			wg.Add(1)
+			go count("--assignee", identity, status)
+		}
+	}
+	for _, identity := range identities {
+		wg.Add(1)
+		go count("--label", "cc:"+identity, "open")
+	}
+	wg.Wait()
+
+	if !anySucceeded && lastErr != nil {
+		return 0, fmt.Errorf("all mailbox queries failed: %w", lastErr)
+	}
+
+	return unread, nil
+}
+
 // Append adds a message to the mailbox (legacy mode only).
 // For beads mode, use Router.Send() instead.
 func (m *Mailbox) Append(msg *Message) error {
@@ -832,8 +1107,8 @@ func (m *Mailbox) rewriteLegacy(messages []*Message) error {
 	for _, msg := range messages {
 		data, err := json.Marshal(msg)
 		if err != nil {
-			_ = file.Close()         // best-effort cleanup
-			_ = os.Remove(tmpPath)   // best-effort cleanup
+			_ = file.Close()       // best-effort cleanup
+			_ = os.Remove(tmpPath) // best-effort cleanup
 			return err
 		}
 		_, _ = file.WriteString(string(data) + "\n") // non-fatal: partial write is acceptable
@@ -165,6 +165,86 @@ func TestMailboxLegacyMarkRead(t *testing.T) {
 	}
 }
 
+func TestMailboxLegacySnooze(t *testing.T) {
+	tmpDir := t.TempDir()
+	m := NewMailbox(tmpDir)
+
+	msg := &Message{ID: "msg-001", Read: false}
+	if err := m.Append(msg); err != nil {
+		t.Fatalf("Append error: %v", err)
+	}
+
+	until := time.Now().Add(time.Hour)
+	if err := m.Snooze("msg-001", until); err != nil {
+		t.Fatalf("Snooze error: %v", err)
+	}
+
+	got, err := m.Get("msg-001")
+	if err != nil {
+		t.Fatalf("Get error: %v", err)
+	}
+	if got.SnoozedUntil == nil || !got.SnoozedUntil.Equal(until) {
+		t.Errorf("SnoozedUntil = %v, want %v", got.SnoozedUntil, until)
+	}
+
+	// Snoozing is still unread - it only hides from peek/check.
+	unread, err := m.ListUnread()
+	if err != nil {
+		t.Fatalf("ListUnread error: %v", err)
+	}
+	if len(unread) != 1 {
+		t.Fatalf("ListUnread returned %d messages, want 1", len(unread))
+	}
+
+	// Snooze non-existent
+	err = m.Snooze("msg-nonexistent", until)
+	if err != ErrMessageNotFound {
+		t.Errorf("Snooze non-existent = %v, want ErrMessageNotFound", err)
+	}
+}
+
+func TestMailboxLegacyListUnreadVisible(t *testing.T) {
+	tmpDir := t.TempDir()
+	m := NewMailbox(tmpDir)
+
+	now := time.Now()
+	msgs := []*Message{
+		{ID: "msg-001", Subject: "Not snoozed"},
+		{ID: "msg-002", Subject: "Snoozed, still active"},
+		{ID: "msg-003", Subject: "Snoozed, expired"},
+	}
+	for _, msg := range msgs {
+		if err := m.Append(msg); err != nil {
+			t.Fatalf("Append error: %v", err)
+		}
+	}
+
+	active := now.Add(time.Hour)
+	if err := m.Snooze("msg-002", active); err != nil {
+		t.Fatalf("Snooze error: %v", err)
+	}
+	expired := now.Add(-time.Hour)
+	if err := m.Snooze("msg-003", expired); err != nil {
+		t.Fatalf("Snooze error: %v", err)
+	}
+
+	visible, snoozed, err := m.ListUnreadVisible(now)
+	if err != nil {
+		t.Fatalf("ListUnreadVisible error: %v", err)
+	}
+	if snoozed != 1 {
+		t.Errorf("snoozed = %d, want 1", snoozed)
+	}
+	if len(visible) != 2 {
+		t.Fatalf("visible = %d messages, want 2", len(visible))
+	}
+	for _, msg := range visible {
+		if msg.ID == "msg-002" {
+			t.Error("actively snoozed message should not be visible")
+		}
+	}
+}
+
 func TestMailboxLegacyDelete(t *testing.T) {
 	tmpDir := t.TempDir()
 	m := NewMailbox(tmpDir)
@@ -203,6 +283,85 @@ func TestMailboxLegacyDelete(t *testing.T) {
 	}
 }
 
+func TestMailboxLegacyDeleteWhere(t *testing.T) {
+	tmpDir := t.TempDir()
+	m := NewMailbox(tmpDir)
+
+	now := time.Now()
+	msgs := []*Message{
+		{ID: "msg-001", From: "mayor/", Read: true, Timestamp: now.Add(-10 * 24 * time.Hour)},
+		{ID: "msg-002", From: "mayor/", Read: false, Timestamp: now},
+		{ID: "msg-003", From: "refinery/greenplace", Read: true, Timestamp: now.Add(-10 * 24 * time.Hour)},
+		{ID: "msg-004", From: "mayor/", Read: true, Timestamp: now.Add(-10 * 24 * time.Hour), Pinned: true},
+	}
+	for _, msg := range msgs {
+		if err := m.Append(msg); err != nil {
+			t.Fatalf("Append error: %v", err)
+		}
+	}
+
+	n, err := m.DeleteWhere(ListFilter{Read: true, From: "mayor/", OlderThan: 24 * time.Hour, Now: now})
+	if err != nil {
+		t.Fatalf("DeleteWhere error: %v", err)
+	}
+	if n != 1 {
+		t.Fatalf("DeleteWhere deleted %d messages, want 1", n)
+	}
+
+	listed, err := m.List()
+	if err != nil {
+		t.Fatalf("List error: %v", err)
+	}
+	if len(listed) != 3 {
+		t.Fatalf("List returned %d messages, want 3", len(listed))
+	}
+	for _, msg := range listed {
+		if msg.ID == "msg-001" {
+			t.Errorf("msg-001 should have been deleted")
+		}
+	}
+}
+
+func TestMailboxLegacyDeleteWhereExcludesPinnedByDefault(t *testing.T) {
+	tmpDir := t.TempDir()
+	m := NewMailbox(tmpDir)
+
+	now := time.Now()
+	msgs := []*Message{
+		{ID: "msg-001", Read: true, Timestamp: now, Pinned: true},
+		{ID: "msg-002", Read: true, Timestamp: now},
+	}
+	for _, msg := range msgs {
+		if err := m.Append(msg); err != nil {
+			t.Fatalf("Append error: %v", err)
+		}
+	}
+
+	n, err := m.DeleteWhere(ListFilter{Read: true})
+	if err != nil {
+		t.Fatalf("DeleteWhere error: %v", err)
+	}
+	if n != 1 {
+		t.Fatalf("DeleteWhere deleted %d messages, want 1", n)
+	}
+
+	listed, err := m.List()
+	if err != nil {
+		t.Fatalf("List error: %v", err)
+	}
+	if len(listed) != 1 || listed[0].ID != "msg-001" {
+		t.Fatalf("expected only pinned msg-001 to remain, got %+v", listed)
+	}
+
+	n, err = m.DeleteWhere(ListFilter{Read: true, IncludePinned: true})
+	if err != nil {
+		t.Fatalf("DeleteWhere error: %v", err)
+	}
+	if n != 1 {
+		t.Fatalf("DeleteWhere with IncludePinned deleted %d messages, want 1", n)
+	}
+}
+
 func TestMailboxLegacyCount(t *testing.T) {
 	tmpDir := t.TempDir()
 	m := NewMailbox(tmpDir)
@@ -240,6 +399,111 @@ func TestMailboxLegacyCount(t *testing.T) {
 	}
 }
 
+func TestMailboxLegacyCountUnread(t *testing.T) {
+	tmpDir := t.TempDir()
+	m := NewMailbox(tmpDir)
+
+	// Empty inbox
+	unread, err := m.CountUnread()
+	if err != nil {
+		t.Fatalf("CountUnread error: %v", err)
+	}
+	if unread != 0 {
+		t.Errorf("Empty inbox CountUnread = %d, want 0", unread)
+	}
+
+	msgs := []*Message{
+		{ID: "msg-001", Read: false},
+		{ID: "msg-002", Read: true},
+		{ID: "msg-003", Read: false},
+	}
+	for _, msg := range msgs {
+		if err := m.Append(msg); err != nil {
+			t.Fatalf("Append error: %v", err)
+		}
+	}
+
+	_, wantUnread, err := m.Count()
+	if err != nil {
+		t.Fatalf("Count error: %v", err)
+	}
+
+	gotUnread, err := m.CountUnread()
+	if err != nil {
+		t.Fatalf("CountUnread error: %v", err)
+	}
+	if gotUnread != wantUnread {
+		t.Errorf("CountUnread = %d, want %d (matching slow Count)", gotUnread, wantUnread)
+	}
+}
+
+// TestMailboxCountUnreadMatchesSlowCount seeds a larger, mixed-read inbox
+// and asserts the fast path agrees with Count() exactly, since CountUnread
+// skips full Message deserialization and must not drift from it.
+func TestMailboxCountUnreadMatchesSlowCount(t *testing.T) {
+	tmpDir := t.TempDir()
+	m := NewMailbox(tmpDir)
+	seedMailbox(t, m, 500)
+
+	_, wantUnread, err := m.Count()
+	if err != nil {
+		t.Fatalf("Count error: %v", err)
+	}
+
+	gotUnread, err := m.CountUnread()
+	if err != nil {
+		t.Fatalf("CountUnread error: %v", err)
+	}
+	if gotUnread != wantUnread {
+		t.Errorf("CountUnread = %d, want %d (matching slow Count)", gotUnread, wantUnread)
+	}
+}
+
+// seedMailbox appends n messages, alternating read/unread, for benchmarks
+// and larger-scale correctness checks.
+func seedMailbox(t testing.TB, m *Mailbox, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		msg := &Message{
+			ID:      fmt.Sprintf("msg-%04d", i),
+			Subject: fmt.Sprintf("Subject %d", i),
+			Body:    "Some message body text for benchmarking purposes.",
+			Read:    i%3 == 0,
+		}
+		if err := m.Append(msg); err != nil {
+			t.Fatalf("Append error: %v", err)
+		}
+	}
+}
+
+// BenchmarkMailboxCount measures the existing full-deserialization path.
+func BenchmarkMailboxCount(b *testing.B) {
+	tmpDir := b.TempDir()
+	m := NewMailbox(tmpDir)
+	seedMailbox(b, m, 500)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := m.Count(); err != nil {
+			b.Fatalf("Count error: %v", err)
+		}
+	}
+}
+
+// BenchmarkMailboxCountUnread measures the cheap-counting fast path.
+func BenchmarkMailboxCountUnread(b *testing.B) {
+	tmpDir := b.TempDir()
+	m := NewMailbox(tmpDir)
+	seedMailbox(b, m, 500)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := m.CountUnread(); err != nil {
+			b.Fatalf("CountUnread error: %v", err)
+		}
+	}
+}
+
 func TestMailboxLegacyListUnread(t *testing.T) {
 	tmpDir := t.TempDir()
 	m := NewMailbox(tmpDir)
@@ -507,3 +771,58 @@ func TestMailboxLegacyMarkReadTwice(t *testing.T) {
 	}
 }
 
+func TestMailboxGetReloadsBodyRef(t *testing.T) {
+	tmpDir := t.TempDir()
+	m := NewMailbox(tmpDir)
+
+	blobPath := filepath.Join(tmpDir, "blob.txt")
+	fullBody := "this is the full spilled-over body"
+	if err := os.WriteFile(blobPath, []byte(fullBody), 0644); err != nil {
+		t.Fatalf("writing blob: %v", err)
+	}
+
+	msg := &Message{
+		ID:      "msg-001",
+		Subject: "Big message",
+		Body:    "[message truncated - full body spilled to disk]",
+		BodyRef: blobPath,
+	}
+	if err := m.Append(msg); err != nil {
+		t.Fatalf("Append error: %v", err)
+	}
+
+	got, err := m.Get("msg-001")
+	if err != nil {
+		t.Fatalf("Get error: %v", err)
+	}
+	if got.Body != fullBody {
+		t.Errorf("Body = %q, want the reloaded blob contents %q", got.Body, fullBody)
+	}
+	if got.BodyRef != blobPath {
+		t.Errorf("BodyRef = %q, want %q", got.BodyRef, blobPath)
+	}
+}
+
+func TestMailboxGetMissingBlobKeepsPreview(t *testing.T) {
+	tmpDir := t.TempDir()
+	m := NewMailbox(tmpDir)
+
+	preview := "[message truncated - full body spilled to disk]"
+	msg := &Message{
+		ID:      "msg-001",
+		Subject: "Big message",
+		Body:    preview,
+		BodyRef: filepath.Join(tmpDir, "deleted-blob.txt"),
+	}
+	if err := m.Append(msg); err != nil {
+		t.Fatalf("Append error: %v", err)
+	}
+
+	got, err := m.Get("msg-001")
+	if err != nil {
+		t.Fatalf("Get error: %v", err)
+	}
+	if got.Body != preview {
+		t.Errorf("Body = %q, want the preview left unchanged when the blob is missing %q", got.Body, preview)
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
@@ -14,6 +17,16 @@ import (
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
+// DefaultMaxBodySize is the default limit, in bytes, on a message body
+// before Send spills it to a blob file. Agents occasionally mail whole
+// test logs or diffs as message bodies, and beads issues that large bloat
+// the town database and slow down inbox listing.
+const DefaultMaxBodySize = 32 * 1024
+
+// bodyPreviewBytes is how much of an oversized body stays inline as a
+// preview once the rest has been spilled to a blob file.
+const bodyPreviewBytes = 2 * 1024
+
 // ErrUnknownList indicates a mailing list name was not found in configuration.
 var ErrUnknownList = errors.New("unknown mailing list")
 
@@ -28,9 +41,10 @@ var ErrUnknownAnnounce = errors.New("unknown announce channel")
 // - Town-level (mayor/, deacon/) -> {townRoot}/.beads
 // - Rig-level (rig/polecat) -> {townRoot}/{rig}/.beads
 type Router struct {
-	workDir  string // fallback directory to run bd commands in
-	townRoot string // town root directory (e.g., ~/gt)
-	tmux     *tmux.Tmux
+	workDir     string // fallback directory to run bd commands in
+	townRoot    string // town root directory (e.g., ~/gt)
+	tmux        *tmux.Tmux
+	maxBodySize int // 0 means DefaultMaxBodySize
 }
 
 // NewRouter creates a new mail router.
@@ -56,6 +70,20 @@ func NewRouterWithTownRoot(workDir, townRoot string) *Router {
 	}
 }
 
+// SetMaxBodySize overrides the default max message body size (in bytes)
+// before Send spills a body to a blob file. A value <= 0 restores
+// DefaultMaxBodySize.
+func (r *Router) SetMaxBodySize(n int) {
+	r.maxBodySize = n
+}
+
+func (r *Router) maxBodySizeOrDefault() int {
+	if r.maxBodySize > 0 {
+		return r.maxBodySize
+	}
+	return DefaultMaxBodySize
+}
+
 // isListAddress returns true if the address uses list:name syntax.
 func isListAddress(address string) bool {
 	return strings.HasPrefix(address, "list:")
@@ -502,6 +530,11 @@ func (r *Router) shouldBeWisp(msg *Message) bool {
 // - Queues (queue:name) - stores single message for worker claiming
 // - Announces (announce:name) - bulletin board, no claiming, retention-limited
 func (r *Router) Send(msg *Message) error {
+	msg, err := r.spillBodyIfLarge(msg)
+	if err != nil {
+		return err
+	}
+
 	// Check for mailing list address
 	if isListAddress(msg.To) {
 		return r.sendToList(msg)
@@ -531,6 +564,50 @@ func (r *Router) Send(msg *Message) error {
 	return r.sendToSingle(msg)
 }
 
+// spillBodyIfLarge writes msg.Body to a blob file under
+// <town>/.runtime/mail-blobs/ when it exceeds the router's max body size,
+// returning a copy of msg with Body replaced by a truncated preview and
+// BodyRef set to the blob path. Messages within the limit are returned
+// unchanged.
+func (r *Router) spillBodyIfLarge(msg *Message) (*Message, error) {
+	limit := r.maxBodySizeOrDefault()
+	if len(msg.Body) <= limit {
+		return msg, nil
+	}
+
+	blobDir := filepath.Join(r.townRoot, ".runtime", "mail-blobs")
+	if err := os.MkdirAll(blobDir, 0755); err != nil {
+		return nil, fmt.Errorf("creating mail blob dir: %w", err)
+	}
+
+	// Callers building a Message literal directly (rather than via
+	// NewMessage/NewReply) often leave ID empty. Without an ID every such
+	// oversized message would spill to the same "<blobDir>/.txt" path,
+	// silently overwriting each other.
+	id := msg.ID
+	if id == "" {
+		id = generateID()
+	}
+	blobPath := filepath.Join(blobDir, id+".txt")
+	if err := os.WriteFile(blobPath, []byte(msg.Body), 0644); err != nil { //nolint:gosec // G306: mail bodies are non-sensitive operational data
+		return nil, fmt.Errorf("writing mail blob: %w", err)
+	}
+
+	previewLen := bodyPreviewBytes
+	if previewLen > limit {
+		previewLen = limit
+	}
+	if previewLen > len(msg.Body) {
+		previewLen = len(msg.Body)
+	}
+
+	spilled := *msg
+	spilled.Body = fmt.Sprintf("%s\n\n[message truncated - %d bytes total, full body spilled to disk]",
+		msg.Body[:previewLen], len(msg.Body))
+	spilled.BodyRef = blobPath
+	return &spilled, nil
+}
+
 // sendToGroup resolves a @group address and sends individual messages to each member.
 func (r *Router) sendToGroup(msg *Message) error {
 	group := parseGroupAddress(msg.To)
@@ -585,6 +662,12 @@ func (r *Router) sendToSingle(msg *Message) error {
 		ccIdentity := AddressToIdentity(cc)
 		labels = append(labels, "cc:"+ccIdentity)
 	}
+	if msg.BodyRef != "" {
+		labels = append(labels, "body-ref:"+msg.BodyRef)
+	}
+	if msg.DeliverAt != nil {
+		labels = append(labels, "pending", "deliver-at:"+msg.DeliverAt.UTC().Format(time.RFC3339))
+	}
 
 	// Build command: bd create <subject> --type=message --assignee=<recipient> -d <body>
 	args := []string{"create", msg.Subject,
@@ -610,18 +693,34 @@ func (r *Router) sendToSingle(msg *Message) error {
 		args = append(args, "--ephemeral")
 	}
 
+	args = append(args, "--json")
+
 	beadsDir := r.resolveBeadsDir(msg.To)
-	_, err := runBdCommand(args, filepath.Dir(beadsDir), beadsDir)
+	stdout, err := runBdCommand(args, filepath.Dir(beadsDir), beadsDir)
 	if err != nil {
 		return fmt.Errorf("sending message: %w", err)
 	}
 
 	// Notify recipient if they have an active session (best-effort notification)
 	// Skip notification for self-mail (handoffs to future-self don't need present-self notified)
-	if !isSelfMail(msg.From, msg.To) {
+	// and for pending messages (nothing to notify about until ReleaseDue delivers them)
+	if !isSelfMail(msg.From, msg.To) && msg.DeliverAt == nil {
 		_ = r.notifyRecipient(msg)
 	}
 
+	// The overseer mailbox is the "--human" special address: in addition to
+	// the beads mailbox copy above (which is the audit trail), bridge the
+	// message to whatever external channel (webhook/sendmail) the overseer
+	// has configured, so a human away from a terminal actually sees it.
+	if msg.To == "overseer" {
+		var created struct {
+			ID string `json:"id"`
+		}
+		if jsonErr := json.Unmarshal(stdout, &created); jsonErr == nil {
+			r.deliverToOverseerBridge(msg, created.ID)
+		}
+	}
+
 	return nil
 }
 
@@ -695,6 +794,9 @@ func (r *Router) sendToQueue(msg *Message) error {
 		ccIdentity := AddressToIdentity(cc)
 		labels = append(labels, "cc:"+ccIdentity)
 	}
+	if msg.BodyRef != "" {
+		labels = append(labels, "body-ref:"+msg.BodyRef)
+	}
 
 	// Build command: bd create <subject> --type=message --assignee=queue:<name> -d <body>
 	// Use queue:<name> as assignee so inbox queries can filter by queue
@@ -766,6 +868,9 @@ func (r *Router) sendToAnnounce(msg *Message) error {
 		ccIdentity := AddressToIdentity(cc)
 		labels = append(labels, "cc:"+ccIdentity)
 	}
+	if msg.BodyRef != "" {
+		labels = append(labels, "body-ref:"+msg.BodyRef)
+	}
 
 	// Build command: bd create <subject> --type=message --assignee=announce:<name> -d <body>
 	// Use announce:<name> as assignee so queries can filter by channel
@@ -839,6 +944,9 @@ func (r *Router) sendToChannel(msg *Message) error {
 		ccIdentity := AddressToIdentity(cc)
 		labels = append(labels, "cc:"+ccIdentity)
 	}
+	if msg.BodyRef != "" {
+		labels = append(labels, "body-ref:"+msg.BodyRef)
+	}
 
 	// Build command: bd create <subject> --type=message --assignee=channel:<name> -d <body>
 	// Use channel:<name> as assignee so queries can filter by channel
@@ -961,6 +1069,252 @@ func (r *Router) GetMailbox(address string) (*Mailbox, error) {
 	return NewMailboxFromAddress(address, workDir), nil
 }
 
+// Thread returns every message bead labeled with threadID, regardless of
+// which mailbox (assignee) it was delivered to. Mailbox.ListByThread only
+// sees messages addressed to one identity, so a two-party exchange only
+// shows the caller's half; this queries the underlying town beads directly
+// by label instead of by assignee. All mail lives in town-level beads, so
+// authorization is simple: every message in a thread is town-visible.
+func (r *Router) Thread(threadID string) ([]*Message, error) {
+	beadsDir := r.resolveBeadsDir("")
+	args := []string{"list",
+		"--type", "message",
+		"--label", "thread:" + threadID,
+		"--status", "all",
+		"--json",
+		"--limit=0",
+	}
+
+	stdout, err := runBdCommand(args, filepath.Dir(beadsDir), beadsDir)
+	if err != nil {
+		return nil, fmt.Errorf("querying thread %s: %w", threadID, err)
+	}
+
+	var beadsMsgs []BeadsMessage
+	if err := json.Unmarshal(stdout, &beadsMsgs); err != nil {
+		if len(stdout) == 0 || string(stdout) == "null" {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("parsing thread query result: %w", err)
+	}
+
+	messages := make([]*Message, 0, len(beadsMsgs))
+	for _, bm := range beadsMsgs {
+		messages = append(messages, bm.ToMessage())
+	}
+
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].Timestamp.Before(messages[j].Timestamp)
+	})
+
+	return messages, nil
+}
+
+// Pending returns every scheduled-but-not-yet-delivered message across the
+// town, regardless of recipient. Used by `gt mail outbox --pending` and by
+// ReleaseDue.
+func (r *Router) Pending() ([]*Message, error) {
+	beadsDir := r.resolveBeadsDir("")
+	args := []string{"list",
+		"--type", "message",
+		"--label", "pending",
+		"--status", "open",
+		"--json",
+		"--limit=0",
+	}
+
+	stdout, err := runBdCommand(args, filepath.Dir(beadsDir), beadsDir)
+	if err != nil {
+		return nil, fmt.Errorf("querying pending messages: %w", err)
+	}
+
+	var beadsMsgs []BeadsMessage
+	if err := json.Unmarshal(stdout, &beadsMsgs); err != nil {
+		if len(stdout) == 0 || string(stdout) == "null" {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("parsing pending query result: %w", err)
+	}
+
+	messages := make([]*Message, 0, len(beadsMsgs))
+	for _, bm := range beadsMsgs {
+		messages = append(messages, bm.ToMessage())
+	}
+
+	sort.Slice(messages, func(i, j int) bool {
+		if messages[i].DeliverAt == nil || messages[j].DeliverAt == nil {
+			return messages[i].Timestamp.Before(messages[j].Timestamp)
+		}
+		return messages[i].DeliverAt.Before(*messages[j].DeliverAt)
+	})
+
+	return messages, nil
+}
+
+// ReleaseDue flips every pending message whose DeliverAt has passed to
+// delivered: it removes the "pending" label (making it visible to
+// Mailbox.List/ListUnread/Count) and records a "delivered-at" label so the
+// message's effective timestamp reflects when it actually arrived, not when
+// it was originally scheduled. Returns the number of messages released.
+func (r *Router) ReleaseDue(now time.Time) (int, error) {
+	pending, err := r.Pending()
+	if err != nil {
+		return 0, err
+	}
+
+	beadsDir := r.resolveBeadsDir("")
+	released := 0
+	var errs []string
+	for _, msg := range pending {
+		if msg.DeliverAt == nil || msg.DeliverAt.After(now) {
+			continue
+		}
+		if err := r.releaseMessage(beadsDir, msg.ID, now); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", msg.ID, err))
+			continue
+		}
+		released++
+		if !isSelfMail(msg.From, msg.To) {
+			_ = r.notifyRecipient(msg)
+		}
+	}
+
+	if len(errs) > 0 {
+		return released, fmt.Errorf("releasing due messages: %s", strings.Join(errs, "; "))
+	}
+	return released, nil
+}
+
+// releaseMessage removes the "pending" label and adds "delivered-at" for a
+// single message ID.
+func (r *Router) releaseMessage(beadsDir, id string, now time.Time) error {
+	if _, err := runBdCommand([]string{"label", "remove", id, "pending"}, filepath.Dir(beadsDir), beadsDir); err != nil {
+		return err
+	}
+	args := []string{"label", "add", id, "delivered-at:" + now.UTC().Format(time.RFC3339)}
+	_, err := runBdCommand(args, filepath.Dir(beadsDir), beadsDir)
+	return err
+}
+
+// CancelPending cancels a scheduled message before it's delivered, by
+// closing it outright. Returns ErrMessageNotFound if id isn't a pending
+// message.
+func (r *Router) CancelPending(id string) error {
+	pending, err := r.Pending()
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, msg := range pending {
+		if msg.ID == id {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return ErrMessageNotFound
+	}
+
+	beadsDir := r.resolveBeadsDir("")
+	return beads.NewWithBeadsDir(filepath.Dir(beadsDir), beadsDir).Close(id, "cancelled")
+}
+
+// humanBridgePendingLabel marks an overseer mailbox bead whose webhook/sendmail
+// bridge delivery failed, so RetryHumanDeliveries can find and retry it later.
+const humanBridgePendingLabel = "human-bridge-pending"
+
+// deliverToOverseerBridge attempts the external delivery method (webhook or
+// sendmail command) configured in the overseer config, best-effort: the
+// mailbox bead created by sendToSingle is already the durable record, so a
+// bridge failure here just flags that bead for the deacon to retry via
+// RetryHumanDeliveries rather than losing the notification silently.
+func (r *Router) deliverToOverseerBridge(msg *Message, beadID string) {
+	if r.townRoot == "" || beadID == "" {
+		return
+	}
+
+	overseerCfg, err := config.LoadOverseerConfig(config.OverseerConfigPath(r.townRoot))
+	if err != nil {
+		return // no overseer config, nothing to bridge
+	}
+
+	if err := deliverToHuman(overseerCfg.Delivery, msg); err != nil {
+		beadsDir := r.resolveBeadsDir("overseer")
+		_, _ = runBdCommand([]string{"label", "add", beadID, humanBridgePendingLabel}, filepath.Dir(beadsDir), beadsDir)
+	}
+}
+
+// PendingHumanDeliveries returns overseer mailbox messages whose bridge
+// delivery (webhook/sendmail) has failed and is awaiting retry.
+func (r *Router) PendingHumanDeliveries() ([]*Message, error) {
+	beadsDir := r.resolveBeadsDir("overseer")
+	args := []string{"list",
+		"--type", "message",
+		"--label", humanBridgePendingLabel,
+		"--status", "open",
+		"--json",
+		"--limit=0",
+	}
+
+	stdout, err := runBdCommand(args, filepath.Dir(beadsDir), beadsDir)
+	if err != nil {
+		return nil, fmt.Errorf("querying pending human deliveries: %w", err)
+	}
+
+	var beadsMsgs []BeadsMessage
+	if err := json.Unmarshal(stdout, &beadsMsgs); err != nil {
+		if len(stdout) == 0 || string(stdout) == "null" {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("parsing pending human delivery query result: %w", err)
+	}
+
+	messages := make([]*Message, 0, len(beadsMsgs))
+	for _, bm := range beadsMsgs {
+		messages = append(messages, bm.ToMessage())
+	}
+	return messages, nil
+}
+
+// RetryHumanDeliveries re-attempts bridge delivery for every message flagged
+// humanBridgePendingLabel, removing the label on success. Intended to be run
+// periodically by the deacon patrol, mirroring ReleaseDue. Returns the number
+// of messages successfully delivered.
+func (r *Router) RetryHumanDeliveries() (int, error) {
+	pending, err := r.PendingHumanDeliveries()
+	if err != nil {
+		return 0, err
+	}
+	if len(pending) == 0 {
+		return 0, nil
+	}
+
+	overseerCfg, err := config.LoadOverseerConfig(config.OverseerConfigPath(r.townRoot))
+	if err != nil {
+		return 0, fmt.Errorf("loading overseer config: %w", err)
+	}
+
+	beadsDir := r.resolveBeadsDir("overseer")
+	delivered := 0
+	var errs []string
+	for _, msg := range pending {
+		if err := deliverToHuman(overseerCfg.Delivery, msg); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", msg.ID, err))
+			continue
+		}
+		if _, err := runBdCommand([]string{"label", "remove", msg.ID, humanBridgePendingLabel}, filepath.Dir(beadsDir), beadsDir); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: clearing pending label: %v", msg.ID, err))
+			continue
+		}
+		delivered++
+	}
+
+	if len(errs) > 0 {
+		return delivered, fmt.Errorf("retrying human deliveries: %s", strings.Join(errs, "; "))
+	}
+	return delivered, nil
+}
+
 // notifyRecipient sends a notification to a recipient's tmux session.
 // Uses NudgeSession to add the notification to the agent's conversation history.
 // Supports mayor/, rig/polecat, and rig/refinery addresses.
@@ -3,7 +3,10 @@ package mail
 import (
 	"os"
 	"path/filepath"
+	"runtime"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestDetectTownRoot(t *testing.T) {
@@ -221,6 +224,60 @@ func TestNewRouterWithTownRoot(t *testing.T) {
 	}
 }
 
+// ============ Thread Tests ============
+
+// writeBDStub writes a fake `bd` executable to binDir that responds to
+// `bd list --type message --label thread:<id> --status all --json --limit=0`
+// with a fixed two-party thread, regardless of BD_IDENTITY.
+func writeBDStub(t *testing.T, binDir, script string) string {
+	t.Helper()
+	path := filepath.Join(binDir, "bd")
+	if err := os.WriteFile(path, []byte(script), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	return path
+}
+
+func TestRouter_Thread(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub is a shell script")
+	}
+
+	binDir := t.TempDir()
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	script := `#!/bin/sh
+echo '[
+  {"id":"hq-1","title":"Status?","description":"Any progress?","assignee":"gastown/Toast","priority":2,"status":"closed","created_at":"2026-01-01T10:00:00Z","labels":["from:mayor/","thread:thread-abc"]},
+  {"id":"hq-2","title":"Re: Status?","description":"Almost done","assignee":"mayor/","priority":2,"status":"open","created_at":"2026-01-01T10:05:00Z","labels":["from:gastown/Toast","thread:thread-abc"]}
+]'
+`
+	writeBDStub(t, binDir, script)
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	r := NewRouterWithTownRoot(townRoot, townRoot)
+	messages, err := r.Thread("thread-abc")
+	if err != nil {
+		t.Fatalf("Thread() error = %v", err)
+	}
+
+	if len(messages) != 2 {
+		t.Fatalf("Thread() returned %d messages, want 2", len(messages))
+	}
+	if messages[0].From != "mayor/" || messages[0].To != "gastown/Toast" {
+		t.Errorf("messages[0] = %+v, want mayor/ -> gastown/Toast", messages[0])
+	}
+	if messages[1].From != "gastown/Toast" || messages[1].To != "mayor/" {
+		t.Errorf("messages[1] = %+v, want gastown/Toast -> mayor/", messages[1])
+	}
+	if !messages[0].Timestamp.Before(messages[1].Timestamp) {
+		t.Error("messages should be ordered oldest first")
+	}
+}
+
 // ============ Mailing List Tests ============
 
 func TestIsListAddress(t *testing.T) {
@@ -813,3 +870,248 @@ func TestExpandAnnounceNoTownRoot(t *testing.T) {
 		t.Errorf("expandAnnounce error = %v, want containing 'no town root'", err)
 	}
 }
+
+// ============ Body Spillover Tests ============
+
+func TestSpillBodyIfLarge_UnderLimitUnchanged(t *testing.T) {
+	r := &Router{townRoot: t.TempDir()}
+	msg := NewMessage("mayor/", "gastown/witness", "small", "short body")
+
+	got, err := r.spillBodyIfLarge(msg)
+	if err != nil {
+		t.Fatalf("spillBodyIfLarge() error = %v", err)
+	}
+	if got != msg {
+		t.Error("spillBodyIfLarge() should return the same message unchanged when under the limit")
+	}
+	if got.BodyRef != "" {
+		t.Errorf("BodyRef = %q, want empty for a small body", got.BodyRef)
+	}
+}
+
+func TestSpillBodyIfLarge_OverLimitSpillsToBlob(t *testing.T) {
+	townRoot := t.TempDir()
+	r := &Router{townRoot: townRoot}
+	r.SetMaxBodySize(100)
+
+	bigBody := strings.Repeat("x", 1000)
+	msg := NewMessage("mayor/", "gastown/witness", "big", bigBody)
+
+	got, err := r.spillBodyIfLarge(msg)
+	if err != nil {
+		t.Fatalf("spillBodyIfLarge() error = %v", err)
+	}
+	if got.BodyRef == "" {
+		t.Fatal("BodyRef = empty, want a blob path for an oversized body")
+	}
+	if len(got.Body) >= len(bigBody) {
+		t.Errorf("Body len = %d, want a truncated preview shorter than the original %d bytes", len(got.Body), len(bigBody))
+	}
+
+	data, err := os.ReadFile(got.BodyRef)
+	if err != nil {
+		t.Fatalf("reading blob file: %v", err)
+	}
+	if string(data) != bigBody {
+		t.Error("blob file contents do not match the original body")
+	}
+
+	wantDir := filepath.Join(townRoot, ".runtime", "mail-blobs")
+	if filepath.Dir(got.BodyRef) != wantDir {
+		t.Errorf("blob dir = %q, want %q", filepath.Dir(got.BodyRef), wantDir)
+	}
+}
+
+func TestSpillBodyIfLarge_NoIDDoesNotCollide(t *testing.T) {
+	townRoot := t.TempDir()
+	r := &Router{townRoot: townRoot}
+	r.SetMaxBodySize(100)
+
+	// Messages built as literals (not via NewMessage/NewReply) have no ID -
+	// this is the shape most call sites in internal/cmd and internal/witness
+	// use.
+	first := &Message{To: "gastown/witness", Subject: "one", Body: strings.Repeat("a", 1000)}
+	second := &Message{To: "gastown/witness", Subject: "two", Body: strings.Repeat("b", 1000)}
+
+	gotFirst, err := r.spillBodyIfLarge(first)
+	if err != nil {
+		t.Fatalf("spillBodyIfLarge(first) error = %v", err)
+	}
+	gotSecond, err := r.spillBodyIfLarge(second)
+	if err != nil {
+		t.Fatalf("spillBodyIfLarge(second) error = %v", err)
+	}
+
+	if gotFirst.BodyRef == gotSecond.BodyRef {
+		t.Fatalf("expected distinct blob paths for ID-less messages, both got %q", gotFirst.BodyRef)
+	}
+
+	firstData, err := os.ReadFile(gotFirst.BodyRef)
+	if err != nil {
+		t.Fatalf("reading first blob: %v", err)
+	}
+	if string(firstData) != first.Body {
+		t.Error("first blob was overwritten by the second message")
+	}
+}
+
+func TestSpillBodyIfLarge_DefaultLimit(t *testing.T) {
+	r := &Router{townRoot: t.TempDir()}
+
+	underLimit := NewMessage("mayor/", "gastown/witness", "ok", strings.Repeat("a", DefaultMaxBodySize))
+	got, err := r.spillBodyIfLarge(underLimit)
+	if err != nil {
+		t.Fatalf("spillBodyIfLarge() error = %v", err)
+	}
+	if got.BodyRef != "" {
+		t.Error("a body exactly at DefaultMaxBodySize should not spill")
+	}
+
+	overLimit := NewMessage("mayor/", "gastown/witness", "big", strings.Repeat("a", DefaultMaxBodySize+1))
+	got, err = r.spillBodyIfLarge(overLimit)
+	if err != nil {
+		t.Fatalf("spillBodyIfLarge() error = %v", err)
+	}
+	if got.BodyRef == "" {
+		t.Error("a body over DefaultMaxBodySize should spill")
+	}
+}
+
+// ============ Scheduled Delivery Tests ============
+
+// writeScheduledBDStub writes a `bd` stub that answers `list` with two
+// pending messages - one due, one not - and appends every invocation to
+// callLog so tests can assert which label/close mutations actually ran.
+func writeScheduledBDStub(t *testing.T, binDir, callLog string, dueAt, notDueAt time.Time) {
+	t.Helper()
+	script := `#!/bin/sh
+echo "$@" >> ` + callLog + `
+if [ "$1" = "list" ]; then
+  cat <<EOF
+[
+  {"id":"hq-due","title":"Due","description":"body","assignee":"mayor/","priority":2,"status":"open","created_at":"2026-01-01T00:00:00Z","labels":["from:gastown/Toast","pending","deliver-at:` + dueAt.UTC().Format(time.RFC3339) + `"]},
+  {"id":"hq-notdue","title":"Not due","description":"body","assignee":"mayor/","priority":2,"status":"open","created_at":"2026-01-01T00:00:00Z","labels":["from:gastown/Toast","pending","deliver-at:` + notDueAt.UTC().Format(time.RFC3339) + `"]}
+]
+EOF
+fi
+`
+	writeBDStub(t, binDir, script)
+}
+
+func TestRouter_Pending(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub is a shell script")
+	}
+
+	binDir := t.TempDir()
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	callLog := filepath.Join(binDir, "calls.log")
+	if err := os.WriteFile(callLog, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	writeScheduledBDStub(t, binDir, callLog, now.Add(-time.Hour), now.Add(time.Hour))
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	r := NewRouterWithTownRoot(townRoot, townRoot)
+	pending, err := r.Pending()
+	if err != nil {
+		t.Fatalf("Pending() error = %v", err)
+	}
+	if len(pending) != 2 {
+		t.Fatalf("Pending() returned %d messages, want 2", len(pending))
+	}
+	for _, msg := range pending {
+		if !msg.Pending {
+			t.Errorf("message %s: Pending = false, want true", msg.ID)
+		}
+		if msg.DeliverAt == nil {
+			t.Errorf("message %s: DeliverAt is nil", msg.ID)
+		}
+	}
+}
+
+func TestRouter_ReleaseDue_SkipsBeforeReleasesAfter(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub is a shell script")
+	}
+
+	binDir := t.TempDir()
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	callLog := filepath.Join(binDir, "calls.log")
+	if err := os.WriteFile(callLog, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	writeScheduledBDStub(t, binDir, callLog, now.Add(-time.Hour), now.Add(time.Hour))
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	r := NewRouterWithTownRoot(townRoot, townRoot)
+	released, err := r.ReleaseDue(now)
+	if err != nil {
+		t.Fatalf("ReleaseDue() error = %v", err)
+	}
+	if released != 1 {
+		t.Fatalf("ReleaseDue() released = %d, want 1", released)
+	}
+
+	log, err := os.ReadFile(callLog)
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := string(log)
+	if !strings.Contains(calls, "label remove hq-due pending") {
+		t.Errorf("expected the due message's pending label to be removed, calls:\n%s", calls)
+	}
+	if strings.Contains(calls, "hq-notdue pending") && strings.Contains(calls, "label remove") {
+		t.Errorf("the not-due message should not have been released, calls:\n%s", calls)
+	}
+	if !strings.Contains(calls, "delivered-at:") {
+		t.Errorf("expected a delivered-at label to be added, calls:\n%s", calls)
+	}
+}
+
+func TestRouter_CancelPending(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub is a shell script")
+	}
+
+	binDir := t.TempDir()
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	callLog := filepath.Join(binDir, "calls.log")
+	if err := os.WriteFile(callLog, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	writeScheduledBDStub(t, binDir, callLog, now.Add(-time.Hour), now.Add(time.Hour))
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	r := NewRouterWithTownRoot(townRoot, townRoot)
+	if err := r.CancelPending("hq-notdue"); err != nil {
+		t.Fatalf("CancelPending() error = %v", err)
+	}
+
+	log, err := os.ReadFile(callLog)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(log), "close hq-notdue") {
+		t.Errorf("expected the message to be closed, calls:\n%s", string(log))
+	}
+
+	if err := r.CancelPending("hq-nonexistent"); err != ErrMessageNotFound {
+		t.Errorf("CancelPending(unknown) = %v, want ErrMessageNotFound", err)
+	}
+}
@@ -29,7 +29,6 @@ const (
 // MessageType indicates the purpose of a message.
 type MessageType string
 
-
 const (
 	// TypeTask indicates a message requiring action from the recipient.
 	TypeTask MessageType = "task"
@@ -123,6 +122,28 @@ type Message struct {
 	// ClaimedAt is when the queue message was claimed.
 	// Only set for queue messages after claiming.
 	ClaimedAt *time.Time `json:"claimed_at,omitempty"`
+
+	// SnoozedUntil hides the message from `mail peek`/`mail check` until this
+	// time. The message stays in the inbox and still counts as unread -
+	// snoozing only affects the low-value-notification surfaces.
+	SnoozedUntil *time.Time `json:"snoozed_until,omitempty"`
+
+	// DeliverAt schedules the message to become visible at a future time
+	// (set via `gt mail send --deliver-at`). Unlike SnoozedUntil, a pending
+	// message is invisible to List/ListUnread/Count entirely, not just
+	// peek/check, until Router.ReleaseDue flips it to delivered.
+	DeliverAt *time.Time `json:"deliver_at,omitempty"`
+
+	// Pending is true for a scheduled message that hasn't been released yet
+	// by Router.ReleaseDue. Cleared (along with the underlying "pending"
+	// label) once the message is delivered.
+	Pending bool `json:"pending,omitempty"`
+
+	// BodyRef is the path to a blob file holding the full body when Router.Send
+	// spilled an oversized body out of beads. Body then holds a truncated
+	// preview. Mailbox.Get transparently reloads the full body from BodyRef
+	// when the blob still exists.
+	BodyRef string `json:"body_ref,omitempty"`
 }
 
 // NewMessage creates a new message with a generated ID and thread ID.
@@ -212,6 +233,12 @@ func (m *Message) IsClaimed() bool {
 	return m.ClaimedBy != ""
 }
 
+// IsSnoozed reports whether the message is currently hidden from
+// peek/check - i.e. it has a snooze that hasn't expired as of now.
+func (m *Message) IsSnoozed(now time.Time) bool {
+	return m.SnoozedUntil != nil && now.Before(*m.SnoozedUntil)
+}
+
 // Validate checks that the message has a valid routing configuration.
 // Returns an error if to, queue, and channel are not mutually exclusive.
 func (m *Message) Validate() error {
@@ -276,20 +303,24 @@ type BeadsMessage struct {
 	Priority    int       `json:"priority"`    // 0=urgent, 1=high, 2=normal, 3=low
 	Status      string    `json:"status"`      // open=unread, closed=read
 	CreatedAt   time.Time `json:"created_at"`
-	Labels      []string  `json:"labels"` // Metadata labels (from:X, thread:X, reply-to:X, msg-type:X, cc:X, queue:X, channel:X, claimed-by:X, claimed-at:X)
+	Labels      []string  `json:"labels"` // Metadata labels (from:X, thread:X, reply-to:X, msg-type:X, cc:X, queue:X, channel:X, claimed-by:X, claimed-at:X, body-ref:X)
 	Pinned      bool      `json:"pinned,omitempty"`
 	Wisp        bool      `json:"wisp,omitempty"` // Ephemeral message (filtered from JSONL export)
 
 	// Cached parsed values (populated by ParseLabels)
-	sender    string
-	threadID  string
-	replyTo   string
-	msgType   string
-	cc        []string   // CC recipients
-	queue     string     // Queue name (for queue messages)
-	channel   string     // Channel name (for broadcast messages)
-	claimedBy string     // Who claimed the queue message
-	claimedAt *time.Time // When the queue message was claimed
+	sender       string
+	threadID     string
+	replyTo      string
+	msgType      string
+	cc           []string   // CC recipients
+	queue        string     // Queue name (for queue messages)
+	channel      string     // Channel name (for broadcast messages)
+	claimedBy    string     // Who claimed the queue message
+	claimedAt    *time.Time // When the queue message was claimed
+	snoozedUntil *time.Time // When an active snooze on the message expires
+	bodyRef      string     // Path to spilled-body blob file, if the body was too large to store inline
+	deliverAt    *time.Time // When a scheduled message becomes due
+	deliveredAt  *time.Time // When Router.ReleaseDue flipped a scheduled message to delivered
 }
 
 // ParseLabels extracts metadata from the labels array.
@@ -316,6 +347,23 @@ func (bm *BeadsMessage) ParseLabels() {
 			if t, err := time.Parse(time.RFC3339, ts); err == nil {
 				bm.claimedAt = &t
 			}
+		} else if strings.HasPrefix(label, "snoozed-until:") {
+			ts := strings.TrimPrefix(label, "snoozed-until:")
+			if t, err := time.Parse(time.RFC3339, ts); err == nil {
+				bm.snoozedUntil = &t
+			}
+		} else if strings.HasPrefix(label, "body-ref:") {
+			bm.bodyRef = strings.TrimPrefix(label, "body-ref:")
+		} else if strings.HasPrefix(label, "deliver-at:") {
+			ts := strings.TrimPrefix(label, "deliver-at:")
+			if t, err := time.Parse(time.RFC3339, ts); err == nil {
+				bm.deliverAt = &t
+			}
+		} else if strings.HasPrefix(label, "delivered-at:") {
+			ts := strings.TrimPrefix(label, "delivered-at:")
+			if t, err := time.Parse(time.RFC3339, ts); err == nil {
+				bm.deliveredAt = &t
+			}
 		}
 	}
 }
@@ -366,24 +414,36 @@ func (bm *BeadsMessage) ToMessage() *Message {
 		ccAddrs = append(ccAddrs, identityToAddress(cc))
 	}
 
+	// A message stays timestamped at its scheduled send time until
+	// released; once delivered-at is recorded, that's the effective time
+	// shown to the recipient.
+	timestamp := bm.CreatedAt
+	if bm.deliveredAt != nil {
+		timestamp = *bm.deliveredAt
+	}
+
 	return &Message{
-		ID:        bm.ID,
-		From:      identityToAddress(bm.sender),
-		To:        identityToAddress(bm.Assignee),
-		Subject:   bm.Title,
-		Body:      bm.Description,
-		Timestamp: bm.CreatedAt,
-		Read:      bm.Status == "closed" || bm.HasLabel("read"),
-		Priority:  priority,
-		Type:      msgType,
-		ThreadID:  bm.threadID,
-		ReplyTo:   bm.replyTo,
-		Wisp:      bm.Wisp,
-		CC:        ccAddrs,
-		Queue:     bm.queue,
-		Channel:   bm.channel,
-		ClaimedBy: bm.claimedBy,
-		ClaimedAt: bm.claimedAt,
+		ID:           bm.ID,
+		From:         identityToAddress(bm.sender),
+		To:           identityToAddress(bm.Assignee),
+		Subject:      bm.Title,
+		Body:         bm.Description,
+		Timestamp:    timestamp,
+		Read:         bm.Status == "closed" || bm.HasLabel("read"),
+		Priority:     priority,
+		Type:         msgType,
+		ThreadID:     bm.threadID,
+		ReplyTo:      bm.replyTo,
+		Wisp:         bm.Wisp,
+		CC:           ccAddrs,
+		Queue:        bm.queue,
+		Channel:      bm.channel,
+		ClaimedBy:    bm.claimedBy,
+		ClaimedAt:    bm.claimedAt,
+		SnoozedUntil: bm.snoozedUntil,
+		BodyRef:      bm.bodyRef,
+		DeliverAt:    bm.deliverAt,
+		Pending:      bm.HasLabel("pending"),
 	}
 }
 
@@ -407,6 +467,12 @@ func (bm *BeadsMessage) GetClaimedAt() *time.Time {
 	return bm.claimedAt
 }
 
+// GetSnoozedUntil returns when an active snooze on the message expires, or
+// nil if the message isn't snoozed.
+func (bm *BeadsMessage) GetSnoozedUntil() *time.Time {
+	return bm.snoozedUntil
+}
+
 // IsQueueMessage returns true if this is a queue-routed message.
 func (bm *BeadsMessage) IsQueueMessage() bool {
 	bm.ParseLabels()
@@ -19,8 +19,8 @@ func TestAddressToIdentity(t *testing.T) {
 		// Rig-level agents: crew/ and polecats/ normalized to canonical form
 		{"gastown/polecats/Toast", "gastown/Toast"},
 		{"gastown/crew/max", "gastown/max"},
-		{"gastown/Toast", "gastown/Toast"},         // Already canonical
-		{"gastown/max", "gastown/max"},             // Already canonical
+		{"gastown/Toast", "gastown/Toast"}, // Already canonical
+		{"gastown/max", "gastown/max"},     // Already canonical
 		{"gastown/refinery", "gastown/refinery"},
 		{"gastown/witness", "gastown/witness"},
 
@@ -52,7 +52,7 @@ func TestIdentityToAddress(t *testing.T) {
 		// Rig-level agents: crew/ and polecats/ normalized
 		{"gastown/polecats/Toast", "gastown/Toast"},
 		{"gastown/crew/max", "gastown/max"},
-		{"gastown/Toast", "gastown/Toast"},  // Already canonical
+		{"gastown/Toast", "gastown/Toast"}, // Already canonical
 		{"gastown/refinery", "gastown/refinery"},
 		{"gastown/witness", "gastown/witness"},
 
@@ -101,7 +101,7 @@ func TestPriorityFromInt(t *testing.T) {
 		{1, PriorityHigh},
 		{2, PriorityNormal},
 		{3, PriorityLow},
-		{4, PriorityLow},  // Out of range maps to low
+		{4, PriorityLow},     // Out of range maps to low
 		{-1, PriorityNormal}, // Negative maps to normal
 	}
 
@@ -712,3 +712,26 @@ func TestMessageIsClaimed(t *testing.T) {
 		t.Error("Claimed message should be claimed")
 	}
 }
+
+func TestMessageIsSnoozed(t *testing.T) {
+	now := time.Now()
+
+	unsnoozed := NewQueueMessage("mayor/", "work-requests", "Task", "Body")
+	if unsnoozed.IsSnoozed(now) {
+		t.Error("Message with no snooze should not be snoozed")
+	}
+
+	active := NewQueueMessage("mayor/", "work-requests", "Task", "Body")
+	until := now.Add(time.Hour)
+	active.SnoozedUntil = &until
+	if !active.IsSnoozed(now) {
+		t.Error("Message with a future snoozed-until should be snoozed")
+	}
+
+	expired := NewQueueMessage("mayor/", "work-requests", "Task", "Body")
+	past := now.Add(-time.Hour)
+	expired.SnoozedUntil = &past
+	if expired.IsSnoozed(now) {
+		t.Error("Message with a past snoozed-until should not be snoozed")
+	}
+}
@@ -0,0 +1,200 @@
+// Package notify runs external commands in response to Gas Town events, so
+// teams can wire Slack/webhook pings for things like merges landing or a
+// polecat stalling without polling the events feed themselves. Rules are
+// configured in town or rig settings (see config.NotificationRule) and
+// matched against events written through internal/events.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+// minInterval is the minimum time between two invocations of the same
+// notification command. Without it, a noisy event type could hammer an
+// external webhook every time it fires.
+const minInterval = 10 * time.Second
+
+// commandTimeout bounds how long a notification command may run. A hung
+// webhook call must never be able to block the process that fired the
+// event (e.g. the refinery mid-merge).
+const commandTimeout = 15 * time.Second
+
+// Runner executes a notification command with the event JSON on stdin.
+// Abstracted so tests can substitute a fake without touching a real shell.
+type Runner interface {
+	Run(ctx context.Context, command string, stdin []byte) error
+}
+
+// shellRunner runs commands via "sh -c", matching how other Gas Town
+// hooks (e.g. runtime hooks) shell out to user-provided commands.
+type shellRunner struct{}
+
+func (shellRunner) Run(ctx context.Context, command string, stdin []byte) error {
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	cmd.Stdin = bytes.NewReader(stdin)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if stderr.Len() > 0 {
+			return fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+		}
+		return err
+	}
+	return nil
+}
+
+// Dispatcher matches events against configured rules and fires the
+// matching commands, rate-limited per command string.
+type Dispatcher struct {
+	runner Runner
+
+	mu      sync.Mutex
+	lastRun map[string]time.Time
+}
+
+// NewDispatcher creates a Dispatcher that runs commands via the shell.
+func NewDispatcher() *Dispatcher {
+	return NewDispatcherWithRunner(shellRunner{})
+}
+
+// NewDispatcherWithRunner creates a Dispatcher backed by a custom Runner
+// (for testing).
+func NewDispatcherWithRunner(runner Runner) *Dispatcher {
+	return &Dispatcher{
+		runner:  runner,
+		lastRun: make(map[string]time.Time),
+	}
+}
+
+// defaultDispatcher is the process-wide dispatcher wired to events.Notify.
+var defaultDispatcher = NewDispatcher()
+
+// Dispatch is wired to events.Notify (see cmd/notify.go's init) so the
+// events package can fire notifications without depending on this
+// package's config/exec machinery. It looks up the rules that apply to
+// the event's town (and rig, if the event payload names one) and fires
+// every match asynchronously.
+func Dispatch(townRoot string, event events.Event) {
+	rigName, _ := event.Payload["rig"].(string)
+	rules, err := LoadRules(townRoot, rigName)
+	if err != nil || len(rules) == 0 {
+		return
+	}
+	defaultDispatcher.Fire(rules, event)
+}
+
+// LoadRules returns the effective notification rules for a workspace:
+// town-wide rules from mayor/config.json, followed by the named rig's own
+// rules from settings/config.json, if any. A rig can add its own hooks
+// without redefining the town-wide ones; missing config files are not an
+// error (no rules configured is the default).
+func LoadRules(townRoot, rigName string) ([]config.NotificationRule, error) {
+	var rules []config.NotificationRule
+
+	mayorCfg, err := config.LoadMayorConfig(filepath.Join(townRoot, "mayor", "config.json"))
+	switch {
+	case err == nil:
+		rules = append(rules, mayorCfg.Notifications...)
+	case !errors.Is(err, config.ErrNotFound):
+		return nil, err
+	}
+
+	if rigName != "" {
+		rigSettings, err := config.LoadRigSettings(config.RigSettingsPath(filepath.Join(townRoot, rigName)))
+		switch {
+		case err == nil:
+			rules = append(rules, rigSettings.Notifications...)
+		case !errors.Is(err, config.ErrNotFound):
+			return nil, err
+		}
+	}
+
+	return rules, nil
+}
+
+// Fire runs every rule matching event.Type, each in its own goroutine, so a
+// slow or hung command can't block the caller.
+func (d *Dispatcher) Fire(rules []config.NotificationRule, event events.Event) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+
+	for _, rule := range rules {
+		if !matchesEvent(rule, event.Type) || !d.allow(rule.Command) {
+			continue
+		}
+		go d.run(rule.Command, payload)
+	}
+}
+
+// Result records the outcome of running one matched notification command.
+type Result struct {
+	Command string
+	Error   error
+}
+
+// FireAndWait runs every rule matching event.Type synchronously, ignoring
+// the per-command rate limit, and returns each command's result. Used by
+// `gt notify test`, where the point is to wait and see whether the
+// command succeeded rather than fire-and-forget.
+func (d *Dispatcher) FireAndWait(rules []config.NotificationRule, event events.Event) ([]Result, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []Result
+	for _, rule := range rules {
+		if !matchesEvent(rule, event.Type) {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+		err := d.runner.Run(ctx, rule.Command, payload)
+		cancel()
+		results = append(results, Result{Command: rule.Command, Error: err})
+	}
+	return results, nil
+}
+
+func matchesEvent(rule config.NotificationRule, eventType string) bool {
+	for _, e := range rule.Events {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// allow reports whether command may run now, applying minInterval
+// throttling per distinct command string.
+func (d *Dispatcher) allow(command string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if last, ok := d.lastRun[command]; ok && time.Since(last) < minInterval {
+		return false
+	}
+	d.lastRun[command] = time.Now()
+	return true
+}
+
+func (d *Dispatcher) run(command string, stdin []byte) {
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	// Notifications are best-effort, same as event logging itself: a
+	// broken webhook script must not surface as a Gas Town error.
+	_ = d.runner.Run(ctx, command, stdin)
+}
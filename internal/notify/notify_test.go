@@ -0,0 +1,217 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+// fakeRunner records every invocation instead of shelling out, so tests can
+// inspect the exact stdin payload each command received.
+type fakeRunner struct {
+	mu    sync.Mutex
+	calls []fakeCall
+	done  chan struct{} // signaled after each Run, for tests exercising the async path
+	err   error
+}
+
+type fakeCall struct {
+	command string
+	stdin   []byte
+}
+
+func newFakeRunner() *fakeRunner {
+	return &fakeRunner{done: make(chan struct{}, 16)}
+}
+
+func (f *fakeRunner) Run(ctx context.Context, command string, stdin []byte) error {
+	f.mu.Lock()
+	f.calls = append(f.calls, fakeCall{command: command, stdin: append([]byte(nil), stdin...)})
+	f.mu.Unlock()
+	f.done <- struct{}{}
+	return f.err
+}
+
+func (f *fakeRunner) Calls() []fakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]fakeCall(nil), f.calls...)
+}
+
+func waitForCall(t *testing.T, done <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for notification command to run")
+	}
+}
+
+func TestDispatcher_Fire_MatchesEventType(t *testing.T) {
+	runner := newFakeRunner()
+	d := NewDispatcherWithRunner(runner)
+
+	rules := []config.NotificationRule{
+		{Events: []string{"merged"}, Command: "on-merge.sh"},
+		{Events: []string{"merge_failed"}, Command: "on-fail.sh"},
+	}
+	event := events.Event{Type: "merged", Actor: "refinery"}
+
+	d.Fire(rules, event)
+	waitForCall(t, runner.done)
+
+	calls := runner.Calls()
+	if len(calls) != 1 {
+		t.Fatalf("Calls = %d, want 1", len(calls))
+	}
+	if calls[0].command != "on-merge.sh" {
+		t.Errorf("command = %q, want on-merge.sh", calls[0].command)
+	}
+	if !strings.Contains(string(calls[0].stdin), `"type":"merged"`) {
+		t.Errorf("stdin = %s, want it to contain the event JSON", calls[0].stdin)
+	}
+}
+
+func TestDispatcher_Fire_RateLimited(t *testing.T) {
+	runner := newFakeRunner()
+	d := NewDispatcherWithRunner(runner)
+
+	rules := []config.NotificationRule{{Events: []string{"merged"}, Command: "on-merge.sh"}}
+	event := events.Event{Type: "merged"}
+
+	d.Fire(rules, event)
+	waitForCall(t, runner.done)
+
+	// Firing again immediately should be suppressed by the rate limit.
+	d.Fire(rules, event)
+	select {
+	case <-runner.done:
+		t.Fatal("second Fire() should have been rate-limited")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	if len(runner.Calls()) != 1 {
+		t.Errorf("Calls = %d, want 1 (second call rate-limited)", len(runner.Calls()))
+	}
+}
+
+func TestDispatcher_FireAndWait_IgnoresRateLimit(t *testing.T) {
+	runner := newFakeRunner()
+	d := NewDispatcherWithRunner(runner)
+
+	rules := []config.NotificationRule{{Events: []string{"merged"}, Command: "on-merge.sh"}}
+	event := events.Event{Type: "merged"}
+
+	if _, err := d.FireAndWait(rules, event); err != nil {
+		t.Fatalf("FireAndWait() error = %v", err)
+	}
+	if _, err := d.FireAndWait(rules, event); err != nil {
+		t.Fatalf("FireAndWait() error = %v", err)
+	}
+
+	if len(runner.Calls()) != 2 {
+		t.Errorf("Calls = %d, want 2 (FireAndWait bypasses rate limiting)", len(runner.Calls()))
+	}
+}
+
+func TestDispatcher_FireAndWait_ReportsErrors(t *testing.T) {
+	runner := newFakeRunner()
+	runner.err = errors.New("boom")
+	d := NewDispatcherWithRunner(runner)
+
+	rules := []config.NotificationRule{{Events: []string{"merged"}, Command: "on-merge.sh"}}
+	results, err := d.FireAndWait(rules, events.Event{Type: "merged"})
+	if err != nil {
+		t.Fatalf("FireAndWait() error = %v", err)
+	}
+	if len(results) != 1 || results[0].Error == nil {
+		t.Fatalf("results = %+v, want one result with an error", results)
+	}
+}
+
+func TestDispatcher_Fire_NoMatchingRule(t *testing.T) {
+	runner := newFakeRunner()
+	d := NewDispatcherWithRunner(runner)
+
+	rules := []config.NotificationRule{{Events: []string{"merged"}, Command: "on-merge.sh"}}
+	d.Fire(rules, events.Event{Type: "agent_quarantined"})
+
+	select {
+	case <-runner.done:
+		t.Fatal("no rule should have matched")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestLoadRules_MergesTownAndRig(t *testing.T) {
+	townRoot := t.TempDir()
+
+	mustMkdirAll(t, filepath.Join(townRoot, "mayor"))
+	mustWriteFile(t, filepath.Join(townRoot, "mayor", "config.json"), `{
+		"type": "mayor-config",
+		"version": 1,
+		"notifications": [{"events": ["merged"], "command": "town-hook.sh"}]
+	}`)
+
+	rigPath := filepath.Join(townRoot, "gastown")
+	mustMkdirAll(t, filepath.Join(rigPath, "settings"))
+	mustWriteFile(t, filepath.Join(rigPath, "settings", "config.json"), `{
+		"type": "rig-settings",
+		"version": 1,
+		"notifications": [{"events": ["merge_failed"], "command": "rig-hook.sh"}]
+	}`)
+
+	rules, err := LoadRules(townRoot, "gastown")
+	if err != nil {
+		t.Fatalf("LoadRules() error = %v", err)
+	}
+	if len(rules) != 2 {
+		t.Fatalf("rules = %+v, want 2", rules)
+	}
+	if rules[0].Command != "town-hook.sh" || rules[1].Command != "rig-hook.sh" {
+		t.Errorf("rules = %+v, want town rules first", rules)
+	}
+
+	// Without a rig name, only the town-wide rule applies.
+	townOnly, err := LoadRules(townRoot, "")
+	if err != nil {
+		t.Fatalf("LoadRules() error = %v", err)
+	}
+	if len(townOnly) != 1 {
+		t.Fatalf("townOnly = %+v, want 1", townOnly)
+	}
+}
+
+func TestLoadRules_MissingConfigIsNotAnError(t *testing.T) {
+	townRoot := t.TempDir()
+
+	rules, err := LoadRules(townRoot, "no-such-rig")
+	if err != nil {
+		t.Fatalf("LoadRules() error = %v, want nil when config files don't exist", err)
+	}
+	if len(rules) != 0 {
+		t.Errorf("rules = %+v, want empty", rules)
+	}
+}
+
+func mustMkdirAll(t *testing.T, path string) {
+	t.Helper()
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		t.Fatalf("mkdir %s: %v", path, err)
+	}
+}
+
+func mustWriteFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+}
@@ -0,0 +1,191 @@
+// Package plugins provides lifecycle hook discovery and execution for
+// Gas Town.
+//
+// A plugin is a single executable file named after the lifecycle event it
+// handles (e.g. "on-merge") placed directly under a plugins/ directory:
+//
+//	<town>/plugins/on-merge     (town-level, applies to every rig)
+//	<rig>/plugins/on-merge      (rig-level, overrides the town-level one)
+//
+// At the corresponding lifecycle point, gt invokes the executable with a
+// JSON payload on stdin and a timeout. This is a distinct, simpler contract
+// from the internal/plugin package's plugin.md-based Deacon patrol
+// automations - lifecycle hooks are one-shot, fire-and-forget scripts, not
+// scheduled tasks.
+package plugins
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Lifecycle event names. Rigs and the town may provide an executable named
+// after any of these under their plugins/ directory.
+const (
+	// EventOnMerge fires after the refinery successfully merges an MR.
+	EventOnMerge = "on-merge"
+
+	// EventOnSpawn fires after a polecat session has been spawned.
+	EventOnSpawn = "on-spawn"
+
+	// EventPreShutdown fires before a rig is torn down. Unlike the other
+	// events, it may veto the shutdown by exiting with VetoExitCode.
+	EventPreShutdown = "pre-shutdown"
+)
+
+// VetoExitCode is the exit code a pre-shutdown plugin uses to block the
+// shutdown it was asked about. Any other non-zero exit is treated as an
+// ordinary (non-fatal) plugin failure.
+const VetoExitCode = 3
+
+// DefaultTimeout bounds how long a plugin is given to run before it's
+// killed. Plugins are fire-and-forget hooks, not long tasks.
+const DefaultTimeout = 10 * time.Second
+
+// Runner discovers and executes lifecycle plugins for a town and,
+// optionally, a specific rig.
+type Runner struct {
+	// TownRoot is the town's root directory (containing plugins/).
+	TownRoot string
+
+	// RigPath is the rig's directory (containing plugins/). Empty for
+	// events with no rig context; rig-level plugins override town-level
+	// ones of the same name.
+	RigPath string
+
+	// Timeout bounds each plugin invocation. Defaults to DefaultTimeout
+	// when zero.
+	Timeout time.Duration
+}
+
+// NewRunner creates a Runner for a rig. rigPath may be empty for events
+// that have no rig context.
+func NewRunner(townRoot, rigPath string) *Runner {
+	return &Runner{TownRoot: townRoot, RigPath: rigPath}
+}
+
+// Discover returns the path to the executable that should handle event,
+// preferring a rig-level plugin over a town-level one of the same name.
+// Returns ok=false if neither exists or is executable.
+func (r *Runner) Discover(event string) (path string, ok bool) {
+	if r.RigPath != "" {
+		if p := filepath.Join(r.RigPath, "plugins", event); isExecutable(p) {
+			return p, true
+		}
+	}
+	if p := filepath.Join(r.TownRoot, "plugins", event); isExecutable(p) {
+		return p, true
+	}
+	return "", false
+}
+
+// isExecutable reports whether path is a regular file with at least one
+// executable bit set.
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || !info.Mode().IsRegular() {
+		return false
+	}
+	return info.Mode().Perm()&0o111 != 0
+}
+
+// Result is what a single plugin invocation produced.
+type Result struct {
+	// Event is the lifecycle event that was run.
+	Event string
+
+	// Path is the plugin executable that ran. Empty if no plugin was found.
+	Path string
+
+	// Ran is true if a plugin was found and invoked (regardless of outcome).
+	Ran bool
+
+	// ExitCode is the process's exit code. Meaningless if Err is set to a
+	// non-exec error (e.g. the payload failed to marshal).
+	ExitCode int
+
+	// Stdout and Stderr are the plugin's captured output.
+	Stdout string
+	Stderr string
+
+	// Err is set for failures that aren't a plugin's own non-zero exit -
+	// e.g. a payload marshal failure or the process failing to start.
+	Err error
+}
+
+// Vetoed reports whether a pre-shutdown plugin exited with VetoExitCode.
+func (res *Result) Vetoed() bool {
+	return res.ExitCode == VetoExitCode
+}
+
+// Failed reports whether the plugin ran but did not exit cleanly, or
+// failed to run at all. Callers should treat this as non-fatal and log it,
+// except for EventPreShutdown where Vetoed() should be checked first.
+func (res *Result) Failed() bool {
+	return res.Err != nil || res.ExitCode != 0
+}
+
+// FailureDetail formats a failed Result for a one-line log message.
+func (res *Result) FailureDetail() string {
+	if res.Err != nil {
+		return res.Err.Error()
+	}
+	return fmt.Sprintf("exited %d: %s", res.ExitCode, strings.TrimSpace(res.Stderr))
+}
+
+// Run discovers and invokes the plugin for event, if any, passing payload
+// as JSON on stdin. If no plugin is found, it returns a Result with
+// Ran=false and no error - having no plugin installed is not a failure.
+func (r *Runner) Run(event string, payload any) *Result {
+	result := &Result{Event: event}
+
+	path, ok := r.Discover(event)
+	if !ok {
+		return result
+	}
+	result.Path = path
+	result.Ran = true
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		result.Err = fmt.Errorf("marshaling payload for %s plugin: %w", event, err)
+		return result
+	}
+
+	timeout := r.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, path)
+	cmd.Stdin = bytes.NewReader(data)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	runErr := cmd.Run()
+	result.Stdout = stdout.String()
+	result.Stderr = stderr.String()
+
+	var exitErr *exec.ExitError
+	switch {
+	case runErr == nil:
+		// exit code 0
+	case errors.As(runErr, &exitErr):
+		result.ExitCode = exitErr.ExitCode()
+	default:
+		result.Err = fmt.Errorf("running %s plugin %s: %w", event, path, runErr)
+	}
+
+	return result
+}
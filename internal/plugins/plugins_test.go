@@ -0,0 +1,151 @@
+package plugins
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeScript writes an executable shell script fixture at
+// <dir>/plugins/<name> and returns its path.
+func writeScript(t *testing.T, dir, name, script string) string {
+	t.Helper()
+	pluginsDir := filepath.Join(dir, "plugins")
+	if err := os.MkdirAll(pluginsDir, 0o755); err != nil {
+		t.Fatalf("MkdirAll: %v", err)
+	}
+	path := filepath.Join(pluginsDir, name)
+	if err := os.WriteFile(path, []byte(script), 0o755); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	return path
+}
+
+func TestRunner_Discover_RigOverridesTown(t *testing.T) {
+	town := t.TempDir()
+	rig := t.TempDir()
+
+	writeScript(t, town, EventOnMerge, "#!/bin/sh\nexit 0\n")
+	r := NewRunner(town, rig)
+
+	path, ok := r.Discover(EventOnMerge)
+	if !ok {
+		t.Fatal("expected town-level plugin to be discovered")
+	}
+	if filepath.Dir(path) != filepath.Join(town, "plugins") {
+		t.Errorf("expected town plugin path, got %s", path)
+	}
+
+	rigPath := writeScript(t, rig, EventOnMerge, "#!/bin/sh\nexit 0\n")
+	path, ok = r.Discover(EventOnMerge)
+	if !ok || path != rigPath {
+		t.Errorf("expected rig-level plugin to override town-level, got path=%s ok=%v", path, ok)
+	}
+}
+
+func TestRunner_Discover_NotFound(t *testing.T) {
+	r := NewRunner(t.TempDir(), t.TempDir())
+	if _, ok := r.Discover(EventOnSpawn); ok {
+		t.Error("expected no plugin to be discovered")
+	}
+}
+
+func TestRunner_Discover_NonExecutableIgnored(t *testing.T) {
+	town := t.TempDir()
+	path := writeScript(t, town, EventOnMerge, "#!/bin/sh\nexit 0\n")
+	if err := os.Chmod(path, 0o644); err != nil {
+		t.Fatalf("Chmod: %v", err)
+	}
+	r := NewRunner(town, "")
+	if _, ok := r.Discover(EventOnMerge); ok {
+		t.Error("expected non-executable file to be ignored")
+	}
+}
+
+func TestRunner_Run_NoPluginInstalled(t *testing.T) {
+	r := NewRunner(t.TempDir(), "")
+	result := r.Run(EventOnMerge, map[string]string{"mr_id": "gt-123"})
+	if result.Ran {
+		t.Error("expected Ran=false when no plugin is installed")
+	}
+	if result.Failed() {
+		t.Error("missing plugin should not count as a failure")
+	}
+}
+
+// TestRunner_Run_CapturesPayload uses a shell-script fixture that dumps
+// its stdin, verifying the JSON payload is delivered intact.
+func TestRunner_Run_CapturesPayload(t *testing.T) {
+	town := t.TempDir()
+	captureFile := filepath.Join(town, "captured.json")
+	writeScript(t, town, EventOnMerge, "#!/bin/sh\ncat > \""+captureFile+"\"\n")
+
+	r := NewRunner(town, "")
+	payload := map[string]string{"mr_id": "gt-123", "branch": "polecat/nux"}
+	result := r.Run(EventOnMerge, payload)
+
+	if !result.Ran || result.Failed() {
+		t.Fatalf("expected plugin to run successfully, got %+v", result)
+	}
+
+	captured, err := os.ReadFile(captureFile)
+	if err != nil {
+		t.Fatalf("reading captured payload: %v", err)
+	}
+	var got map[string]string
+	if err := json.Unmarshal(captured, &got); err != nil {
+		t.Fatalf("captured payload isn't valid JSON: %v", err)
+	}
+	if got["mr_id"] != "gt-123" || got["branch"] != "polecat/nux" {
+		t.Errorf("captured payload = %v, want %v", got, payload)
+	}
+}
+
+func TestRunner_Run_NonZeroExitIsNonFatal(t *testing.T) {
+	town := t.TempDir()
+	writeScript(t, town, EventOnSpawn, "#!/bin/sh\necho boom >&2\nexit 1\n")
+
+	r := NewRunner(town, "")
+	result := r.Run(EventOnSpawn, nil)
+
+	if !result.Ran {
+		t.Fatal("expected plugin to have run")
+	}
+	if !result.Failed() {
+		t.Error("expected exit 1 to be reported as a failure")
+	}
+	if result.Vetoed() {
+		t.Error("exit 1 should not count as a veto")
+	}
+	if result.Stderr == "" {
+		t.Error("expected captured stderr")
+	}
+}
+
+func TestRunner_Run_PreShutdownVeto(t *testing.T) {
+	town := t.TempDir()
+	writeScript(t, town, EventPreShutdown, "#!/bin/sh\nexit 3\n")
+
+	r := NewRunner(town, "")
+	result := r.Run(EventPreShutdown, nil)
+
+	if !result.Vetoed() {
+		t.Errorf("expected exit code 3 to be reported as vetoed, got %+v", result)
+	}
+}
+
+func TestRunner_Run_TimeoutKillsHungPlugin(t *testing.T) {
+	town := t.TempDir()
+	writeScript(t, town, EventOnMerge, "#!/bin/sh\nexec sleep 5\n")
+
+	r := &Runner{TownRoot: town, Timeout: 50_000_000} // 50ms
+	result := r.Run(EventOnMerge, nil)
+
+	if !result.Ran {
+		t.Fatal("expected plugin to have started")
+	}
+	if !result.Failed() {
+		t.Error("expected a killed-by-timeout plugin to be reported as failed")
+	}
+}
@@ -0,0 +1,150 @@
+package polecat
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// defaultBranchTemplate is used when a rig has no polecat_branch_template
+// configured. It mirrors buildBranchName's built-in default so this file
+// and buildBranchName always agree on what a "default" branch looks like.
+const defaultBranchTemplate = "polecat/{name}/{issue}@{timestamp}"
+
+// legacyBranchTemplates are branch shapes gt has produced in the past.
+// branchPatterns always tries these after the rig's configured (or
+// default) template, so branches created before a rig adopted a custom
+// polecat_branch_template - or before {issue} was added to the default -
+// are still recognized.
+var legacyBranchTemplates = []string{
+	"polecat/{name}-{timestamp}", // pre-issue-embedding default
+	"polecat/{name}",             // pre-timestamping fallback format
+}
+
+// branchPlaceholders lists every template variable buildBranchName
+// understands.
+var branchPlaceholders = []string{"{user}", "{year}", "{month}", "{name}", "{issue}", "{description}", "{timestamp}"}
+
+// namedBranchGroups are the placeholders branch patterns capture by name;
+// the rest are matched but discarded.
+var namedBranchGroups = map[string]string{
+	"{name}":  "name",
+	"{issue}": "issue",
+}
+
+// compileBranchTemplate turns a buildBranchName-style template into an
+// anchored regexp that recognizes branches it produces, capturing {name}
+// and {issue} as named groups. Anything in template that isn't a known
+// placeholder is treated as a literal, so this never fails to compile -
+// ValidateBranchTemplate is what rejects templates that don't make sense
+// to use as a polecat_branch_template setting.
+func compileBranchTemplate(template string) *regexp.Regexp {
+	var pattern strings.Builder
+	pattern.WriteString("^")
+
+	for len(template) > 0 {
+		matched := false
+		for _, ph := range branchPlaceholders {
+			if !strings.HasPrefix(template, ph) {
+				continue
+			}
+			if group, ok := namedBranchGroups[ph]; ok {
+				fmt.Fprintf(&pattern, "(?P<%s>[^/]+?)", group)
+			} else {
+				pattern.WriteString("[^/]+?")
+			}
+			template = template[len(ph):]
+			matched = true
+			break
+		}
+		if matched {
+			continue
+		}
+		pattern.WriteString(regexp.QuoteMeta(template[:1]))
+		template = template[1:]
+	}
+
+	pattern.WriteString("$")
+	return regexp.MustCompile(pattern.String())
+}
+
+// branchPatterns returns the compiled patterns used to recognize this
+// rig's polecat branches: the configured (or default) template first,
+// then every legacy shape gt has produced historically. IssueFromBranch
+// tries them in that order.
+func (m *Manager) branchPatterns() []*regexp.Regexp {
+	template := m.rig.GetStringConfig("polecat_branch_template")
+	if template == "" {
+		template = defaultBranchTemplate
+	}
+
+	patterns := []*regexp.Regexp{compileBranchTemplate(template)}
+	for _, legacy := range legacyBranchTemplates {
+		if legacy == template {
+			continue
+		}
+		patterns = append(patterns, compileBranchTemplate(legacy))
+	}
+	return patterns
+}
+
+// IssueFromBranch extracts the issue ID embedded in a polecat branch name,
+// per the rig's configured (or default) branch template, falling back to
+// every legacy branch shape gt has produced historically. ok is false if
+// branch doesn't match any recognized pattern, or matches one with no
+// {issue} segment (e.g. an issue-less legacy branch).
+func (m *Manager) IssueFromBranch(branch string) (issue string, ok bool) {
+	for _, re := range m.branchPatterns() {
+		match := re.FindStringSubmatch(branch)
+		if match == nil {
+			continue
+		}
+		idx := re.SubexpIndex("issue")
+		if idx == -1 || idx >= len(match) || match[idx] == "" {
+			return "", false
+		}
+		return match[idx], true
+	}
+	return "", false
+}
+
+// ValidateBranchTemplate reports whether template is usable as a
+// polecat_branch_template setting. It must reference {name} (so two
+// polecats can never collide on the same branch) and must not contain a
+// "{...}" segment that isn't one of buildBranchName's known placeholders -
+// almost always a typo, e.g. "{issueid}".
+func ValidateBranchTemplate(template string) error {
+	if template == "" {
+		return nil // empty means "use the default"
+	}
+	if !strings.Contains(template, "{name}") {
+		return fmt.Errorf("branch template must include {name}: %q", template)
+	}
+
+	rest := template
+	for {
+		start := strings.IndexByte(rest, '{')
+		if start == -1 {
+			break
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end == -1 {
+			return fmt.Errorf("branch template has an unterminated placeholder: %q", template)
+		}
+		placeholder := rest[start : start+end+1]
+
+		known := false
+		for _, ph := range branchPlaceholders {
+			if placeholder == ph {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("branch template has unknown placeholder %s: %q", placeholder, template)
+		}
+		rest = rest[start+end+1:]
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package polecat
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func withBranchTemplate(t *testing.T, template string) {
+	t.Helper()
+	orig := rig.SystemDefaults["polecat_branch_template"]
+	rig.SystemDefaults["polecat_branch_template"] = template
+	t.Cleanup(func() {
+		rig.SystemDefaults["polecat_branch_template"] = orig
+	})
+}
+
+func TestIssueFromBranch_DefaultTemplate(t *testing.T) {
+	r := &rig.Rig{Name: "test-rig", Path: "/tmp/test-rig"}
+	m := NewManager(r, nil, nil)
+
+	tests := []struct {
+		name      string
+		branch    string
+		wantIssue string
+		wantFound bool
+	}{
+		{"current default with issue", "polecat/alpha/gt-123@1a2b3c", "gt-123", true},
+		{"legacy issue-less default", "polecat/alpha-1a2b3c", "", false},
+		{"very old fallback format", "polecat/alpha", "", false},
+		{"unrelated branch", "main", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issue, ok := m.IssueFromBranch(tt.branch)
+			if ok != tt.wantFound || issue != tt.wantIssue {
+				t.Errorf("IssueFromBranch(%q) = (%q, %v), want (%q, %v)", tt.branch, issue, ok, tt.wantIssue, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestIssueFromBranch_CustomTemplate(t *testing.T) {
+	withBranchTemplate(t, "polecat/{name}/{issue}")
+
+	r := &rig.Rig{Name: "test-rig", Path: "/tmp/test-rig"}
+	m := NewManager(r, nil, nil)
+
+	tests := []struct {
+		name      string
+		branch    string
+		wantIssue string
+		wantFound bool
+	}{
+		{"matches custom template", "polecat/alpha/gt-999", "gt-999", true},
+		{"legacy branch still recognized", "polecat/alpha-1a2b3c", "", false},
+		{"very old fallback still recognized", "polecat/alpha", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issue, ok := m.IssueFromBranch(tt.branch)
+			if ok != tt.wantFound || issue != tt.wantIssue {
+				t.Errorf("IssueFromBranch(%q) = (%q, %v), want (%q, %v)", tt.branch, issue, ok, tt.wantIssue, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestValidateBranchTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  bool
+	}{
+		{"empty means default", "", false},
+		{"valid with name and issue", "polecat/{name}/{issue}", false},
+		{"valid with only name", "feature/{name}", false},
+		{"missing name is rejected", "work/{issue}", true},
+		{"unknown placeholder is rejected", "polecat/{namee}/{issue}", true},
+		{"unterminated placeholder is rejected", "polecat/{name/{issue}", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBranchTemplate(tt.template)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBranchTemplate(%q) error = %v, wantErr %v", tt.template, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/tmux"
@@ -22,10 +23,11 @@ import (
 
 // Common errors
 var (
-	ErrPolecatExists     = errors.New("polecat already exists")
-	ErrPolecatNotFound   = errors.New("polecat not found")
-	ErrHasChanges        = errors.New("polecat has uncommitted changes")
-	ErrHasUncommittedWork = errors.New("polecat has uncommitted work")
+	ErrPolecatExists       = errors.New("polecat already exists")
+	ErrPolecatNotFound     = errors.New("polecat not found")
+	ErrHasChanges          = errors.New("polecat has uncommitted changes")
+	ErrHasUncommittedWork  = errors.New("polecat has uncommitted work")
+	ErrPolecatLimitReached = errors.New("polecat limit reached")
 )
 
 // UncommittedWorkError provides details about uncommitted work.
@@ -264,8 +266,8 @@ func (m *Manager) buildBranchName(name, issue string) string {
 
 	// {year} and {month}
 	now := time.Now()
-	vars["{year}"] = now.Format("06")   // YY format
-	vars["{month}"] = now.Format("01")  // MM format
+	vars["{year}"] = now.Format("06")  // YY format
+	vars["{month}"] = now.Format("01") // MM format
 
 	// {name}
 	vars["{name}"] = name
@@ -344,11 +346,36 @@ func (m *Manager) Add(name string) (*Polecat, error) {
 // AddWithOptions creates a new polecat with the specified options.
 // This allows setting hook_bead atomically at creation time, avoiding
 // cross-beads routing issues when slinging work to new polecats.
+// checkPolecatLimit enforces the rig's max_polecats config against the
+// current on-disk polecat count. max_polecats defaults to 0, meaning
+// unlimited; it is never bypassed by --force, since --force only overrides
+// the uncommitted-work check.
+func (m *Manager) checkPolecatLimit() error {
+	limit := m.rig.GetIntConfig("max_polecats")
+	if limit <= 0 {
+		return nil
+	}
+
+	polecats, err := m.List()
+	if err != nil {
+		return fmt.Errorf("checking polecat limit: %w", err)
+	}
+	if len(polecats) >= limit {
+		return fmt.Errorf("%w: rig %s has %d/%d polecats (raise it with 'gt rig config set %s max_polecats <n>')",
+			ErrPolecatLimitReached, m.rig.Name, len(polecats), limit, m.rig.Name)
+	}
+	return nil
+}
+
 func (m *Manager) AddWithOptions(name string, opts AddOptions) (*Polecat, error) {
 	if m.exists(name) {
 		return nil, ErrPolecatExists
 	}
 
+	if err := m.checkPolecatLimit(); err != nil {
+		return nil, err
+	}
+
 	// New structure: polecats/<name>/<rigname>/ for LLM ergonomics
 	// The polecat's home dir is polecats/<name>/, worktree is polecats/<name>/<rigname>/
 	polecatDir := m.polecatDir(name)
@@ -368,8 +395,9 @@ func (m *Manager) AddWithOptions(name string, opts AddOptions) (*Polecat, error)
 		return nil, fmt.Errorf("finding repo base: %w", err)
 	}
 
-	// Fetch latest from origin to ensure worktree starts from up-to-date code
-	if err := repoGit.Fetch("origin"); err != nil {
+	// Fetch latest from origin to ensure worktree starts from up-to-date code.
+	// Retries transient network failures rather than failing the whole spawn.
+	if err := repoGit.FetchRetry("origin", 3); err != nil {
 		// Non-fatal - proceed with potentially stale code
 		fmt.Printf("Warning: could not fetch origin: %v\n", err)
 	}
@@ -414,6 +442,12 @@ func (m *Manager) AddWithOptions(name string, opts AddOptions) (*Polecat, error)
 		fmt.Printf("Warning: could not set up shared beads: %v\n", err)
 	}
 
+	// Write .gastown marker so workspace/role detection still works if this
+	// clone ends up outside the town directory tree.
+	if err := m.writeWorkspaceMarker(clonePath, name); err != nil {
+		fmt.Printf("Warning: could not write workspace marker: %v\n", err)
+	}
+
 	// Provision PRIME.md with Gas Town context for this worker.
 	// This is the fallback if SessionStart hook fails - ensures polecats
 	// always have GUPP and essential Gas Town context.
@@ -639,6 +673,14 @@ func (m *Manager) RepairWorktreeWithOptions(name string, force bool, opts AddOpt
 		return nil, ErrPolecatNotFound
 	}
 
+	// Repair replaces this polecat one-for-one, so it never increases the
+	// rig's polecat count. checkPolecatLimit still runs so a rig that's over
+	// limit only because max_polecats was lowered after the fact surfaces
+	// the same clear error, rather than silently letting repairs through.
+	if err := m.checkPolecatLimit(); err != nil {
+		return nil, err
+	}
+
 	// Get the old clone path (may be old or new structure)
 	oldClonePath := m.clonePath(name)
 	polecatGit := git.NewGit(oldClonePath)
@@ -671,6 +713,19 @@ func (m *Manager) RepairWorktreeWithOptions(name string, force bool, opts AddOpt
 		}
 	}
 
+	// Load rig config once so we can both determine the default branch below
+	// and, before we wipe the old worktree, preserve any untracked files the
+	// rig's preserve_on_recreate list says are worth carrying over.
+	rigCfg, rigCfgErr := rig.LoadRigConfig(m.rig.Path)
+
+	var preserved []preservedFile
+	if rigCfgErr == nil && len(rigCfg.PreserveOnRecreate) > 0 {
+		preserved, err = collectPreservedFiles(oldClonePath, rigCfg.PreserveOnRecreate)
+		if err != nil {
+			fmt.Printf("Warning: could not preserve local files: %v\n", err)
+		}
+	}
+
 	// Remove the old worktree (use force for git worktree removal)
 	if err := repoGit.WorktreeRemove(oldClonePath, true); err != nil {
 		// Fall back to direct removal
@@ -682,8 +737,10 @@ func (m *Manager) RepairWorktreeWithOptions(name string, force bool, opts AddOpt
 	// Prune stale worktree entries (non-fatal: cleanup only)
 	_ = repoGit.WorktreePrune()
 
-	// Fetch latest from origin to ensure we have fresh commits (non-fatal: may be offline)
-	_ = repoGit.Fetch("origin")
+	// Fetch latest from origin to ensure we have fresh commits (non-fatal: may
+	// be offline). Retries transient network failures so a brief blip doesn't
+	// leave the new worktree starting from a stale fetch.
+	_ = repoGit.FetchRetry("origin", 3)
 
 	// Ensure polecat directory exists for new structure
 	if err := os.MkdirAll(polecatDir, 0755); err != nil {
@@ -693,7 +750,7 @@ func (m *Manager) RepairWorktreeWithOptions(name string, force bool, opts AddOpt
 	// Determine the start point for the new worktree
 	// Use origin/<default-branch> to ensure we start from latest fetched commits
 	defaultBranch := "main"
-	if rigCfg, err := rig.LoadRigConfig(m.rig.Path); err == nil && rigCfg.DefaultBranch != "" {
+	if rigCfgErr == nil && rigCfg.DefaultBranch != "" {
 		defaultBranch = rigCfg.DefaultBranch
 	}
 	startPoint := fmt.Sprintf("origin/%s", defaultBranch)
@@ -726,6 +783,12 @@ func (m *Manager) RepairWorktreeWithOptions(name string, force bool, opts AddOpt
 		fmt.Printf("Warning: could not set up shared beads: %v\n", err)
 	}
 
+	// Write .gastown marker so workspace/role detection still works if this
+	// clone ends up outside the town directory tree.
+	if err := m.writeWorkspaceMarker(newClonePath, name); err != nil {
+		fmt.Printf("Warning: could not write workspace marker: %v\n", err)
+	}
+
 	// Copy overlay files from .runtime/overlay/ to polecat root.
 	if err := rig.CopyOverlay(m.rig.Path, newClonePath); err != nil {
 		fmt.Printf("Warning: could not copy overlay files: %v\n", err)
@@ -738,6 +801,15 @@ func (m *Manager) RepairWorktreeWithOptions(name string, force bool, opts AddOpt
 
 	// NOTE: Slash commands inherited from town level - no per-workspace copies needed.
 
+	// Restore any files preserved above the wipe (.env, .envrc, etc.). Files
+	// matching .gitignore but not in preserve_on_recreate stay deleted.
+	if len(preserved) > 0 {
+		restored := restorePreservedFiles(newClonePath, preserved)
+		if len(restored) > 0 {
+			fmt.Printf("Preserved %d local file(s): %s\n", len(restored), strings.Join(restored, ", "))
+		}
+	}
+
 	// Create or reopen agent bead for ZFC compliance
 	// HookBead is set atomically at recreation time if provided.
 	// Uses CreateOrReopenAgentBead to handle re-spawning with same name (GH #332).
@@ -943,9 +1015,55 @@ func (m *Manager) AssignIssue(name, issue string) error {
 		return fmt.Errorf("setting issue assignee: %w", err)
 	}
 
+	// Record when the assignment happened so elapsed time (gt polecat list,
+	// gt swarm status, witness stall detection) doesn't have to guess from
+	// bd's own updated_at, which changes on every unrelated edit too.
+	now := time.Now()
+	if err := m.recordAssignedAt(issue, now); err != nil {
+		fmt.Printf("Warning: could not record assigned_at on %s: %v\n", issue, err)
+	}
+	if err := m.beads.UpdateAgentAssignedAt(m.agentBeadID(name), now); err != nil {
+		fmt.Printf("Warning: could not record assigned_at on agent bead: %v\n", err)
+	}
+
+	if err := m.renameBranchForIssue(name, issue); err != nil {
+		fmt.Printf("Warning: could not rename branch for %s: %v\n", name, err)
+	}
+
+	_ = events.LogFeed(events.TypeWorkAssigned, "gt", events.WorkAssignedPayload(m.rig.Name, name, issue))
+
 	return nil
 }
 
+// renameBranchForIssue renames name's current branch to embed issue, if it
+// doesn't already. A polecat's branch is normally named with its issue at
+// spawn time (via AddWithOptions's HookBead), but AssignIssue can also run
+// later, against an issue that wasn't known at spawn - this keeps the
+// branch name correlated to whatever issue the polecat actually ends up
+// working.
+func (m *Manager) renameBranchForIssue(name, issue string) error {
+	if issue == "" {
+		return nil
+	}
+
+	clonePath := m.clonePath(name)
+	polecatGit := git.NewGit(clonePath)
+	current, err := polecatGit.CurrentBranch()
+	if err != nil {
+		return fmt.Errorf("getting current branch: %w", err)
+	}
+
+	if existing, ok := m.IssueFromBranch(current); ok && existing == issue {
+		return nil // already embeds this issue
+	}
+
+	newBranch := m.buildBranchName(name, issue)
+	if newBranch == current {
+		return nil
+	}
+	return polecatGit.RenameBranch(current, newBranch)
+}
+
 // ClearIssue removes the issue assignment from a polecat.
 // In the transient model, this transitions to Done state for cleanup.
 // This clears the assignee from the currently assigned issue in beads.
@@ -976,9 +1094,38 @@ func (m *Manager) ClearIssue(name string) error {
 		return fmt.Errorf("clearing issue assignee: %w", err)
 	}
 
+	if err := m.recordAssignedAt(issue.ID, time.Time{}); err != nil {
+		fmt.Printf("Warning: could not clear assigned_at on %s: %v\n", issue.ID, err)
+	}
+	if err := m.beads.UpdateAgentAssignedAt(m.agentBeadID(name), time.Time{}); err != nil {
+		fmt.Printf("Warning: could not clear assigned_at on agent bead: %v\n", err)
+	}
+
 	return nil
 }
 
+// recordAssignedAt sets or clears the assigned_at key:value field in an
+// issue's description. Pass a zero time.Time to clear it.
+func (m *Manager) recordAssignedAt(issueID string, at time.Time) error {
+	current, err := m.beads.Show(issueID)
+	if err != nil {
+		return err
+	}
+
+	fields := beads.ParseWorkFields(current)
+	if fields == nil {
+		fields = &beads.WorkFields{}
+	}
+	if at.IsZero() {
+		fields.AssignedAt = ""
+	} else {
+		fields.AssignedAt = at.UTC().Format(time.RFC3339)
+	}
+
+	description := beads.SetWorkFields(current, fields)
+	return m.beads.Update(issueID, beads.UpdateOptions{Description: &description})
+}
+
 // loadFromBeads gets polecat info from beads assignee field.
 // State is simple: issue assigned → working, no issue → done (ready for cleanup).
 // Transient polecats should always have work; no work means ready for Witness cleanup.
@@ -1015,18 +1162,25 @@ func (m *Manager) loadFromBeads(name string) (*Polecat, error) {
 	// Polecats without work should be nuked by the Witness
 	state := StateDone
 	issueID := ""
+	var assignedAt time.Time
 	if issue != nil {
 		issueID = issue.ID
 		state = StateWorking
+		if fields := beads.ParseWorkFields(issue); fields != nil && fields.AssignedAt != "" {
+			if t, err := time.Parse(time.RFC3339, fields.AssignedAt); err == nil {
+				assignedAt = t
+			}
+		}
 	}
 
 	return &Polecat{
-		Name:      name,
-		Rig:       m.rig.Name,
-		State:     state,
-		ClonePath: clonePath,
-		Branch:    branchName,
-		Issue:     issueID,
+		Name:       name,
+		Rig:        m.rig.Name,
+		State:      state,
+		ClonePath:  clonePath,
+		Branch:     branchName,
+		Issue:      issueID,
+		AssignedAt: assignedAt,
 	}, nil
 }
 
@@ -1037,6 +1191,19 @@ func (m *Manager) setupSharedBeads(clonePath string) error {
 	return beads.SetupRedirect(townRoot, clonePath)
 }
 
+// writeWorkspaceMarker writes a .gastown marker into the polecat's clone so
+// workspace discovery still works if the clone was created outside the
+// town directory tree (e.g. --path pointing at another disk).
+func (m *Manager) writeWorkspaceMarker(clonePath, name string) error {
+	townRoot := filepath.Dir(m.rig.Path)
+	return workspace.WriteMarker(clonePath, workspace.Marker{
+		TownRoot: townRoot,
+		Rig:      m.rig.Name,
+		Role:     "polecat",
+		Name:     name,
+	})
+}
+
 // CleanupStaleBranches removes orphaned polecat branches that are no longer in use.
 // This includes:
 // - Branches for polecats that no longer exist
@@ -1090,13 +1257,13 @@ func (m *Manager) CleanupStaleBranches() (int, error) {
 
 // StalenessInfo contains details about a polecat's staleness.
 type StalenessInfo struct {
-	Name            string
-	CommitsBehind   int  // How many commits behind origin/main
-	HasActiveSession bool // Whether tmux session is running
-	HasUncommittedWork bool // Whether there's uncommitted or unpushed work
-	AgentState      string // From agent bead (empty if no bead)
-	IsStale         bool   // Overall assessment: safe to clean up
-	Reason          string // Why it's considered stale (or not)
+	Name               string
+	CommitsBehind      int    // How many commits behind origin/main
+	HasActiveSession   bool   // Whether tmux session is running
+	HasUncommittedWork bool   // Whether there's uncommitted or unpushed work
+	AgentState         string // From agent bead (empty if no bead)
+	IsStale            bool   // Overall assessment: safe to clean up
+	Reason             string // Why it's considered stale (or not)
 }
 
 // DetectStalePolecats identifies polecats that are candidates for cleanup.
@@ -1214,3 +1381,87 @@ func assessStaleness(info *StalenessInfo, threshold int) (bool, string) {
 	// (The session is the source of truth for liveness)
 	return true, "no active session"
 }
+
+// IdleSessionInfo describes the outcome of evaluating one running polecat
+// session for auto-stop by StopIdle.
+type IdleSessionInfo struct {
+	Name          string
+	IdleFor       time.Duration
+	AgentState    string
+	CleanupStatus CleanupStatus
+	Stopped       bool   // true if the session was actually stopped (false in dry-run)
+	Eligible      bool   // true if it met every StopIdle condition
+	Reason        string // why it was (or wasn't) stopped
+}
+
+// StopIdle stops polecat sessions that have sat idle beyond maxIdle
+// (tmux's own last-activity timestamp), but only when the polecat has
+// self-reported that it's actually done: agent_state "done" and
+// cleanup_status "clean" (the same signals Remove uses to decide it's safe
+// to nuke a worktree, see getCleanupStatusFromBead). A polecat that's still
+// working, stuck, or has uncommitted work is never touched, no matter how
+// long its session has been idle - per the State doc, there is no idle
+// pool, only sessions that finished and failed to exit (zombies).
+//
+// dryRun evaluates every running session and reports what would be
+// stopped without stopping anything.
+func (m *Manager) StopIdle(maxIdle time.Duration, dryRun bool) ([]*IdleSessionInfo, error) {
+	sm := NewSessionManager(m.tmux, m.rig)
+	sessions, err := sm.List()
+	if err != nil {
+		return nil, fmt.Errorf("listing sessions: %w", err)
+	}
+
+	var results []*IdleSessionInfo
+	for _, s := range sessions {
+		info := &IdleSessionInfo{Name: s.Polecat}
+
+		status, err := sm.Status(s.Polecat)
+		if err != nil || status.LastActivity.IsZero() {
+			info.Reason = "could not determine session activity"
+			results = append(results, info)
+			continue
+		}
+		info.IdleFor = time.Since(status.LastActivity)
+		if info.IdleFor < maxIdle {
+			info.Reason = fmt.Sprintf("idle %s, under threshold", info.IdleFor.Round(time.Second))
+			results = append(results, info)
+			continue
+		}
+
+		agentID := m.agentBeadID(s.Polecat)
+		_, fields, err := m.beads.GetAgentBead(agentID)
+		if err != nil || fields == nil {
+			info.Reason = "no agent bead"
+			results = append(results, info)
+			continue
+		}
+		info.AgentState = fields.AgentState
+		info.CleanupStatus = CleanupStatus(fields.CleanupStatus)
+
+		if fields.AgentState != "done" {
+			info.Reason = fmt.Sprintf("agent_state=%s (not done)", fields.AgentState)
+			results = append(results, info)
+			continue
+		}
+		if !info.CleanupStatus.IsSafe() {
+			info.Reason = fmt.Sprintf("cleanup_status=%s (not clean)", info.CleanupStatus)
+			results = append(results, info)
+			continue
+		}
+
+		info.Eligible = true
+		info.Reason = fmt.Sprintf("idle %s, done and clean", info.IdleFor.Round(time.Second))
+		if !dryRun {
+			if err := sm.Stop(s.Polecat, false); err != nil {
+				info.Reason = fmt.Sprintf("stop failed: %v", err)
+				results = append(results, info)
+				continue
+			}
+			info.Stopped = true
+		}
+		results = append(results, info)
+	}
+
+	return results, nil
+}
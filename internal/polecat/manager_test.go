@@ -1,6 +1,7 @@
 package polecat
 
 import (
+	"errors"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/wisp"
 )
 
 func TestStateIsActive(t *testing.T) {
@@ -446,6 +448,137 @@ func TestAddWithOptions_AgentsMDFallback(t *testing.T) {
 		t.Errorf("AGENTS.md content = %q, want %q", gotContent, wantContent)
 	}
 }
+
+func TestAddWithOptions_RespectsMaxPolecats(t *testing.T) {
+	root := t.TempDir()
+	townRoot := filepath.Dir(root)
+
+	// Two polecats already exist on disk.
+	for _, name := range []string{"Toast", "Cheedo"} {
+		if err := os.MkdirAll(filepath.Join(root, "polecats", name), 0755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+
+	r := &rig.Rig{Name: filepath.Base(root), Path: root}
+	if err := wisp.NewConfig(townRoot, r.Name).Set("max_polecats", 2); err != nil {
+		t.Fatalf("setting max_polecats: %v", err)
+	}
+
+	m := NewManager(r, git.NewGit(root), nil)
+
+	if _, err := m.AddWithOptions("Furiosa", AddOptions{}); !errors.Is(err, ErrPolecatLimitReached) {
+		t.Fatalf("AddWithOptions at limit: err = %v, want ErrPolecatLimitReached", err)
+	}
+}
+
+func TestRepairWorktreeWithOptions_ForceDoesNotBypassMaxPolecats(t *testing.T) {
+	root := t.TempDir()
+	townRoot := filepath.Dir(root)
+
+	// Three polecats already exist, one over the limit of two.
+	for _, name := range []string{"Toast", "Cheedo", "Furiosa"} {
+		if err := os.MkdirAll(filepath.Join(root, "polecats", name), 0755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+
+	r := &rig.Rig{Name: filepath.Base(root), Path: root}
+	if err := wisp.NewConfig(townRoot, r.Name).Set("max_polecats", 2); err != nil {
+		t.Fatalf("setting max_polecats: %v", err)
+	}
+
+	m := NewManager(r, git.NewGit(root), nil)
+
+	// force=true bypasses the uncommitted-work check, not the polecat count
+	// check, so repairing Furiosa should still be refused.
+	if _, err := m.RepairWorktreeWithOptions("Furiosa", true, AddOptions{}); !errors.Is(err, ErrPolecatLimitReached) {
+		t.Fatalf("RepairWorktreeWithOptions(force=true) at limit: err = %v, want ErrPolecatLimitReached", err)
+	}
+
+	// Raising the limit (not --force) is what actually lifts the block.
+	if err := wisp.NewConfig(townRoot, r.Name).Set("max_polecats", 4); err != nil {
+		t.Fatalf("raising max_polecats: %v", err)
+	}
+	if err := m.checkPolecatLimit(); err != nil {
+		t.Errorf("checkPolecatLimit after raising limit: %v", err)
+	}
+}
+
+func TestRepairWorktreeWithOptions_PreservesConfiguredFiles(t *testing.T) {
+	root := t.TempDir()
+
+	mayorRig := filepath.Join(root, "mayor", "rig")
+	if err := os.MkdirAll(mayorRig, 0755); err != nil {
+		t.Fatalf("mkdir mayor/rig: %v", err)
+	}
+
+	cmd := exec.Command("git", "init")
+	cmd.Dir = mayorRig
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v\n%s", err, out)
+	}
+	mayorGit := git.NewGit(mayorRig)
+	if err := os.WriteFile(filepath.Join(mayorRig, "README.md"), []byte("# Test\n"), 0644); err != nil {
+		t.Fatalf("write README.md: %v", err)
+	}
+	if err := mayorGit.Add("README.md"); err != nil {
+		t.Fatalf("git add: %v", err)
+	}
+	if err := mayorGit.Commit("Initial commit"); err != nil {
+		t.Fatalf("git commit: %v", err)
+	}
+
+	cmd = exec.Command("git", "remote", "add", "origin", mayorRig)
+	cmd.Dir = mayorRig
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git remote add: %v\n%s", err, out)
+	}
+	cmd = exec.Command("git", "update-ref", "refs/remotes/origin/main", "HEAD")
+	cmd.Dir = mayorRig
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git update-ref: %v\n%s", err, out)
+	}
+
+	// preserve_on_recreate keeps .env but not scratch.log.
+	rigConfig := `{"type":"rig","version":1,"name":"rig","preserve_on_recreate":[".env"]}`
+	if err := os.WriteFile(filepath.Join(root, "config.json"), []byte(rigConfig), 0644); err != nil {
+		t.Fatalf("write config.json: %v", err)
+	}
+
+	r := &rig.Rig{Name: "rig", Path: root}
+	m := NewManager(r, git.NewGit(root), nil)
+
+	polecat, err := m.AddWithOptions("TestAgent", AddOptions{})
+	if err != nil {
+		t.Fatalf("AddWithOptions: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(polecat.ClonePath, ".env"), []byte("SECRET=1\n"), 0644); err != nil {
+		t.Fatalf("write .env: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(polecat.ClonePath, "scratch.log"), []byte("noise\n"), 0644); err != nil {
+		t.Fatalf("write scratch.log: %v", err)
+	}
+
+	repaired, err := m.RepairWorktreeWithOptions("TestAgent", true, AddOptions{})
+	if err != nil {
+		t.Fatalf("RepairWorktreeWithOptions: %v", err)
+	}
+
+	envContent, err := os.ReadFile(filepath.Join(repaired.ClonePath, ".env"))
+	if err != nil {
+		t.Fatalf(".env was not preserved: %v", err)
+	}
+	if string(envContent) != "SECRET=1\n" {
+		t.Errorf(".env content = %q, want %q", envContent, "SECRET=1\n")
+	}
+
+	if _, err := os.Stat(filepath.Join(repaired.ClonePath, "scratch.log")); !os.IsNotExist(err) {
+		t.Errorf("scratch.log should not have been preserved, stat err = %v", err)
+	}
+}
+
 // TestReconcilePoolWith tests all permutations of directory and session existence.
 // This is the core allocation policy logic.
 //
@@ -0,0 +1,145 @@
+package polecat
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// preservedFile records where an untracked file was copied to during a
+// worktree recreation, so it can be restored afterward.
+type preservedFile struct {
+	relPath  string // path relative to the worktree root
+	tempPath string // absolute path under the scratch temp dir
+}
+
+// collectPreservedFiles copies untracked and gitignored files under
+// clonePath that match one of the preserve_on_recreate glob patterns into a
+// fresh scratch temp dir, returning the copies so they can be restored into
+// the new worktree after Recreate wipes clonePath. Patterns ending in "/"
+// match an entire directory prefix; others are matched with filepath.Match
+// against the file's path relative to clonePath.
+func collectPreservedFiles(clonePath string, patterns []string) ([]preservedFile, error) {
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+
+	relPaths, err := untrackedAndIgnoredFiles(clonePath)
+	if err != nil {
+		return nil, fmt.Errorf("listing untracked files: %w", err)
+	}
+
+	tempDir, err := os.MkdirTemp("", "gt-polecat-preserve-*")
+	if err != nil {
+		return nil, fmt.Errorf("creating scratch dir: %w", err)
+	}
+
+	var preserved []preservedFile
+	for _, relPath := range relPaths {
+		if !matchesAnyPreservePattern(relPath, patterns) {
+			continue
+		}
+
+		src := filepath.Join(clonePath, relPath)
+		dst := filepath.Join(tempDir, relPath)
+		if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+			return preserved, fmt.Errorf("preparing scratch dir for %s: %w", relPath, err)
+		}
+		if err := copyPreservedFile(src, dst); err != nil {
+			return preserved, fmt.Errorf("preserving %s: %w", relPath, err)
+		}
+		preserved = append(preserved, preservedFile{relPath: relPath, tempPath: dst})
+	}
+
+	return preserved, nil
+}
+
+// restorePreservedFiles copies previously-collected preserved files into the
+// fresh worktree at newClonePath, returning the relative paths that were
+// successfully restored so callers can report them.
+func restorePreservedFiles(newClonePath string, preserved []preservedFile) []string {
+	var restored []string
+	for _, p := range preserved {
+		dst := filepath.Join(newClonePath, p.relPath)
+		if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+			continue
+		}
+		if err := copyPreservedFile(p.tempPath, dst); err != nil {
+			continue
+		}
+		restored = append(restored, p.relPath)
+	}
+	return restored
+}
+
+// matchesAnyPreservePattern reports whether relPath matches one of the
+// preserve_on_recreate patterns. A pattern ending in "/" preserves everything
+// under that directory; other patterns are matched with filepath.Match
+// against the full relative path.
+func matchesAnyPreservePattern(relPath string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if dir, ok := strings.CutSuffix(pattern, "/"); ok {
+			if relPath == dir || strings.HasPrefix(relPath, dir+"/") {
+				return true
+			}
+			continue
+		}
+		if matched, err := filepath.Match(pattern, relPath); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// untrackedAndIgnoredFiles lists files under clonePath that are untracked or
+// gitignored, one path per file (not directory), relative to clonePath.
+func untrackedAndIgnoredFiles(clonePath string) ([]string, error) {
+	cmd := exec.Command("git", "status", "--porcelain", "--ignored=matching", "-uall")
+	cmd.Dir = clonePath
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, line := range strings.Split(string(out), "\n") {
+		if len(line) < 4 {
+			continue
+		}
+		status := line[:2]
+		if status == "??" || status == "!!" {
+			paths = append(paths, strings.TrimSpace(line[3:]))
+		}
+	}
+	return paths, nil
+}
+
+// copyPreservedFile copies a single regular file, creating dst's parent if
+// needed. Non-regular files (symlinks, sockets, etc.) are silently skipped.
+func copyPreservedFile(src, dst string) error {
+	info, err := os.Lstat(src)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
@@ -0,0 +1,26 @@
+package polecat
+
+import "testing"
+
+func TestMatchesAnyPreservePattern(t *testing.T) {
+	tests := []struct {
+		relPath  string
+		patterns []string
+		want     bool
+	}{
+		{".env", []string{".env"}, true},
+		{".env.local", []string{".env"}, false},
+		{"local/notes.txt", []string{"local/"}, true},
+		{"local", []string{"local/"}, true},
+		{"other/notes.txt", []string{"local/"}, false},
+		{"config/dev.yaml", []string{"config/*.yaml"}, true},
+		{"config/dev.json", []string{"config/*.yaml"}, false},
+		{"anything", nil, false},
+	}
+
+	for _, tt := range tests {
+		if got := matchesAnyPreservePattern(tt.relPath, tt.patterns); got != tt.want {
+			t.Errorf("matchesAnyPreservePattern(%q, %v) = %v, want %v", tt.relPath, tt.patterns, got, tt.want)
+		}
+	}
+}
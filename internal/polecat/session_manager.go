@@ -13,6 +13,7 @@ import (
 
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/session"
@@ -98,6 +99,14 @@ func (m *SessionManager) SessionName(polecat string) string {
 	return fmt.Sprintf("gt-%s-%s", m.rig.Name, polecat)
 }
 
+// ClonePath returns the path where the polecat's git worktree lives, for
+// callers outside this package that need to inspect it directly (e.g.
+// witness.Recover checking for uncommitted work before restarting a
+// session).
+func (m *SessionManager) ClonePath(polecat string) string {
+	return m.clonePath(polecat)
+}
+
 // polecatDir returns the parent directory for a polecat.
 // This is polecats/<name>/ - the polecat's home directory.
 func (m *SessionManager) polecatDir(polecat string) string {
@@ -295,6 +304,12 @@ func (m *SessionManager) Stop(polecat string, force bool) error {
 		return fmt.Errorf("killing session: %w", err)
 	}
 
+	reason := ""
+	if force {
+		reason = "force"
+	}
+	_ = events.LogFeed(events.TypeAgentStopped, "gt", events.AgentStoppedPayload(m.rig.Name, polecat, reason))
+
 	return nil
 }
 
@@ -77,6 +77,10 @@ type Polecat struct {
 	// Issue is the currently assigned issue ID (if any).
 	Issue string `json:"issue,omitempty"`
 
+	// AssignedAt is when Issue was assigned to this polecat. Zero if no
+	// issue is currently assigned.
+	AssignedAt time.Time `json:"assigned_at,omitempty"`
+
 	// CreatedAt is when the polecat was created.
 	CreatedAt time.Time `json:"created_at"`
 
@@ -15,6 +15,7 @@ import (
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/protocol"
@@ -35,6 +36,15 @@ type MergeQueueConfig struct {
 	// OnConflict is the strategy for handling conflicts: "assign_back" or "auto_rebase".
 	OnConflict string `json:"on_conflict"`
 
+	// AutoResolvePaths lists glob patterns (matched against both the full
+	// repo-relative path and the basename) for files that doMerge may
+	// auto-resolve during an auto-rebase by taking the source branch's
+	// version - e.g. lockfiles or generated code where a manual rebase is
+	// usually just noise. Only consulted when OnConflict is "auto_rebase".
+	// A rebase with conflicts outside this list still falls back to
+	// assign_back.
+	AutoResolvePaths []string `json:"auto_resolve_paths,omitempty"`
+
 	// RunTests controls whether to run tests before merging.
 	RunTests bool `json:"run_tests"`
 
@@ -52,8 +62,26 @@ type MergeQueueConfig struct {
 
 	// MaxConcurrent is the maximum number of MRs to process concurrently.
 	MaxConcurrent int `json:"max_concurrent"`
+
+	// RequireReview gates ProcessMR on a witness sign-off: an MR whose bead
+	// lacks a `reviewed_by:` field is skipped until `gt witness review
+	// --approve` records one.
+	RequireReview bool `json:"require_review"`
+
+	// CloseIssueOnMerge controls what happens to the source issue when its
+	// MR merges: CloseIssueOnMergeClose (default) closes it, while
+	// CloseIssueOnMergeLabelOnly leaves it open and adds a "merged" label
+	// instead - useful for issues meant to stay open for further tracking
+	// (e.g. epics with more MRs still to land).
+	CloseIssueOnMerge string `json:"close_issue_on_merge"`
 }
 
+// Values for MergeQueueConfig.CloseIssueOnMerge.
+const (
+	CloseIssueOnMergeClose     = "close"
+	CloseIssueOnMergeLabelOnly = "label_only"
+)
+
 // DefaultMergeQueueConfig returns sensible defaults for merge queue configuration.
 func DefaultMergeQueueConfig() *MergeQueueConfig {
 	return &MergeQueueConfig{
@@ -67,6 +95,7 @@ func DefaultMergeQueueConfig() *MergeQueueConfig {
 		RetryFlakyTests:      1,
 		PollInterval:         30 * time.Second,
 		MaxConcurrent:        1,
+		CloseIssueOnMerge:    CloseIssueOnMergeClose,
 	}
 }
 
@@ -100,6 +129,11 @@ type Engineer struct {
 	output  io.Writer    // Output destination for user-facing messages
 	router  *mail.Router // Mail router for sending protocol messages
 
+	// configMTime is the mtime of config.json as of the last (re)load, used
+	// by ReloadConfigIfChanged to detect edits without re-reading the file
+	// on every loop tick. Zero until the first load.
+	configMTime time.Time
+
 	// stopCh is used for graceful shutdown
 	stopCh chan struct{}
 }
@@ -164,16 +198,19 @@ func (e *Engineer) LoadConfig() error {
 	// Parse merge_queue section into our config struct
 	// We need special handling for poll_interval (string -> Duration)
 	var mqRaw struct {
-		Enabled              *bool   `json:"enabled"`
-		TargetBranch         *string `json:"target_branch"`
-		IntegrationBranches  *bool   `json:"integration_branches"`
-		OnConflict           *string `json:"on_conflict"`
-		RunTests             *bool   `json:"run_tests"`
-		TestCommand          *string `json:"test_command"`
-		DeleteMergedBranches *bool   `json:"delete_merged_branches"`
-		RetryFlakyTests      *int    `json:"retry_flaky_tests"`
-		PollInterval         *string `json:"poll_interval"`
-		MaxConcurrent        *int    `json:"max_concurrent"`
+		Enabled              *bool           `json:"enabled"`
+		TargetBranch         *string         `json:"target_branch"`
+		IntegrationBranches  *bool           `json:"integration_branches"`
+		OnConflict           *string         `json:"on_conflict"`
+		RunTests             *bool           `json:"run_tests"`
+		TestCommand          *string         `json:"test_command"`
+		DeleteMergedBranches *bool           `json:"delete_merged_branches"`
+		RetryFlakyTests      *int            `json:"retry_flaky_tests"`
+		PollInterval         *string         `json:"poll_interval"`
+		MaxConcurrent        *int            `json:"max_concurrent"`
+		RequireReview        *bool           `json:"require_review"`
+		AutoResolvePaths     []string        `json:"auto_resolve_paths"`
+		CloseIssueOnMerge    json.RawMessage `json:"close_issue_on_merge"`
 	}
 
 	if err := json.Unmarshal(rawConfig.MergeQueue, &mqRaw); err != nil {
@@ -208,6 +245,12 @@ func (e *Engineer) LoadConfig() error {
 	if mqRaw.MaxConcurrent != nil {
 		e.config.MaxConcurrent = *mqRaw.MaxConcurrent
 	}
+	if mqRaw.RequireReview != nil {
+		e.config.RequireReview = *mqRaw.RequireReview
+	}
+	if mqRaw.AutoResolvePaths != nil {
+		e.config.AutoResolvePaths = mqRaw.AutoResolvePaths
+	}
 	if mqRaw.PollInterval != nil {
 		dur, err := time.ParseDuration(*mqRaw.PollInterval)
 		if err != nil {
@@ -215,6 +258,27 @@ func (e *Engineer) LoadConfig() error {
 		}
 		e.config.PollInterval = dur
 	}
+	if mqRaw.CloseIssueOnMerge != nil {
+		// close_issue_on_merge accepts either a bool (true means the
+		// default "close" behavior, false is rejected below since there's
+		// no third state) or the string "label_only".
+		var asBool bool
+		if err := json.Unmarshal(mqRaw.CloseIssueOnMerge, &asBool); err == nil {
+			if !asBool {
+				return fmt.Errorf("invalid close_issue_on_merge: false is not supported, use %q", CloseIssueOnMergeLabelOnly)
+			}
+			e.config.CloseIssueOnMerge = CloseIssueOnMergeClose
+		} else {
+			var asString string
+			if err := json.Unmarshal(mqRaw.CloseIssueOnMerge, &asString); err != nil {
+				return fmt.Errorf("invalid close_issue_on_merge: must be true or %q", CloseIssueOnMergeLabelOnly)
+			}
+			if asString != CloseIssueOnMergeClose && asString != CloseIssueOnMergeLabelOnly {
+				return fmt.Errorf("invalid close_issue_on_merge %q: must be true or %q", asString, CloseIssueOnMergeLabelOnly)
+			}
+			e.config.CloseIssueOnMerge = asString
+		}
+	}
 
 	return nil
 }
@@ -224,6 +288,80 @@ func (e *Engineer) Config() *MergeQueueConfig {
 	return e.config
 }
 
+// ReloadConfigIfChanged re-reads config.json if its mtime has advanced since
+// the last (re)load, so a long-running foreground loop picks up rig setting
+// edits - a paused flag, a new test_command, etc. - without a restart.
+// Meant to be called once per loop tick.
+//
+// A parse error is surfaced (returned, and logged to e.output) rather than
+// silently falling back to the previous config, since LoadConfig's own
+// defaulting behavior on read errors would otherwise mask a typo in
+// config.json. The previous config is kept in place either way.
+//
+// Returns whether the config actually changed (false on the first load,
+// which only establishes the baseline mtime).
+func (e *Engineer) ReloadConfigIfChanged() (bool, error) {
+	configPath := filepath.Join(e.rig.Path, "config.json")
+	info, err := os.Stat(configPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("stat config: %w", err)
+	}
+
+	firstLoad := e.configMTime.IsZero()
+	if !firstLoad && !info.ModTime().After(e.configMTime) {
+		return false, nil
+	}
+
+	before := *e.config
+	if err := e.LoadConfig(); err != nil {
+		_, _ = fmt.Fprintf(e.output, "[Engineer] config.json reload failed, keeping previous merge_queue config: %v\n", err)
+		e.configMTime = info.ModTime()
+		return false, err
+	}
+	e.configMTime = info.ModTime()
+
+	if firstLoad {
+		return false, nil
+	}
+
+	if diff := diffMergeQueueConfig(before, *e.config); diff != "" {
+		_, _ = fmt.Fprintf(e.output, "[Engineer] config.json changed:\n%s", diff)
+		return true, nil
+	}
+	return false, nil
+}
+
+// diffMergeQueueConfig formats the merge_queue fields that changed between
+// before and after, one "  field: old -> new" line each, for logging by
+// ReloadConfigIfChanged. Returns "" if nothing changed.
+func diffMergeQueueConfig(before, after MergeQueueConfig) string {
+	var b strings.Builder
+	line := func(field string, oldVal, newVal any) {
+		if fmt.Sprint(oldVal) != fmt.Sprint(newVal) {
+			fmt.Fprintf(&b, "  %s: %v -> %v\n", field, oldVal, newVal)
+		}
+	}
+
+	line("enabled", before.Enabled, after.Enabled)
+	line("target_branch", before.TargetBranch, after.TargetBranch)
+	line("integration_branches", before.IntegrationBranches, after.IntegrationBranches)
+	line("on_conflict", before.OnConflict, after.OnConflict)
+	line("auto_resolve_paths", before.AutoResolvePaths, after.AutoResolvePaths)
+	line("run_tests", before.RunTests, after.RunTests)
+	line("test_command", before.TestCommand, after.TestCommand)
+	line("delete_merged_branches", before.DeleteMergedBranches, after.DeleteMergedBranches)
+	line("retry_flaky_tests", before.RetryFlakyTests, after.RetryFlakyTests)
+	line("poll_interval", before.PollInterval, after.PollInterval)
+	line("max_concurrent", before.MaxConcurrent, after.MaxConcurrent)
+	line("require_review", before.RequireReview, after.RequireReview)
+	line("close_issue_on_merge", before.CloseIssueOnMerge, after.CloseIssueOnMerge)
+
+	return b.String()
+}
+
 // ProcessResult contains the result of processing a merge request.
 type ProcessResult struct {
 	Success     bool
@@ -231,6 +369,25 @@ type ProcessResult struct {
 	Error       string
 	Conflict    bool
 	TestsFailed bool
+
+	// AwaitingReview is set when merge_queue.require_review is on and the MR
+	// bead has no reviewed_by field yet. Not a failure - ProcessMR should be
+	// retried once `gt witness review --approve` records a sign-off.
+	AwaitingReview bool
+
+	// TestCommand is the command that actually ran for this MR (after any
+	// ci: label overrides), empty if tests were skipped or not configured.
+	TestCommand string
+
+	// SourceIssueAction records what handleSuccess/HandleMRInfoSuccess did
+	// to the source issue: "closed", "labeled", or "" if there was no
+	// source issue to update.
+	SourceIssueAction string
+
+	// StartedAt is when processing of this MR began, used to compute
+	// queue_wait_seconds and processing_seconds for the merged/merge_failed
+	// events. Zero if unknown.
+	StartedAt time.Time
 }
 
 // ProcessMR processes a single merge request from a beads issue.
@@ -250,12 +407,31 @@ func (e *Engineer) ProcessMR(ctx context.Context, mr *beads.Issue) ProcessResult
 	_, _ = fmt.Fprintf(e.output, "  Target: %s\n", mrFields.Target)
 	_, _ = fmt.Fprintf(e.output, "  Worker: %s\n", mrFields.Worker)
 
+	if e.config.RequireReview && mrFields.ReviewedBy == "" {
+		_, _ = fmt.Fprintln(e.output, "  Status: awaiting review")
+		return ProcessResult{
+			Success:        false,
+			AwaitingReview: true,
+			Error:          "awaiting review: rig requires a witness sign-off (gt witness review --approve) before merge",
+		}
+	}
+
 	return e.doMerge(ctx, mrFields.Branch, mrFields.Target, mrFields.SourceIssue)
 }
 
 // doMerge performs the actual git merge operation.
 // This is the core merge logic shared by ProcessMR and ProcessMRFromQueue.
 func (e *Engineer) doMerge(ctx context.Context, branch, target, sourceIssue string) ProcessResult {
+	startedAt := time.Now()
+	result := e.doMergeAttempt(ctx, branch, target, sourceIssue, false)
+	result.StartedAt = startedAt
+	return result
+}
+
+// doMergeAttempt is doMerge's implementation, with rebased tracking whether
+// this call is a retry after a successful auto-rebase - so a branch that
+// still conflicts post-rebase falls back to assign_back instead of looping.
+func (e *Engineer) doMergeAttempt(ctx context.Context, branch, target, sourceIssue string, rebased bool) ProcessResult {
 	// Step 1: Verify source branch exists locally (shared .repo.git with polecats)
 	_, _ = fmt.Fprintf(e.output, "[Engineer] Checking local branch %s...\n", branch)
 	exists, err := e.git.BranchExists(branch)
@@ -298,6 +474,19 @@ func (e *Engineer) doMerge(ctx context.Context, branch, target, sourceIssue stri
 		}
 	}
 	if len(conflicts) > 0 {
+		if !rebased && e.config.OnConflict == "auto_rebase" {
+			if rebaseErr := e.attemptAutoRebase(branch, target); rebaseErr == nil {
+				_, _ = fmt.Fprintf(e.output, "[Engineer] Auto-rebase resolved conflicts, retrying merge\n")
+				return e.doMergeAttempt(ctx, branch, target, sourceIssue, true)
+			} else {
+				_, _ = fmt.Fprintf(e.output, "[Engineer] Auto-rebase did not resolve conflicts: %v\n", rebaseErr)
+				return ProcessResult{
+					Success:  false,
+					Conflict: true,
+					Error:    fmt.Sprintf("merge conflicts in: %v (auto-rebase failed: %v)", conflicts, rebaseErr),
+				}
+			}
+		}
 		return ProcessResult{
 			Success:  false,
 			Conflict: true,
@@ -305,17 +494,39 @@ func (e *Engineer) doMerge(ctx context.Context, branch, target, sourceIssue stri
 		}
 	}
 
-	// Step 4: Run tests if configured
-	if e.config.RunTests && e.config.TestCommand != "" {
-		_, _ = fmt.Fprintf(e.output, "[Engineer] Running tests: %s\n", e.config.TestCommand)
-		result := e.runTests(ctx)
+	// Step 4: Run tests if configured, applying any ci: label overrides from
+	// the source issue on top of the rig's configured test command.
+	runTests := e.config.RunTests
+	testCmd := e.config.TestCommand
+	if sourceIssue != "" {
+		if issue, err := e.beads.Show(sourceIssue); err == nil {
+			skip, cmd, warning := resolveTestPlan(testCmd, issue.Labels, e.rig.GetBoolConfig("allow_test_skip"))
+			if warning != "" {
+				_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: %s\n", warning)
+			}
+			if skip {
+				runTests = false
+				_, _ = fmt.Fprintf(e.output, "[Engineer] Skipping tests: %s has %s label\n", sourceIssue, ciSkipTestsLabel)
+			}
+			testCmd = cmd
+		}
+		// If Show fails (e.g. source issue not found), fall back to the
+		// configured test command/policy rather than blocking the merge.
+	}
+
+	var testCommandRun string
+	if runTests && testCmd != "" {
+		_, _ = fmt.Fprintf(e.output, "[Engineer] Running tests: %s\n", testCmd)
+		result := e.runTests(ctx, testCmd)
 		if !result.Success {
 			return ProcessResult{
 				Success:     false,
 				TestsFailed: true,
 				Error:       result.Error,
+				TestCommand: testCmd,
 			}
 		}
+		testCommandRun = testCmd
 		_, _ = fmt.Fprintln(e.output, "[Engineer] Tests passed")
 	}
 
@@ -372,12 +583,138 @@ func (e *Engineer) doMerge(ctx context.Context, branch, target, sourceIssue stri
 	return ProcessResult{
 		Success:     true,
 		MergeCommit: mergeCommit,
+		TestCommand: testCommandRun,
+	}
+}
+
+// attemptAutoRebase tries to unblock a conflicting branch without bouncing
+// it back to the worker: it rebases branch onto origin/target in a scratch
+// worktree, and if git can't finish cleanly, auto-resolves any conflicting
+// file that matches AutoResolvePaths by taking the branch's own version
+// (git's "theirs" during a rebase) before continuing. Any conflict outside
+// that list aborts the rebase and returns an error. On success, the
+// rebased branch is force-pushed and the caller should retry the merge.
+func (e *Engineer) attemptAutoRebase(branch, target string) error {
+	scratchPath, err := os.MkdirTemp("", "gt-refinery-rebase-")
+	if err != nil {
+		return fmt.Errorf("creating scratch dir: %w", err)
+	}
+	defer func() {
+		_ = e.git.WorktreeRemove(scratchPath, true)
+		_ = os.RemoveAll(scratchPath)
+	}()
+
+	if err := e.git.WorktreeAddExistingForce(scratchPath, branch); err != nil {
+		return fmt.Errorf("creating scratch worktree: %w", err)
+	}
+	scratchGit := git.NewGit(scratchPath)
+
+	rebaseErr := scratchGit.Rebase(fmt.Sprintf("origin/%s", target))
+	if rebaseErr != nil {
+		conflicts, confErr := scratchGit.GetConflictingFiles()
+		if confErr != nil || len(conflicts) == 0 {
+			_ = scratchGit.AbortRebase()
+			return fmt.Errorf("rebase failed: %w", rebaseErr)
+		}
+
+		var unresolvable []string
+		for _, f := range conflicts {
+			if !matchesAutoResolvePath(f, e.config.AutoResolvePaths) {
+				unresolvable = append(unresolvable, f)
+			}
+		}
+		if len(unresolvable) > 0 {
+			_ = scratchGit.AbortRebase()
+			return fmt.Errorf("conflicts outside auto_resolve_paths: %v", unresolvable)
+		}
+
+		for _, f := range conflicts {
+			if err := scratchGit.CheckoutOursTheirs(f, true); err != nil {
+				_ = scratchGit.AbortRebase()
+				return fmt.Errorf("resolving %s: %w", f, err)
+			}
+		}
+		if err := scratchGit.RebaseContinue(); err != nil {
+			_ = scratchGit.AbortRebase()
+			return fmt.Errorf("continuing rebase after auto-resolve: %w", err)
+		}
+	}
+
+	if err := scratchGit.Push("origin", branch, true); err != nil {
+		return fmt.Errorf("force-pushing rebased branch: %w", err)
+	}
+
+	// scratchGit is a worktree of the same shared repo as e.git (see
+	// WorktreeAddExistingForce), so branch's ref is already updated here -
+	// no fetch needed before the caller retries the merge against it.
+	return nil
+}
+
+// matchesAutoResolvePath reports whether path matches any of the given glob
+// patterns, checked against both the full path and its basename so a
+// pattern like "*.lock" matches "yarn.lock" wherever it lives, while
+// "generated/*.go" still requires the directory prefix.
+func matchesAutoResolvePath(path string, patterns []string) bool {
+	base := filepath.Base(path)
+	for _, pattern := range patterns {
+		if ok, err := filepath.Match(pattern, path); err == nil && ok {
+			return true
+		}
+		if ok, err := filepath.Match(pattern, base); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// CI label conventions that let a source issue override the rig's configured
+// test command for a single MR. Read from the source issue's labels (via
+// MRFields.SourceIssue) and applied on top of MergeQueueConfig in doMerge.
+const (
+	// ciSkipTestsLabel skips the test step entirely. Only honored if the
+	// rig's allow_test_skip config is true; otherwise it's ignored with a warning.
+	ciSkipTestsLabel = "ci:skip-tests"
+
+	// ciTestLabelPrefix replaces the configured test command outright, e.g. "ci:test=go test ./foo/...".
+	ciTestLabelPrefix = "ci:test="
+
+	// ciExtraLabelPrefix appends an additional command after the configured one, e.g. "ci:extra=make integration".
+	ciExtraLabelPrefix = "ci:extra="
+)
+
+// resolveTestPlan applies ci: labels from the source issue on top of the
+// rig's configured test command, returning whether to skip testing
+// altogether and the effective command to run otherwise. allowSkip is the
+// rig's allow_test_skip setting - a ci:skip-tests label without it is
+// ignored (with a warning) rather than silently honored.
+func resolveTestPlan(baseCommand string, labels []string, allowSkip bool) (skip bool, command string, warning string) {
+	command = baseCommand
+	for _, label := range labels {
+		switch {
+		case label == ciSkipTestsLabel:
+			if allowSkip {
+				skip = true
+			} else {
+				warning = fmt.Sprintf("ignoring %s label: allow_test_skip is not enabled for this rig", ciSkipTestsLabel)
+			}
+		case strings.HasPrefix(label, ciTestLabelPrefix):
+			command = strings.TrimPrefix(label, ciTestLabelPrefix)
+		case strings.HasPrefix(label, ciExtraLabelPrefix):
+			if extra := strings.TrimPrefix(label, ciExtraLabelPrefix); extra != "" {
+				if command != "" {
+					command += " && " + extra
+				} else {
+					command = extra
+				}
+			}
+		}
 	}
+	return skip, command, warning
 }
 
-// runTests runs the configured test command and returns the result.
-func (e *Engineer) runTests(ctx context.Context) ProcessResult {
-	if e.config.TestCommand == "" {
+// runTests runs the given test command and returns the result.
+func (e *Engineer) runTests(ctx context.Context, testCommand string) ProcessResult {
+	if testCommand == "" {
 		return ProcessResult{Success: true}
 	}
 
@@ -393,9 +730,10 @@ func (e *Engineer) runTests(ctx context.Context) ProcessResult {
 			_, _ = fmt.Fprintf(e.output, "[Engineer] Retrying tests (attempt %d/%d)...\n", attempt, maxRetries)
 		}
 
-		// Note: TestCommand comes from rig's config.json (trusted infrastructure config),
-		// not from PR branches. Shell execution is intentional for flexibility (pipes, etc).
-		cmd := exec.CommandContext(ctx, "sh", "-c", e.config.TestCommand) //nolint:gosec // G204: TestCommand is from trusted rig config
+		// Note: testCommand comes from rig's config.json / source issue ci: labels
+		// (trusted infrastructure config, not from PR branches). Shell execution
+		// is intentional for flexibility (pipes, &&, etc).
+		cmd := exec.CommandContext(ctx, "sh", "-c", testCommand) //nolint:gosec // G204: testCommand is from trusted rig config / labels
 		cmd.Dir = e.workDir
 		var stdout, stderr bytes.Buffer
 		cmd.Stdout = &stdout
@@ -423,14 +761,44 @@ func (e *Engineer) runTests(ctx context.Context) ProcessResult {
 	}
 }
 
+// updateSourceIssueOnMerge applies the configured CloseIssueOnMerge action
+// to a merged MR's source issue: closing it (default) or leaving it open
+// and adding a "merged" label instead. Returns "closed", "labeled", or ""
+// if there was no source issue to update. Failures are warn-only - they
+// must never fail the merge itself, so this always returns the action it
+// attempted regardless of whether the beads call succeeded.
+func (e *Engineer) updateSourceIssueOnMerge(mrID, sourceIssue string) string {
+	if sourceIssue == "" {
+		return ""
+	}
+
+	if e.config.CloseIssueOnMerge == CloseIssueOnMergeLabelOnly {
+		if err := e.beads.Update(sourceIssue, beads.UpdateOptions{AddLabels: []string{"merged"}}); err != nil {
+			_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: failed to label source issue %s: %v\n", sourceIssue, err)
+		} else {
+			_, _ = fmt.Fprintf(e.output, "[Engineer] Labeled source issue as merged: %s\n", sourceIssue)
+		}
+		return "labeled"
+	}
+
+	closeReason := fmt.Sprintf("Merged in %s", mrID)
+	if err := e.beads.CloseWithReason(closeReason, sourceIssue); err != nil {
+		_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: failed to close source issue %s: %v\n", sourceIssue, err)
+	} else {
+		_, _ = fmt.Fprintf(e.output, "[Engineer] Closed source issue: %s\n", sourceIssue)
+	}
+	return "closed"
+}
+
 // handleSuccess handles a successful merge completion.
 // Steps:
 // 1. Update MR with merge_commit SHA
 // 2. Close MR with reason 'merged'
-// 3. Close source issue with reference to MR
+// 3. Close (or label, per CloseIssueOnMerge) the source issue
 // 4. Delete source branch if configured
 // 5. Log success
-func (e *Engineer) handleSuccess(mr *beads.Issue, result ProcessResult) {
+// Returns result with SourceIssueAction set to what was done in step 3.
+func (e *Engineer) handleSuccess(mr *beads.Issue, result ProcessResult) ProcessResult {
 	// Parse MR fields from description
 	mrFields := beads.ParseMRFields(mr)
 	if mrFields == nil {
@@ -440,6 +808,7 @@ func (e *Engineer) handleSuccess(mr *beads.Issue, result ProcessResult) {
 	// 1. Update MR with merge_commit SHA
 	mrFields.MergeCommit = result.MergeCommit
 	mrFields.CloseReason = "merged"
+	mrFields.TestCommand = result.TestCommand
 	newDesc := beads.SetMRFields(mr, mrFields)
 	if err := e.beads.Update(mr.ID, beads.UpdateOptions{Description: &newDesc}); err != nil {
 		_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: failed to update MR %s with merge commit: %v\n", mr.ID, err)
@@ -450,20 +819,14 @@ func (e *Engineer) handleSuccess(mr *beads.Issue, result ProcessResult) {
 		_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: failed to close MR %s: %v\n", mr.ID, err)
 	}
 
-	// 3. Close source issue with reference to MR
-	if mrFields.SourceIssue != "" {
-		closeReason := fmt.Sprintf("Merged in %s", mr.ID)
-		if err := e.beads.CloseWithReason(closeReason, mrFields.SourceIssue); err != nil {
-			_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: failed to close source issue %s: %v\n", mrFields.SourceIssue, err)
-		} else {
-			_, _ = fmt.Fprintf(e.output, "[Engineer] Closed source issue: %s\n", mrFields.SourceIssue)
-
-			// Redundant convoy observer: check if merged issue is tracked by a convoy
-			logger := func(format string, args ...interface{}) {
-				_, _ = fmt.Fprintf(e.output, "[Engineer] "+format+"\n", args...)
-			}
-			convoy.CheckConvoysForIssue(e.rig.Path, mrFields.SourceIssue, "refinery", logger)
+	// 3. Close (or label) source issue with reference to MR
+	result.SourceIssueAction = e.updateSourceIssueOnMerge(mr.ID, mrFields.SourceIssue)
+	if result.SourceIssueAction != "" {
+		// Redundant convoy observer: check if merged issue is tracked by a convoy
+		logger := func(format string, args ...interface{}) {
+			_, _ = fmt.Fprintf(e.output, "[Engineer] "+format+"\n", args...)
 		}
+		convoy.CheckConvoysForIssue(e.rig.Path, mrFields.SourceIssue, "refinery", logger)
 	}
 
 	// 3.5. Clear agent bead's active_mr reference (traceability cleanup)
@@ -492,6 +855,12 @@ func (e *Engineer) handleSuccess(mr *beads.Issue, result ProcessResult) {
 
 	// 5. Log success
 	_, _ = fmt.Fprintf(e.output, "[Engineer] ✓ Merged: %s (commit: %s)\n", mr.ID, result.MergeCommit)
+
+	queueWait, processing := e.mergeDurations(mr.CreatedAt.Time, result.StartedAt)
+	_ = events.LogFeed(events.TypeMerged, e.rig.Name+"/refinery",
+		events.MergePayload(mr.ID, mrFields.Worker, mrFields.Branch, mrFields.SourceIssue, "", queueWait, processing))
+
+	return result
 }
 
 // handleFailure handles a failed merge request.
@@ -505,6 +874,27 @@ func (e *Engineer) handleFailure(mr *beads.Issue, result ProcessResult) {
 
 	// Log the failure
 	_, _ = fmt.Fprintf(e.output, "[Engineer] ✗ Failed: %s - %s\n", mr.ID, result.Error)
+
+	mrFields := beads.ParseMRFields(mr)
+	if mrFields == nil {
+		mrFields = &beads.MRFields{}
+	}
+	queueWait, processing := e.mergeDurations(mr.CreatedAt.Time, result.StartedAt)
+	_ = events.LogFeed(events.TypeMergeFailed, e.rig.Name+"/refinery",
+		events.MergePayload(mr.ID, mrFields.Worker, mrFields.Branch, mrFields.SourceIssue, result.Error, queueWait, processing))
+}
+
+// mergeDurations computes queue_wait (registration to processing start) and
+// processing (start to now) durations for a merge event payload, treating
+// unknown timestamps as zero so callers can omit the corresponding field.
+func (e *Engineer) mergeDurations(createdAt, startedAt time.Time) (queueWait, processing time.Duration) {
+	if !createdAt.IsZero() && !startedAt.IsZero() {
+		queueWait = startedAt.Sub(createdAt)
+	}
+	if !startedAt.IsZero() {
+		processing = time.Since(startedAt)
+	}
+	return queueWait, processing
 }
 
 // ProcessMRInfo processes a merge request from MRInfo.
@@ -521,7 +911,7 @@ func (e *Engineer) ProcessMRInfo(ctx context.Context, mr *MRInfo) ProcessResult
 }
 
 // HandleMRInfoSuccess handles a successful merge from MRInfo.
-func (e *Engineer) HandleMRInfoSuccess(mr *MRInfo, result ProcessResult) {
+func (e *Engineer) HandleMRInfoSuccess(mr *MRInfo, result ProcessResult) ProcessResult {
 	// Release merge slot if this was a conflict resolution
 	// The slot is held while conflict resolution is in progress
 	holder := e.rig.Name + "/refinery"
@@ -550,6 +940,7 @@ func (e *Engineer) HandleMRInfoSuccess(mr *MRInfo, result ProcessResult) {
 			}
 			mrFields.MergeCommit = result.MergeCommit
 			mrFields.CloseReason = "merged"
+			mrFields.TestCommand = result.TestCommand
 			newDesc := beads.SetMRFields(mrBead, mrFields)
 			if err := e.beads.Update(mr.ID, beads.UpdateOptions{Description: &newDesc}); err != nil {
 				_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: failed to update MR %s with merge commit: %v\n", mr.ID, err)
@@ -564,20 +955,14 @@ func (e *Engineer) HandleMRInfoSuccess(mr *MRInfo, result ProcessResult) {
 		}
 	}
 
-	// 1. Close source issue with reference to MR
-	if mr.SourceIssue != "" {
-		closeReason := fmt.Sprintf("Merged in %s", mr.ID)
-		if err := e.beads.CloseWithReason(closeReason, mr.SourceIssue); err != nil {
-			_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: failed to close source issue %s: %v\n", mr.SourceIssue, err)
-		} else {
-			_, _ = fmt.Fprintf(e.output, "[Engineer] Closed source issue: %s\n", mr.SourceIssue)
-
-			// Redundant convoy observer: check if merged issue is tracked by a convoy
-			logger := func(format string, args ...interface{}) {
-				_, _ = fmt.Fprintf(e.output, "[Engineer] "+format+"\n", args...)
-			}
-			convoy.CheckConvoysForIssue(e.rig.Path, mr.SourceIssue, "refinery", logger)
+	// 1. Close (or label) source issue with reference to MR
+	result.SourceIssueAction = e.updateSourceIssueOnMerge(mr.ID, mr.SourceIssue)
+	if result.SourceIssueAction != "" {
+		// Redundant convoy observer: check if merged issue is tracked by a convoy
+		logger := func(format string, args ...interface{}) {
+			_, _ = fmt.Fprintf(e.output, "[Engineer] "+format+"\n", args...)
 		}
+		convoy.CheckConvoysForIssue(e.rig.Path, mr.SourceIssue, "refinery", logger)
 	}
 
 	// 1.5. Clear agent bead's active_mr reference (traceability cleanup)
@@ -598,6 +983,12 @@ func (e *Engineer) HandleMRInfoSuccess(mr *MRInfo, result ProcessResult) {
 
 	// 3. Log success
 	_, _ = fmt.Fprintf(e.output, "[Engineer] ✓ Merged: %s (commit: %s)\n", mr.ID, result.MergeCommit)
+
+	queueWait, processing := e.mergeDurations(mr.CreatedAt, result.StartedAt)
+	_ = events.LogFeed(events.TypeMerged, e.rig.Name+"/refinery",
+		events.MergePayload(mr.ID, mr.Worker, mr.Branch, mr.SourceIssue, "", queueWait, processing))
+
+	return result
 }
 
 // HandleMRInfoFailure handles a failed merge from MRInfo.
@@ -619,6 +1010,10 @@ func (e *Engineer) HandleMRInfoFailure(mr *MRInfo, result ProcessResult) {
 		fmt.Fprintf(e.output, "[Engineer] Notified witness of merge failure for %s\n", mr.Worker)
 	}
 
+	queueWait, processing := e.mergeDurations(mr.CreatedAt, result.StartedAt)
+	_ = events.LogFeed(events.TypeMergeFailed, e.rig.Name+"/refinery",
+		events.MergePayload(mr.ID, mr.Worker, mr.Branch, mr.SourceIssue, result.Error, queueWait, processing))
+
 	// If this was a conflict, create a conflict-resolution task for dispatch
 	// and block the MR until the task is resolved (non-blocking delegation)
 	if result.Conflict {
@@ -813,13 +1208,7 @@ func (e *Engineer) ListReadyMRs() ([]*MRInfo, error) {
 			}
 		}
 
-		// Parse issue created_at
-		var createdAt time.Time
-		if issue.CreatedAt != "" {
-			if t, err := time.Parse(time.RFC3339, issue.CreatedAt); err == nil {
-				createdAt = t
-			}
-		}
+		createdAt := issue.CreatedAt.Time
 
 		mr := &MRInfo{
 			ID:              issue.ID,
@@ -891,13 +1280,7 @@ func (e *Engineer) ListBlockedMRs() ([]*MRInfo, error) {
 			}
 		}
 
-		// Parse issue created_at
-		var createdAt time.Time
-		if issue.CreatedAt != "" {
-			if t, err := time.Parse(time.RFC3339, issue.CreatedAt); err == nil {
-				createdAt = t
-			}
-		}
+		createdAt := issue.CreatedAt.Time
 
 		// Use the first open blocker as BlockedBy
 		blockedBy := ""
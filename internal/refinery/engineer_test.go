@@ -1,12 +1,18 @@
 package refinery
 
 import (
+	"context"
 	"encoding/json"
+	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/rig"
 )
 
@@ -30,6 +36,81 @@ func TestDefaultMergeQueueConfig(t *testing.T) {
 	}
 }
 
+func TestResolveTestPlan(t *testing.T) {
+	tests := []struct {
+		name        string
+		baseCommand string
+		labels      []string
+		allowSkip   bool
+		wantSkip    bool
+		wantCommand string
+		wantWarning bool
+	}{
+		{
+			name:        "no labels uses base command",
+			baseCommand: "go test ./...",
+			labels:      nil,
+			wantCommand: "go test ./...",
+		},
+		{
+			name:        "skip label honored when allowed",
+			baseCommand: "go test ./...",
+			labels:      []string{"ci:skip-tests"},
+			allowSkip:   true,
+			wantSkip:    true,
+			wantCommand: "go test ./...",
+		},
+		{
+			name:        "skip label ignored without allow_test_skip",
+			baseCommand: "go test ./...",
+			labels:      []string{"ci:skip-tests"},
+			allowSkip:   false,
+			wantSkip:    false,
+			wantCommand: "go test ./...",
+			wantWarning: true,
+		},
+		{
+			name:        "test label replaces command",
+			baseCommand: "go test ./...",
+			labels:      []string{"ci:test=go test ./foo/..."},
+			wantCommand: "go test ./foo/...",
+		},
+		{
+			name:        "extra label appends to command",
+			baseCommand: "go test ./...",
+			labels:      []string{"ci:extra=make integration"},
+			wantCommand: "go test ./... && make integration",
+		},
+		{
+			name:        "extra label with no base command runs alone",
+			baseCommand: "",
+			labels:      []string{"ci:extra=make integration"},
+			wantCommand: "make integration",
+		},
+		{
+			name:        "unrelated labels are ignored",
+			baseCommand: "go test ./...",
+			labels:      []string{"priority:high", "gt:merge-request"},
+			wantCommand: "go test ./...",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			skip, command, warning := resolveTestPlan(tt.baseCommand, tt.labels, tt.allowSkip)
+			if skip != tt.wantSkip {
+				t.Errorf("skip = %v, want %v", skip, tt.wantSkip)
+			}
+			if command != tt.wantCommand {
+				t.Errorf("command = %q, want %q", command, tt.wantCommand)
+			}
+			if (warning != "") != tt.wantWarning {
+				t.Errorf("warning = %q, wantWarning = %v", warning, tt.wantWarning)
+			}
+		})
+	}
+}
+
 func TestEngineer_LoadConfig_NoFile(t *testing.T) {
 	// Create a temp directory without config.json
 	tmpDir, err := os.MkdirTemp("", "engineer-test-*")
@@ -118,6 +199,129 @@ func TestEngineer_LoadConfig_WithMergeQueue(t *testing.T) {
 	}
 }
 
+func TestEngineer_LoadConfig_RequireReview(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "engineer-test-*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	config := map[string]interface{}{
+		"type":    "rig",
+		"version": 1,
+		"name":    "test-rig",
+		"merge_queue": map[string]interface{}{
+			"require_review": true,
+		},
+	}
+
+	data, _ := json.MarshalIndent(config, "", "  ")
+	if err := os.WriteFile(filepath.Join(tmpDir, "config.json"), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	r := &rig.Rig{Name: "test-rig", Path: tmpDir}
+	e := NewEngineer(r)
+
+	if err := e.LoadConfig(); err != nil {
+		t.Errorf("unexpected error loading config: %v", err)
+	}
+	if !e.config.RequireReview {
+		t.Error("expected RequireReview to be true")
+	}
+}
+
+func TestEngineer_LoadConfig_CloseIssueOnMerge(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "true means close", value: true, want: CloseIssueOnMergeClose},
+		{name: "label_only string", value: "label_only", want: CloseIssueOnMergeLabelOnly},
+		{name: "close string", value: "close", want: CloseIssueOnMergeClose},
+		{name: "false is rejected", value: false, wantErr: true},
+		{name: "garbage string is rejected", value: "sometimes", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			config := map[string]interface{}{
+				"type":        "rig",
+				"version":     1,
+				"name":        "test-rig",
+				"merge_queue": map[string]interface{}{"close_issue_on_merge": tt.value},
+			}
+			data, _ := json.MarshalIndent(config, "", "  ")
+			if err := os.WriteFile(filepath.Join(tmpDir, "config.json"), data, 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			e := NewEngineer(&rig.Rig{Name: "test-rig", Path: tmpDir})
+			err := e.LoadConfig()
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for close_issue_on_merge = %v, got none", tt.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error loading config: %v", err)
+			}
+			if e.config.CloseIssueOnMerge != tt.want {
+				t.Errorf("CloseIssueOnMerge = %q, want %q", e.config.CloseIssueOnMerge, tt.want)
+			}
+		})
+	}
+}
+
+func TestProcessMR_RequireReview_BlocksWithoutReviewedBy(t *testing.T) {
+	r := &rig.Rig{Name: "test-rig", Path: t.TempDir()}
+	e := NewEngineer(r)
+	e.config.RequireReview = true
+
+	mr := &beads.Issue{
+		Description: beads.FormatMRFields(&beads.MRFields{
+			Branch: "polecat/Nux/gt-xyz",
+			Target: "main",
+			Worker: "Nux",
+		}),
+	}
+
+	result := e.ProcessMR(context.Background(), mr)
+	if result.Success {
+		t.Error("expected ProcessMR to fail while awaiting review")
+	}
+	if !result.AwaitingReview {
+		t.Error("expected AwaitingReview to be true")
+	}
+}
+
+func TestProcessMR_RequireReview_ProceedsAfterApproval(t *testing.T) {
+	r := &rig.Rig{Name: "test-rig", Path: t.TempDir()}
+	e := NewEngineer(r)
+	e.config.RequireReview = true
+
+	mr := &beads.Issue{
+		Description: beads.FormatMRFields(&beads.MRFields{
+			Branch:     "polecat/Nux/gt-xyz",
+			Target:     "main",
+			Worker:     "Nux",
+			ReviewedBy: "test-rig/witness",
+		}),
+	}
+
+	// doMerge will fail (no real git repo behind workDir), but the review
+	// gate itself must not be what stops it - confirm we get past it.
+	result := e.ProcessMR(context.Background(), mr)
+	if result.AwaitingReview {
+		t.Error("expected review gate to pass once reviewed_by is set")
+	}
+}
+
 func TestEngineer_LoadConfig_NoMergeQueueSection(t *testing.T) {
 	// Create a temp directory with config.json without merge_queue
 	tmpDir, err := os.MkdirTemp("", "engineer-test-*")
@@ -186,6 +390,128 @@ func TestEngineer_LoadConfig_InvalidPollInterval(t *testing.T) {
 	}
 }
 
+// writeConfigAt writes config.json with the given content and sets its mtime
+// explicitly, so tests don't depend on filesystem mtime resolution to see a
+// change between two writes.
+func writeConfigAt(t *testing.T, path string, content map[string]interface{}, mtime time.Time) {
+	t.Helper()
+	data, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReloadConfigIfChanged_FirstLoadEstablishesBaseline(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.json")
+	writeConfigAt(t, configPath, map[string]interface{}{
+		"merge_queue": map[string]interface{}{"test_command": "make test"},
+	}, time.Now())
+
+	r := &rig.Rig{Name: "test-rig", Path: tmpDir}
+	e := NewEngineer(r)
+
+	changed, err := e.ReloadConfigIfChanged()
+	if err != nil {
+		t.Fatalf("ReloadConfigIfChanged: %v", err)
+	}
+	if changed {
+		t.Error("first load should not report a change, only establish the baseline")
+	}
+	if e.config.TestCommand != "make test" {
+		t.Errorf("TestCommand = %q, want %q", e.config.TestCommand, "make test")
+	}
+}
+
+func TestReloadConfigIfChanged_PicksUpEditBetweenTicks(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.json")
+	base := time.Now()
+	writeConfigAt(t, configPath, map[string]interface{}{
+		"merge_queue": map[string]interface{}{"test_command": "make test"},
+	}, base)
+
+	r := &rig.Rig{Name: "test-rig", Path: tmpDir}
+	e := NewEngineer(r)
+
+	// Simulate the first loop tick.
+	if _, err := e.ReloadConfigIfChanged(); err != nil {
+		t.Fatalf("ReloadConfigIfChanged (tick 1): %v", err)
+	}
+
+	// A second tick with no edit should be a no-op.
+	changed, err := e.ReloadConfigIfChanged()
+	if err != nil {
+		t.Fatalf("ReloadConfigIfChanged (tick 2): %v", err)
+	}
+	if changed {
+		t.Error("ReloadConfigIfChanged reported a change with no edit")
+	}
+
+	// Edit the settings file between iterations.
+	writeConfigAt(t, configPath, map[string]interface{}{
+		"merge_queue": map[string]interface{}{"test_command": "make test-fast"},
+	}, base.Add(time.Second))
+
+	changed, err = e.ReloadConfigIfChanged()
+	if err != nil {
+		t.Fatalf("ReloadConfigIfChanged (tick 3): %v", err)
+	}
+	if !changed {
+		t.Fatal("ReloadConfigIfChanged should report a change after the edit")
+	}
+	if e.config.TestCommand != "make test-fast" {
+		t.Errorf("TestCommand = %q, want %q", e.config.TestCommand, "make test-fast")
+	}
+}
+
+func TestReloadConfigIfChanged_ParseErrorSurfacesLoudly(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.json")
+	base := time.Now()
+	writeConfigAt(t, configPath, map[string]interface{}{
+		"merge_queue": map[string]interface{}{"test_command": "make test"},
+	}, base)
+
+	r := &rig.Rig{Name: "test-rig", Path: tmpDir}
+	e := NewEngineer(r)
+	var log strings.Builder
+	e.SetOutput(&log)
+
+	if _, err := e.ReloadConfigIfChanged(); err != nil {
+		t.Fatalf("ReloadConfigIfChanged (tick 1): %v", err)
+	}
+
+	// A typo in poll_interval should be a loud error, not a silent
+	// fallback to defaults - and the previous config must survive.
+	if err := os.WriteFile(configPath, []byte(`{"merge_queue":{"poll_interval":"soon"}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(configPath, base.Add(time.Second), base.Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+
+	changed, err := e.ReloadConfigIfChanged()
+	if err == nil {
+		t.Fatal("expected an error for invalid poll_interval")
+	}
+	if changed {
+		t.Error("a failed reload should not report success")
+	}
+	if e.config.TestCommand != "make test" {
+		t.Errorf("previous config should be kept on parse failure, TestCommand = %q", e.config.TestCommand)
+	}
+	if !strings.Contains(log.String(), "reload failed") {
+		t.Errorf("expected the parse failure to be logged loudly, got: %q", log.String())
+	}
+}
+
 func TestNewEngineer(t *testing.T) {
 	r := &rig.Rig{
 		Name: "test-rig",
@@ -215,3 +541,363 @@ func TestEngineer_DeleteMergedBranchesConfig(t *testing.T) {
 		t.Error("expected DeleteMergedBranches to be true by default")
 	}
 }
+
+// newAutoRebaseTestEngineer sets up a rig with a real mayor/rig git repo
+// (legacy layout, so NewEngineer's fallback picks it up), a bare "origin"
+// remote, and a main branch pushed to it. It returns the Engineer, ready
+// for callers to branch and commit against, along with the repo's dir.
+func newAutoRebaseTestEngineer(t *testing.T) (*Engineer, string) {
+	t.Helper()
+	root := t.TempDir()
+	gitDir := filepath.Join(root, "mayor", "rig")
+	if err := os.MkdirAll(gitDir, 0755); err != nil {
+		t.Fatalf("mkdir gitDir: %v", err)
+	}
+
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = gitDir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	run("init")
+	run("config", "user.email", "test@test.com")
+	run("config", "user.name", "Test User")
+	run("checkout", "-b", "main")
+	if err := os.WriteFile(filepath.Join(gitDir, "README.md"), []byte("# Test\n"), 0644); err != nil {
+		t.Fatalf("write README.md: %v", err)
+	}
+	run("add", ".")
+	run("commit", "-m", "initial")
+
+	originDir := filepath.Join(root, "origin.git")
+	if err := exec.Command("git", "init", "--bare", originDir).Run(); err != nil {
+		t.Fatalf("git init --bare: %v", err)
+	}
+	run("remote", "add", "origin", originDir)
+	run("push", "-u", "origin", "main")
+
+	r := &rig.Rig{Name: "test-rig", Path: root}
+	e := NewEngineer(r)
+	e.output = io.Discard
+	e.config.OnConflict = "auto_rebase"
+	return e, gitDir
+}
+
+// advanceMainAndFetch commits a change to main in gitDir, pushes it to
+// origin, and fetches so the local origin/main tracking ref (which
+// attemptAutoRebase rebases onto) reflects it.
+func advanceMainAndFetch(t *testing.T, gitDir, file, content, message string) {
+	t.Helper()
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = gitDir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	run("checkout", "main")
+	if err := os.WriteFile(filepath.Join(gitDir, file), []byte(content), 0644); err != nil {
+		t.Fatalf("write %s: %v", file, err)
+	}
+	run("add", file)
+	run("commit", "-m", message)
+	run("push", "origin", "main")
+	run("fetch", "origin", "main")
+}
+
+func TestAttemptAutoRebase_CleanRebase(t *testing.T) {
+	e, gitDir := newAutoRebaseTestEngineer(t)
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = gitDir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	run("checkout", "-b", "polecat/x")
+	if err := os.WriteFile(filepath.Join(gitDir, "feature.txt"), []byte("feature\n"), 0644); err != nil {
+		t.Fatalf("write feature.txt: %v", err)
+	}
+	run("add", "feature.txt")
+	run("commit", "-m", "add feature")
+
+	advanceMainAndFetch(t, gitDir, "other.txt", "unrelated\n", "advance main")
+
+	if err := e.attemptAutoRebase("polecat/x", "main"); err != nil {
+		t.Fatalf("attemptAutoRebase: %v", err)
+	}
+
+	// The rebased branch should now sit on top of main's new commit and
+	// have been force-pushed to origin.
+	out, err := exec.Command("git", "-C", gitDir, "log", "--oneline", "origin/polecat/x").CombinedOutput()
+	if err != nil {
+		t.Fatalf("log origin/polecat/x: %v\n%s", err, out)
+	}
+	if !strings.Contains(string(out), "advance main") {
+		t.Errorf("expected rebased branch on origin to include main's commit, got:\n%s", out)
+	}
+}
+
+func TestAttemptAutoRebase_ResolvesViaAutoResolvePaths(t *testing.T) {
+	e, gitDir := newAutoRebaseTestEngineer(t)
+	e.config.AutoResolvePaths = []string{"generated.json"}
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = gitDir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	run("checkout", "-b", "polecat/x")
+	genFile := filepath.Join(gitDir, "generated.json")
+	if err := os.WriteFile(genFile, []byte(`{"branch":true}`), 0644); err != nil {
+		t.Fatalf("write generated.json: %v", err)
+	}
+	run("add", "generated.json")
+	run("commit", "-m", "regenerate on branch")
+
+	advanceMainAndFetch(t, gitDir, "generated.json", `{"branch":false}`, "regenerate on main")
+
+	if err := e.attemptAutoRebase("polecat/x", "main"); err != nil {
+		t.Fatalf("attemptAutoRebase: %v", err)
+	}
+
+	run("checkout", "polecat/x")
+	content, err := os.ReadFile(genFile)
+	if err != nil {
+		t.Fatalf("read generated.json: %v", err)
+	}
+	if string(content) != `{"branch":true}` {
+		t.Errorf("generated.json = %q, want branch's own version to have won", string(content))
+	}
+}
+
+func TestAttemptAutoRebase_FallsBackOnUnresolvableConflict(t *testing.T) {
+	e, gitDir := newAutoRebaseTestEngineer(t)
+	// No AutoResolvePaths configured - the README.md conflict below isn't covered.
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = gitDir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	run("checkout", "-b", "polecat/x")
+	readmeFile := filepath.Join(gitDir, "README.md")
+	if err := os.WriteFile(readmeFile, []byte("# Branch changes\n"), 0644); err != nil {
+		t.Fatalf("write README.md: %v", err)
+	}
+	run("add", "README.md")
+	run("commit", "-m", "modify readme on branch")
+
+	advanceMainAndFetch(t, gitDir, "README.md", "# Main changes\n", "modify readme on main")
+
+	if err := e.attemptAutoRebase("polecat/x", "main"); err == nil {
+		t.Fatal("expected attemptAutoRebase to fail on an unresolvable conflict")
+	}
+
+	// The branch itself must be left untouched - no rebase in progress and
+	// nothing force-pushed to origin.
+	run("checkout", "polecat/x")
+	status, err := e.git.Status()
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	if !status.Clean {
+		t.Errorf("expected clean working directory after a failed auto-rebase, got: %+v", status)
+	}
+	out, err := exec.Command("git", "-C", gitDir, "ls-remote", "origin", "polecat/x").CombinedOutput()
+	if err != nil {
+		t.Fatalf("ls-remote: %v\n%s", err, out)
+	}
+	if len(out) != 0 {
+		t.Errorf("expected polecat/x not to have been pushed to origin, got: %s", out)
+	}
+}
+
+func TestDoMerge_AutoRebase_RetriesAfterCleanRebase(t *testing.T) {
+	e, gitDir := newAutoRebaseTestEngineer(t)
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = gitDir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	run("checkout", "-b", "polecat/x")
+	if err := os.WriteFile(filepath.Join(gitDir, "feature.txt"), []byte("feature\n"), 0644); err != nil {
+		t.Fatalf("write feature.txt: %v", err)
+	}
+	run("add", "feature.txt")
+	run("commit", "-m", "add feature")
+
+	advanceMainAndFetch(t, gitDir, "other.txt", "unrelated\n", "advance main")
+
+	result := e.doMerge(context.Background(), "polecat/x", "main", "")
+	if !result.Success {
+		t.Fatalf("expected doMerge to succeed via auto-rebase retry, got: %+v", result)
+	}
+}
+
+// newHandleSuccessTestEngineer sets up an Engineer backed by an isolated bd
+// repo (no real git needed - handleSuccess only touches beads) along with an
+// MR bead and its source issue, ready for handleSuccess to process.
+func newHandleSuccessTestEngineer(t *testing.T) (e *Engineer, mr *beads.Issue, sourceIssue *beads.Issue) {
+	t.Helper()
+	if _, err := exec.LookPath("bd"); err != nil {
+		t.Skip("bd not installed")
+	}
+
+	tmpDir := t.TempDir()
+	b := beads.NewIsolated(tmpDir)
+	if err := b.Init("test"); err != nil {
+		t.Fatalf("bd init: %v", err)
+	}
+
+	source, err := b.Create(beads.CreateOptions{Title: "do the thing", Type: "task"})
+	if err != nil {
+		t.Fatalf("creating source issue: %v", err)
+	}
+
+	desc := beads.FormatMRFields(&beads.MRFields{
+		Branch:      "polecat/nux/gt-xyz",
+		Target:      "main",
+		SourceIssue: source.ID,
+		Worker:      "nux",
+	})
+	mrIssue, err := b.Create(beads.CreateOptions{Title: "merge polecat/nux/gt-xyz", Type: "merge-request", Description: desc})
+	if err != nil {
+		t.Fatalf("creating MR issue: %v", err)
+	}
+
+	e = NewEngineer(&rig.Rig{Name: "test-rig", Path: tmpDir})
+	e.output = io.Discard
+	e.beads = b
+
+	return e, mrIssue, source
+}
+
+func TestHandleSuccess_ClosesSourceIssueByDefault(t *testing.T) {
+	e, mr, source := newHandleSuccessTestEngineer(t)
+
+	result := e.handleSuccess(mr, ProcessResult{Success: true, MergeCommit: "abc123"})
+
+	if result.SourceIssueAction != "closed" {
+		t.Errorf("SourceIssueAction = %q, want %q", result.SourceIssueAction, "closed")
+	}
+	updated, err := e.beads.Show(source.ID)
+	if err != nil {
+		t.Fatalf("Show: %v", err)
+	}
+	if updated.Status != "closed" {
+		t.Errorf("source issue status = %q, want %q", updated.Status, "closed")
+	}
+}
+
+func TestHandleSuccess_LabelOnlyLeavesSourceIssueOpen(t *testing.T) {
+	e, mr, source := newHandleSuccessTestEngineer(t)
+	e.config.CloseIssueOnMerge = CloseIssueOnMergeLabelOnly
+
+	result := e.handleSuccess(mr, ProcessResult{Success: true, MergeCommit: "abc123"})
+
+	if result.SourceIssueAction != "labeled" {
+		t.Errorf("SourceIssueAction = %q, want %q", result.SourceIssueAction, "labeled")
+	}
+	updated, err := e.beads.Show(source.ID)
+	if err != nil {
+		t.Fatalf("Show: %v", err)
+	}
+	if updated.Status == "closed" {
+		t.Error("source issue was closed, want it to remain open under label_only")
+	}
+	found := false
+	for _, label := range updated.Labels {
+		if label == "merged" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected source issue to have 'merged' label, got labels: %v", updated.Labels)
+	}
+}
+
+// withEventsWorkspace marks tmpDir as a Gas Town workspace root (via
+// mayor/town.json) and chdirs into it for the duration of the test, so
+// events.LogFeed's cwd-based workspace lookup succeeds.
+func withEventsWorkspace(t *testing.T, tmpDir string) {
+	t.Helper()
+	mayorDir := filepath.Join(tmpDir, "mayor")
+	if err := os.MkdirAll(mayorDir, 0755); err != nil {
+		t.Fatalf("mkdir mayor: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(mayorDir, "town.json"), []byte(`{}`), 0644); err != nil {
+		t.Fatalf("write town.json: %v", err)
+	}
+
+	origWd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Getwd: %v", err)
+	}
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("Chdir: %v", err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(origWd) })
+}
+
+func TestHandleSuccess_EmitsMergedEventWithDurations(t *testing.T) {
+	e, mr, _ := newHandleSuccessTestEngineer(t)
+	withEventsWorkspace(t, e.rig.Path)
+
+	startedAt := time.Now()
+	e.handleSuccess(mr, ProcessResult{Success: true, MergeCommit: "abc123", StartedAt: startedAt})
+
+	evts, err := events.Query(e.rig.Path, events.QueryOptions{Type: events.TypeMerged})
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(evts) != 1 {
+		t.Fatalf("expected 1 merged event, got %d", len(evts))
+	}
+	payload := evts[0].Payload
+	if payload["mr"] != mr.ID {
+		t.Errorf("mr = %v, want %q", payload["mr"], mr.ID)
+	}
+	if payload["worker"] != "nux" {
+		t.Errorf("worker = %v, want %q", payload["worker"], "nux")
+	}
+	if _, ok := payload["issue_id"]; !ok {
+		t.Error("expected issue_id field on merged event payload")
+	}
+	if _, ok := payload["queue_wait_seconds"]; !ok {
+		t.Error("expected queue_wait_seconds field on merged event payload")
+	}
+	if _, ok := payload["processing_seconds"]; !ok {
+		t.Error("expected processing_seconds field on merged event payload")
+	}
+}
+
+func TestHandleFailure_EmitsMergeFailedEvent(t *testing.T) {
+	e, mr, _ := newHandleSuccessTestEngineer(t)
+	withEventsWorkspace(t, e.rig.Path)
+
+	e.handleFailure(mr, ProcessResult{Success: false, Error: "tests failed", StartedAt: time.Now()})
+
+	evts, err := events.Query(e.rig.Path, events.QueryOptions{Type: events.TypeMergeFailed})
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(evts) != 1 {
+		t.Fatalf("expected 1 merge_failed event, got %d", len(evts))
+	}
+	if evts[0].Payload["reason"] != "tests failed" {
+		t.Errorf("reason = %v, want %q", evts[0].Payload["reason"], "tests failed")
+	}
+}
@@ -14,7 +14,9 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/plugins"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/session"
@@ -56,6 +58,13 @@ func (m *Manager) SessionName() string {
 	return fmt.Sprintf("gt-%s-refinery", m.rig.Name)
 }
 
+// actor returns the BD_ACTOR identity to attribute to beads writes made by
+// this manager, matching the format config.AgentEnv assigns the refinery's
+// own tmux session.
+func (m *Manager) actor() string {
+	return fmt.Sprintf("%s/refinery", m.rig.Name)
+}
+
 // IsRunning checks if the refinery session is active.
 // ZFC: tmux session existence is the source of truth.
 func (m *Manager) IsRunning() (bool, error) {
@@ -224,11 +233,14 @@ func (m *Manager) Stop() error {
 
 // Queue returns the current merge queue.
 // Uses beads merge-request issues as the source of truth (not git branches).
-// ZFC-compliant: beads is the source of truth, no state file.
+// ZFC-compliant: beads is the source of truth for which MRs exist and their
+// status. If the Refinery agent has reported a fresh QueueSnapshot (via
+// `gt refinery report`), its per-MR LastError is merged in - beads doesn't
+// carry that transient detail, but a stale snapshot is ignored.
 func (m *Manager) Queue() ([]QueueItem, error) {
 	// Query beads for open merge-request type issues
 	// BeadsPath() returns the git-synced beads location
-	b := beads.New(m.rig.BeadsPath())
+	b := beads.New(m.rig.BeadsPath()).WithActor(m.actor())
 	issues, err := b.List(beads.ListOptions{
 		Type:     "merge-request",
 		Status:   "open",
@@ -254,31 +266,255 @@ func (m *Manager) Queue() ([]QueueItem, error) {
 		return scored[i].score > scored[j].score
 	})
 
-	// Convert scored issues to queue items
+	// Convert scored issues to queue items (unnumbered - superseded items
+	// get dropped below before positions are assigned).
 	var items []QueueItem
-	pos := 1
 	for _, s := range scored {
 		mr := m.issueToMR(s.issue)
 		if mr != nil {
 			items = append(items, QueueItem{
-				Position: pos,
-				MR:       mr,
-				Age:      formatAge(mr.CreatedAt),
+				MR:  mr,
+				Age: formatAge(mr.CreatedAt),
 			})
-			pos++
 		}
 	}
 
+	items = m.dedupeSupersededQueueItems(items)
+
+	pos := 1
+	for i := range items {
+		items[i].Position = pos
+		pos++
+	}
+
+	if snapshot, err := m.LoadSnapshot(); err == nil && snapshot != nil && !snapshot.IsStale(now, DefaultSnapshotStaleAfter) {
+		applySnapshot(items, snapshot)
+	}
+
 	return items, nil
 }
 
+// dedupeSupersededQueueItems groups queue items that represent the same
+// underlying work - the same worker+source issue, or literally the same
+// branch - keeps only the one whose branch tip commit is newest, and
+// closes the rest with CloseReasonSuperseded. This covers both a polecat
+// force-pushing a rework (same branch, new commits) and a respawn onto the
+// same issue (new branch, same worker+issue).
+func (m *Manager) dedupeSupersededQueueItems(items []QueueItem) []QueueItem {
+	kept, superseded := selectSupersedeWinners(items, m.branchTipTime)
+	for _, item := range superseded {
+		m.closeSuperseded(item.MR)
+	}
+	return kept
+}
+
+// selectSupersedeWinners groups items via groupSupersededQueueItems and, for
+// each group of duplicates, picks the one with the newest branch tip commit
+// (via branchTip) as the survivor. The survivor's SupersededIDs records what
+// it replaced, for display. Pure aside from the branchTip callback, so tests
+// can exercise the grouping/winner logic without a real git repo or beads.
+func selectSupersedeWinners(items []QueueItem, branchTip func(branch string) time.Time) (kept, superseded []QueueItem) {
+	for _, group := range groupSupersededQueueItems(items) {
+		if len(group) == 1 {
+			kept = append(kept, items[group[0]])
+			continue
+		}
+
+		newest := group[0]
+		newestTip := branchTip(items[newest].MR.Branch)
+		for _, idx := range group[1:] {
+			if tip := branchTip(items[idx].MR.Branch); tip.After(newestTip) {
+				newest, newestTip = idx, tip
+			}
+		}
+
+		survivor := items[newest]
+		for _, idx := range group {
+			if idx == newest {
+				continue
+			}
+			survivor.SupersededIDs = append(survivor.SupersededIDs, items[idx].MR.ID)
+			superseded = append(superseded, items[idx])
+		}
+		kept = append(kept, survivor)
+	}
+	return kept, superseded
+}
+
+// groupSupersededQueueItems partitions items into groups of indices that
+// share the same non-empty branch, or the same non-empty worker+source
+// issue pair. Items that share neither key with anything else form a
+// singleton group.
+func groupSupersededQueueItems(items []QueueItem) [][]int {
+	branchGroup := make(map[string]int)
+	workerIssueGroup := make(map[string]int)
+	var groups [][]int
+
+	for i, item := range items {
+		if item.MR == nil {
+			continue
+		}
+		branchKey := item.MR.Branch
+		workerIssueKey := ""
+		if item.MR.Worker != "" && item.MR.IssueID != "" {
+			workerIssueKey = item.MR.Worker + "|" + item.MR.IssueID
+		}
+
+		g, ok := -1, false
+		if branchKey != "" {
+			g, ok = branchGroup[branchKey]
+		}
+		if !ok && workerIssueKey != "" {
+			g, ok = workerIssueGroup[workerIssueKey]
+		}
+		if !ok {
+			g = len(groups)
+			groups = append(groups, nil)
+		}
+		groups[g] = append(groups[g], i)
+		if branchKey != "" {
+			branchGroup[branchKey] = g
+		}
+		if workerIssueKey != "" {
+			workerIssueGroup[workerIssueKey] = g
+		}
+	}
+
+	return groups
+}
+
+// branchTipTime returns branch's tip commit time, or the zero time if it
+// can't be determined (e.g. the branch was already deleted). Callers treat
+// the zero time as "oldest", so an unresolvable branch never wins a
+// supersede comparison against one that resolves.
+func (m *Manager) branchTipTime(branch string) time.Time {
+	if branch == "" {
+		return time.Time{}
+	}
+	t, err := git.NewGit(m.refineryGitDir()).CommitTime(branch)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// refineryGitDir returns the git working directory refinery operations
+// should run in - the refinery worktree if it exists, else mayor/rig
+// (legacy architecture). Using rig.Path directly would find the town's
+// .git with rig-named remotes instead of "origin".
+func (m *Manager) refineryGitDir() string {
+	gitDir := filepath.Join(m.rig.Path, "refinery", "rig")
+	if _, err := os.Stat(gitDir); os.IsNotExist(err) {
+		gitDir = filepath.Join(m.rig.Path, "mayor", "rig")
+	}
+	return gitDir
+}
+
+// closeSuperseded closes a duplicate MR's bead with CloseReasonSuperseded
+// and emits a merge_skipped event, mirroring completeMR's handling of the
+// same close reason. Returns true if the bead was closed.
+func (m *Manager) closeSuperseded(mr *MergeRequest) bool {
+	b := beads.New(m.rig.BeadsPath()).WithActor(m.actor())
+	if err := b.CloseWithReason("superseded", mr.ID); err != nil {
+		_, _ = fmt.Fprintf(m.output, "Warning: failed to close superseded MR %s: %v\n", mr.ID, err)
+		return false
+	}
+	actor := fmt.Sprintf("%s/refinery", m.rig.Name)
+	_ = events.LogFeed(events.TypeMergeSkipped, actor,
+		events.MergePayload(mr.ID, mr.Worker, mr.Branch, mr.IssueID, "superseded", 0, 0))
+	return true
+}
+
+// QueueLen returns the number of merge requests currently queued, without
+// paying for the scoring/sorting that Queue does. Used by callers (like
+// witness.Patrol) that only need to know whether the queue is non-empty.
+func (m *Manager) QueueLen() (int, error) {
+	b := beads.New(m.rig.BeadsPath()).WithActor(m.actor())
+	issues, err := b.List(beads.ListOptions{
+		Type:     "merge-request",
+		Status:   "open",
+		Priority: -1,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("querying merge queue from beads: %w", err)
+	}
+	return len(issues), nil
+}
+
+// CurrentMR returns the merge request currently claimed by a refinery
+// worker (assignee set on an open merge-request bead), or nil if none is
+// claimed. If more than one is claimed, the first one found is returned.
+func (m *Manager) CurrentMR() (*MergeRequest, error) {
+	b := beads.New(m.rig.BeadsPath()).WithActor(m.actor())
+	issues, err := b.List(beads.ListOptions{
+		Type:     "merge-request",
+		Status:   "open",
+		Priority: -1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("querying merge queue from beads: %w", err)
+	}
+
+	for _, issue := range issues {
+		if issue.Assignee == "" {
+			continue
+		}
+		mr := m.issueToMR(issue)
+		if mr != nil {
+			mr.Status = MRInProgress
+		}
+		return mr, nil
+	}
+
+	return nil, nil
+}
+
+// LastMerge returns the most recently merged request, or nil if none has
+// merged yet.
+func (m *Manager) LastMerge() (*MergeRequest, error) {
+	b := beads.New(m.rig.BeadsPath()).WithActor(m.actor())
+	issues, err := b.List(beads.ListOptions{
+		Type:     "merge-request",
+		Status:   "closed",
+		Priority: -1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("querying closed merge requests from beads: %w", err)
+	}
+
+	var latest *beads.Issue
+	var latestClosedAt time.Time
+	for _, issue := range issues {
+		fields := beads.ParseMRFields(issue)
+		if fields == nil || fields.CloseReason != string(CloseReasonMerged) {
+			continue
+		}
+		closedAt := issue.ClosedAt.Time
+		if latest == nil || closedAt.After(latestClosedAt) {
+			latest = issue
+			latestClosedAt = closedAt
+		}
+	}
+	if latest == nil {
+		return nil, nil
+	}
+
+	mr := m.issueToMR(latest)
+	if mr != nil {
+		mr.Status = MRClosed
+		mr.CloseReason = CloseReasonMerged
+		mr.ClosedAt = &latestClosedAt
+	}
+	return mr, nil
+}
+
 // calculateIssueScore computes the priority score for an MR issue.
 // Higher scores mean higher priority (process first).
 func (m *Manager) calculateIssueScore(issue *beads.Issue, now time.Time) float64 {
 	fields := beads.ParseMRFields(issue)
 
 	// Parse MR creation time
-	mrCreatedAt := parseTime(issue.CreatedAt)
+	mrCreatedAt := issue.CreatedAt.Time
 	if mrCreatedAt.IsZero() {
 		mrCreatedAt = now // Fallback
 	}
@@ -321,7 +557,8 @@ func (m *Manager) issueToMR(issue *beads.Issue) *MergeRequest {
 			ID:           issue.ID,
 			IssueID:      issue.ID,
 			Status:       MROpen,
-			CreatedAt:    parseTime(issue.CreatedAt),
+			Priority:     issue.Priority,
+			CreatedAt:    issue.CreatedAt.Time,
 			TargetBranch: defaultBranch,
 		}
 	}
@@ -339,7 +576,9 @@ func (m *Manager) issueToMR(issue *beads.Issue) *MergeRequest {
 		IssueID:      fields.SourceIssue,
 		TargetBranch: target,
 		Status:       MROpen,
-		CreatedAt:    parseTime(issue.CreatedAt),
+		Priority:     issue.Priority,
+		CreatedAt:    issue.CreatedAt.Time,
+		ReviewedBy:   fields.ReviewedBy,
 	}
 }
 
@@ -400,7 +639,10 @@ func (m *Manager) completeMR(mr *MergeRequest, closeReason CloseReason, errMsg s
 		}
 		if closeReason == CloseReasonSuperseded {
 			// Emit merge_skipped event
-			_ = events.LogFeed(events.TypeMergeSkipped, actor, events.MergePayload(mr.ID, mr.Worker, mr.Branch, "superseded"))
+			_ = events.LogFeed(events.TypeMergeSkipped, actor, events.MergePayload(mr.ID, mr.Worker, mr.Branch, mr.IssueID, "superseded", 0, 0))
+		}
+		if closeReason == CloseReasonMerged {
+			m.runOnMergePlugin(mr)
 		}
 	} else {
 		// Reopen the MR for rework (in_progress → open)
@@ -411,6 +653,18 @@ func (m *Manager) completeMR(mr *MergeRequest, closeReason CloseReason, errMsg s
 	}
 }
 
+// runOnMergePlugin invokes the on-merge lifecycle plugin, if any, after an
+// MR has been successfully merged. Failures are logged and non-fatal - the
+// merge itself has already completed.
+func (m *Manager) runOnMergePlugin(mr *MergeRequest) {
+	townRoot := filepath.Dir(m.rig.Path)
+	runner := plugins.NewRunner(townRoot, m.rig.Path)
+	result := runner.Run(plugins.EventOnMerge, mr)
+	if result.Failed() {
+		_, _ = fmt.Fprintf(m.output, "Warning: on-merge plugin failed: %v\n", result.FailureDetail())
+	}
+}
+
 // runTests executes the test command.
 // Deprecated: The Refinery agent runs tests directly via shell commands (ZFC #5).
 func (m *Manager) runTests(testCmd string) error {
@@ -447,43 +701,16 @@ func (m *Manager) getMergeConfig() MergeConfig {
 	return mergeConfig
 }
 
-// pushWithRetry pushes to the target branch with exponential backoff retry.
+// pushWithRetry pushes to the target branch, retrying transient network
+// failures via git.PushRetry's exponential backoff.
 // Deprecated: The Refinery agent decides retry strategy (ZFC #5).
 func (m *Manager) pushWithRetry(targetBranch string, config MergeConfig) error {
-	var lastErr error
-	delay := time.Duration(config.PushRetryDelayMs) * time.Millisecond
-
-	for attempt := 0; attempt <= config.PushRetryCount; attempt++ {
-		if attempt > 0 {
-			_, _ = fmt.Fprintf(m.output, "Push retry %d/%d after %v\n", attempt, config.PushRetryCount, delay)
-			time.Sleep(delay)
-			delay *= 2 // Exponential backoff
-		}
-
-		err := util.ExecRun(m.workDir, "git", "push", "origin", targetBranch)
-		if err == nil {
-			return nil // Success
-		}
-		lastErr = err
-	}
-
-	return fmt.Errorf("push failed after %d retries: %v", config.PushRetryCount, lastErr)
+	return git.NewGit(m.workDir).PushRetry("origin", targetBranch, false, config.PushRetryCount+1)
 }
 
 // formatAge formats a duration since the given time.
 func formatAge(t time.Time) string {
-	d := time.Since(t)
-
-	if d < time.Minute {
-		return fmt.Sprintf("%ds ago", int(d.Seconds()))
-	}
-	if d < time.Hour {
-		return fmt.Sprintf("%dm ago", int(d.Minutes()))
-	}
-	if d < 24*time.Hour {
-		return fmt.Sprintf("%dh ago", int(d.Hours()))
-	}
-	return fmt.Sprintf("%dd ago", int(d.Hours()/24))
+	return beads.HumanizeAge(time.Since(t))
 }
 
 // notifyWorkerConflict sends a conflict notification to a polecat.
@@ -594,11 +821,26 @@ func (m *Manager) RejectMR(idOrBranch string, reason string, notify bool) (*Merg
 	}
 
 	// Close the bead in storage with the rejection reason
-	b := beads.New(m.rig.BeadsPath())
+	b := beads.New(m.rig.BeadsPath()).WithActor(m.actor())
 	if err := b.CloseWithReason("rejected: "+reason, mr.ID); err != nil {
 		return nil, fmt.Errorf("failed to close MR bead: %w", err)
 	}
 
+	// Record the rejection on the bead so FindActiveRejection can recognize
+	// this branch is under an active rejection and refuse to spin up a fresh
+	// MR for it before the rejection expires or is lifted with `gt mq unreject`.
+	if issue, err := b.Show(mr.ID); err == nil {
+		fields := beads.ParseMRFields(issue)
+		if fields == nil {
+			fields = &beads.MRFields{}
+		}
+		fields.RejectReason = reason
+		fields.RejectedAt = time.Now().UTC().Format(time.RFC3339)
+		if err := b.UpdateMRFields(mr.ID, fields); err != nil {
+			_, _ = fmt.Fprintf(m.output, "Warning: failed to persist rejection metadata: %v\n", err)
+		}
+	}
+
 	// Update in-memory state for return value
 	if err := mr.Close(CloseReasonRejected); err != nil {
 		// Non-fatal: bead is already closed, just log
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/rig"
 )
@@ -119,3 +120,62 @@ func TestManager_Retry_Deprecated(t *testing.T) {
 		t.Errorf("Retry() unexpected error: %v", err)
 	}
 }
+
+func TestSelectSupersedeWinners_SameIssueKeepsNewestByBranchTip(t *testing.T) {
+	old := QueueItem{MR: &MergeRequest{ID: "gt-mr-1", Worker: "Toast", IssueID: "gt-1", Branch: "polecat/Toast/gt-1"}}
+	respawned := QueueItem{MR: &MergeRequest{ID: "gt-mr-2", Worker: "Toast", IssueID: "gt-1", Branch: "polecat/Toast-2/gt-1"}}
+
+	tips := map[string]time.Time{
+		old.MR.Branch:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		respawned.MR.Branch: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	branchTip := func(branch string) time.Time { return tips[branch] }
+
+	kept, superseded := selectSupersedeWinners([]QueueItem{old, respawned}, branchTip)
+
+	if len(kept) != 1 || kept[0].MR.ID != respawned.MR.ID {
+		t.Fatalf("kept = %+v, want only %s", kept, respawned.MR.ID)
+	}
+	if len(superseded) != 1 || superseded[0].MR.ID != old.MR.ID {
+		t.Fatalf("superseded = %+v, want only %s", superseded, old.MR.ID)
+	}
+	if want := []string{old.MR.ID}; len(kept[0].SupersededIDs) != 1 || kept[0].SupersededIDs[0] != want[0] {
+		t.Errorf("SupersededIDs = %v, want %v", kept[0].SupersededIDs, want)
+	}
+}
+
+func TestSelectSupersedeWinners_SameBranchDifferentIDsDeduped(t *testing.T) {
+	stale := QueueItem{MR: &MergeRequest{ID: "gt-mr-1", Worker: "Toast", IssueID: "gt-1", Branch: "polecat/Toast/gt-1"}}
+	reworked := QueueItem{MR: &MergeRequest{ID: "gt-mr-2", Worker: "Toast", IssueID: "gt-1", Branch: "polecat/Toast/gt-1"}}
+
+	tips := map[string]time.Time{stale.MR.Branch: time.Now()}
+	callCount := 0
+	branchTip := func(branch string) time.Time {
+		callCount++
+		// Simulate the force-pushed branch tip advancing between lookups.
+		return tips[branch].Add(time.Duration(callCount) * time.Minute)
+	}
+
+	kept, superseded := selectSupersedeWinners([]QueueItem{stale, reworked}, branchTip)
+
+	if len(kept) != 1 {
+		t.Fatalf("kept = %+v, want exactly 1 item", kept)
+	}
+	if len(superseded) != 1 {
+		t.Fatalf("superseded = %+v, want exactly 1 item", superseded)
+	}
+}
+
+func TestSelectSupersedeWinners_UnrelatedItemsAllKept(t *testing.T) {
+	a := QueueItem{MR: &MergeRequest{ID: "gt-mr-1", Worker: "Toast", IssueID: "gt-1", Branch: "polecat/Toast/gt-1"}}
+	b := QueueItem{MR: &MergeRequest{ID: "gt-mr-2", Worker: "Nux", IssueID: "gt-2", Branch: "polecat/Nux/gt-2"}}
+
+	kept, superseded := selectSupersedeWinners([]QueueItem{a, b}, func(string) time.Time { return time.Time{} })
+
+	if len(kept) != 2 {
+		t.Fatalf("kept = %+v, want both items", kept)
+	}
+	if len(superseded) != 0 {
+		t.Fatalf("superseded = %+v, want none", superseded)
+	}
+}
@@ -0,0 +1,164 @@
+package refinery
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultSnapshotStaleAfter is how long a QueueSnapshot is trusted before
+// Queue()/Status() flag it as stale in their output.
+const DefaultSnapshotStaleAfter = 10 * time.Minute
+
+// QueueSnapshot records the merge queue as last observed by the Refinery
+// agent. Queue() itself always queries beads directly - beads remains the
+// source of truth for which MRs exist and their status (ZFC). The snapshot
+// exists only to carry the per-cycle detail the agent knows while it works
+// (the last error it hit on an MR) that has no field in the beads issue
+// itself, so Queue() enriches its beads-derived items with a fresh
+// snapshot's LastError/UpdatedAt when one is available.
+type QueueSnapshot struct {
+	GeneratedAt time.Time           `json:"generated_at"`
+	Items       []QueueSnapshotItem `json:"items"`
+}
+
+// QueueSnapshotItem is one merge request as the agent last saw it.
+type QueueSnapshotItem struct {
+	ID          string    `json:"id"`
+	Branch      string    `json:"branch"`
+	Status      MRStatus  `json:"status"`
+	CloseReason string    `json:"close_reason,omitempty"`
+	LastError   string    `json:"last_error,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// IsStale reports whether the snapshot is older than maxAge as of now.
+func (s *QueueSnapshot) IsStale(now time.Time, maxAge time.Duration) bool {
+	if s == nil {
+		return false
+	}
+	return now.Sub(s.GeneratedAt) > maxAge
+}
+
+// SnapshotPath returns where this rig's refinery queue snapshot is stored.
+// Lives alongside the refinery's rig clone (internal/rig.AgentDirs already
+// reserves "refinery/" for this agent).
+func (m *Manager) SnapshotPath() string {
+	return filepath.Join(m.rig.Path, "refinery", "state.json")
+}
+
+// WriteSnapshot persists the given queue items as a QueueSnapshot, meant to
+// be called by the Refinery agent (via `gt refinery report`) after each
+// processing cycle. Written atomically via a temp file + rename.
+func (m *Manager) WriteSnapshot(items []QueueItem) error {
+	snapshot := &QueueSnapshot{
+		GeneratedAt: time.Now(),
+	}
+	for _, item := range items {
+		if item.MR == nil {
+			continue
+		}
+		snapshot.Items = append(snapshot.Items, QueueSnapshotItem{
+			ID:          item.MR.ID,
+			Branch:      item.MR.Branch,
+			Status:      item.MR.Status,
+			CloseReason: string(item.MR.CloseReason),
+			LastError:   item.MR.Error,
+			UpdatedAt:   snapshot.GeneratedAt,
+		})
+	}
+
+	dir := filepath.Dir(m.SnapshotPath())
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating refinery state dir: %w", err)
+	}
+
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling queue snapshot: %w", err)
+	}
+
+	tmp := m.SnapshotPath() + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("writing queue snapshot: %w", err)
+	}
+	return os.Rename(tmp, m.SnapshotPath())
+}
+
+// LoadSnapshot reads the last-written QueueSnapshot for this rig, or nil if
+// the agent has never reported one.
+func (m *Manager) LoadSnapshot() (*QueueSnapshot, error) {
+	data, err := os.ReadFile(m.SnapshotPath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading queue snapshot: %w", err)
+	}
+
+	var snapshot QueueSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("parsing queue snapshot: %w", err)
+	}
+	return &snapshot, nil
+}
+
+// QueueFromSnapshot rebuilds queue items from the last-reported snapshot
+// instead of querying beads/git live. It exists for --watch and --summary
+// in agent mode, where the caller polls frequently enough that redoing full
+// branch discovery (beads query + git branch-tip lookups for supersede
+// dedup) on every refresh would be wasteful; the agent's own per-cycle `gt
+// refinery report` has already done that work once. Returns nil, nil if no
+// snapshot has ever been reported.
+func (m *Manager) QueueFromSnapshot() ([]QueueItem, error) {
+	snapshot, err := m.LoadSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, nil
+	}
+
+	items := make([]QueueItem, 0, len(snapshot.Items))
+	pos := 1
+	for _, si := range snapshot.Items {
+		if si.Status == MRClosed {
+			continue
+		}
+		mr := &MergeRequest{
+			ID:          si.ID,
+			Branch:      si.Branch,
+			Status:      si.Status,
+			CloseReason: CloseReason(si.CloseReason),
+			Error:       si.LastError,
+			CreatedAt:   si.UpdatedAt,
+		}
+		items = append(items, QueueItem{Position: pos, MR: mr, Age: formatAge(si.UpdatedAt)})
+		pos++
+	}
+	return items, nil
+}
+
+// applySnapshot merges a snapshot's per-MR LastError into beads-derived
+// queue items sharing the same MR ID. Beads remains authoritative for which
+// items exist and their status; the snapshot only fills in the transient
+// LastError detail beads doesn't carry.
+func applySnapshot(items []QueueItem, snapshot *QueueSnapshot) {
+	if snapshot == nil {
+		return
+	}
+	byID := make(map[string]QueueSnapshotItem, len(snapshot.Items))
+	for _, si := range snapshot.Items {
+		byID[si.ID] = si
+	}
+	for _, item := range items {
+		if item.MR == nil {
+			continue
+		}
+		if si, ok := byID[item.MR.ID]; ok && si.LastError != "" {
+			item.MR.Error = si.LastError
+		}
+	}
+}
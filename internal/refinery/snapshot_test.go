@@ -0,0 +1,143 @@
+package refinery
+
+import (
+	"testing"
+	"time"
+)
+
+func TestManager_WriteLoadSnapshot(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+
+	items := []QueueItem{
+		{
+			Position: 1,
+			MR:       &MergeRequest{ID: "gt-1", Branch: "polecat/foo", Status: MROpen, Error: "tests failed: TestFoo"},
+			Age:      "1m ago",
+		},
+	}
+
+	if err := mgr.WriteSnapshot(items); err != nil {
+		t.Fatalf("WriteSnapshot() error = %v", err)
+	}
+
+	snapshot, err := mgr.LoadSnapshot()
+	if err != nil {
+		t.Fatalf("LoadSnapshot() error = %v", err)
+	}
+	if snapshot == nil {
+		t.Fatal("LoadSnapshot() = nil, want a snapshot")
+	}
+	if len(snapshot.Items) != 1 {
+		t.Fatalf("len(snapshot.Items) = %d, want 1", len(snapshot.Items))
+	}
+	if snapshot.Items[0].LastError != "tests failed: TestFoo" {
+		t.Errorf("LastError = %q, want %q", snapshot.Items[0].LastError, "tests failed: TestFoo")
+	}
+}
+
+func TestManager_LoadSnapshot_NotReported(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+
+	snapshot, err := mgr.LoadSnapshot()
+	if err != nil {
+		t.Fatalf("LoadSnapshot() error = %v", err)
+	}
+	if snapshot != nil {
+		t.Errorf("LoadSnapshot() = %+v, want nil when the agent has never reported", snapshot)
+	}
+}
+
+func TestQueueSnapshot_IsStale(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		generated time.Time
+		wantStale bool
+	}{
+		{name: "fresh", generated: now.Add(-1 * time.Minute), wantStale: false},
+		{name: "exactly at threshold", generated: now.Add(-DefaultSnapshotStaleAfter), wantStale: false},
+		{name: "past threshold", generated: now.Add(-DefaultSnapshotStaleAfter - time.Second), wantStale: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot := &QueueSnapshot{GeneratedAt: tt.generated}
+			if got := snapshot.IsStale(now, DefaultSnapshotStaleAfter); got != tt.wantStale {
+				t.Errorf("IsStale() = %v, want %v", got, tt.wantStale)
+			}
+		})
+	}
+}
+
+func TestApplySnapshot_MergesLastError(t *testing.T) {
+	items := []QueueItem{
+		{MR: &MergeRequest{ID: "gt-1", Status: MROpen}},
+		{MR: &MergeRequest{ID: "gt-2", Status: MROpen}},
+	}
+	snapshot := &QueueSnapshot{
+		GeneratedAt: time.Now(),
+		Items: []QueueSnapshotItem{
+			{ID: "gt-1", LastError: "conflict on merge"},
+		},
+	}
+
+	applySnapshot(items, snapshot)
+
+	if items[0].MR.Error != "conflict on merge" {
+		t.Errorf("items[0].MR.Error = %q, want %q", items[0].MR.Error, "conflict on merge")
+	}
+	if items[1].MR.Error != "" {
+		t.Errorf("items[1].MR.Error = %q, want empty (no snapshot entry)", items[1].MR.Error)
+	}
+}
+
+func TestApplySnapshot_NilSnapshotIsNoOp(t *testing.T) {
+	items := []QueueItem{{MR: &MergeRequest{ID: "gt-1", Status: MROpen}}}
+	applySnapshot(items, nil)
+	if items[0].MR.Error != "" {
+		t.Errorf("items[0].MR.Error = %q, want empty", items[0].MR.Error)
+	}
+}
+
+func TestManager_QueueFromSnapshot_NoSnapshotYet(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+
+	items, err := mgr.QueueFromSnapshot()
+	if err != nil {
+		t.Fatalf("QueueFromSnapshot() error = %v", err)
+	}
+	if items != nil {
+		t.Errorf("QueueFromSnapshot() = %+v, want nil when the agent has never reported", items)
+	}
+}
+
+func TestManager_QueueFromSnapshot_SkipsClosedAndAssignsPositions(t *testing.T) {
+	mgr, _ := setupTestManager(t)
+
+	written := []QueueItem{
+		{Position: 1, MR: &MergeRequest{ID: "gt-1", Branch: "polecat/foo", Status: MRInProgress}},
+		{Position: 2, MR: &MergeRequest{ID: "gt-2", Branch: "polecat/bar", Status: MROpen, Error: "tests failed"}},
+		{Position: 3, MR: &MergeRequest{ID: "gt-3", Branch: "polecat/baz", Status: MRClosed, CloseReason: CloseReasonMerged}},
+	}
+	if err := mgr.WriteSnapshot(written); err != nil {
+		t.Fatalf("WriteSnapshot() error = %v", err)
+	}
+
+	items, err := mgr.QueueFromSnapshot()
+	if err != nil {
+		t.Fatalf("QueueFromSnapshot() error = %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("len(items) = %d, want 2 (closed item dropped)", len(items))
+	}
+	if items[0].MR.ID != "gt-1" || items[0].Position != 1 {
+		t.Errorf("items[0] = %+v, want gt-1 at position 1", items[0])
+	}
+	if items[1].MR.ID != "gt-2" || items[1].Position != 2 {
+		t.Errorf("items[1] = %+v, want gt-2 at position 2", items[1])
+	}
+	if items[1].MR.Error != "tests failed" {
+		t.Errorf("items[1].MR.Error = %q, want %q", items[1].MR.Error, "tests failed")
+	}
+}
@@ -0,0 +1,71 @@
+package refinery
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// QueueSummaryLine renders items (and the most recently merged request, if
+// any) as a single line suitable for tmux status bars and mail subjects,
+// e.g. "queue: 3 pending, 1 in-progress (gt-abc, 4m), last merge 22m ago".
+// Used by both `gt refinery queue --summary` and the worker status line.
+func QueueSummaryLine(items []QueueItem, lastMerge *MergeRequest, now time.Time) string {
+	var processing *MergeRequest
+	pending := 0
+	failing := 0
+
+	for _, item := range items {
+		if item.MR == nil {
+			continue
+		}
+		if item.MR.Status == MRInProgress {
+			if processing == nil {
+				processing = item.MR
+			}
+			continue
+		}
+		pending++
+		if item.MR.Error != "" {
+			failing++
+		}
+	}
+
+	var parts []string
+	switch {
+	case pending == 0 && processing == nil:
+		parts = append(parts, "empty")
+	case failing == 0:
+		parts = append(parts, fmt.Sprintf("%d pending", pending))
+	default:
+		parts = append(parts, fmt.Sprintf("%d pending (%d failing)", pending, failing))
+	}
+
+	if processing != nil {
+		parts = append(parts, fmt.Sprintf("1 in-progress (%s, %s)", processing.ID, formatShortAge(now.Sub(processing.CreatedAt))))
+	}
+
+	if lastMerge != nil && lastMerge.ClosedAt != nil {
+		parts = append(parts, fmt.Sprintf("last merge %s", beads.HumanizeAge(now.Sub(*lastMerge.ClosedAt))))
+	}
+
+	return "queue: " + strings.Join(parts, ", ")
+}
+
+// formatShortAge renders d as a compact age like "4m" or "2h" - the same
+// units as beads.HumanizeAge but without the trailing "ago", for inline use
+// like "in-progress (gt-abc, 4m)".
+func formatShortAge(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+}
@@ -0,0 +1,81 @@
+package refinery
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQueueSummaryLine(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	pastMerge := now.Add(-22 * time.Minute)
+
+	tests := []struct {
+		name      string
+		items     []QueueItem
+		lastMerge *MergeRequest
+		want      string
+	}{
+		{
+			name: "empty queue, no merges yet",
+			want: "queue: empty",
+		},
+		{
+			name:      "empty queue, with a past merge",
+			lastMerge: &MergeRequest{ID: "gt-old", ClosedAt: &pastMerge},
+			want:      "queue: empty, last merge 22m ago",
+		},
+		{
+			name: "busy queue with pending and processing",
+			items: []QueueItem{
+				{MR: &MergeRequest{ID: "gt-abc", Status: MRInProgress, CreatedAt: now.Add(-4 * time.Minute)}},
+				{MR: &MergeRequest{ID: "gt-a", Status: MROpen}},
+				{MR: &MergeRequest{ID: "gt-b", Status: MROpen}},
+				{MR: &MergeRequest{ID: "gt-c", Status: MROpen}},
+			},
+			lastMerge: &MergeRequest{ID: "gt-old", ClosedAt: &pastMerge},
+			want:      "queue: 3 pending, 1 in-progress (gt-abc, 4m), last merge 22m ago",
+		},
+		{
+			name: "pending only, nothing processing",
+			items: []QueueItem{
+				{MR: &MergeRequest{ID: "gt-a", Status: MROpen}},
+			},
+			want: "queue: 1 pending",
+		},
+		{
+			name: "error state - a pending item is failing",
+			items: []QueueItem{
+				{MR: &MergeRequest{ID: "gt-a", Status: MROpen, Error: "tests failed: TestFoo"}},
+				{MR: &MergeRequest{ID: "gt-b", Status: MROpen}},
+			},
+			want: "queue: 2 pending (1 failing)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := QueueSummaryLine(tt.items, tt.lastMerge, now)
+			if got != tt.want {
+				t.Errorf("QueueSummaryLine() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatShortAge(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{30 * time.Second, "30s"},
+		{4 * time.Minute, "4m"},
+		{2 * time.Hour, "2h"},
+		{3 * 24 * time.Hour, "3d"},
+	}
+
+	for _, tt := range tests {
+		if got := formatShortAge(tt.d); got != tt.want {
+			t.Errorf("formatShortAge(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
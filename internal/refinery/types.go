@@ -30,6 +30,9 @@ type MergeRequest struct {
 	// TargetBranch is where this should merge (usually integration or main).
 	TargetBranch string `json:"target_branch"`
 
+	// Priority is the underlying issue priority (0=P0/critical, 4=P4/backlog).
+	Priority int `json:"priority"`
+
 	// CreatedAt is when the MR was queued.
 	CreatedAt time.Time `json:"created_at"`
 
@@ -41,6 +44,15 @@ type MergeRequest struct {
 
 	// Error contains error details if the MR failed.
 	Error string `json:"error,omitempty"`
+
+	// ReviewedBy is the identity that approved this MR via `gt witness
+	// review --approve`, or empty if not yet reviewed. Only meaningful when
+	// the rig's merge_queue.require_review is enabled.
+	ReviewedBy string `json:"reviewed_by,omitempty"`
+
+	// ClosedAt is when the MR was closed (merged, rejected, etc), or nil if
+	// still open. Only populated by Manager.LastMerge today.
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
 }
 
 // MRStatus represents the status of a merge request.
@@ -75,7 +87,6 @@ const (
 	CloseReasonSuperseded CloseReason = "superseded"
 )
 
-
 // MergeConfig contains configuration for the merge process.
 type MergeConfig struct {
 	// RunTests controls whether tests are run after merge.
@@ -113,9 +124,13 @@ func DefaultMergeConfig() MergeConfig {
 
 // QueueItem represents an item in the merge queue for display.
 type QueueItem struct {
-	Position  int       `json:"position"`
-	MR        *MergeRequest `json:"mr"`
-	Age       string    `json:"age"`
+	Position int           `json:"position"`
+	MR       *MergeRequest `json:"mr"`
+	Age      string        `json:"age"`
+
+	// SupersededIDs lists MR IDs that were closed as duplicates of this one
+	// (same worker+issue, or same branch, with an older branch tip commit).
+	SupersededIDs []string `json:"superseded_ids,omitempty"`
 }
 
 // State transition errors.
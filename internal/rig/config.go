@@ -33,10 +33,12 @@ type ConfigResult struct {
 var SystemDefaults = map[string]interface{}{
 	"status":                  "operational",
 	"auto_restart":            true,
-	"max_polecats":            10,
+	"max_polecats":            0, // 0 = unlimited
 	"priority_adjustment":     0,
 	"dnd":                     false,
 	"polecat_branch_template": "", // Empty = use default behavior (polecat/{name}/...)
+	"allow_test_skip":         false,
+	"notify_on_urgent":        false,
 }
 
 // StackingKeys defines which keys use stacking semantics (values add up).
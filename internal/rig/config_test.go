@@ -36,10 +36,10 @@ func TestGetConfig_SystemDefaults(t *testing.T) {
 		t.Error("expected auto_restart to be true by default")
 	}
 
-	// Test int default
+	// Test int default (0 = unlimited)
 	maxPolecats := rig.GetIntConfig("max_polecats")
-	if maxPolecats != 10 {
-		t.Errorf("expected max_polecats=10, got %d", maxPolecats)
+	if maxPolecats != 0 {
+		t.Errorf("expected max_polecats=0 (unlimited), got %d", maxPolecats)
 	}
 }
 
@@ -80,6 +80,12 @@ type RigConfig struct {
 	DefaultBranch string       `json:"default_branch,omitempty"` // main, master, etc.
 	CreatedAt     time.Time    `json:"created_at"`               // when rig was created
 	Beads         *BeadsConfig `json:"beads,omitempty"`
+
+	// PreserveOnRecreate lists glob patterns (relative to a polecat's worktree
+	// root) of untracked files to carry over when a polecat's worktree is
+	// wiped and recreated, e.g. [".env", ".envrc", "local/"]. Everything else
+	// untracked is discarded as before.
+	PreserveOnRecreate []string `json:"preserve_on_recreate,omitempty"`
 }
 
 // BeadsConfig represents beads configuration for the rig.
@@ -210,7 +216,8 @@ func (m *Manager) loadRig(name string, entry config.RigEntry) (*Rig, error) {
 // AddRigOptions configures rig creation.
 type AddRigOptions struct {
 	Name          string // Rig name (directory name)
-	GitURL        string // Repository URL
+	GitURL        string // Repository URL (canonical - recorded in rigs.json regardless of SourcePath)
+	SourcePath    string // Optional local checkout to clone directly from instead of GitURL
 	BeadsPrefix   string // Beads issue prefix (defaults to derived from name)
 	LocalRepo     string // Optional local repo for reference clones
 	DefaultBranch string // Default branch (defaults to auto-detected from remote)
@@ -286,11 +293,27 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 		opts.BeadsPrefix = deriveBeadsPrefix(opts.Name)
 	}
 
+	// Reject a colliding prefix before any filesystem work - otherwise the
+	// rig and the one it collides with would silently share a beads
+	// database, a bug that's usually only diagnosed hours later.
+	if err := config.CheckPrefixAvailable(m.config, opts.BeadsPrefix); err != nil {
+		return nil, err
+	}
+
 	localRepo, warn := resolveLocalRepo(opts.LocalRepo, opts.GitURL)
 	if warn != "" {
 		fmt.Printf("  Warning: %s\n", warn)
 	}
 
+	// cloneSource is what we actually clone from. Normally that's GitURL,
+	// but when the caller passed an existing local checkout (SourcePath),
+	// clone directly from it - no network needed - while still recording
+	// the canonical GitURL below for future fetches/pushes/clones.
+	cloneSource := opts.GitURL
+	if opts.SourcePath != "" {
+		cloneSource = opts.SourcePath
+	}
+
 	// Create container directory
 	if err := os.MkdirAll(rigPath, 0755); err != nil {
 		return nil, fmt.Errorf("creating rig directory: %w", err)
@@ -327,15 +350,15 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 	fmt.Printf("  Cloning repository (this may take a moment)...\n")
 	bareRepoPath := filepath.Join(rigPath, ".repo.git")
 	if localRepo != "" {
-		if err := m.git.CloneBareWithReference(opts.GitURL, bareRepoPath, localRepo); err != nil {
+		if err := m.git.CloneBareWithReference(cloneSource, bareRepoPath, localRepo); err != nil {
 			fmt.Printf("  Warning: could not use local repo reference: %v\n", err)
 			_ = os.RemoveAll(bareRepoPath)
-			if err := m.git.CloneBare(opts.GitURL, bareRepoPath); err != nil {
+			if err := m.git.CloneBare(cloneSource, bareRepoPath); err != nil {
 				return nil, wrapCloneError(err, opts.GitURL)
 			}
 		}
 	} else {
-		if err := m.git.CloneBare(opts.GitURL, bareRepoPath); err != nil {
+		if err := m.git.CloneBare(cloneSource, bareRepoPath); err != nil {
 			return nil, wrapCloneError(err, opts.GitURL)
 		}
 	}
@@ -368,15 +391,15 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 		return nil, fmt.Errorf("creating mayor dir: %w", err)
 	}
 	if localRepo != "" {
-		if err := m.git.CloneWithReference(opts.GitURL, mayorRigPath, localRepo); err != nil {
+		if err := m.git.CloneWithReference(cloneSource, mayorRigPath, localRepo); err != nil {
 			fmt.Printf("  Warning: could not use local repo reference: %v\n", err)
 			_ = os.RemoveAll(mayorRigPath)
-			if err := m.git.Clone(opts.GitURL, mayorRigPath); err != nil {
+			if err := m.git.Clone(cloneSource, mayorRigPath); err != nil {
 				return nil, fmt.Errorf("cloning for mayor: %w", err)
 			}
 		}
 	} else {
-		if err := m.git.Clone(opts.GitURL, mayorRigPath); err != nil {
+		if err := m.git.Clone(cloneSource, mayorRigPath); err != nil {
 			return nil, fmt.Errorf("cloning for mayor: %w", err)
 		}
 	}
@@ -388,6 +411,19 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 	}
 	fmt.Printf("   ✓ Created mayor clone\n")
 
+	// When we cloned from a local checkout rather than GitURL, "origin" on
+	// both clones now points at that local path. Repoint it at the
+	// canonical URL so future fetches/pushes go against the real remote,
+	// not a path that may not exist on other machines or later.
+	if opts.SourcePath != "" && opts.GitURL != "" && opts.GitURL != cloneSource {
+		if err := bareGit.SetRemoteURL("origin", opts.GitURL); err != nil {
+			fmt.Printf("  Warning: could not repoint bare repo's origin at %s: %v\n", opts.GitURL, err)
+		}
+		if err := mayorGit.SetRemoteURL("origin", opts.GitURL); err != nil {
+			fmt.Printf("  Warning: could not repoint mayor clone's origin at %s: %v\n", opts.GitURL, err)
+		}
+	}
+
 	// Check if source repo has tracked .beads/ directory.
 	// If so, we need to initialize the database (beads.db is gitignored so it doesn't exist after clone).
 	sourceBeadsDir := filepath.Join(mayorRigPath, ".beads")
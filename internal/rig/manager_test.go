@@ -231,6 +231,48 @@ func TestAddRig_RejectsInvalidNames(t *testing.T) {
 	}
 }
 
+func TestAddRig_RejectsCollidingPrefix(t *testing.T) {
+	root, rigsConfig := setupTestTown(t)
+	rigsConfig.Rigs["alpha"] = config.RigEntry{
+		GitURL:      "git@github.com:test/alpha.git",
+		BeadsConfig: &config.BeadsConfig{Prefix: "gt-"},
+	}
+	manager := NewManager(root, rigsConfig, git.NewGit(root))
+
+	_, err := manager.AddRig(AddRigOptions{
+		Name:        "bravo",
+		GitURL:      "git@github.com:test/bravo.git",
+		BeadsPrefix: "gt",
+	})
+	if err == nil {
+		t.Fatal("AddRig with a colliding prefix should error")
+	}
+	if !strings.Contains(err.Error(), "alpha") {
+		t.Errorf("AddRig error = %q, want it to name the colliding rig 'alpha'", err.Error())
+	}
+
+	if _, statErr := os.Stat(filepath.Join(root, "bravo")); !os.IsNotExist(statErr) {
+		t.Error("AddRig should reject the prefix before creating the rig directory")
+	}
+}
+
+func TestAddRig_RejectsTownPrefix(t *testing.T) {
+	root, rigsConfig := setupTestTown(t)
+	manager := NewManager(root, rigsConfig, git.NewGit(root))
+
+	_, err := manager.AddRig(AddRigOptions{
+		Name:        "hqlike",
+		GitURL:      "git@github.com:test/hqlike.git",
+		BeadsPrefix: "hq",
+	})
+	if err == nil {
+		t.Fatal("AddRig with the town-level prefix should error")
+	}
+	if !strings.Contains(err.Error(), "town-level") {
+		t.Errorf("AddRig error = %q, want it to mention the town-level prefix", err.Error())
+	}
+}
+
 func TestListRigNames(t *testing.T) {
 	root, rigsConfig := setupTestTown(t)
 	rigsConfig.Rigs["rig1"] = config.RigEntry{}
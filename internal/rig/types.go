@@ -51,21 +51,38 @@ var AgentDirs = []string{
 
 // RigSummary provides a concise overview of a rig.
 type RigSummary struct {
-	Name         string `json:"name"`
-	PolecatCount int    `json:"polecat_count"`
-	CrewCount    int    `json:"crew_count"`
-	HasWitness   bool   `json:"has_witness"`
-	HasRefinery  bool   `json:"has_refinery"`
+	Name         string   `json:"name"`
+	PolecatCount int      `json:"polecat_count"`
+	MaxPolecats  int      `json:"max_polecats,omitempty"`
+	CrewCount    int      `json:"crew_count"`
+	HasWitness   bool     `json:"has_witness"`
+	HasRefinery  bool     `json:"has_refinery"`
+	HasMayor     bool     `json:"has_mayor"`
+	Agents       []string `json:"agents,omitempty"`
 }
 
 // Summary returns a RigSummary for this rig.
 func (r *Rig) Summary() RigSummary {
+	var agents []string
+	if r.HasRefinery {
+		agents = append(agents, "refinery")
+	}
+	if r.HasWitness {
+		agents = append(agents, "witness")
+	}
+	if r.HasMayor {
+		agents = append(agents, "mayor")
+	}
+
 	return RigSummary{
 		Name:         r.Name,
 		PolecatCount: len(r.Polecats),
+		MaxPolecats:  r.GetIntConfig("max_polecats"),
 		CrewCount:    len(r.Crew),
 		HasWitness:   r.HasWitness,
 		HasRefinery:  r.HasRefinery,
+		HasMayor:     r.HasMayor,
+		Agents:       agents,
 	}
 }
 
@@ -0,0 +1,54 @@
+package session
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// readyPromptPrefix is the character Claude Code prints at the start of
+// its input prompt once it has finished booting.
+const readyPromptPrefix = "❯" // ❯
+
+// paneCapturer is the subset of *tmux.Tmux that WaitForReady needs,
+// extracted so tests can drive a fake instead of real tmux.
+type paneCapturer interface {
+	CapturePaneLines(session string, lines int) ([]string, error)
+}
+
+// WaitForReady polls t's pane for session until the Claude prompt appears,
+// backing off exponentially between polls (starting at 100ms, capped at
+// 2s) instead of sleeping a fixed duration or hammering tmux on every
+// tick. Returns an error if the prompt hasn't appeared by timeout.
+func WaitForReady(t paneCapturer, sessionName string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	backoff := 100 * time.Millisecond
+	const maxBackoff = 2 * time.Second
+
+	for {
+		lines, err := t.CapturePaneLines(sessionName, 10)
+		if err == nil {
+			for _, line := range lines {
+				if strings.HasPrefix(strings.TrimSpace(line), readyPromptPrefix) {
+					return nil
+				}
+			}
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return fmt.Errorf("timed out after %s waiting for %s to become ready", timeout, sessionName)
+		}
+
+		sleep := backoff
+		if sleep > remaining {
+			sleep = remaining
+		}
+		time.Sleep(sleep)
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
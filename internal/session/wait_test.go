@@ -0,0 +1,46 @@
+package session
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakePaneCapturer returns "not ready" output for the first readyAfter
+// calls, then a line starting with the Claude prompt.
+type fakePaneCapturer struct {
+	readyAfter int
+	calls      int
+}
+
+func (f *fakePaneCapturer) CapturePaneLines(session string, lines int) ([]string, error) {
+	f.calls++
+	if f.calls > f.readyAfter {
+		return []string{"some output", "❯ "}, nil
+	}
+	return []string{"Booting Claude Code..."}, nil
+}
+
+func TestWaitForReady_BecomesReadyAfterPolls(t *testing.T) {
+	fake := &fakePaneCapturer{readyAfter: 3}
+
+	err := WaitForReady(fake, "gt-wyvern-toast", time.Second)
+	if err != nil {
+		t.Fatalf("WaitForReady() error = %v, want nil", err)
+	}
+	if fake.calls < 4 {
+		t.Errorf("expected at least 4 polls (3 not-ready + 1 ready), got %d", fake.calls)
+	}
+}
+
+func TestWaitForReady_Timeout(t *testing.T) {
+	fake := &fakePaneCapturer{readyAfter: 1000}
+
+	err := WaitForReady(fake, "gt-wyvern-toast", 300*time.Millisecond)
+	if err == nil {
+		t.Fatal("WaitForReady() error = nil, want timeout error")
+	}
+	if !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("WaitForReady() error = %q, want it to mention timeout", err.Error())
+	}
+}
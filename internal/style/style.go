@@ -36,23 +36,48 @@ var (
 	// Bold style for emphasis
 	Bold = lipgloss.NewStyle().
 		Bold(true)
+)
+
+// SuccessPrefix is the checkmark prefix for success messages. A function
+// rather than a var so it reflects --plain / non-TTY state at call time
+// (icon consts are resolved lazily by ui.IconPass, and Render itself
+// consults the shared lipgloss renderer, which --plain also updates).
+func SuccessPrefix() string {
+	return Success.Render(ui.IconPass())
+}
 
-	// SuccessPrefix is the checkmark prefix for success messages
-	SuccessPrefix = Success.Render(ui.IconPass)
+// WarningPrefix is the warning prefix.
+func WarningPrefix() string {
+	return Warning.Render(ui.IconWarn())
+}
 
-	// WarningPrefix is the warning prefix
-	WarningPrefix = Warning.Render(ui.IconWarn)
+// ErrorPrefix is the error prefix.
+func ErrorPrefix() string {
+	return Error.Render(ui.IconFail())
+}
 
-	// ErrorPrefix is the error prefix
-	ErrorPrefix = Error.Render(ui.IconFail)
+// ArrowPrefix for action indicators.
+func ArrowPrefix() string {
+	if !ui.ShouldUseEmoji() {
+		return Info.Render("->")
+	}
+	return Info.Render("→")
+}
 
-	// ArrowPrefix for action indicators
-	ArrowPrefix = Info.Render("→")
-)
+// Icon returns emoji when emoji output is appropriate (TTY, no --plain, no
+// GT_NO_EMOJI), and ascii otherwise. Use this at call sites with a raw
+// literal emoji that isn't already covered by the ui.Icon* system, so
+// hook-consumed or piped output stays machine-readable.
+func Icon(emoji, ascii string) string {
+	if ui.ShouldUseEmoji() {
+		return emoji
+	}
+	return ascii
+}
 
 // PrintWarning prints a warning message with consistent formatting.
 // The format and args work like fmt.Printf.
 func PrintWarning(format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
-	fmt.Printf("%s %s\n", Warning.Render(ui.IconWarn+" Warning:"), msg)
+	fmt.Printf("%s %s\n", Warning.Render(ui.IconWarn()+" Warning:"), msg)
 }
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"testing"
+
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 func TestStyleVariables(t *testing.T) {
@@ -42,10 +45,10 @@ func TestPrefixVariables(t *testing.T) {
 		name   string
 		prefix string
 	}{
-		{"SuccessPrefix", SuccessPrefix},
-		{"WarningPrefix", WarningPrefix},
-		{"ErrorPrefix", ErrorPrefix},
-		{"ArrowPrefix", ArrowPrefix},
+		{"SuccessPrefix", SuccessPrefix()},
+		{"WarningPrefix", WarningPrefix()},
+		{"ErrorPrefix", ErrorPrefix()},
+		{"ArrowPrefix", ArrowPrefix()},
 	}
 
 	for _, tt := range tests {
@@ -161,6 +164,37 @@ func TestMultiplePrintWarning(t *testing.T) {
 	}
 }
 
+// TestPrintWarning_Plain verifies that ui.SetPlain(true) strips PrintWarning's
+// escape codes and swaps its emoji-adjacent icon for the ASCII form, so
+// hook-injected/piped output stays parseable.
+func TestPrintWarning_Plain(t *testing.T) {
+	ui.SetPlain(true)
+	defer ui.SetPlain(false)
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	PrintWarning("disk space low")
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	var buf bytes.Buffer
+	io.Copy(&buf, r)
+	output := buf.String()
+
+	if strings.Contains(output, "\x1b[") {
+		t.Errorf("PrintWarning() under --plain should not emit ANSI escape codes, got %q", output)
+	}
+	if !strings.Contains(output, "[WARN]") {
+		t.Errorf("PrintWarning() under --plain should use the [WARN] ASCII icon, got %q", output)
+	}
+	if strings.Contains(output, "⚠") {
+		t.Errorf("PrintWarning() under --plain should not contain the unicode warning glyph, got %q", output)
+	}
+}
+
 func ExamplePrintWarning() {
 	// This example demonstrates PrintWarning usage
 	fmt.Print("Example output:\n")
@@ -242,7 +242,7 @@ func DAGProgress(steps map[string]string, phases []string) string {
 func SuggestionBox(message string, suggestions []string, hint string) string {
 	var sb strings.Builder
 
-	sb.WriteString(fmt.Sprintf("\n%s %s\n", ErrorPrefix, message))
+	sb.WriteString(fmt.Sprintf("\n%s %s\n", ErrorPrefix(), message))
 
 	if len(suggestions) > 0 {
 		sb.WriteString("\n  Did you mean?\n")
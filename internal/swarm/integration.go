@@ -4,8 +4,12 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
+
+	"github.com/steveyegge/gastown/internal/refinery"
 )
 
 // Integration branch errors
@@ -101,13 +105,89 @@ func (m *Manager) AbortMerge() error {
 	return m.gitRun("merge", "--abort")
 }
 
-// LandToMain merges the integration branch to the target branch (usually main).
-func (m *Manager) LandToMain(swarmID string) error {
+// LandTestGateError reports a failed pre-merge landing gate run, along with
+// the test command and its captured combined output.
+type LandTestGateError struct {
+	Command string
+	Output  string
+	Err     error
+}
+
+func (e *LandTestGateError) Error() string {
+	return fmt.Sprintf("landing test gate failed (%s): %v", e.Command, e.Err)
+}
+
+func (e *LandTestGateError) Unwrap() error {
+	return e.Err
+}
+
+// landTestCommand resolves the test command to run before landing: the
+// swarm's own LandTestCommand override if set, otherwise the rig's
+// merge_queue test command.
+func (m *Manager) landTestCommand(swarm *Swarm) string {
+	if swarm.LandTestCommand != "" {
+		return swarm.LandTestCommand
+	}
+
+	eng := refinery.NewEngineer(m.rig)
+	if err := eng.LoadConfig(); err != nil {
+		return ""
+	}
+	return eng.Config().TestCommand
+}
+
+// runLandingTestGate checks out the integration branch in a scratch worktree
+// and runs the resolved test command there, so a broken swarm never reaches
+// the target branch. A swarm with no test command configured (neither a
+// LandTestCommand override nor a rig merge_queue TestCommand) skips the gate.
+func (m *Manager) runLandingTestGate(swarm *Swarm) error {
+	testCmd := m.landTestCommand(swarm)
+	if testCmd == "" {
+		return nil
+	}
+
+	worktreePath := filepath.Join(m.rig.Path, ".runtime", "swarm-land-gate", swarm.ID)
+	_ = os.RemoveAll(worktreePath)
+	if err := m.gitRun("worktree", "add", "--force", worktreePath, swarm.Integration); err != nil {
+		return fmt.Errorf("creating landing gate worktree: %w", err)
+	}
+	defer func() {
+		_ = m.gitRun("worktree", "remove", "--force", worktreePath)
+	}()
+
+	// Note: testCmd comes from the rig's config.json / a land-test-command:
+	// label (trusted infrastructure config, not from worker branches). Shell
+	// execution is intentional for flexibility (pipes, &&, etc), matching the
+	// refinery merge queue's test runner.
+	cmd := exec.Command("sh", "-c", testCmd) //nolint:gosec // G204: testCmd is from trusted rig config / labels
+	cmd.Dir = worktreePath
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	if err := cmd.Run(); err != nil {
+		return &LandTestGateError{Command: testCmd, Output: output.String(), Err: err}
+	}
+
+	return nil
+}
+
+// LandToMain merges the integration branch to the target branch (usually
+// main). Unless skipTests is set, it first runs the landing test gate against
+// the integration branch in a scratch worktree and refuses to merge if the
+// tests fail, returning a *LandTestGateError.
+func (m *Manager) LandToMain(swarmID string, skipTests bool) error {
 	swarm, err := m.LoadSwarm(swarmID)
 	if err != nil {
 		return err
 	}
 
+	if !skipTests {
+		if err := m.runLandingTestGate(swarm); err != nil {
+			return err
+		}
+	}
+
 	// Checkout target branch
 	if err := m.gitRun("checkout", swarm.TargetBranch); err != nil {
 		return fmt.Errorf("checking out %s: %w", swarm.TargetBranch, err)
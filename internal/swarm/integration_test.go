@@ -1,6 +1,8 @@
 package swarm
 
 import (
+	"os/exec"
+	"strings"
 	"testing"
 
 	"github.com/steveyegge/gastown/internal/rig"
@@ -20,5 +22,87 @@ func TestGetWorkerBranch(t *testing.T) {
 	}
 }
 
-// Note: Integration tests that require git operations and beads
-// are covered by the E2E test (gt-kc7yj.4).
+// newLandingGateTestRepo creates a temp git repo with an initial commit on
+// main and an "integration" branch, returning a Manager rooted there.
+func newLandingGateTestRepo(t *testing.T) *Manager {
+	t.Helper()
+	dir := t.TempDir()
+
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	run("init", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "Test")
+	run("commit", "--allow-empty", "-m", "initial commit")
+	run("checkout", "-b", "integration")
+	run("checkout", "main")
+
+	return &Manager{
+		rig:      &rig.Rig{Name: "test-rig", Path: dir},
+		beadsDir: dir,
+		gitDir:   dir,
+	}
+}
+
+func TestRunLandingTestGate_FailingCommandBlocksLanding(t *testing.T) {
+	m := newLandingGateTestRepo(t)
+	swarm := &Swarm{
+		ID:              "sw-1",
+		Integration:     "integration",
+		LandTestCommand: "echo boom && exit 1",
+	}
+
+	err := m.runLandingTestGate(swarm)
+	if err == nil {
+		t.Fatal("runLandingTestGate should fail for a deliberately failing test command")
+	}
+
+	gateErr, ok := err.(*LandTestGateError)
+	if !ok {
+		t.Fatalf("err = %T, want *LandTestGateError", err)
+	}
+	if gateErr.Command != swarm.LandTestCommand {
+		t.Errorf("Command = %q, want %q", gateErr.Command, swarm.LandTestCommand)
+	}
+	if !strings.Contains(gateErr.Output, "boom") {
+		t.Errorf("Output = %q, want it to contain %q", gateErr.Output, "boom")
+	}
+}
+
+func TestRunLandingTestGate_PassingCommandAllowsLanding(t *testing.T) {
+	m := newLandingGateTestRepo(t)
+	swarm := &Swarm{
+		ID:              "sw-2",
+		Integration:     "integration",
+		LandTestCommand: "true",
+	}
+
+	if err := m.runLandingTestGate(swarm); err != nil {
+		t.Errorf("runLandingTestGate = %v, want nil", err)
+	}
+}
+
+func TestRunLandingTestGate_NoCommandSkipsGate(t *testing.T) {
+	m := newLandingGateTestRepo(t)
+	swarm := &Swarm{
+		ID:          "sw-3",
+		Integration: "integration",
+		RigName:     "test-rig",
+	}
+
+	// No LandTestCommand and no rig merge_queue config to fall back to -
+	// the gate should be a no-op rather than erroring.
+	if err := m.runLandingTestGate(swarm); err != nil {
+		t.Errorf("runLandingTestGate = %v, want nil (gate should be skipped)", err)
+	}
+}
+
+// Note: Integration tests that require beads (`bd`) are covered by the E2E
+// test (gt-kc7yj.4).
@@ -2,6 +2,7 @@ package swarm
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"os/exec"
 	"strings"
@@ -22,6 +23,11 @@ type LandingConfig struct {
 
 	// SkipGitAudit skips the git safety audit.
 	SkipGitAudit bool
+
+	// SkipTests skips the pre-merge landing test gate. Callers should warn
+	// loudly before setting this - it means a broken swarm can reach the
+	// target branch.
+	SkipTests bool
 }
 
 // LandingResult contains the result of a landing operation.
@@ -99,13 +105,32 @@ func (m *Manager) ExecuteLanding(swarmID string, config LandingConfig) (*Landing
 		}
 	}
 
-	// Phase 3: Cleanup branches
+	// Phase 3: Run the landing test gate and merge to the target branch
+	if err := m.LandToMain(swarmID, config.SkipTests); err != nil {
+		var gateErr *LandTestGateError
+		if errors.As(err, &gateErr) {
+			swarm.State = SwarmMerging
+			swarm.Error = gateErr.Error()
+			result.Error = fmt.Sprintf("landing test gate failed, swarm left in merging state: %s", gateErr.Error())
+
+			if config.TownRoot != "" {
+				m.notifyMayorTestGateFailed(config.TownRoot, swarm, gateErr)
+			}
+
+			return result, nil
+		}
+
+		result.Error = fmt.Sprintf("merging to %s: %v", swarm.TargetBranch, err)
+		return result, nil
+	}
+
+	// Phase 4: Cleanup branches
 	if err := m.CleanupBranches(swarmID); err != nil {
 		// Log but continue
 	}
 	result.BranchesCleaned = len(swarm.Tasks) + 1 // tasks + integration
 
-	// Phase 4: Update swarm state
+	// Phase 5: Update swarm state
 	swarm.State = SwarmLanded
 	swarm.UpdatedAt = time.Now()
 
@@ -213,6 +238,29 @@ Manual intervention required.`,
 	_ = router.Send(msg) // best-effort notification
 }
 
+// notifyMayorTestGateFailed alerts Mayor that the pre-merge landing gate
+// rejected a swarm, with the captured test output attached.
+func (m *Manager) notifyMayorTestGateFailed(_ string, swarm *Swarm, gateErr *LandTestGateError) { // townRoot unused: router uses gitDir
+	router := mail.NewRouter(m.gitDir)
+	msg := &mail.Message{
+		From:    fmt.Sprintf("%s/refinery", m.rig.Name),
+		To:      "mayor/",
+		Subject: fmt.Sprintf("Landing test gate failed for swarm %s", swarm.ID),
+		Body: fmt.Sprintf(`Landing blocked for swarm %s.
+
+Test command: %s
+
+Output:
+%s
+
+Swarm left in 'merging' state. Fix the failure on the integration branch
+(%s) and re-run 'gt swarm land %s', or pass --skip-tests to override.`,
+			swarm.ID, gateErr.Command, gateErr.Output, swarm.Integration, swarm.ID),
+		Priority: mail.PriorityHigh,
+	}
+	_ = router.Send(msg) // best-effort notification
+}
+
 // notifyMayorLanded sends a landing report to Mayor.
 func (m *Manager) notifyMayorLanded(_ string, swarm *Swarm, result *LandingResult) { // townRoot unused: router uses gitDir
 	router := mail.NewRouter(m.gitDir)
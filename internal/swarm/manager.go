@@ -13,19 +13,19 @@ import (
 
 // Common errors
 var (
-	ErrSwarmNotFound  = errors.New("swarm not found")
-	ErrSwarmExists    = errors.New("swarm already exists")
-	ErrInvalidState   = errors.New("invalid state transition")
-	ErrNoReadyTasks   = errors.New("no ready tasks")
-	ErrBeadsNotFound  = errors.New("beads not available")
+	ErrSwarmNotFound = errors.New("swarm not found")
+	ErrSwarmExists   = errors.New("swarm already exists")
+	ErrInvalidState  = errors.New("invalid state transition")
+	ErrNoReadyTasks  = errors.New("no ready tasks")
+	ErrBeadsNotFound = errors.New("beads not available")
 )
 
 // Manager handles swarm lifecycle operations.
 // Manager is stateless - all swarm state is discovered from beads.
 type Manager struct {
-	rig       *rig.Rig
-	beadsDir  string // Path for beads operations (git-synced)
-	gitDir    string // Path for git operations (rig root)
+	rig      *rig.Rig
+	beadsDir string // Path for beads operations (git-synced)
+	gitDir   string // Path for git operations (rig root)
 }
 
 // NewManager creates a new swarm manager for a rig.
@@ -54,12 +54,13 @@ func (m *Manager) LoadSwarm(epicID string) (*Swarm, error) {
 
 	// Parse the epic
 	var epic struct {
-		ID        string `json:"id"`
-		Title     string `json:"title"`
-		Status    string `json:"status"`
-		MolType   string `json:"mol_type"`
-		CreatedAt string `json:"created_at"`
-		UpdatedAt string `json:"updated_at"`
+		ID        string   `json:"id"`
+		Title     string   `json:"title"`
+		Status    string   `json:"status"`
+		MolType   string   `json:"mol_type"`
+		CreatedAt string   `json:"created_at"`
+		UpdatedAt string   `json:"updated_at"`
+		Labels    []string `json:"labels"`
 	}
 	if err := json.Unmarshal(stdout.Bytes(), &epic); err != nil {
 		return nil, fmt.Errorf("parsing epic: %w", err)
@@ -94,6 +95,14 @@ func (m *Manager) LoadSwarm(epicID string) (*Swarm, error) {
 		Tasks:        []SwarmTask{},
 	}
 
+	// A land-test-command:<cmd> label overrides the rig's merge_queue test
+	// command for this swarm's landing gate.
+	for _, label := range epic.Labels {
+		if cmd, ok := strings.CutPrefix(label, "land-test-command:"); ok {
+			swarm.LandTestCommand = cmd
+		}
+	}
+
 	// Load tasks from beads (children of the epic)
 	tasks, err := m.loadTasksFromBeads(epicID)
 	if err == nil {
@@ -109,6 +118,44 @@ func (m *Manager) LoadSwarm(epicID string) (*Swarm, error) {
 	return swarm, nil
 }
 
+// EpicTaskValidation reports which of an epic's children are actually
+// dispatchable, so a swarm isn't created against an epic that would
+// immediately get stuck with nothing to hand out.
+type EpicTaskValidation struct {
+	Ready   []SwarmTask // open and unassigned - safe to swarm
+	Blocked []string    // human-readable reasons a task was excluded
+}
+
+// ValidateEpicTasks loads the epic's children and classifies each one as
+// ready (open, unassigned) or blocked (closed, or already assigned to a
+// worker). Callers should refuse or warn before creating a swarm when Ready
+// is empty.
+func (m *Manager) ValidateEpicTasks(epicID string) (*EpicTaskValidation, error) {
+	tasks, err := m.loadTasksFromBeads(epicID)
+	if err != nil {
+		return nil, err
+	}
+	return classifyEpicTasks(tasks), nil
+}
+
+// classifyEpicTasks splits an epic's children into ready and blocked. It's
+// a pure function so the warn/strict decision logic in ValidateEpicTasks
+// can be tested without shelling out to bd.
+func classifyEpicTasks(tasks []SwarmTask) *EpicTaskValidation {
+	result := &EpicTaskValidation{}
+	for _, task := range tasks {
+		switch {
+		case task.State == TaskMerged:
+			result.Blocked = append(result.Blocked, fmt.Sprintf("%s: already closed", task.IssueID))
+		case task.Assignee != "":
+			result.Blocked = append(result.Blocked, fmt.Sprintf("%s: already assigned to %s", task.IssueID, task.Assignee))
+		default:
+			result.Ready = append(result.Ready, task)
+		}
+	}
+	return result
+}
+
 // appendUnique appends s to slice if not already present.
 func appendUnique(slice []string, s string) []string {
 	for _, v := range slice {
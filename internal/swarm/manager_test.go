@@ -1,6 +1,7 @@
 package swarm
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/steveyegge/gastown/internal/rig"
@@ -63,16 +64,77 @@ func TestIsCompleteNotFound(t *testing.T) {
 	}
 }
 
+// TestClassifyEpicTasksAllReady verifies that a mix of pending tasks with
+// no assignee are all reported as ready with nothing blocked.
+func TestClassifyEpicTasksAllReady(t *testing.T) {
+	tasks := []SwarmTask{
+		{IssueID: "gt-1", State: TaskPending},
+		{IssueID: "gt-2", State: TaskPending},
+	}
+
+	result := classifyEpicTasks(tasks)
+
+	if len(result.Ready) != 2 {
+		t.Errorf("Ready = %d, want 2", len(result.Ready))
+	}
+	if len(result.Blocked) != 0 {
+		t.Errorf("Blocked = %v, want none", result.Blocked)
+	}
+}
+
+// TestClassifyEpicTasksMixedStatus covers the warn path: some tasks are
+// closed or already assigned, but at least one is still ready.
+func TestClassifyEpicTasksMixedStatus(t *testing.T) {
+	tasks := []SwarmTask{
+		{IssueID: "gt-1", State: TaskPending},
+		{IssueID: "gt-2", State: TaskMerged},
+		{IssueID: "gt-3", State: TaskInProgress, Assignee: "Toast"},
+	}
+
+	result := classifyEpicTasks(tasks)
+
+	if len(result.Ready) != 1 || result.Ready[0].IssueID != "gt-1" {
+		t.Errorf("Ready = %v, want [gt-1]", result.Ready)
+	}
+	if len(result.Blocked) != 2 {
+		t.Fatalf("Blocked = %v, want 2 entries", result.Blocked)
+	}
+	if !strings.Contains(result.Blocked[0], "gt-2") || !strings.Contains(result.Blocked[0], "closed") {
+		t.Errorf("Blocked[0] = %q, want mention of gt-2 being closed", result.Blocked[0])
+	}
+	if !strings.Contains(result.Blocked[1], "gt-3") || !strings.Contains(result.Blocked[1], "Toast") {
+		t.Errorf("Blocked[1] = %q, want mention of gt-3 assigned to Toast", result.Blocked[1])
+	}
+}
+
+// TestClassifyEpicTasksAllBlocked covers the strict path: every child is
+// closed or assigned, so Ready is empty and callers should refuse or warn.
+func TestClassifyEpicTasksAllBlocked(t *testing.T) {
+	tasks := []SwarmTask{
+		{IssueID: "gt-1", State: TaskMerged},
+		{IssueID: "gt-2", State: TaskInProgress, Assignee: "Nux"},
+	}
+
+	result := classifyEpicTasks(tasks)
+
+	if len(result.Ready) != 0 {
+		t.Errorf("Ready = %v, want none", result.Ready)
+	}
+	if len(result.Blocked) != 2 {
+		t.Errorf("Blocked = %v, want 2 entries", result.Blocked)
+	}
+}
+
 // TestSwarmE2ELifecycle documents the end-to-end swarm integration test protocol.
 // This test documents the manual testing steps that were validated for gt-kc7yj.4.
 //
 // The test scenario creates a DAG of work:
 //
-//	     A
-//	    / \
-//	   B   C
-//	    \ /
-//	     D
+//	  A
+//	 / \
+//	B   C
+//	 \ /
+//	  D
 //
 // Test Results (verified 2025-12-29):
 //
@@ -72,7 +72,13 @@ type Swarm struct {
 	// Tasks is the list of tasks in this swarm.
 	Tasks []SwarmTask `json:"tasks"`
 
-	// Error contains error details if State is SwarmFailed.
+	// LandTestCommand overrides the rig's merge_queue test command for this
+	// swarm's pre-merge landing gate. Empty means fall back to the rig's
+	// configured merge_queue test command.
+	LandTestCommand string `json:"land_test_command,omitempty"`
+
+	// Error contains error details if State is SwarmFailed or the landing
+	// gate rejected the merge while State is SwarmMerging.
 	Error string `json:"error,omitempty"`
 }
 
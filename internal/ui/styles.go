@@ -184,15 +184,41 @@ var CommandStyle = lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{
 	Dark:  "#bfbdb6", // slightly brighter than standard
 })
 
-// Status icons - consistent semantic indicators
-// Design: small Unicode symbols, NOT emoji-style icons for visual consistency
-const (
-	IconPass = "✓"
-	IconWarn = "⚠"
-	IconFail = "✖"
-	IconSkip = "-"
-	IconInfo = "ℹ"
-)
+// Status icons - consistent semantic indicators.
+// Design: small Unicode symbols, NOT emoji-style icons for visual
+// consistency. Each is a function rather than a const so --plain / non-TTY
+// output (see ShouldUseEmoji) can swap in a pure-ASCII form for downstream
+// parsers, without every call site needing to know about plain mode.
+func IconPass() string {
+	if !ShouldUseEmoji() {
+		return "[OK]"
+	}
+	return "✓"
+}
+
+func IconWarn() string {
+	if !ShouldUseEmoji() {
+		return "[WARN]"
+	}
+	return "⚠"
+}
+
+func IconFail() string {
+	if !ShouldUseEmoji() {
+		return "[FAIL]"
+	}
+	return "✖"
+}
+
+// IconSkip is already plain ASCII, so it needs no plain-mode variant.
+const IconSkip = "-"
+
+func IconInfo() string {
+	if !ShouldUseEmoji() {
+		return "[INFO]"
+	}
+	return "ℹ"
+}
 
 // Issue status icons - used consistently across all commands
 // Design principle: icons > text labels for scannability
@@ -272,17 +298,17 @@ func RenderCommand(s string) string {
 
 // RenderPassIcon renders the pass icon with styling
 func RenderPassIcon() string {
-	return PassStyle.Render(IconPass)
+	return PassStyle.Render(IconPass())
 }
 
 // RenderWarnIcon renders the warning icon with styling
 func RenderWarnIcon() string {
-	return WarnStyle.Render(IconWarn)
+	return WarnStyle.Render(IconWarn())
 }
 
 // RenderFailIcon renders the fail icon with styling
 func RenderFailIcon() string {
-	return FailStyle.Render(IconFail)
+	return FailStyle.Render(IconFail())
 }
 
 // RenderSkipIcon renders the skip icon with styling
@@ -292,7 +318,7 @@ func RenderSkipIcon() string {
 
 // RenderInfoIcon renders the info icon with styling
 func RenderInfoIcon() string {
-	return AccentStyle.Render(IconInfo)
+	return AccentStyle.Render(IconInfo())
 }
 
 // === Issue Component Renderers ===
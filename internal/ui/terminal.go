@@ -3,6 +3,8 @@ package ui
 import (
 	"os"
 
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
 	"golang.org/x/term"
 )
 
@@ -11,9 +13,37 @@ func IsTerminal() bool {
 	return term.IsTerminal(int(os.Stdout.Fd()))
 }
 
+// plainOverride tracks whether --plain forced plain output for this
+// process, overriding the usual NO_COLOR/TTY detection below. Set once at
+// startup via SetPlain, before any styled output is printed.
+var plainOverride bool
+
+// SetPlain forces plain (no color, no emoji, raw Render) output for the
+// rest of the process, regardless of NO_COLOR or TTY detection. Intended
+// to be called once, from the root command's --plain flag handling,
+// before any command prints styled output.
+func SetPlain(v bool) {
+	plainOverride = v
+	if v {
+		lipgloss.SetColorProfile(termenv.Ascii)
+	} else if ShouldUseColor() {
+		lipgloss.SetColorProfile(termenv.TrueColor)
+	}
+}
+
+// IsPlain returns true if --plain forced plain output.
+func IsPlain() bool {
+	return plainOverride
+}
+
 // ShouldUseColor determines if ANSI color codes should be used.
-// Respects NO_COLOR (https://no-color.org/), CLICOLOR, and CLICOLOR_FORCE conventions.
+// Respects --plain, NO_COLOR (https://no-color.org/), CLICOLOR, and
+// CLICOLOR_FORCE conventions.
 func ShouldUseColor() bool {
+	if plainOverride {
+		return false
+	}
+
 	// NO_COLOR takes precedence - any value disables color
 	if _, exists := os.LookupEnv("NO_COLOR"); exists {
 		return false
@@ -34,8 +64,13 @@ func ShouldUseColor() bool {
 }
 
 // ShouldUseEmoji determines if emoji decorations should be used.
-// Disabled in non-TTY mode to keep output machine-readable.
+// Disabled by --plain, GT_NO_EMOJI, and in non-TTY mode to keep output
+// machine-readable.
 func ShouldUseEmoji() bool {
+	if plainOverride {
+		return false
+	}
+
 	// GT_NO_EMOJI disables emoji output
 	if _, exists := os.LookupEnv("GT_NO_EMOJI"); exists {
 		return false
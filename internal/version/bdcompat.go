@@ -0,0 +1,101 @@
+package version
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TestedBDMajorMin and TestedBDMajorMax bound the bd major versions gt has
+// been tested against. bd is still pre-1.0 (major always 0 today), so this
+// mostly guards against a future bd 1.0+ release changing behavior underfoot
+// before gt has been verified against it.
+const (
+	TestedBDMajorMin = 0
+	TestedBDMajorMax = 0
+)
+
+var bdVersionOutputRe = regexp.MustCompile(`bd version (\d+)\.(\d+)(?:\.(\d+))?`)
+
+// BDCompatibility reports whether the installed bd binary's major version
+// falls within the range gt has been tested against.
+type BDCompatibility struct {
+	Version    string // installed bd version string, e.g. "0.44.0" ("" if undetermined)
+	Compatible bool   // false if outside the tested range or undetermined
+	Warning    string // human-readable warning, empty when Compatible
+}
+
+// parseBDVersionOutput extracts the version string and major version from
+// the output of `bd version` (e.g. "bd version 0.44.0" or "bd version 0.44.0
+// (dev)"). Returns an error if no version number can be found.
+func parseBDVersionOutput(raw string) (versionStr string, major int, err error) {
+	match := bdVersionOutputRe.FindStringSubmatch(strings.TrimSpace(raw))
+	if match == nil {
+		return "", 0, fmt.Errorf("cannot parse bd version from: %s", strings.TrimSpace(raw))
+	}
+
+	versionStr = match[1] + "." + match[2]
+	if match[3] != "" {
+		versionStr += "." + match[3]
+	}
+
+	major, err = strconv.Atoi(match[1])
+	if err != nil {
+		return "", 0, fmt.Errorf("parsing major version from %q: %w", raw, err)
+	}
+
+	return versionStr, major, nil
+}
+
+var (
+	bdCompatOnce   sync.Once
+	bdCompatResult *BDCompatibility
+)
+
+// CheckBDCompatibility runs `bd version`, parses the major version, and warns
+// when it falls outside the range gt has been tested against. The check
+// shells out at most once per process - the result is cached and shared by
+// every caller (gt doctor, gt version, ...).
+func CheckBDCompatibility() *BDCompatibility {
+	bdCompatOnce.Do(func() {
+		bdCompatResult = checkBDCompatibilityInternal()
+	})
+	return bdCompatResult
+}
+
+func checkBDCompatibilityInternal() *BDCompatibility {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	out, err := exec.CommandContext(ctx, "bd", "version").Output()
+	if err != nil {
+		return &BDCompatibility{Warning: fmt.Sprintf("cannot determine bd version: %v (is beads installed?)", err)}
+	}
+
+	return bdCompatibilityFromOutput(string(out))
+}
+
+// bdCompatibilityFromOutput builds a BDCompatibility from raw `bd version`
+// output, split out from checkBDCompatibilityInternal so tests can exercise
+// it with mocked output instead of shelling out to a real bd binary.
+func bdCompatibilityFromOutput(raw string) *BDCompatibility {
+	versionStr, major, err := parseBDVersionOutput(raw)
+	if err != nil {
+		return &BDCompatibility{Warning: err.Error()}
+	}
+
+	if major < TestedBDMajorMin || major > TestedBDMajorMax {
+		return &BDCompatibility{
+			Version: versionStr,
+			Warning: fmt.Sprintf("bd %s has major version %d, outside the tested range (%d-%d) - some gt features may not behave as expected",
+				versionStr, major, TestedBDMajorMin, TestedBDMajorMax),
+		}
+	}
+
+	return &BDCompatibility{Version: versionStr, Compatible: true}
+}
@@ -0,0 +1,65 @@
+package version
+
+import "testing"
+
+func TestParseBDVersionOutput(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         string
+		wantVersion string
+		wantMajor   int
+		wantErr     bool
+	}{
+		{"current", "bd version 0.44.0", "0.44.0", 0, false},
+		{"old", "bd version 0.30.0", "0.30.0", 0, false},
+		{"dev suffix", "bd version 0.44.0 (dev)", "0.44.0", 0, false},
+		{"no patch", "bd version 0.44", "0.44", 0, false},
+		{"future major", "bd version 2.0.0", "2.0.0", 2, false},
+		{"unparsable", "not a version string at all", "", 0, true},
+		{"empty", "", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotVersion, gotMajor, err := parseBDVersionOutput(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseBDVersionOutput(%q) error = %v, wantErr %v", tt.raw, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if gotVersion != tt.wantVersion {
+				t.Errorf("version = %q, want %q", gotVersion, tt.wantVersion)
+			}
+			if gotMajor != tt.wantMajor {
+				t.Errorf("major = %d, want %d", gotMajor, tt.wantMajor)
+			}
+		})
+	}
+}
+
+func TestBDCompatibilityFromOutput(t *testing.T) {
+	tests := []struct {
+		name           string
+		raw            string
+		wantCompatible bool
+		wantWarning    bool
+	}{
+		{"current version is compatible", "bd version 0.44.0", true, false},
+		{"old version within tested major is compatible", "bd version 0.30.0", true, false},
+		{"future major warns", "bd version 2.0.0", false, true},
+		{"unparsable warns", "garbage output", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := bdCompatibilityFromOutput(tt.raw)
+			if got.Compatible != tt.wantCompatible {
+				t.Errorf("Compatible = %v, want %v", got.Compatible, tt.wantCompatible)
+			}
+			if (got.Warning != "") != tt.wantWarning {
+				t.Errorf("Warning = %q, want non-empty = %v", got.Warning, tt.wantWarning)
+			}
+		})
+	}
+}
@@ -3,6 +3,15 @@
 // This package was originally for "hook files" but those are now deprecated
 // in favor of pinned beads. The remaining utilities help with directory
 // management for the beads system.
+//
+// Because of that, a hooked bead has no file of its own to go corrupt or
+// need schema versioning: "hooked" is just an issue status
+// (beads.StatusHooked) set with `bd update --status=hooked`, and beads
+// itself is the only thing that validates or migrates issue data. gt
+// prime and gt mol status already fail closed (see
+// showMoleculeExecutionPrompt) when bd's own output can't be parsed,
+// which is the file-based-hook equivalent this package would otherwise
+// need SlungWork/ErrCorruptHook for.
 package wisp
 
 // WispDir is the directory where beads data is stored.
@@ -0,0 +1,115 @@
+package witness
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/deacon"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// HealthLine is a single OK/WARN observation in a HealthReport.
+type HealthLine struct {
+	OK      bool   `json:"ok"`
+	Message string `json:"message"`
+}
+
+// HealthReport is the combined liveness picture for a rig's Witness,
+// gathered for `gt witness status`: tmux session existence, the witness
+// agent bead's last heartbeat, and whether the Deacon's restart ledger has
+// quarantined it.
+type HealthReport struct {
+	RigName          string     `json:"rig_name"`
+	SessionName      string     `json:"session_name"`
+	SessionRunning   bool       `json:"session_running"`
+	LastHeartbeat    *time.Time `json:"last_heartbeat,omitempty"`
+	Quarantined      bool       `json:"quarantined"`
+	QuarantineReason string     `json:"quarantine_reason,omitempty"`
+
+	Lines []HealthLine `json:"lines"`
+}
+
+// Healthy reports whether every line in the report is OK. `gt witness
+// status` uses this to decide its exit code for cron health checks.
+func (r *HealthReport) Healthy() bool {
+	for _, l := range r.Lines {
+		if !l.OK {
+			return false
+		}
+	}
+	return true
+}
+
+// healthSessionSource is the tmux surface CheckHealth needs, narrowed so
+// tests can stub session existence without a real tmux server.
+type healthSessionSource interface {
+	HasSession(name string) (bool, error)
+}
+
+// healthAgentBeadSource is the beads surface CheckHealth needs, narrowed so
+// tests can stub the witness's agent bead without shelling out to bd.
+type healthAgentBeadSource interface {
+	GetAgentBead(id string) (*beads.Issue, *beads.AgentFields, error)
+}
+
+// CheckHealth gathers the combined liveness report for a rig's Witness.
+// townRoot is the town workspace root the restart ledger and agent bead
+// database live under.
+func CheckHealth(r *rig.Rig, townRoot string) (*HealthReport, error) {
+	mgr := NewManager(r)
+	bd := beads.NewWithBeadsDir(townRoot, beads.ResolveBeadsDir(townRoot)).WithActor(witnessActor(r.Name))
+	return checkHealth(r.Name, mgr.SessionName(), townRoot, tmux.NewTmux(), bd)
+}
+
+func checkHealth(rigName, sessionName, townRoot string, t healthSessionSource, bd healthAgentBeadSource) (*HealthReport, error) {
+	running, err := t.HasSession(sessionName)
+	if err != nil {
+		return nil, fmt.Errorf("checking session: %w", err)
+	}
+
+	report := &HealthReport{RigName: rigName, SessionName: sessionName, SessionRunning: running}
+	if running {
+		report.Lines = append(report.Lines, HealthLine{OK: true, Message: fmt.Sprintf("session %s is running", sessionName)})
+	} else {
+		report.Lines = append(report.Lines, HealthLine{OK: false, Message: fmt.Sprintf("session %s is not running", sessionName)})
+	}
+
+	// A dead session already accounts for a missing heartbeat; only judge
+	// heartbeat freshness while the session claims to be up.
+	if running {
+		_, fields, err := bd.GetAgentBead(sessionName)
+		switch {
+		case err != nil:
+			report.Lines = append(report.Lines, HealthLine{OK: false, Message: fmt.Sprintf("reading agent bead: %v", err)})
+		case fields == nil || fields.LastHeartbeat == "":
+			report.Lines = append(report.Lines, HealthLine{OK: false, Message: "no heartbeat recorded on agent bead"})
+		default:
+			ts, err := time.Parse(time.RFC3339, fields.LastHeartbeat)
+			if err != nil {
+				report.Lines = append(report.Lines, HealthLine{OK: false, Message: fmt.Sprintf("invalid last_heartbeat on agent bead: %v", err)})
+				break
+			}
+			report.LastHeartbeat = &ts
+			age := time.Since(ts)
+			if age >= DefaultHeartbeatMaxAge {
+				report.Lines = append(report.Lines, HealthLine{OK: false, Message: fmt.Sprintf("agent bead heartbeat is %s old (stale)", age.Round(time.Second))})
+			} else {
+				report.Lines = append(report.Lines, HealthLine{OK: true, Message: fmt.Sprintf("agent bead heartbeat %s ago", age.Round(time.Second))})
+			}
+		}
+	}
+
+	ledger, err := deacon.LoadRestartLedger(townRoot)
+	if err != nil {
+		return nil, fmt.Errorf("loading restart ledger: %w", err)
+	}
+	if e, ok := ledger.Sessions[sessionName]; ok && e.Quarantined {
+		report.Quarantined = true
+		report.QuarantineReason = e.QuarantineReason
+		report.Lines = append(report.Lines, HealthLine{OK: false, Message: fmt.Sprintf("quarantined by restart ledger: %s", e.QuarantineReason)})
+	}
+
+	return report, nil
+}
@@ -0,0 +1,114 @@
+package witness
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/deacon"
+)
+
+type stubHealthSessionSource struct {
+	running bool
+}
+
+func (s stubHealthSessionSource) HasSession(name string) (bool, error) {
+	return s.running, nil
+}
+
+type stubAgentBeadSource struct {
+	fields *beads.AgentFields
+	err    error
+}
+
+func (s stubAgentBeadSource) GetAgentBead(id string) (*beads.Issue, *beads.AgentFields, error) {
+	if s.err != nil {
+		return nil, nil, s.err
+	}
+	if s.fields == nil {
+		return nil, nil, nil
+	}
+	return &beads.Issue{ID: id}, s.fields, nil
+}
+
+func TestCheckHealth_SessionNotRunning(t *testing.T) {
+	townRoot := t.TempDir()
+
+	report, err := checkHealth("greenplace", "gt-greenplace-witness", townRoot,
+		stubHealthSessionSource{running: false}, stubAgentBeadSource{})
+	if err != nil {
+		t.Fatalf("checkHealth: %v", err)
+	}
+	if report.SessionRunning {
+		t.Fatal("expected SessionRunning to be false")
+	}
+	if report.Healthy() {
+		t.Fatal("expected Healthy() to be false when the session isn't running")
+	}
+}
+
+func TestCheckHealth_FreshHeartbeatIsHealthy(t *testing.T) {
+	townRoot := t.TempDir()
+	fields := &beads.AgentFields{LastHeartbeat: time.Now().UTC().Format(time.RFC3339)}
+
+	report, err := checkHealth("greenplace", "gt-greenplace-witness", townRoot,
+		stubHealthSessionSource{running: true}, stubAgentBeadSource{fields: fields})
+	if err != nil {
+		t.Fatalf("checkHealth: %v", err)
+	}
+	if !report.Healthy() {
+		t.Fatalf("expected a fresh heartbeat with a running session to be healthy, got lines: %+v", report.Lines)
+	}
+	if report.LastHeartbeat == nil {
+		t.Fatal("expected LastHeartbeat to be set")
+	}
+}
+
+func TestCheckHealth_StaleHeartbeatIsUnhealthy(t *testing.T) {
+	townRoot := t.TempDir()
+	fields := &beads.AgentFields{LastHeartbeat: time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)}
+
+	report, err := checkHealth("greenplace", "gt-greenplace-witness", townRoot,
+		stubHealthSessionSource{running: true}, stubAgentBeadSource{fields: fields})
+	if err != nil {
+		t.Fatalf("checkHealth: %v", err)
+	}
+	if report.Healthy() {
+		t.Fatal("expected a stale agent bead heartbeat to make the report unhealthy")
+	}
+}
+
+func TestCheckHealth_MissingHeartbeatIsUnhealthy(t *testing.T) {
+	townRoot := t.TempDir()
+
+	report, err := checkHealth("greenplace", "gt-greenplace-witness", townRoot,
+		stubHealthSessionSource{running: true}, stubAgentBeadSource{fields: &beads.AgentFields{}})
+	if err != nil {
+		t.Fatalf("checkHealth: %v", err)
+	}
+	if report.Healthy() {
+		t.Fatal("expected a missing heartbeat to make the report unhealthy")
+	}
+}
+
+func TestCheckHealth_QuarantinedSessionIsUnhealthy(t *testing.T) {
+	townRoot := t.TempDir()
+	fields := &beads.AgentFields{LastHeartbeat: time.Now().UTC().Format(time.RFC3339)}
+	for i := 0; i < deacon.DefaultMaxRestartsPerHour+1; i++ {
+		if _, err := deacon.RecordRestart(townRoot, "gt-greenplace-witness"); err != nil {
+			t.Fatalf("RecordRestart: %v", err)
+		}
+	}
+
+	report, err := checkHealth("greenplace", "gt-greenplace-witness", townRoot,
+		stubHealthSessionSource{running: true}, stubAgentBeadSource{fields: fields})
+	if err != nil {
+		t.Fatalf("checkHealth: %v", err)
+	}
+	if !report.Quarantined {
+		t.Fatal("expected Quarantined to be true")
+	}
+	if report.Healthy() {
+		t.Fatal("expected a quarantined session to make the report unhealthy")
+	}
+}
@@ -49,6 +49,13 @@ func (m *Manager) SessionName() string {
 	return fmt.Sprintf("gt-%s-witness", m.rig.Name)
 }
 
+// witnessActor returns the BD_ACTOR identity to attribute to beads writes
+// made on behalf of a rig's witness, matching the format config.AgentEnv
+// assigns the witness's own tmux session.
+func witnessActor(rigName string) string {
+	return fmt.Sprintf("%s/witness", rigName)
+}
+
 // Status returns information about the witness session.
 // ZFC-compliant: tmux session is the source of truth.
 func (m *Manager) Status() (*tmux.SessionInfo, error) {
@@ -202,7 +209,7 @@ func (m *Manager) Start(foreground bool, agentOverride string, envOverrides []st
 func (m *Manager) roleConfig() (*beads.RoleConfig, error) {
 	// Role beads use hq- prefix and live in town-level beads, not rig beads
 	townRoot := m.townRoot()
-	bd := beads.NewWithBeadsDir(townRoot, beads.ResolveBeadsDir(townRoot))
+	bd := beads.NewWithBeadsDir(townRoot, beads.ResolveBeadsDir(townRoot)).WithActor(witnessActor(m.rig.Name))
 	roleConfig, err := bd.GetRoleConfig(beads.RoleBeadIDTown("witness"))
 	if err != nil {
 		return nil, fmt.Errorf("loading witness role config: %w", err)
@@ -0,0 +1,521 @@
+package witness
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// Default thresholds for the mechanical patrol checks.
+const (
+	// DefaultIdleMailThreshold is how long a polecat must be idle with
+	// unread task mail before it's flagged.
+	DefaultIdleMailThreshold = 15 * time.Minute
+
+	// DefaultStallThreshold is how long an issue may sit in_progress before
+	// the stalled-issue check flags it. Overridden per rig via
+	// RigSettings.StallThreshold.
+	DefaultStallThreshold = 2 * time.Hour
+
+	// DefaultHeartbeatMaxAge is how long an agent bead's last_heartbeat may
+	// go stale before the stale-heartbeat check flags it. This is meant to
+	// catch a session stuck in a tool loop, so it's tighter than
+	// DefaultStallThreshold (which tolerates long-running work).
+	DefaultHeartbeatMaxAge = 20 * time.Minute
+)
+
+// PatrolOptions configures a Patrol run.
+type PatrolOptions struct {
+	// IdleMailThreshold overrides DefaultIdleMailThreshold.
+	IdleMailThreshold time.Duration
+
+	// Notify, when true, sends the report summary as mail to the witness
+	// and mayor mailboxes if any findings were produced.
+	Notify bool
+
+	// Queue supplies refinery queue state for the stalled-refinery check.
+	// The witness package can't import internal/refinery directly (refinery
+	// imports internal/protocol, which imports witness), so callers that
+	// have a *refinery.Manager pass it in wrapped in this interface. If nil,
+	// the stalled-refinery check is skipped.
+	Queue QueueSource
+
+	// AutoRecover, when true, runs Recover against the same rig right after
+	// the mechanical checks and folds its results into the report. This is
+	// how a FindingDeadSession gets acted on (restart or escalate) instead
+	// of just being reported.
+	AutoRecover bool
+
+	// StallThreshold overrides DefaultStallThreshold for the stalled-issue
+	// check.
+	StallThreshold time.Duration
+
+	// HeartbeatMaxAge overrides DefaultHeartbeatMaxAge for the
+	// stale-heartbeat check.
+	HeartbeatMaxAge time.Duration
+
+	// SyncMRs, when true, runs SyncMRs against the same rig right after the
+	// mechanical checks and folds the number of MR beads it created into
+	// the report. Orphan branches SyncMRs finds are mailed to the mayor
+	// directly (see SyncMRs), independent of opts.Notify.
+	SyncMRs bool
+}
+
+// QueueSource is the minimal refinery.Manager surface Patrol needs.
+type QueueSource interface {
+	IsRunning() (bool, error)
+	QueueLen() (int, error)
+}
+
+// FindingKind identifies which mechanical check produced a finding.
+type FindingKind string
+
+const (
+	FindingOrphanedIssue   FindingKind = "orphaned_in_progress"
+	FindingDeadSession     FindingKind = "dead_session_hooked_work"
+	FindingIdleWithMail    FindingKind = "idle_with_unread_mail"
+	FindingRefineryStalled FindingKind = "refinery_stopped_nonempty_queue"
+	FindingStalledIssue    FindingKind = "stalled_in_progress"
+	FindingStaleHeartbeat  FindingKind = "stale_heartbeat"
+)
+
+// PatrolFinding is a single mechanical observation from a patrol run.
+type PatrolFinding struct {
+	Kind        FindingKind `json:"kind"`
+	PolecatName string      `json:"polecat_name,omitempty"`
+	IssueID     string      `json:"issue_id,omitempty"`
+	Message     string      `json:"message"`
+}
+
+// PatrolReport is the structured result of a Patrol run.
+type PatrolReport struct {
+	RigName    string           `json:"rig_name"`
+	StartedAt  time.Time        `json:"started_at"`
+	Findings   []PatrolFinding  `json:"findings"`
+	Recoveries []RecoveryResult `json:"recoveries,omitempty"`
+
+	// MRsSynced is set when opts.SyncMRs is true: the number of MR beads
+	// SyncMRs created from pushed polecat branches during this patrol.
+	MRsSynced int `json:"mrs_synced,omitempty"`
+}
+
+// Clean reports whether the patrol found nothing to act on.
+func (r *PatrolReport) Clean() bool {
+	return len(r.Findings) == 0
+}
+
+// Summary renders a short human-readable summary of the report.
+func (r *PatrolReport) Summary() string {
+	if r.Clean() {
+		return fmt.Sprintf("patrol %s: clean, no issues found", r.RigName)
+	}
+	s := fmt.Sprintf("patrol %s: %d issue(s) found\n", r.RigName, len(r.Findings))
+	for _, f := range r.Findings {
+		s += fmt.Sprintf("  - [%s] %s\n", f.Kind, f.Message)
+	}
+	for _, res := range r.Recoveries {
+		s += fmt.Sprintf("  - [%s] %s: %s\n", res.Action, res.PolecatName, res.Message)
+	}
+	return s
+}
+
+// patrolIssueSource is the subset of beads.Beads used by Patrol.
+// Defined as an interface so tests can stub it without shelling out to bd.
+type patrolIssueSource interface {
+	List(opts beads.ListOptions) ([]*beads.Issue, error)
+}
+
+// patrolSessionSource is the subset of tmux.Tmux used by Patrol.
+type patrolSessionSource interface {
+	HasSession(name string) (bool, error)
+}
+
+// patrolMailSource looks up unread mail for a polecat identity.
+type patrolMailSource interface {
+	ListUnread(identity string) ([]*mail.Message, error)
+}
+
+// patrolDeps bundles the dependencies Patrol needs, all as narrow
+// interfaces so unit tests can substitute stubs.
+type patrolDeps struct {
+	issues   patrolIssueSource
+	tmux     patrolSessionSource
+	queue    QueueSource
+	mail     patrolMailSource
+	polecats []string
+	rigName  string
+}
+
+// realMailSource adapts per-identity mail.Mailbox lookups to patrolMailSource.
+type realMailSource struct {
+	workDir string
+}
+
+func (s realMailSource) ListUnread(identity string) ([]*mail.Message, error) {
+	box := mail.NewMailboxBeads(identity, s.workDir)
+	return box.ListUnread()
+}
+
+// Patrol runs the mechanical (non-LLM) subset of witness checks against a
+// rig: orphaned in_progress issues, dead sessions holding hooked work,
+// idle polecats sitting on unread task mail, and a stalled refinery with a
+// non-empty queue. It optionally mails a summary to witness/mayor.
+func Patrol(r *rig.Rig, opts PatrolOptions) (*PatrolReport, error) {
+	if opts.IdleMailThreshold <= 0 {
+		opts.IdleMailThreshold = DefaultIdleMailThreshold
+	}
+	if opts.StallThreshold <= 0 {
+		opts.StallThreshold = DefaultStallThreshold
+	}
+	if opts.HeartbeatMaxAge <= 0 {
+		opts.HeartbeatMaxAge = DefaultHeartbeatMaxAge
+	}
+
+	resolvedBeads := beads.ResolveBeadsDir(r.Path)
+	deps := patrolDeps{
+		issues:   beads.NewWithBeadsDir(r.Path, resolvedBeads).WithActor(witnessActor(r.Name)),
+		tmux:     tmux.NewTmux(),
+		queue:    opts.Queue,
+		mail:     realMailSource{workDir: r.Path},
+		polecats: r.Polecats,
+		rigName:  r.Name,
+	}
+
+	report, err := runPatrol(deps, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.AutoRecover {
+		recovered, err := Recover(r, RecoverOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("running recovery: %w", err)
+		}
+		report.Recoveries = recovered.Results
+	}
+
+	if opts.SyncMRs {
+		synced, err := SyncMRs(r, SyncMRsOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("syncing MRs: %w", err)
+		}
+		for _, res := range synced.Results {
+			if res.Created {
+				report.MRsSynced++
+			}
+		}
+	}
+
+	if opts.Notify && !report.Clean() {
+		notifyPatrolReport(r, report)
+	}
+
+	return report, nil
+}
+
+// runPatrol executes each mechanical check against the supplied
+// dependencies. Split out from Patrol so tests can inject stubs.
+func runPatrol(deps patrolDeps, opts PatrolOptions) (*PatrolReport, error) {
+	report := &PatrolReport{
+		RigName:   deps.rigName,
+		StartedAt: time.Now().UTC(),
+	}
+
+	orphaned, err := checkOrphanedIssues(deps)
+	if err != nil {
+		return nil, fmt.Errorf("checking orphaned issues: %w", err)
+	}
+	report.Findings = append(report.Findings, orphaned...)
+
+	dead, err := checkDeadSessionsWithHookedWork(deps)
+	if err != nil {
+		return nil, fmt.Errorf("checking dead sessions: %w", err)
+	}
+	report.Findings = append(report.Findings, dead...)
+
+	idle, err := checkIdlePolecatsWithMail(deps, opts.IdleMailThreshold)
+	if err != nil {
+		return nil, fmt.Errorf("checking idle polecats: %w", err)
+	}
+	report.Findings = append(report.Findings, idle...)
+
+	stalled, err := checkStalledRefinery(deps)
+	if err != nil {
+		return nil, fmt.Errorf("checking refinery: %w", err)
+	}
+	report.Findings = append(report.Findings, stalled...)
+
+	stalledIssues, err := checkStalledIssues(deps, opts.StallThreshold, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("checking stalled issues: %w", err)
+	}
+	report.Findings = append(report.Findings, stalledIssues...)
+
+	staleHeartbeats, err := checkStaleHeartbeats(deps, opts.HeartbeatMaxAge, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("checking stale heartbeats: %w", err)
+	}
+	report.Findings = append(report.Findings, staleHeartbeats...)
+
+	return report, nil
+}
+
+// checkOrphanedIssues flags in_progress issues assigned to a polecat whose
+// tmux session no longer exists.
+func checkOrphanedIssues(deps patrolDeps) ([]PatrolFinding, error) {
+	var findings []PatrolFinding
+
+	for _, name := range deps.polecats {
+		issues, err := deps.issues.List(beads.ListOptions{
+			Status:   "open",
+			Assignee: assigneeForPolecat(deps.rigName, name),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		running, err := deps.tmux.HasSession(polecatSessionName(deps.rigName, name))
+		if err != nil {
+			return nil, err
+		}
+		if running {
+			continue
+		}
+
+		for _, issue := range issues {
+			if issue.Status != "in_progress" {
+				continue
+			}
+			findings = append(findings, PatrolFinding{
+				Kind:        FindingOrphanedIssue,
+				PolecatName: name,
+				IssueID:     issue.ID,
+				Message:     fmt.Sprintf("%s is in_progress on %s but %s has no live session", issue.ID, name, name),
+			})
+		}
+	}
+
+	return findings, nil
+}
+
+// checkStalledIssues flags in_progress issues whose assigned_at field (set
+// by polecat.Manager.AssignIssue) is older than threshold. now is passed in
+// rather than read with time.Now() so tests can drive it with a fake clock.
+func checkStalledIssues(deps patrolDeps, threshold time.Duration, now time.Time) ([]PatrolFinding, error) {
+	var findings []PatrolFinding
+
+	for _, name := range deps.polecats {
+		issues, err := deps.issues.List(beads.ListOptions{
+			Status:   "open",
+			Assignee: assigneeForPolecat(deps.rigName, name),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, issue := range issues {
+			if issue.Status != "in_progress" {
+				continue
+			}
+			fields := beads.ParseWorkFields(issue)
+			if fields == nil || fields.AssignedAt == "" {
+				continue
+			}
+			assignedAt, err := time.Parse(time.RFC3339, fields.AssignedAt)
+			if err != nil {
+				continue
+			}
+			elapsed := now.Sub(assignedAt)
+			if elapsed < threshold {
+				continue
+			}
+			findings = append(findings, PatrolFinding{
+				Kind:        FindingStalledIssue,
+				PolecatName: name,
+				IssueID:     issue.ID,
+				Message:     fmt.Sprintf("%s has been in_progress on %s for %s (threshold %s)", issue.ID, name, elapsed.Round(time.Minute), threshold),
+			})
+		}
+	}
+
+	return findings, nil
+}
+
+// checkDeadSessionsWithHookedWork flags agent beads that still have a
+// HookBead attached while their tmux session is gone.
+func checkDeadSessionsWithHookedWork(deps patrolDeps) ([]PatrolFinding, error) {
+	var findings []PatrolFinding
+
+	for _, name := range deps.polecats {
+		agents, err := deps.issues.List(beads.ListOptions{
+			Label:    "gt:agent",
+			Assignee: assigneeForPolecat(deps.rigName, name),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		running, err := deps.tmux.HasSession(polecatSessionName(deps.rigName, name))
+		if err != nil {
+			return nil, err
+		}
+		if running {
+			continue
+		}
+
+		for _, agent := range agents {
+			if agent.HookBead == "" {
+				continue
+			}
+			findings = append(findings, PatrolFinding{
+				Kind:        FindingDeadSession,
+				PolecatName: name,
+				IssueID:     agent.HookBead,
+				Message:     fmt.Sprintf("%s's session is dead but still holds hooked work %s", name, agent.HookBead),
+			})
+		}
+	}
+
+	return findings, nil
+}
+
+// checkStaleHeartbeats flags polecats whose session is running but whose
+// agent bead's last_heartbeat hasn't updated in maxAge - a Claude session
+// stuck in a tool loop still holds its tmux pane open, so
+// checkDeadSessionsWithHookedWork's "session exists" check can't catch it.
+// now is passed in rather than read with time.Now() so tests can drive it
+// with a fake clock.
+func checkStaleHeartbeats(deps patrolDeps, maxAge time.Duration, now time.Time) ([]PatrolFinding, error) {
+	var findings []PatrolFinding
+
+	for _, name := range deps.polecats {
+		sessionName := polecatSessionName(deps.rigName, name)
+		running, err := deps.tmux.HasSession(sessionName)
+		if err != nil {
+			return nil, err
+		}
+		if !running {
+			continue
+		}
+
+		agents, err := deps.issues.List(beads.ListOptions{
+			Label:    "gt:agent",
+			Assignee: assigneeForPolecat(deps.rigName, name),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, agent := range agents {
+			fields := beads.ParseAgentFields(agent.Description)
+			if !beads.AgentClaimsStale(agent, fields, maxAge, now) {
+				continue
+			}
+			findings = append(findings, PatrolFinding{
+				Kind:        FindingStaleHeartbeat,
+				PolecatName: name,
+				IssueID:     agent.ID,
+				Message:     fmt.Sprintf("%s's session is running but its agent bead hasn't heartbeated in over %s", name, maxAge),
+			})
+		}
+	}
+
+	return findings, nil
+}
+
+// checkIdlePolecatsWithMail flags polecats whose session exists but is
+// sitting idle with unread task mail.
+func checkIdlePolecatsWithMail(deps patrolDeps, threshold time.Duration) ([]PatrolFinding, error) {
+	var findings []PatrolFinding
+
+	for _, name := range deps.polecats {
+		sessionName := polecatSessionName(deps.rigName, name)
+		running, err := deps.tmux.HasSession(sessionName)
+		if err != nil {
+			return nil, err
+		}
+		if !running {
+			continue
+		}
+
+		unread, err := deps.mail.ListUnread(fmt.Sprintf("%s/polecats/%s", deps.rigName, name))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, msg := range unread {
+			if msg.Type != mail.TypeTask {
+				continue
+			}
+			if time.Since(msg.Timestamp) < threshold {
+				continue
+			}
+			findings = append(findings, PatrolFinding{
+				Kind:        FindingIdleWithMail,
+				PolecatName: name,
+				Message:     fmt.Sprintf("%s has unread task mail %s older than %s", name, msg.ID, threshold),
+			})
+		}
+	}
+
+	return findings, nil
+}
+
+// checkStalledRefinery flags a stopped refinery with merge requests queued.
+// Skipped entirely when no QueueSource was supplied.
+func checkStalledRefinery(deps patrolDeps) ([]PatrolFinding, error) {
+	if deps.queue == nil {
+		return nil, nil
+	}
+
+	running, err := deps.queue.IsRunning()
+	if err != nil {
+		return nil, err
+	}
+	if running {
+		return nil, nil
+	}
+
+	queueLen, err := deps.queue.QueueLen()
+	if err != nil {
+		return nil, err
+	}
+	if queueLen == 0 {
+		return nil, nil
+	}
+
+	return []PatrolFinding{{
+		Kind:    FindingRefineryStalled,
+		Message: fmt.Sprintf("refinery is stopped with %d merge request(s) queued", queueLen),
+	}}, nil
+}
+
+func polecatSessionName(rigName, polecatName string) string {
+	return fmt.Sprintf("gt-%s-%s", rigName, polecatName)
+}
+
+func assigneeForPolecat(rigName, polecatName string) string {
+	return fmt.Sprintf("%s/%s", rigName, polecatName)
+}
+
+// notifyPatrolReport mails the report summary to the witness and mayor
+// inboxes. Best-effort: failures are not surfaced to the caller since a
+// notification failure shouldn't fail the patrol itself.
+func notifyPatrolReport(r *rig.Rig, report *PatrolReport) {
+	router := mail.NewRouter(r.Path)
+	subject := fmt.Sprintf("Patrol: %d issue(s) found in %s", len(report.Findings), r.Name)
+
+	for _, to := range []string{fmt.Sprintf("%s/witness", r.Name), "mayor/"} {
+		_ = router.Send(&mail.Message{
+			From:    fmt.Sprintf("%s/witness", r.Name),
+			To:      to,
+			Subject: subject,
+			Body:    report.Summary(),
+			Type:    mail.TypeNotification,
+		})
+	}
+}
@@ -0,0 +1,289 @@
+package witness
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/mail"
+)
+
+type stubIssueSource struct {
+	byAssignee map[string][]*beads.Issue
+}
+
+func (s stubIssueSource) List(opts beads.ListOptions) ([]*beads.Issue, error) {
+	return s.byAssignee[opts.Assignee], nil
+}
+
+type stubSessionSource struct {
+	running map[string]bool
+}
+
+func (s stubSessionSource) HasSession(name string) (bool, error) {
+	return s.running[name], nil
+}
+
+type stubQueueSource struct {
+	running  bool
+	queueLen int
+}
+
+func (s stubQueueSource) IsRunning() (bool, error) { return s.running, nil }
+func (s stubQueueSource) QueueLen() (int, error)   { return s.queueLen, nil }
+
+type stubMailSource struct {
+	unread map[string][]*mail.Message
+}
+
+func (s stubMailSource) ListUnread(identity string) ([]*mail.Message, error) {
+	return s.unread[identity], nil
+}
+
+func TestRunPatrol_OrphanedInProgressIssue(t *testing.T) {
+	deps := patrolDeps{
+		rigName:  "greenplace",
+		polecats: []string{"max"},
+		issues: stubIssueSource{byAssignee: map[string][]*beads.Issue{
+			"greenplace/max": {{ID: "gt-1", Status: "in_progress"}},
+		}},
+		tmux: stubSessionSource{running: map[string]bool{}},
+		mail: stubMailSource{},
+	}
+
+	report, err := runPatrol(deps, PatrolOptions{})
+	if err != nil {
+		t.Fatalf("runPatrol: %v", err)
+	}
+	if len(report.Findings) != 1 || report.Findings[0].Kind != FindingOrphanedIssue {
+		t.Fatalf("expected one orphaned-issue finding, got %+v", report.Findings)
+	}
+}
+
+func TestRunPatrol_DeadSessionHoldsHookedWork(t *testing.T) {
+	deps := patrolDeps{
+		rigName:  "greenplace",
+		polecats: []string{"max"},
+		issues: stubIssueSource{byAssignee: map[string][]*beads.Issue{
+			"greenplace/max": {{ID: "gt-agent-max", HookBead: "gt-99"}},
+		}},
+		tmux: stubSessionSource{running: map[string]bool{}},
+		mail: stubMailSource{},
+	}
+
+	report, err := runPatrol(deps, PatrolOptions{})
+	if err != nil {
+		t.Fatalf("runPatrol: %v", err)
+	}
+	if len(report.Findings) != 1 || report.Findings[0].Kind != FindingDeadSession {
+		t.Fatalf("expected one dead-session finding, got %+v", report.Findings)
+	}
+}
+
+func TestRunPatrol_IdlePolecatWithStaleUnreadTaskMail(t *testing.T) {
+	deps := patrolDeps{
+		rigName:  "greenplace",
+		polecats: []string{"max"},
+		issues:   stubIssueSource{},
+		tmux:     stubSessionSource{running: map[string]bool{"gt-greenplace-max": true}},
+		mail: stubMailSource{unread: map[string][]*mail.Message{
+			"greenplace/polecats/max": {{ID: "bd-1", Type: mail.TypeTask, Timestamp: time.Now().Add(-1 * time.Hour)}},
+		}},
+	}
+
+	report, err := runPatrol(deps, PatrolOptions{IdleMailThreshold: 15 * time.Minute})
+	if err != nil {
+		t.Fatalf("runPatrol: %v", err)
+	}
+	if len(report.Findings) != 1 || report.Findings[0].Kind != FindingIdleWithMail {
+		t.Fatalf("expected one idle-with-mail finding, got %+v", report.Findings)
+	}
+}
+
+func TestRunPatrol_IdlePolecatWithFreshMailIsNotFlagged(t *testing.T) {
+	deps := patrolDeps{
+		rigName:  "greenplace",
+		polecats: []string{"max"},
+		issues:   stubIssueSource{},
+		tmux:     stubSessionSource{running: map[string]bool{"gt-greenplace-max": true}},
+		mail: stubMailSource{unread: map[string][]*mail.Message{
+			"greenplace/polecats/max": {{ID: "bd-1", Type: mail.TypeTask, Timestamp: time.Now()}},
+		}},
+	}
+
+	report, err := runPatrol(deps, PatrolOptions{IdleMailThreshold: 15 * time.Minute})
+	if err != nil {
+		t.Fatalf("runPatrol: %v", err)
+	}
+	if !report.Clean() {
+		t.Fatalf("expected clean report, got %+v", report.Findings)
+	}
+}
+
+func TestRunPatrol_StalledRefineryWithQueue(t *testing.T) {
+	deps := patrolDeps{
+		rigName: "greenplace",
+		issues:  stubIssueSource{},
+		tmux:    stubSessionSource{},
+		mail:    stubMailSource{},
+		queue:   stubQueueSource{running: false, queueLen: 3},
+	}
+
+	report, err := runPatrol(deps, PatrolOptions{})
+	if err != nil {
+		t.Fatalf("runPatrol: %v", err)
+	}
+	if len(report.Findings) != 1 || report.Findings[0].Kind != FindingRefineryStalled {
+		t.Fatalf("expected one stalled-refinery finding, got %+v", report.Findings)
+	}
+}
+
+func TestRunPatrol_RunningRefineryNotFlagged(t *testing.T) {
+	deps := patrolDeps{
+		rigName: "greenplace",
+		issues:  stubIssueSource{},
+		tmux:    stubSessionSource{},
+		mail:    stubMailSource{},
+		queue:   stubQueueSource{running: true, queueLen: 3},
+	}
+
+	report, err := runPatrol(deps, PatrolOptions{})
+	if err != nil {
+		t.Fatalf("runPatrol: %v", err)
+	}
+	if !report.Clean() {
+		t.Fatalf("expected clean report, got %+v", report.Findings)
+	}
+}
+
+func TestRunPatrol_NilQueueSourceSkipsRefineryCheck(t *testing.T) {
+	deps := patrolDeps{
+		rigName: "greenplace",
+		issues:  stubIssueSource{},
+		tmux:    stubSessionSource{},
+		mail:    stubMailSource{},
+	}
+
+	report, err := runPatrol(deps, PatrolOptions{})
+	if err != nil {
+		t.Fatalf("runPatrol: %v", err)
+	}
+	if !report.Clean() {
+		t.Fatalf("expected clean report, got %+v", report.Findings)
+	}
+}
+
+func TestCheckStalledIssues(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	threshold := time.Hour
+
+	deps := patrolDeps{
+		rigName:  "greenplace",
+		polecats: []string{"max", "nux"},
+		issues: stubIssueSource{byAssignee: map[string][]*beads.Issue{
+			"greenplace/max": {{
+				ID:          "gt-1",
+				Status:      "in_progress",
+				Description: "assigned_at: " + now.Add(-2*time.Hour).Format(time.RFC3339),
+			}},
+			"greenplace/nux": {{
+				ID:          "gt-2",
+				Status:      "in_progress",
+				Description: "assigned_at: " + now.Add(-10*time.Minute).Format(time.RFC3339),
+			}},
+		}},
+	}
+
+	findings, err := checkStalledIssues(deps, threshold, now)
+	if err != nil {
+		t.Fatalf("checkStalledIssues: %v", err)
+	}
+	if len(findings) != 1 || findings[0].IssueID != "gt-1" {
+		t.Fatalf("expected only gt-1 flagged as stalled, got %+v", findings)
+	}
+	if findings[0].Kind != FindingStalledIssue {
+		t.Errorf("Kind = %q, want %q", findings[0].Kind, FindingStalledIssue)
+	}
+}
+
+func TestCheckStalledIssues_NoAssignedAtIsIgnored(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	deps := patrolDeps{
+		rigName:  "greenplace",
+		polecats: []string{"max"},
+		issues: stubIssueSource{byAssignee: map[string][]*beads.Issue{
+			"greenplace/max": {{ID: "gt-1", Status: "in_progress"}},
+		}},
+	}
+
+	findings, err := checkStalledIssues(deps, time.Hour, now)
+	if err != nil {
+		t.Fatalf("checkStalledIssues: %v", err)
+	}
+	if len(findings) != 0 {
+		t.Fatalf("expected no findings without an assigned_at field, got %+v", findings)
+	}
+}
+
+func TestCheckStaleHeartbeats(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	maxAge := 20 * time.Minute
+
+	deps := patrolDeps{
+		rigName:  "greenplace",
+		polecats: []string{"max", "nux"},
+		tmux: stubSessionSource{running: map[string]bool{
+			"gt-greenplace-max": true,
+			"gt-greenplace-nux": true,
+		}},
+		issues: stubIssueSource{byAssignee: map[string][]*beads.Issue{
+			"greenplace/max": {{
+				ID:          "gt-agent-max",
+				Status:      "open",
+				Description: "agent_state: idle\nlast_heartbeat: " + now.Add(-30*time.Minute).Format(time.RFC3339),
+			}},
+			"greenplace/nux": {{
+				ID:          "gt-agent-nux",
+				Status:      "open",
+				Description: "agent_state: idle\nlast_heartbeat: " + now.Add(-5*time.Minute).Format(time.RFC3339),
+			}},
+		}},
+	}
+
+	findings, err := checkStaleHeartbeats(deps, maxAge, now)
+	if err != nil {
+		t.Fatalf("checkStaleHeartbeats: %v", err)
+	}
+	if len(findings) != 1 || findings[0].PolecatName != "max" {
+		t.Fatalf("expected only max flagged as stale, got %+v", findings)
+	}
+	if findings[0].Kind != FindingStaleHeartbeat {
+		t.Errorf("Kind = %q, want %q", findings[0].Kind, FindingStaleHeartbeat)
+	}
+}
+
+func TestCheckStaleHeartbeats_SessionNotRunningIsIgnored(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	deps := patrolDeps{
+		rigName:  "greenplace",
+		polecats: []string{"max"},
+		tmux:     stubSessionSource{running: map[string]bool{}},
+		issues: stubIssueSource{byAssignee: map[string][]*beads.Issue{
+			"greenplace/max": {{
+				ID:          "gt-agent-max",
+				Status:      "open",
+				Description: "agent_state: idle\nlast_heartbeat: " + now.Add(-2*time.Hour).Format(time.RFC3339),
+			}},
+		}},
+	}
+
+	findings, err := checkStaleHeartbeats(deps, 20*time.Minute, now)
+	if err != nil {
+		t.Fatalf("checkStaleHeartbeats: %v", err)
+	}
+	if len(findings) != 0 {
+		t.Fatalf("expected no findings when the session isn't running, got %+v", findings)
+	}
+}
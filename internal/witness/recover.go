@@ -0,0 +1,270 @@
+package witness
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// DefaultMaxRecoveryAttempts caps how many times Recover will restart the
+// same hooked work before giving up and escalating to the mayor instead of
+// looping forever on a session that keeps dying.
+const DefaultMaxRecoveryAttempts = 3
+
+// RecoverOptions configures a Recover run.
+type RecoverOptions struct {
+	// MaxAttempts overrides DefaultMaxRecoveryAttempts.
+	MaxAttempts int
+}
+
+// RecoveryAction describes what Recover did for a single polecat.
+type RecoveryAction string
+
+const (
+	// RecoveryRestarted means the session was restarted to resume the hooked molecule.
+	RecoveryRestarted RecoveryAction = "restarted"
+	// RecoveryEscalated means the mayor was mailed and the agent bead marked
+	// needs_attention instead of restarting.
+	RecoveryEscalated RecoveryAction = "escalated"
+)
+
+// RecoveryResult is the outcome of recovering (or escalating) a single polecat.
+type RecoveryResult struct {
+	PolecatName string         `json:"polecat_name"`
+	AgentBead   string         `json:"agent_bead"`
+	HookBead    string         `json:"hook_bead"`
+	Action      RecoveryAction `json:"action"`
+	Message     string         `json:"message"`
+}
+
+// RecoverReport is the structured result of a Recover run.
+type RecoverReport struct {
+	RigName   string           `json:"rig_name"`
+	StartedAt time.Time        `json:"started_at"`
+	Results   []RecoveryResult `json:"results"`
+}
+
+// Clean reports whether Recover found nothing to act on.
+func (r *RecoverReport) Clean() bool {
+	return len(r.Results) == 0
+}
+
+// Summary renders a short human-readable summary of the report.
+func (r *RecoverReport) Summary() string {
+	if r.Clean() {
+		return fmt.Sprintf("recover %s: clean, no dead sessions holding hooked work", r.RigName)
+	}
+	s := fmt.Sprintf("recover %s: %d polecat(s) processed\n", r.RigName, len(r.Results))
+	for _, res := range r.Results {
+		s += fmt.Sprintf("  - [%s] %s: %s\n", res.Action, res.PolecatName, res.Message)
+	}
+	return s
+}
+
+// recoverIssueSource is the subset of beads.Beads Recover needs to find and
+// update agent beads. Defined as an interface so tests can stub it without
+// shelling out to bd.
+type recoverIssueSource interface {
+	List(opts beads.ListOptions) ([]*beads.Issue, error)
+	UpdateAgentState(id string, state string, hookBead *string) error
+	UpdateAgentRecovery(id string, attempts int, at time.Time) error
+}
+
+// recoverSessionSource is the subset of tmux.Tmux Recover needs.
+type recoverSessionSource interface {
+	HasSession(name string) (bool, error)
+}
+
+// recoverSessionStarter restarts a polecat's tmux session so its
+// SessionStart hook and hook wisp resume the interrupted molecule.
+type recoverSessionStarter interface {
+	Start(polecatName string, opts polecat.SessionStartOptions) error
+}
+
+// recoverWorktreeChecker reports whether a polecat's worktree has no
+// uncommitted work at risk of being lost by a session restart.
+type recoverWorktreeChecker interface {
+	Clean(polecatName string) (clean bool, detail string, err error)
+}
+
+// recoverMailSource sends a mail.Message. A narrow interface over
+// mail.Router so tests can stub it.
+type recoverMailSource interface {
+	Send(msg *mail.Message) error
+}
+
+// recoverDeps bundles the dependencies Recover needs, all as narrow
+// interfaces so unit tests can substitute stubs.
+type recoverDeps struct {
+	issues      recoverIssueSource
+	tmux        recoverSessionSource
+	sessions    recoverSessionStarter
+	worktrees   recoverWorktreeChecker
+	mail        recoverMailSource
+	polecats    []string
+	rigName     string
+	maxAttempts int
+}
+
+// realWorktreeChecker adapts a polecat's clone path to recoverWorktreeChecker
+// via git.Git, matching the CleanExcludingBeads convention already used for
+// stale-polecat detection in polecat.Manager.
+type realWorktreeChecker struct {
+	sessions *polecat.SessionManager
+}
+
+func (c realWorktreeChecker) Clean(polecatName string) (bool, string, error) {
+	workDir := c.sessions.ClonePath(polecatName)
+	status, err := git.NewGit(workDir).CheckUncommittedWork()
+	if err != nil {
+		return false, "", err
+	}
+	detail := fmt.Sprintf("%d modified file(s), %d untracked file(s), %d stash(es), %d unpushed commit(s)",
+		len(status.ModifiedFiles), len(status.UntrackedFiles), status.StashCount, status.UnpushedCommits)
+	return status.CleanExcludingBeads(), detail, nil
+}
+
+// Recover finds polecats whose agent bead is still working/hooked but whose
+// tmux session has died, and either restarts the session (clean worktree,
+// attempts remaining) or escalates to the mayor (dirty worktree, or
+// attempts exhausted) marking the agent bead needs_attention. It's callable
+// directly as `gt witness recover` and from the patrol loop.
+func Recover(r *rig.Rig, opts RecoverOptions) (*RecoverReport, error) {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = DefaultMaxRecoveryAttempts
+	}
+
+	resolvedBeads := beads.ResolveBeadsDir(r.Path)
+	t := tmux.NewTmux()
+	sessions := polecat.NewSessionManager(t, r)
+
+	deps := recoverDeps{
+		issues:      beads.NewWithBeadsDir(r.Path, resolvedBeads).WithActor(witnessActor(r.Name)),
+		tmux:        t,
+		sessions:    sessions,
+		worktrees:   realWorktreeChecker{sessions: sessions},
+		mail:        mail.NewRouter(r.Path),
+		polecats:    r.Polecats,
+		rigName:     r.Name,
+		maxAttempts: opts.MaxAttempts,
+	}
+
+	return runRecover(deps, opts)
+}
+
+// runRecover executes the recovery sweep against the supplied dependencies.
+// Split out from Recover so tests can inject stubs.
+func runRecover(deps recoverDeps, opts RecoverOptions) (*RecoverReport, error) {
+	report := &RecoverReport{
+		RigName:   deps.rigName,
+		StartedAt: time.Now().UTC(),
+	}
+
+	for _, name := range deps.polecats {
+		result, err := recoverPolecat(deps, name)
+		if err != nil {
+			return nil, fmt.Errorf("recovering %s: %w", name, err)
+		}
+		if result != nil {
+			report.Results = append(report.Results, *result)
+		}
+	}
+
+	return report, nil
+}
+
+// recoverPolecat inspects a single polecat's agent bead and tmux session,
+// returning nil if there's nothing to recover.
+func recoverPolecat(deps recoverDeps, name string) (*RecoveryResult, error) {
+	agents, err := deps.issues.List(beads.ListOptions{
+		Label:    "gt:agent",
+		Assignee: assigneeForPolecat(deps.rigName, name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var agent *beads.Issue
+	for _, a := range agents {
+		if a.HookBead != "" && (a.AgentState == "working" || a.AgentState == "running") {
+			agent = a
+			break
+		}
+	}
+	if agent == nil {
+		return nil, nil
+	}
+
+	sessionName := polecatSessionName(deps.rigName, name)
+	running, err := deps.tmux.HasSession(sessionName)
+	if err != nil {
+		return nil, err
+	}
+	if running {
+		return nil, nil
+	}
+
+	fields := beads.ParseAgentFields(agent.Description)
+	attempts := fields.RecoveryAttempts + 1
+	now := time.Now().UTC()
+	if err := deps.issues.UpdateAgentRecovery(agent.ID, attempts, now); err != nil {
+		return nil, fmt.Errorf("recording recovery attempt: %w", err)
+	}
+
+	if attempts > deps.maxAttempts {
+		return escalate(deps, name, agent, fmt.Sprintf("session died %d times while holding %s; recovery attempts exhausted", attempts, agent.HookBead))
+	}
+
+	clean, detail, err := deps.worktrees.Clean(name)
+	if err != nil {
+		return nil, fmt.Errorf("checking worktree: %w", err)
+	}
+	if !clean {
+		return escalate(deps, name, agent, fmt.Sprintf("worktree has uncommitted work (%s), refusing to restart automatically", detail))
+	}
+
+	if err := deps.sessions.Start(name, polecat.SessionStartOptions{}); err != nil {
+		return escalate(deps, name, agent, fmt.Sprintf("restart attempt %d failed: %v", attempts, err))
+	}
+
+	return &RecoveryResult{
+		PolecatName: name,
+		AgentBead:   agent.ID,
+		HookBead:    agent.HookBead,
+		Action:      RecoveryRestarted,
+		Message:     fmt.Sprintf("session restarted (attempt %d/%d) to resume %s", attempts, deps.maxAttempts, agent.HookBead),
+	}, nil
+}
+
+// escalate marks the agent bead needs_attention and mails the mayor the
+// uncommitted-work (or exhausted-attempts) details, since the recovery
+// can't safely proceed on its own.
+func escalate(deps recoverDeps, name string, agent *beads.Issue, reason string) (*RecoveryResult, error) {
+	if err := deps.issues.UpdateAgentState(agent.ID, beads.AgentStateNeedsAttention, nil); err != nil {
+		return nil, fmt.Errorf("marking needs_attention: %w", err)
+	}
+
+	subject := fmt.Sprintf("Recovery needed: %s/%s", deps.rigName, name)
+	body := fmt.Sprintf("%s\n\nAgent bead: %s\nHooked work: %s\n\n%s", subject, agent.ID, agent.HookBead, reason)
+	_ = deps.mail.Send(&mail.Message{
+		From:    fmt.Sprintf("%s/witness", deps.rigName),
+		To:      "mayor/",
+		Subject: subject,
+		Body:    body,
+		Type:    mail.TypeTask,
+	})
+
+	return &RecoveryResult{
+		PolecatName: name,
+		AgentBead:   agent.ID,
+		HookBead:    agent.HookBead,
+		Action:      RecoveryEscalated,
+		Message:     reason,
+	}, nil
+}
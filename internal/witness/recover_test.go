@@ -0,0 +1,214 @@
+package witness
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+type stubRecoverIssueSource struct {
+	byAssignee     map[string][]*beads.Issue
+	states         map[string]string
+	attempts       map[string]int
+	updateStateErr error
+}
+
+func (s *stubRecoverIssueSource) List(opts beads.ListOptions) ([]*beads.Issue, error) {
+	return s.byAssignee[opts.Assignee], nil
+}
+
+func (s *stubRecoverIssueSource) UpdateAgentState(id string, state string, hookBead *string) error {
+	if s.updateStateErr != nil {
+		return s.updateStateErr
+	}
+	if s.states == nil {
+		s.states = map[string]string{}
+	}
+	s.states[id] = state
+	return nil
+}
+
+func (s *stubRecoverIssueSource) UpdateAgentRecovery(id string, attempts int, at time.Time) error {
+	if s.attempts == nil {
+		s.attempts = map[string]int{}
+	}
+	s.attempts[id] = attempts
+	return nil
+}
+
+type stubRecoverSessionSource struct {
+	running map[string]bool
+}
+
+func (s stubRecoverSessionSource) HasSession(name string) (bool, error) {
+	return s.running[name], nil
+}
+
+type stubSessionStarter struct {
+	started map[string]bool
+	err     error
+}
+
+func (s *stubSessionStarter) Start(polecatName string, opts polecat.SessionStartOptions) error {
+	if s.err != nil {
+		return s.err
+	}
+	if s.started == nil {
+		s.started = map[string]bool{}
+	}
+	s.started[polecatName] = true
+	return nil
+}
+
+type stubWorktreeChecker struct {
+	clean  map[string]bool
+	detail string
+}
+
+func (s stubWorktreeChecker) Clean(polecatName string) (bool, string, error) {
+	return s.clean[polecatName], s.detail, nil
+}
+
+type stubRecoverMailSource struct {
+	sent []*mail.Message
+}
+
+func (s *stubRecoverMailSource) Send(msg *mail.Message) error {
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+func TestRunRecover_CleanWorktreeRestartsSession(t *testing.T) {
+	issues := &stubRecoverIssueSource{byAssignee: map[string][]*beads.Issue{
+		"greenplace/max": {{ID: "gt-agent-max", HookBead: "gt-99", AgentState: "working"}},
+	}}
+	starter := &stubSessionStarter{}
+	mailer := &stubRecoverMailSource{}
+
+	deps := recoverDeps{
+		rigName:     "greenplace",
+		polecats:    []string{"max"},
+		issues:      issues,
+		tmux:        stubRecoverSessionSource{running: map[string]bool{}},
+		sessions:    starter,
+		worktrees:   stubWorktreeChecker{clean: map[string]bool{"max": true}},
+		mail:        mailer,
+		maxAttempts: DefaultMaxRecoveryAttempts,
+	}
+
+	report, err := runRecover(deps, RecoverOptions{})
+	if err != nil {
+		t.Fatalf("runRecover: %v", err)
+	}
+	if len(report.Results) != 1 || report.Results[0].Action != RecoveryRestarted {
+		t.Fatalf("expected one restart result, got %+v", report.Results)
+	}
+	if !starter.started["max"] {
+		t.Error("expected session to be restarted for max")
+	}
+	if len(mailer.sent) != 0 {
+		t.Errorf("expected no escalation mail, got %+v", mailer.sent)
+	}
+	if issues.attempts["gt-agent-max"] != 1 {
+		t.Errorf("expected recovery attempts recorded as 1, got %d", issues.attempts["gt-agent-max"])
+	}
+}
+
+func TestRunRecover_DirtyWorktreeEscalatesToMayor(t *testing.T) {
+	issues := &stubRecoverIssueSource{byAssignee: map[string][]*beads.Issue{
+		"greenplace/max": {{ID: "gt-agent-max", HookBead: "gt-99", AgentState: "working"}},
+	}}
+	starter := &stubSessionStarter{}
+	mailer := &stubRecoverMailSource{}
+
+	deps := recoverDeps{
+		rigName:     "greenplace",
+		polecats:    []string{"max"},
+		issues:      issues,
+		tmux:        stubRecoverSessionSource{running: map[string]bool{}},
+		sessions:    starter,
+		worktrees:   stubWorktreeChecker{clean: map[string]bool{"max": false}, detail: "1 modified file(s)"},
+		mail:        mailer,
+		maxAttempts: DefaultMaxRecoveryAttempts,
+	}
+
+	report, err := runRecover(deps, RecoverOptions{})
+	if err != nil {
+		t.Fatalf("runRecover: %v", err)
+	}
+	if len(report.Results) != 1 || report.Results[0].Action != RecoveryEscalated {
+		t.Fatalf("expected one escalation result, got %+v", report.Results)
+	}
+	if starter.started["max"] {
+		t.Error("expected session NOT to be restarted for a dirty worktree")
+	}
+	if len(mailer.sent) != 1 || mailer.sent[0].To != "mayor/" {
+		t.Fatalf("expected one mail to mayor, got %+v", mailer.sent)
+	}
+	if issues.states["gt-agent-max"] != beads.AgentStateNeedsAttention {
+		t.Errorf("expected agent state needs_attention, got %q", issues.states["gt-agent-max"])
+	}
+}
+
+func TestRunRecover_LiveSessionIsNotTouched(t *testing.T) {
+	issues := &stubRecoverIssueSource{byAssignee: map[string][]*beads.Issue{
+		"greenplace/max": {{ID: "gt-agent-max", HookBead: "gt-99", AgentState: "working"}},
+	}}
+
+	deps := recoverDeps{
+		rigName:     "greenplace",
+		polecats:    []string{"max"},
+		issues:      issues,
+		tmux:        stubRecoverSessionSource{running: map[string]bool{"gt-greenplace-max": true}},
+		sessions:    &stubSessionStarter{},
+		worktrees:   stubWorktreeChecker{clean: map[string]bool{"max": true}},
+		mail:        &stubRecoverMailSource{},
+		maxAttempts: DefaultMaxRecoveryAttempts,
+	}
+
+	report, err := runRecover(deps, RecoverOptions{})
+	if err != nil {
+		t.Fatalf("runRecover: %v", err)
+	}
+	if !report.Clean() {
+		t.Fatalf("expected clean report for a live session, got %+v", report.Results)
+	}
+}
+
+func TestRunRecover_ExhaustedAttemptsEscalatesWithoutRestarting(t *testing.T) {
+	issues := &stubRecoverIssueSource{byAssignee: map[string][]*beads.Issue{
+		"greenplace/max": {{
+			ID:          "gt-agent-max",
+			HookBead:    "gt-99",
+			AgentState:  "working",
+			Description: "recovery_attempts: 3\nlast_recovery_at: 2026-01-01T00:00:00Z",
+		}},
+	}}
+	starter := &stubSessionStarter{}
+	mailer := &stubRecoverMailSource{}
+
+	deps := recoverDeps{
+		rigName:     "greenplace",
+		polecats:    []string{"max"},
+		issues:      issues,
+		tmux:        stubRecoverSessionSource{running: map[string]bool{}},
+		sessions:    starter,
+		worktrees:   stubWorktreeChecker{clean: map[string]bool{"max": true}},
+		mail:        mailer,
+		maxAttempts: 3,
+	}
+
+	report, err := runRecover(deps, RecoverOptions{})
+	if err != nil {
+		t.Fatalf("runRecover: %v", err)
+	}
+	if len(report.Results) != 1 || report.Results[0].Action != RecoveryEscalated {
+		t.Fatalf("expected escalation once attempts are exhausted, got %+v", report.Results)
+	}
+	if starter.started["max"] {
+		t.Error("expected session NOT to be restarted once attempts are exhausted")
+	}
+}
@@ -0,0 +1,229 @@
+package witness
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// syncMRIssuePattern extracts an issue ID embedded in a branch name, e.g.
+// "polecat/Nux/gt-xyz" or "gt-abc.1". Mirrors the fallback pattern
+// `gt done`/`gt mq submit` use when parsing branch names.
+var syncMRIssuePattern = regexp.MustCompile(`([a-z]+-[a-z0-9]+(?:\.[0-9]+)?)`)
+
+// SyncMRsOptions configures a SyncMRs run.
+type SyncMRsOptions struct {
+	// DryRun reports what would change without creating or updating beads.
+	DryRun bool
+}
+
+// SyncMRResult describes what SyncMRs did with a single pushed branch.
+type SyncMRResult struct {
+	Branch  string `json:"branch"`
+	Issue   string `json:"issue,omitempty"`
+	Worker  string `json:"worker,omitempty"`
+	MRID    string `json:"mr_id,omitempty"`
+	Created bool   `json:"created"`
+	Orphan  bool   `json:"orphan"`
+}
+
+// SyncMRsReport is the structured result of a SyncMRs run.
+type SyncMRsReport struct {
+	RigName string         `json:"rig_name"`
+	Results []SyncMRResult `json:"results"`
+}
+
+// Orphans returns the results with no matching issue.
+func (r *SyncMRsReport) Orphans() []SyncMRResult {
+	var orphans []SyncMRResult
+	for _, res := range r.Results {
+		if res.Orphan {
+			orphans = append(orphans, res)
+		}
+	}
+	return orphans
+}
+
+// Summary renders a short human-readable summary of the report.
+func (r *SyncMRsReport) Summary() string {
+	if len(r.Results) == 0 {
+		return fmt.Sprintf("sync-mrs %s: no pushed polecat branches found\n", r.RigName)
+	}
+	s := fmt.Sprintf("sync-mrs %s: %d branch(es) checked\n", r.RigName, len(r.Results))
+	for _, res := range r.Results {
+		switch {
+		case res.Orphan:
+			s += fmt.Sprintf("  - %s: orphan, no matching issue\n", res.Branch)
+		case res.Created:
+			s += fmt.Sprintf("  - %s: created %s for %s\n", res.Branch, res.MRID, res.Issue)
+		default:
+			s += fmt.Sprintf("  - %s: up to date (%s for %s)\n", res.Branch, res.MRID, res.Issue)
+		}
+	}
+	return s
+}
+
+// SyncMRs reconciles pushed polecat branches with MR beads. It lists
+// origin/polecat/* branches, matches each to a source issue via the issue ID
+// embedded in the branch name or, failing that, the branch's worker's
+// hooked issue, and creates or refreshes an MR bead with full MRFields
+// (branch, target, source_issue, worker, rig) so the refinery's merge queue
+// never has to invent one with no source issue attached. Branches that
+// can't be matched to an issue are reported as orphans and mailed to the
+// mayor instead of being given an ephemeral MR.
+func SyncMRs(r *rig.Rig, opts SyncMRsOptions) (*SyncMRsReport, error) {
+	// Determine the git working directory the same way the refinery's
+	// Engineer does: prefer refinery/rig worktree, fall back to mayor/rig
+	// (legacy architecture). Using r.Path directly would find the town's
+	// .git with rig-named remotes instead of "origin".
+	gitDir := filepath.Join(r.Path, "refinery", "rig")
+	if _, err := os.Stat(gitDir); os.IsNotExist(err) {
+		gitDir = filepath.Join(r.Path, "mayor", "rig")
+	}
+
+	g := git.NewGit(gitDir)
+	if err := g.Fetch("origin"); err != nil {
+		return nil, fmt.Errorf("fetching origin: %w", err)
+	}
+	branches, err := g.ListRemoteBranches("origin", "polecat/*")
+	if err != nil {
+		return nil, fmt.Errorf("listing polecat branches: %w", err)
+	}
+
+	townRoot, err := workspace.Find(r.Path)
+	if err != nil || townRoot == "" {
+		townRoot = r.Path
+	}
+	prefix := beads.GetPrefixForRig(townRoot, r.Name)
+
+	bd := beads.NewWithBeadsDir(r.Path, beads.ResolveBeadsDir(r.Path)).WithActor(witnessActor(r.Name))
+
+	defaultBranch := "main"
+	if cfg, err := rig.LoadRigConfig(r.Path); err == nil && cfg.DefaultBranch != "" {
+		defaultBranch = cfg.DefaultBranch
+	}
+
+	report := &SyncMRsReport{RigName: r.Name}
+	var orphanBranches []string
+
+	for _, branch := range branches {
+		worker, issueID := matchBranchToIssue(bd, prefix, r.Name, branch)
+		result := SyncMRResult{Branch: branch, Worker: worker, Issue: issueID}
+
+		if issueID == "" {
+			result.Orphan = true
+			report.Results = append(report.Results, result)
+			orphanBranches = append(orphanBranches, branch)
+			continue
+		}
+
+		if opts.DryRun {
+			report.Results = append(report.Results, result)
+			continue
+		}
+
+		existingMR, err := bd.FindMRForBranch(branch)
+		if err != nil {
+			return nil, fmt.Errorf("checking existing MR for %s: %w", branch, err)
+		}
+
+		mrFields := &beads.MRFields{
+			Branch:      branch,
+			Target:      defaultBranch,
+			SourceIssue: issueID,
+			Worker:      worker,
+			Rig:         r.Name,
+		}
+
+		if existingMR != nil {
+			result.MRID = existingMR.ID
+			if err := bd.UpdateMRFields(existingMR.ID, mrFields); err != nil {
+				return nil, fmt.Errorf("refreshing MR for %s: %w", branch, err)
+			}
+		} else {
+			mrIssue, err := bd.Create(beads.CreateOptions{
+				Title:       fmt.Sprintf("Merge: %s", issueID),
+				Type:        "merge-request",
+				Description: beads.FormatMRFields(mrFields),
+				Ephemeral:   true,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("creating MR for %s: %w", branch, err)
+			}
+			result.MRID = mrIssue.ID
+			result.Created = true
+		}
+
+		report.Results = append(report.Results, result)
+	}
+
+	if !opts.DryRun && len(orphanBranches) > 0 {
+		notifyOrphanBranches(r, orphanBranches)
+	}
+
+	return report, nil
+}
+
+// matchBranchToIssue determines the worker and source issue for a pushed
+// polecat branch. It first tries the issue ID conventions branch names
+// carry directly ("polecat/<worker>/<issue>[@ts]", or an issue ID pattern
+// anywhere in the name); if neither is present, it falls back to the
+// worker's agent bead hook - the same authoritative source `gt done` uses
+// when a branch name alone doesn't carry the issue ID.
+func matchBranchToIssue(bd *beads.Beads, prefix, rigName, branch string) (worker, issueID string) {
+	rest := strings.TrimPrefix(branch, "polecat/")
+
+	if parts := strings.SplitN(rest, "/", 2); len(parts) == 2 {
+		worker = parts[0]
+		issue := parts[1]
+		if at := strings.Index(issue, "@"); at > 0 {
+			issue = issue[:at]
+		}
+		return worker, issue
+	}
+
+	if dash := strings.LastIndex(rest, "-"); dash > 0 {
+		worker = rest[:dash]
+	} else {
+		worker = rest
+	}
+
+	if m := syncMRIssuePattern.FindStringSubmatch(branch); len(m) > 1 {
+		return worker, m[1]
+	}
+
+	agentBeadID := beads.PolecatBeadIDWithPrefix(prefix, rigName, worker)
+	agentBead, err := bd.Show(agentBeadID)
+	if err != nil {
+		return worker, ""
+	}
+	return worker, agentBead.HookBead
+}
+
+// notifyOrphanBranches mails the mayor about pushed branches SyncMRs
+// couldn't match to an issue. Best-effort: a notification failure
+// shouldn't fail the sync itself.
+func notifyOrphanBranches(r *rig.Rig, branches []string) {
+	router := mail.NewRouter(r.Path)
+	body := fmt.Sprintf("Found %d pushed branch(es) in %s with no matching issue or hooked work:\n\n", len(branches), r.Name)
+	for _, b := range branches {
+		body += fmt.Sprintf("  - %s\n", b)
+	}
+	body += "\nNo MR bead was created for these - push an identifiable branch name or hook the issue, then rerun 'gt witness sync-mrs'."
+
+	_ = router.Send(&mail.Message{
+		From:    fmt.Sprintf("%s/witness", r.Name),
+		To:      "mayor/",
+		Subject: fmt.Sprintf("sync-mrs: %d orphan branch(es) in %s", len(branches), r.Name),
+		Body:    body,
+		Type:    mail.TypeNotification,
+	})
+}
@@ -0,0 +1,180 @@
+package witness
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+// newSyncMRsTestRig sets up a rig directory with a real mayor/rig git repo
+// (legacy layout, so SyncMRs's gitDir fallback picks it up) and a bare
+// "origin" remote with main pushed to it, along with an isolated bd
+// database at the rig root. Returns the rig and the git repo's dir so
+// callers can push polecat branches against it.
+func newSyncMRsTestRig(t *testing.T) (*rig.Rig, string) {
+	t.Helper()
+	if _, err := exec.LookPath("bd"); err != nil {
+		t.Skip("bd not installed")
+	}
+
+	root := t.TempDir()
+	gitDir := filepath.Join(root, "mayor", "rig")
+	if err := os.MkdirAll(gitDir, 0755); err != nil {
+		t.Fatalf("mkdir gitDir: %v", err)
+	}
+
+	run := func(dir string, args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	run(gitDir, "init")
+	run(gitDir, "config", "user.email", "test@test.com")
+	run(gitDir, "config", "user.name", "Test User")
+	run(gitDir, "checkout", "-b", "main")
+	if err := os.WriteFile(filepath.Join(gitDir, "README.md"), []byte("# Test\n"), 0644); err != nil {
+		t.Fatalf("write README.md: %v", err)
+	}
+	run(gitDir, "add", ".")
+	run(gitDir, "commit", "-m", "initial")
+
+	originDir := filepath.Join(root, "origin.git")
+	if err := exec.Command("git", "init", "--bare", originDir).Run(); err != nil {
+		t.Fatalf("git init --bare: %v", err)
+	}
+	run(gitDir, "remote", "add", "origin", originDir)
+	run(gitDir, "push", "-u", "origin", "main")
+
+	bd := beads.NewIsolated(root)
+	if err := bd.Init("test"); err != nil {
+		t.Fatalf("bd init: %v", err)
+	}
+
+	return &rig.Rig{Name: "test-rig", Path: root}, gitDir
+}
+
+// pushPolecatBranch creates a polecat branch off main in gitDir and pushes
+// it to origin, leaving main checked out afterward.
+func pushPolecatBranch(t *testing.T, gitDir, branch string) {
+	t.Helper()
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = gitDir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	run("checkout", "-b", branch, "main")
+	if err := os.WriteFile(filepath.Join(gitDir, branch+".txt"), []byte("work\n"), 0644); err != nil {
+		t.Fatalf("write work file: %v", err)
+	}
+	run("add", ".")
+	run("commit", "-m", "work on "+branch)
+	run("push", "origin", branch)
+	run("checkout", "main")
+}
+
+func TestSyncMRs_MatchableAndOrphanBranches(t *testing.T) {
+	r, gitDir := newSyncMRsTestRig(t)
+
+	pushPolecatBranch(t, gitDir, "polecat/nux/gt-xyz")
+	pushPolecatBranch(t, gitDir, "polecat/ghost12345")
+
+	report, err := SyncMRs(r, SyncMRsOptions{})
+	if err != nil {
+		t.Fatalf("SyncMRs: %v", err)
+	}
+
+	if len(report.Results) != 2 {
+		t.Fatalf("len(Results) = %d, want 2", len(report.Results))
+	}
+
+	byBranch := make(map[string]SyncMRResult)
+	for _, res := range report.Results {
+		byBranch[res.Branch] = res
+	}
+
+	matched, ok := byBranch["polecat/nux/gt-xyz"]
+	if !ok {
+		t.Fatal("missing result for polecat/nux/gt-xyz")
+	}
+	if matched.Orphan {
+		t.Error("polecat/nux/gt-xyz reported as orphan, want matched")
+	}
+	if matched.Issue != "gt-xyz" {
+		t.Errorf("matched.Issue = %q, want %q", matched.Issue, "gt-xyz")
+	}
+	if matched.Worker != "nux" {
+		t.Errorf("matched.Worker = %q, want %q", matched.Worker, "nux")
+	}
+	if !matched.Created || matched.MRID == "" {
+		t.Errorf("matched branch did not get a new MR bead: %+v", matched)
+	}
+
+	orphan, ok := byBranch["polecat/ghost12345"]
+	if !ok {
+		t.Fatal("missing result for polecat/ghost12345")
+	}
+	if !orphan.Orphan {
+		t.Error("polecat/ghost12345 reported as matched, want orphan")
+	}
+	if orphan.MRID != "" {
+		t.Errorf("orphan branch got an MR bead: %q", orphan.MRID)
+	}
+
+	if len(report.Orphans()) != 1 {
+		t.Errorf("len(Orphans()) = %d, want 1", len(report.Orphans()))
+	}
+
+	// A second run should refresh the same MR bead instead of creating a
+	// duplicate.
+	report2, err := SyncMRs(r, SyncMRsOptions{})
+	if err != nil {
+		t.Fatalf("second SyncMRs: %v", err)
+	}
+	for _, res := range report2.Results {
+		if res.Branch == "polecat/nux/gt-xyz" {
+			if res.Created {
+				t.Error("second run created a new MR instead of refreshing the existing one")
+			}
+			if res.MRID != matched.MRID {
+				t.Errorf("second run MRID = %q, want %q", res.MRID, matched.MRID)
+			}
+		}
+	}
+}
+
+func TestSyncMRs_DryRunMakesNoChanges(t *testing.T) {
+	r, gitDir := newSyncMRsTestRig(t)
+	pushPolecatBranch(t, gitDir, "polecat/nux/gt-xyz")
+
+	report, err := SyncMRs(r, SyncMRsOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("SyncMRs: %v", err)
+	}
+
+	if len(report.Results) != 1 {
+		t.Fatalf("len(Results) = %d, want 1", len(report.Results))
+	}
+	if report.Results[0].MRID != "" || report.Results[0].Created {
+		t.Errorf("dry-run created an MR bead: %+v", report.Results[0])
+	}
+
+	resolvedBeads := beads.ResolveBeadsDir(r.Path)
+	bd := beads.NewWithBeadsDir(r.Path, resolvedBeads)
+	existing, err := bd.FindMRForBranch("polecat/nux/gt-xyz")
+	if err != nil {
+		t.Fatalf("FindMRForBranch: %v", err)
+	}
+	if existing != nil {
+		t.Errorf("dry-run left an MR bead behind: %s", existing.ID)
+	}
+}
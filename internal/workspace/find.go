@@ -26,16 +26,86 @@ const (
 	SecondaryMarker = "mayor"
 )
 
+// townOverride is set by the --town persistent flag (via SetTownOverride)
+// and takes precedence over GT_TOWN and cwd-based discovery in all
+// FindFromCwd* helpers below.
+var townOverride string
+
+// SetTownOverride records an explicit town reference (a workspace path or
+// a name registered in the town registry, see towns.go) for the lifetime
+// of the process. Called once from cmd.persistentPreRun when --town is
+// passed, so every command that goes through FindFromCwd* benefits
+// without needing to thread the flag through individually.
+func SetTownOverride(ref string) {
+	townOverride = ref
+}
+
+// resolveOverride returns the effective town root from, in precedence
+// order, the --town flag and the GT_TOWN environment variable. The second
+// return value is false if neither is set or resolvable, in which case
+// callers should fall back to ordinary cwd-based discovery.
+//
+// GT_TOWN is also read elsewhere (see cmd.deriveSessionName) as a bare
+// town name used for session naming, so an unresolvable GT_TOWN value is
+// treated as "not an override" rather than an error - only the explicit
+// --town flag fails hard on a bad reference.
+func resolveOverride() (string, bool) {
+	if townOverride != "" {
+		if root, err := resolveTownRef(townOverride); err == nil {
+			return root, true
+		}
+		return "", false
+	}
+	if env := os.Getenv("GT_TOWN"); env != "" {
+		if root, err := resolveTownRef(env); err == nil {
+			return root, true
+		}
+	}
+	return "", false
+}
+
+// resolveTownRef resolves a town reference that is either a literal
+// workspace path or a name registered in ~/.config/gastown/towns.json.
+func resolveTownRef(ref string) (string, error) {
+	if ok, _ := IsWorkspace(ref); ok {
+		abs, err := filepath.Abs(ref)
+		if err != nil {
+			return "", fmt.Errorf("resolving path: %w", err)
+		}
+		return abs, nil
+	}
+
+	reg, err := LoadTownRegistry()
+	if err != nil {
+		return "", err
+	}
+	if path, ok := reg.Towns[ref]; ok {
+		return path, nil
+	}
+	return "", fmt.Errorf("town %q is not a workspace path and is not registered (see `gt town list`)", ref)
+}
+
 // Find locates the town root by walking up from the given directory.
 // It prefers mayor/town.json over mayor/ directory as workspace marker.
 // When in a worktree path (polecats/ or crew/), continues to outermost workspace.
 // Does not resolve symlinks to stay consistent with os.Getwd().
+//
+// Before walking, it checks for a .gastown marker file (see MarkerFile) so
+// that polecat/crew clones created outside the town directory tree are
+// still discoverable - the upward walk alone can't find a town root that
+// isn't an ancestor of startDir.
 func Find(startDir string) (string, error) {
 	absDir, err := filepath.Abs(startDir)
 	if err != nil {
 		return "", fmt.Errorf("resolving path: %w", err)
 	}
 
+	if marker, err := FindMarker(absDir); err == nil && marker != nil && marker.TownRoot != "" {
+		if ok, _ := IsWorkspace(marker.TownRoot); ok {
+			return marker.TownRoot, nil
+		}
+	}
+
 	inWorktree := isInWorktreePath(absDir)
 	var primaryMatch, secondaryMatch string
 
@@ -85,7 +155,12 @@ func FindOrError(startDir string) (string, error) {
 }
 
 // FindFromCwd locates the town root from the current working directory.
+// A --town flag or GT_TOWN env var override, if resolvable, short-circuits
+// the cwd walk entirely; see resolveOverride.
 func FindFromCwd() (string, error) {
+	if root, ok := resolveOverride(); ok {
+		return root, nil
+	}
 	cwd, err := os.Getwd()
 	if err != nil {
 		return "", fmt.Errorf("getting current directory: %w", err)
@@ -96,6 +171,9 @@ func FindFromCwd() (string, error) {
 // FindFromCwdOrError is like FindFromCwd but returns an error if not found.
 // If getcwd fails (e.g., worktree deleted), falls back to GT_TOWN_ROOT env var.
 func FindFromCwdOrError() (string, error) {
+	if root, ok := resolveOverride(); ok {
+		return root, nil
+	}
 	cwd, err := os.Getwd()
 	if err != nil {
 		// Fallback: try GT_TOWN_ROOT env var (set by polecat sessions)
@@ -115,6 +193,10 @@ func FindFromCwdOrError() (string, error) {
 // This is useful for commands like `gt done` that need to continue even if the
 // working directory is deleted (e.g., polecat worktree nuked by Witness).
 func FindFromCwdWithFallback() (townRoot string, cwd string, err error) {
+	if root, ok := resolveOverride(); ok {
+		cwd, _ = os.Getwd()
+		return root, cwd, nil
+	}
 	cwd, err = os.Getwd()
 	if err != nil {
 		// Fallback: try GT_TOWN_ROOT env var
@@ -0,0 +1,75 @@
+package workspace
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// MarkerFile is written into the root of a polecat/crew clone by
+// polecat.Manager.Add / crew.Manager.Add (see setupWorkspaceMarker in those
+// packages). It exists so clones created outside the town directory tree
+// (e.g. on a faster disk) can still be discovered: the normal upward walk
+// in Find only works when the clone lives under the town root.
+const MarkerFile = ".gastown"
+
+// Marker records enough identity for a clone to find its way back to the
+// town it belongs to without relying on its position in the filesystem.
+type Marker struct {
+	TownRoot string `json:"town_root"`
+	Rig      string `json:"rig"`
+	Role     string `json:"role"`
+	Name     string `json:"name"`
+}
+
+// WriteMarker writes a marker file into dir, overwriting any existing one.
+func WriteMarker(dir string, m Marker) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding marker: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, MarkerFile), data, 0644); err != nil {
+		return fmt.Errorf("writing marker: %w", err)
+	}
+	return nil
+}
+
+// ReadMarker reads and parses the marker file directly in dir. It returns
+// an error if dir has no marker file.
+func ReadMarker(dir string) (*Marker, error) {
+	path := filepath.Join(dir, MarkerFile)
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return nil, err
+	}
+	var m Marker
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("parsing marker %s: %w", path, err)
+	}
+	return &m, nil
+}
+
+// FindMarker walks upward from startDir looking for a marker file, the same
+// way Find walks upward looking for the town markers. A clone's marker
+// lives at its root, so this also resolves from a subdirectory of the
+// clone. Returns (nil, nil) if no marker is found before reaching the
+// filesystem root.
+func FindMarker(startDir string) (*Marker, error) {
+	absDir, err := filepath.Abs(startDir)
+	if err != nil {
+		return nil, fmt.Errorf("resolving path: %w", err)
+	}
+
+	current := absDir
+	for {
+		if m, err := ReadMarker(current); err == nil {
+			return m, nil
+		}
+		parent := filepath.Dir(current)
+		if parent == current {
+			return nil, nil
+		}
+		current = parent
+	}
+}
@@ -0,0 +1,129 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteReadMarker(t *testing.T) {
+	dir := t.TempDir()
+	want := Marker{TownRoot: "/towns/gastown", Rig: "myrig", Role: "polecat", Name: "Toast"}
+
+	if err := WriteMarker(dir, want); err != nil {
+		t.Fatalf("WriteMarker: %v", err)
+	}
+
+	got, err := ReadMarker(dir)
+	if err != nil {
+		t.Fatalf("ReadMarker: %v", err)
+	}
+	if *got != want {
+		t.Errorf("ReadMarker = %+v, want %+v", *got, want)
+	}
+}
+
+func TestReadMarkerMissing(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := ReadMarker(dir); err == nil {
+		t.Error("ReadMarker should fail when no marker file exists")
+	}
+}
+
+func TestFindMarkerWalksUp(t *testing.T) {
+	root := realPath(t, t.TempDir())
+	want := Marker{TownRoot: "/towns/gastown", Rig: "myrig", Role: "crew", Name: "max"}
+	if err := WriteMarker(root, want); err != nil {
+		t.Fatalf("WriteMarker: %v", err)
+	}
+
+	nested := filepath.Join(root, "some", "deep", "path")
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatalf("mkdir nested: %v", err)
+	}
+
+	got, err := FindMarker(nested)
+	if err != nil {
+		t.Fatalf("FindMarker: %v", err)
+	}
+	if got == nil {
+		t.Fatal("FindMarker = nil, want marker")
+	}
+	if *got != want {
+		t.Errorf("FindMarker = %+v, want %+v", *got, want)
+	}
+}
+
+func TestFindMarkerNotFound(t *testing.T) {
+	dir := t.TempDir()
+	got, err := FindMarker(dir)
+	if err != nil {
+		t.Fatalf("FindMarker: %v", err)
+	}
+	if got != nil {
+		t.Errorf("FindMarker = %+v, want nil", got)
+	}
+}
+
+// TestFindOutOfTreeWorktreeWithMarker covers the scenario the marker exists
+// for: a polecat/crew clone created outside the town directory tree, where
+// the ordinary upward walk in Find would never reach a mayor/ marker.
+func TestFindOutOfTreeWorktreeWithMarker(t *testing.T) {
+	townRoot := realPath(t, t.TempDir())
+	mayorDir := filepath.Join(townRoot, "mayor")
+	if err := os.MkdirAll(mayorDir, 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(mayorDir, "town.json"), []byte(`{"type":"town"}`), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	// Clone lives on a completely separate temp directory tree.
+	clonePath := realPath(t, t.TempDir())
+	if err := WriteMarker(clonePath, Marker{
+		TownRoot: townRoot,
+		Rig:      "myrig",
+		Role:     "polecat",
+		Name:     "Toast",
+	}); err != nil {
+		t.Fatalf("WriteMarker: %v", err)
+	}
+
+	nested := filepath.Join(clonePath, "src", "pkg")
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatalf("mkdir nested: %v", err)
+	}
+
+	found, err := Find(nested)
+	if err != nil {
+		t.Fatalf("Find: %v", err)
+	}
+	if found != townRoot {
+		t.Errorf("Find = %q, want %q", found, townRoot)
+	}
+}
+
+func TestFindMarkerWithStaleTownRootFallsBackToWalk(t *testing.T) {
+	// A marker pointing at a town root that no longer exists (or was never
+	// valid) should not break normal discovery - Find should fall back to
+	// the upward walk.
+	root := realPath(t, t.TempDir())
+	mayorDir := filepath.Join(root, "mayor")
+	if err := os.MkdirAll(mayorDir, 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(mayorDir, "town.json"), []byte(`{"type":"town"}`), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := WriteMarker(root, Marker{TownRoot: "/no/such/town", Rig: "myrig", Role: "polecat", Name: "Toast"}); err != nil {
+		t.Fatalf("WriteMarker: %v", err)
+	}
+
+	found, err := Find(root)
+	if err != nil {
+		t.Fatalf("Find: %v", err)
+	}
+	if found != root {
+		t.Errorf("Find = %q, want %q", found, root)
+	}
+}
@@ -0,0 +1,102 @@
+package workspace
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// TownRegistry records town roots a user has named, so `--town <name>` and
+// GT_TOWN can address a town without spelling out its path. Lives outside
+// any single town at ~/.config/gastown/towns.json since its whole point is
+// to be reachable regardless of which town (if any) the cwd is under.
+type TownRegistry struct {
+	// Towns maps a short name to an absolute town root path.
+	Towns map[string]string `json:"towns"`
+
+	// Current is the name last set via `gt town use`, used as a hint by
+	// callers that want a default when neither --town nor GT_TOWN is set.
+	Current string `json:"current,omitempty"`
+}
+
+// TownRegistryFile returns the path to the town registry.
+func TownRegistryFile() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("resolving home directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "gastown", "towns.json"), nil
+}
+
+// LoadTownRegistry loads the town registry from disk.
+// Returns an empty registry if the file doesn't exist.
+func LoadTownRegistry() (*TownRegistry, error) {
+	path, err := TownRegistryFile()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is derived from the user's home directory
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &TownRegistry{Towns: make(map[string]string)}, nil
+		}
+		return nil, fmt.Errorf("reading town registry: %w", err)
+	}
+
+	var reg TownRegistry
+	if err := json.Unmarshal(data, &reg); err != nil {
+		return nil, fmt.Errorf("parsing town registry: %w", err)
+	}
+	if reg.Towns == nil {
+		reg.Towns = make(map[string]string)
+	}
+	return &reg, nil
+}
+
+// SaveTownRegistry writes the town registry to disk atomically.
+func SaveTownRegistry(reg *TownRegistry) error {
+	path, err := TownRegistryFile()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating gastown config directory: %w", err)
+	}
+	return util.AtomicWriteJSON(path, reg)
+}
+
+// Register adds or updates a named town, validating that path is actually
+// a Gas Town workspace.
+func (r *TownRegistry) Register(name, path string) error {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return fmt.Errorf("resolving path: %w", err)
+	}
+	ok, err := IsWorkspace(abs)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%s is not a Gas Town workspace", abs)
+	}
+	if r.Towns == nil {
+		r.Towns = make(map[string]string)
+	}
+	r.Towns[name] = abs
+	return nil
+}
+
+// Names returns the registered town names, sorted.
+func (r *TownRegistry) Names() []string {
+	names := make([]string, 0, len(r.Towns))
+	for name := range r.Towns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,145 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// makeWorkspace creates a minimal valid town workspace under dir and
+// returns its real (symlink-resolved) path.
+func makeWorkspace(t *testing.T, dir string) string {
+	t.Helper()
+	mayorDir := filepath.Join(dir, "mayor")
+	if err := os.MkdirAll(mayorDir, 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(mayorDir, "town.json"), []byte(`{"type":"town"}`), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	return realPath(t, dir)
+}
+
+// withCwd temporarily changes the working directory for the duration of a test.
+func withCwd(t *testing.T, dir string) {
+	t.Helper()
+	orig, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Getwd: %v", err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("Chdir: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = os.Chdir(orig)
+	})
+}
+
+func TestFindFromCwd_TownFlagOverridesEnvAndCwd(t *testing.T) {
+	cwdTown := makeWorkspace(t, t.TempDir())
+	envTown := makeWorkspace(t, t.TempDir())
+	flagTown := makeWorkspace(t, t.TempDir())
+
+	withCwd(t, cwdTown)
+	t.Setenv("GT_TOWN", envTown)
+	SetTownOverride(flagTown)
+	t.Cleanup(func() { SetTownOverride("") })
+
+	root, err := FindFromCwd()
+	if err != nil {
+		t.Fatalf("FindFromCwd: %v", err)
+	}
+	if root != flagTown {
+		t.Errorf("FindFromCwd = %q, want flag town %q", root, flagTown)
+	}
+}
+
+func TestFindFromCwd_EnvOverridesCwdWhenNoFlag(t *testing.T) {
+	cwdTown := makeWorkspace(t, t.TempDir())
+	envTown := makeWorkspace(t, t.TempDir())
+
+	withCwd(t, cwdTown)
+	t.Setenv("GT_TOWN", envTown)
+	SetTownOverride("")
+
+	root, err := FindFromCwd()
+	if err != nil {
+		t.Fatalf("FindFromCwd: %v", err)
+	}
+	if root != envTown {
+		t.Errorf("FindFromCwd = %q, want env town %q", root, envTown)
+	}
+}
+
+func TestFindFromCwd_FallsBackToCwdWhenNoOverride(t *testing.T) {
+	cwdTown := makeWorkspace(t, t.TempDir())
+
+	withCwd(t, cwdTown)
+	t.Setenv("GT_TOWN", "")
+	SetTownOverride("")
+
+	root, err := FindFromCwd()
+	if err != nil {
+		t.Fatalf("FindFromCwd: %v", err)
+	}
+	if root != cwdTown {
+		t.Errorf("FindFromCwd = %q, want cwd town %q", root, cwdTown)
+	}
+}
+
+func TestResolveOverride_UnresolvableGTTownIsIgnored(t *testing.T) {
+	cwdTown := makeWorkspace(t, t.TempDir())
+
+	withCwd(t, cwdTown)
+	// GT_TOWN is also used elsewhere as a bare town *name* for session
+	// naming (see cmd.deriveSessionName); an unregistered, non-path value
+	// must not break discovery for those callers.
+	t.Setenv("GT_TOWN", "some-town-name-not-registered")
+	SetTownOverride("")
+
+	root, err := FindFromCwd()
+	if err != nil {
+		t.Fatalf("FindFromCwd: %v", err)
+	}
+	if root != cwdTown {
+		t.Errorf("FindFromCwd = %q, want cwd town %q (GT_TOWN should be ignored)", root, cwdTown)
+	}
+}
+
+func TestResolveOverride_RegisteredTownName(t *testing.T) {
+	registeredTown := makeWorkspace(t, t.TempDir())
+	cwdTown := makeWorkspace(t, t.TempDir())
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	reg, err := LoadTownRegistry()
+	if err != nil {
+		t.Fatalf("LoadTownRegistry: %v", err)
+	}
+	if err := reg.Register("work", registeredTown); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	if err := SaveTownRegistry(reg); err != nil {
+		t.Fatalf("SaveTownRegistry: %v", err)
+	}
+
+	withCwd(t, cwdTown)
+	SetTownOverride("work")
+	t.Cleanup(func() { SetTownOverride("") })
+
+	root, err := FindFromCwd()
+	if err != nil {
+		t.Fatalf("FindFromCwd: %v", err)
+	}
+	if root != registeredTown {
+		t.Errorf("FindFromCwd = %q, want registered town %q", root, registeredTown)
+	}
+}
+
+func TestTownRegistry_RegisterRejectsNonWorkspace(t *testing.T) {
+	reg := &TownRegistry{Towns: make(map[string]string)}
+	if err := reg.Register("bogus", t.TempDir()); err == nil {
+		t.Fatal("expected Register to reject a non-workspace path")
+	}
+}
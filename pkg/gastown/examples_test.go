@@ -0,0 +1,187 @@
+package gastown_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/pkg/gastown"
+)
+
+// newExampleTown lays out the minimum a town needs to be discoverable
+// (mayor/ with an empty rigs.json) so OpenTown, Rigs, Mailbox and Events
+// can all be exercised without a real `bd` install.
+func newExampleTown(t *testing.T) string {
+	t.Helper()
+
+	root := t.TempDir()
+	mayorDir := filepath.Join(root, "mayor")
+	if err := os.MkdirAll(mayorDir, 0755); err != nil {
+		t.Fatalf("mkdir mayor: %v", err)
+	}
+
+	rigsConfig := &config.RigsConfig{Version: 1, Rigs: make(map[string]config.RigEntry)}
+	if err := config.SaveRigsConfig(filepath.Join(mayorDir, "rigs.json"), rigsConfig); err != nil {
+		t.Fatalf("save rigs.json: %v", err)
+	}
+
+	return root
+}
+
+func TestOpenTown(t *testing.T) {
+	root := newExampleTown(t)
+
+	town, err := gastown.OpenTown(root)
+	if err != nil {
+		t.Fatalf("OpenTown: %v", err)
+	}
+	if town.Root() != root {
+		t.Errorf("Root() = %q, want %q", town.Root(), root)
+	}
+}
+
+func TestTown_Rigs(t *testing.T) {
+	root := newExampleTown(t)
+
+	town, err := gastown.OpenTown(root)
+	if err != nil {
+		t.Fatalf("OpenTown: %v", err)
+	}
+
+	rigs, err := town.Rigs()
+	if err != nil {
+		t.Fatalf("Rigs: %v", err)
+	}
+	if len(rigs) != 0 {
+		t.Errorf("Rigs() = %d rigs, want 0 for an empty town", len(rigs))
+	}
+
+	if _, err := town.Rig("nonexistent"); err == nil {
+		t.Error("Rig(\"nonexistent\") should error")
+	}
+}
+
+func TestTown_Mailbox(t *testing.T) {
+	if _, err := exec.LookPath("bd"); err != nil {
+		t.Skip("bd not installed")
+	}
+
+	root := newExampleTown(t)
+
+	town, err := gastown.OpenTown(root)
+	if err != nil {
+		t.Fatalf("OpenTown: %v", err)
+	}
+
+	msgs, err := town.Mailbox("mayor").List()
+	if err != nil {
+		t.Fatalf("Mailbox(\"mayor\").List: %v", err)
+	}
+	if len(msgs) != 0 {
+		t.Errorf("List() = %d messages, want 0 for a fresh mailbox", len(msgs))
+	}
+}
+
+func TestTown_Events(t *testing.T) {
+	root := newExampleTown(t)
+
+	town, err := gastown.OpenTown(root)
+	if err != nil {
+		t.Fatalf("OpenTown: %v", err)
+	}
+
+	evts, err := town.Events(gastown.EventQuery{Limit: 10})
+	if err != nil {
+		t.Fatalf("Events: %v", err)
+	}
+	if len(evts) != 0 {
+		t.Errorf("Events() = %d events, want 0 for a town with no event log", len(evts))
+	}
+}
+
+// newExampleRig builds on newExampleTown with a registered rig whose
+// mayor clone has a real git history and an isolated bd database, so
+// Rig.Polecats and Rig.Queue have something to query. Skips if bd isn't
+// installed, matching the rest of the repo's test conventions.
+func newExampleRig(t *testing.T) (*gastown.Town, *gastown.Rig) {
+	t.Helper()
+	if _, err := exec.LookPath("bd"); err != nil {
+		t.Skip("bd not installed")
+	}
+
+	root := newExampleTown(t)
+	rigRoot := filepath.Join(root, "demo")
+	rigDir := filepath.Join(rigRoot, "mayor", "rig")
+	if err := os.MkdirAll(rigDir, 0755); err != nil {
+		t.Fatalf("mkdir rig: %v", err)
+	}
+
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = rigDir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	run("init", "--initial-branch=main")
+	run("config", "user.email", "test@test.com")
+	run("config", "user.name", "Test User")
+	if err := os.WriteFile(filepath.Join(rigDir, "README.md"), []byte("# Demo\n"), 0644); err != nil {
+		t.Fatalf("write README.md: %v", err)
+	}
+	run("add", ".")
+	run("commit", "-m", "initial")
+
+	bd := beads.NewIsolated(rigRoot)
+	if err := bd.Init("demo"); err != nil {
+		t.Fatalf("bd init: %v", err)
+	}
+
+	rigsPath := filepath.Join(root, "mayor", "rigs.json")
+	rigsConfig, err := config.LoadRigsConfig(rigsPath)
+	if err != nil {
+		t.Fatalf("load rigs.json: %v", err)
+	}
+	rigsConfig.Rigs["demo"] = config.RigEntry{GitURL: "https://example.com/demo.git"}
+	if err := config.SaveRigsConfig(rigsPath, rigsConfig); err != nil {
+		t.Fatalf("save rigs.json: %v", err)
+	}
+
+	town, err := gastown.OpenTown(root)
+	if err != nil {
+		t.Fatalf("OpenTown: %v", err)
+	}
+	r, err := town.Rig("demo")
+	if err != nil {
+		t.Fatalf("Rig(\"demo\"): %v", err)
+	}
+	return town, r
+}
+
+func TestRig_Polecats(t *testing.T) {
+	_, r := newExampleRig(t)
+
+	polecats, err := r.Polecats()
+	if err != nil {
+		t.Fatalf("Polecats: %v", err)
+	}
+	if len(polecats) != 0 {
+		t.Errorf("Polecats() = %d, want 0 for a freshly registered rig", len(polecats))
+	}
+}
+
+func TestRig_Queue(t *testing.T) {
+	_, r := newExampleRig(t)
+
+	queue, err := r.Queue()
+	if err != nil {
+		t.Fatalf("Queue: %v", err)
+	}
+	if len(queue) != 0 {
+		t.Errorf("Queue() = %d items, want 0 for a freshly registered rig", len(queue))
+	}
+}
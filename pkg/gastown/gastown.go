@@ -0,0 +1,137 @@
+// Package gastown is a read-only library facade over a Gas Town workspace.
+//
+// It exists for external tooling (dashboards, bots, CI integrations) that
+// wants to inspect a town's rigs, polecats, refinery queues, mail, and
+// event log without shelling out to gt and scraping its output. Everything
+// here is a thin wrapper over the internal packages gt itself is built on;
+// it has no dependency on cobra or internal/cmd.
+//
+// Write operations (spawning agents, sending mail, mutating queues) are
+// out of scope for now. Callers that need to mutate state should continue
+// to use gt directly.
+package gastown
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/refinery"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// Stable, cobra-free result types. These alias the internal types they
+// wrap: those types are already plain, JSON-tagged data and gain nothing
+// from being duplicated here, but the aliases let this package version
+// its public surface independently of internal/* in the future.
+type (
+	// Polecat describes a single agent assignment within a rig.
+	Polecat = polecat.Polecat
+	// QueueItem describes a pending merge request in a rig's refinery queue.
+	QueueItem = refinery.QueueItem
+	// Message is a mail message addressed to or from a town identity.
+	Message = mail.Message
+	// Event is a single entry from the town event log.
+	Event = events.Event
+	// EventQuery filters a call to Town.Events.
+	EventQuery = events.QueryOptions
+)
+
+// Town is an opened handle on a Gas Town workspace rooted at a directory
+// containing mayor/town.json. Obtain one with OpenTown.
+type Town struct {
+	root       string
+	rigsConfig *config.RigsConfig
+	git        *git.Git
+}
+
+// OpenTown resolves the Gas Town workspace containing path (searching
+// upward through parent directories, the same way gt itself does) and
+// returns a handle for inspecting it.
+func OpenTown(path string) (*Town, error) {
+	root, err := workspace.Find(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening town at %s: %w", path, err)
+	}
+
+	rigsConfig, err := config.LoadRigsConfig(constants.MayorRigsPath(root))
+	if err != nil {
+		rigsConfig = &config.RigsConfig{Rigs: make(map[string]config.RigEntry)}
+	}
+
+	return &Town{
+		root:       root,
+		rigsConfig: rigsConfig,
+		git:        git.NewGit(root),
+	}, nil
+}
+
+// Root returns the absolute path to the town's root directory.
+func (t *Town) Root() string {
+	return t.root
+}
+
+// Rig is a managed repository within a town, open for read-only queries.
+type Rig struct {
+	town *Town
+	r    *rig.Rig
+}
+
+// Name returns the rig's identifier (directory name).
+func (r *Rig) Name() string {
+	return r.r.Name
+}
+
+// Rigs returns every rig registered in the town.
+func (t *Town) Rigs() ([]*Rig, error) {
+	mgr := rig.NewManager(t.root, t.rigsConfig, t.git)
+	discovered, err := mgr.DiscoverRigs()
+	if err != nil {
+		return nil, fmt.Errorf("discovering rigs: %w", err)
+	}
+
+	rigs := make([]*Rig, 0, len(discovered))
+	for _, r := range discovered {
+		rigs = append(rigs, &Rig{town: t, r: r})
+	}
+	return rigs, nil
+}
+
+// Rig returns a single rig by name.
+func (t *Town) Rig(name string) (*Rig, error) {
+	mgr := rig.NewManager(t.root, t.rigsConfig, t.git)
+	r, err := mgr.GetRig(name)
+	if err != nil {
+		return nil, fmt.Errorf("rig %q not found: %w", name, err)
+	}
+	return &Rig{town: t, r: r}, nil
+}
+
+// Polecats lists the polecat agents assigned within the rig.
+func (r *Rig) Polecats() ([]*Polecat, error) {
+	mgr := polecat.NewManager(r.r, git.NewGit(r.r.Path), nil)
+	return mgr.List()
+}
+
+// Queue lists the rig's pending refinery merge requests, in merge order.
+func (r *Rig) Queue() ([]QueueItem, error) {
+	mgr := refinery.NewManager(r.r)
+	return mgr.Queue()
+}
+
+// Mailbox opens the mailbox for a town identity (e.g. "mayor",
+// "gastown/refinery"). The returned handle can be used to list messages;
+// it performs no I/O until a method is called.
+func (t *Town) Mailbox(identity string) *mail.Mailbox {
+	return mail.NewMailboxFromAddress(identity, t.root)
+}
+
+// Events queries the town's event log.
+func (t *Town) Events(query EventQuery) ([]Event, error) {
+	return events.Query(t.root, query)
+}